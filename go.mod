@@ -6,6 +6,7 @@ require (
 	github.com/AlecAivazis/survey/v2 v2.3.5
 	github.com/chargebee/chargebee-go v2.12.0+incompatible
 	github.com/compose-spec/compose-go v1.2.4
+	github.com/docker/go-units v0.4.0
 	github.com/dustinkirkland/golang-petname v0.0.0-20191129215211-8e5a1ed0cff0
 	github.com/getsentry/sentry-go v0.13.0
 	github.com/ghodss/yaml v1.0.0
@@ -22,6 +23,7 @@ require (
 	github.com/go-openapi/validate v0.21.0
 	github.com/go-test/deep v1.0.8
 	github.com/google/uuid v1.3.0
+	github.com/gorilla/websocket v1.4.2
 	github.com/hashicorp/go-hclog v1.2.0
 	github.com/hashicorp/go-plugin v1.4.3
 	github.com/jessevdk/go-flags v1.5.0
@@ -43,6 +45,8 @@ require (
 	go.uber.org/multierr v1.8.0 // indirect
 	go.uber.org/zap v1.21.0
 	golang.org/x/net v0.0.0-20220520000938-2e3eb7b945c2
+	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211
+	gopkg.in/square/go-jose.v2 v2.6.0
 	k8s.io/api v0.22.3
 	k8s.io/apiextensions-apiserver v0.22.3
 	k8s.io/apimachinery v0.22.3
@@ -5,6 +5,7 @@
 package app
 
 import (
+	"sync"
 	"time"
 
 	petname "github.com/dustinkirkland/golang-petname"
@@ -22,9 +23,25 @@ import (
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func createService(logger *zap.SugaredLogger, svc *models.Service) error {
+// maxEditAttempts limits edits retried when the service is changed concurrently
+const maxEditAttempts = 5
+
+// serviceNames keeps names generated for subscriptions until the service is created,
+// so a retried create request is the same request
+var serviceNames sync.Map
+
+// forgetServiceName removes the name generated for the subscription, it is called once the create request is settled
+func forgetServiceName(subscriptionId string) {
+	serviceNames.Delete(subscriptionId)
+}
+
+// createService creates the service, requests with the same idempotency key are created once
+func createService(logger *zap.SugaredLogger, svc *models.Service, idempotencyKey string) error {
 	params := service.NewServiceAddParams()
 	params.ServiceItem = svc
+	if idempotencyKey != "" {
+		params.IdempotencyKey = &idempotencyKey
+	}
 
 	apiClient, err := makeClient()
 	if err != nil {
@@ -45,24 +62,46 @@ func createService(logger *zap.SugaredLogger, svc *models.Service) error {
 	return nil
 }
 
-func editService(logger *zap.SugaredLogger, svc *models.Service) error {
-	params := service.NewServiceEditParams()
-	params.ServiceItem = svc
-	params.ServiceID = *svc.ID
-
+// editService applies the change to the current version of the service.
+// The edit is conditional on the version, it is retried with the new version if the service is changed concurrently.
+func editService(logger *zap.SugaredLogger, id string, change func(svc *models.Service) error) error {
 	apiClient, err := makeClient()
 	if err != nil {
 		return err
 	}
+	auth := httptransport.APIKeyAuth("X-Token", "header", viper.GetString(cfg.KlApiserverTokenParam))
 
-	_, err = apiClient.Service.ServiceEdit(params,
-		httptransport.APIKeyAuth("X-Token", "header", viper.GetString(cfg.KlApiserverTokenParam)))
-	if err != nil {
-		return err
-	}
+	for i := 0; i < maxEditAttempts; i++ {
+		getParams := service.NewServiceGetParams()
+		getParams.ServiceID = id
+		current, err := apiClient.Service.ServiceGet(getParams, auth)
+		if err != nil {
+			return err
+		}
 
-	logger.Infof("service is edited: %s", *svc.ID)
-	return nil
+		svc := copyServiceItem(current.Payload) // avoid read-only fields
+		if err := change(svc); err != nil {
+			return err
+		}
+
+		params := service.NewServiceEditParams()
+		params.ServiceItem = svc
+		params.ServiceID = id
+		if current.ETag != "" {
+			params.IfMatch = &current.ETag
+		}
+		_, err = apiClient.Service.ServiceEdit(params, auth)
+		if checkChangedConcurrently(err) {
+			logger.Infof("service %s is changed concurrently, retrying the edit", id)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		logger.Infof("service is edited: %s", id)
+		return nil
+	}
+	return errors.Errorf("service %s is changed concurrently, edit attempts exceeded", id)
 }
 
 func archiveService(logger *zap.SugaredLogger, serviceId string) error {
@@ -156,17 +195,29 @@ func makeClient() (*client2.ServiceAPI, error) {
 	return client2.New(r, strfmt.Default), nil
 }
 
+// checkAlreadyExists checks if the service exists or the same create request is in progress
 func checkAlreadyExists(err error) bool {
 	_, ok := err.(*service.ServiceAddConflict)
 	return ok
 }
 
-func createServiceItem() *models.Service {
-	name := petname.Generate(2, "-")
+// checkChangedConcurrently checks if the edit is rejected because the service was changed since it was read
+func checkChangedConcurrently(err error) bool {
+	switch err.(type) {
+	case *service.ServiceEditPreconditionFailed, *service.ServiceEditConflict:
+		return true
+	}
+	return false
+}
+
+func createServiceItem(subscriptionId string) *models.Service {
+	name, _ := serviceNames.LoadOrStore(subscriptionId, petname.Generate(2, "-"))
+	id := name.(string)
 	serviceType := viper.GetString(cfg.KlTypeParam)
 	return &models.Service{
-		ID:   &name,
-		Type: &serviceType,
+		ID:           &id,
+		Type:         &serviceType,
+		Subscription: subscriptionId,
 	}
 }
 
@@ -71,21 +71,22 @@ func handleSubscriptionChanged(logger *zap.SugaredLogger, event map[string]inter
 		logger.Error("Retries exceeded while trying to get service by subscription", err)
 		return http.StatusBadRequest
 	}
-	service := copyServiceItem(existingService) // avoid read-only fields
-
 	entitlements, err := retrieveSubscriptionEntitlements(subscription.Id)
 	if err != nil {
 		logger.Error("error retrieving subscription entitlements: ", err)
 		return http.StatusBadRequest
 	}
 
-	err = ApplyMapping(logger, entitlements, mapping, service)
+	err = ApplyMapping(logger, entitlements, mapping, copyServiceItem(existingService))
 	if err != nil {
 		logger.Error("error applying mapping: ", err)
 		return http.StatusBadRequest
 	}
 
-	err = editService(logger, service)
+	// the mapping is applied again to the version of the service the edit is based on
+	err = editService(logger, *existingService.ID, func(svc *models.Service) error {
+		return ApplyMapping(logger, entitlements, mapping, svc)
+	})
 	if err != nil {
 		logger.Error("edit operation error: ", err)
 		return http.StatusServiceUnavailable
@@ -104,31 +105,43 @@ func handleSubscriptionCreated(logger *zap.SugaredLogger, event map[string]inter
 	logger = logger.With("subscription id", subscription.Id)
 	logger.Infof("subscription status: %s", subscription.Status)
 
-	svc := createServiceItem()
-	svc.Subscription = subscription.Id
+	// chargebee redelivers events which are not acknowledged
+	if existing, err := getServiceBySubscriptionId(logger, subscription.Id); err == nil {
+		logger.Infof("service %s already exists", *existing.ID)
+		return http.StatusOK
+	}
+
+	svc := createServiceItem(subscription.Id)
 
 	entitlements, err := retrieveSubscriptionEntitlements(subscription.Id)
 	if err != nil {
 		logger.Error("error retrieving subscription entitlements: ", err)
+		forgetServiceName(subscription.Id)
 		return http.StatusBadRequest
 	}
 
 	err = ApplyMapping(logger, entitlements, mapping, svc)
 	if err != nil {
 		logger.Error("error applying mapping: ", err)
+		forgetServiceName(subscription.Id)
 		return http.StatusBadRequest
 	}
 
-	err = createService(logger, svc)
+	// a retried event is the same create request, it is not repeated by the apiserver
+	eventId, _ := event["id"].(string)
+	err = createService(logger, svc, eventId)
 	if err != nil && checkAlreadyExists(err) {
 		logger.Error("service already exists: ", err)
+		forgetServiceName(subscription.Id)
 		// expected behavior due to prevent retries https://www.chargebee.com/docs/2.0/events_and_webhooks.html
 		return http.StatusOK
 	} else if err != nil {
+		// the name is kept, so the retried event repeats the same create request
 		logger.Error("create operation error: ", err)
 		return http.StatusServiceUnavailable
 	}
 
+	forgetServiceName(subscription.Id)
 	return http.StatusOK
 }
 
@@ -97,3 +97,16 @@ func TestApplyMappingEmptySubscriptionItems(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestCreateServiceItemName(t *testing.T) {
+	first := createServiceItem("sub-1")
+	retried := createServiceItem("sub-1")
+	if *first.ID != *retried.ID {
+		t.Errorf("retried name does not equal: actual vs expected: %s vs %s", *retried.ID, *first.ID)
+	}
+
+	forgetServiceName("sub-1")
+	if _, kept := serviceNames.Load("sub-1"); kept {
+		t.Errorf("name of the created service is kept")
+	}
+}
@@ -3,27 +3,34 @@
 package cmd
 
 import (
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
-	errors "github.com/go-openapi/errors"
 	"github.com/go-openapi/loads"
 	"github.com/jessevdk/go-flags"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/app"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/config"
-	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations"
 
+	apiAudit "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/audit"
+
 	apiBackup "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/backup"
 
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+
 	apiRestore "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
 
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
 	apiserverMiddleware "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/net/middleware"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/util/k8s"
@@ -91,21 +98,24 @@ func Main(args []string) {
 		mainLog.Fatalw("could not get base client", "error", err)
 	}
 
-	handlers := app.New(cfg, baseClient, crdClient, logging.WithComponentLogger("server"))
+	handlers := app.New(cfg, baseClient, crdClient, k8sconf, logging.WithComponentLogger("server"))
 	api := operations.NewKuberlogicAPI(swaggerSpec)
 	// Applies when the "x-token" header is set
-	api.KeyAuth = func(token string) (*models.Principal, error) {
-		if token == os.Getenv("KUBERLOGIC_APISERVER_TOKEN") {
-			prin := models.Principal(token)
-			return &prin, nil
-		}
-		api.Logger("Access attempt with incorrect api key auth: %s", token)
-		return nil, errors.New(401, "incorrect api key auth")
-	}
+	api.KeyAuth = handlers.KeyAuthentication
+	// Applies when the "Authorization: Bearer" header is set
+	api.BearerAuth = handlers.BearerAuthentication
+	// principal scopes are checked against the requested operation
+	api.APIAuthorizer = handlers
 
+	api.AuditAuditListHandler = apiAudit.AuditListHandlerFunc(handlers.AuditListHandler)
 	api.BackupBackupAddHandler = apiBackup.BackupAddHandlerFunc(handlers.BackupAddHandler)
 	api.BackupBackupDeleteHandler = apiBackup.BackupDeleteHandlerFunc(handlers.BackupDeleteHandler)
 	api.BackupBackupListHandler = apiBackup.BackupListHandlerFunc(handlers.BackupListHandler)
+	api.PlanPlanAddHandler = apiPlan.PlanAddHandlerFunc(handlers.PlanAddHandler)
+	api.PlanPlanDeleteHandler = apiPlan.PlanDeleteHandlerFunc(handlers.PlanDeleteHandler)
+	api.PlanPlanEditHandler = apiPlan.PlanEditHandlerFunc(handlers.PlanEditHandler)
+	api.PlanPlanGetHandler = apiPlan.PlanGetHandlerFunc(handlers.PlanGetHandler)
+	api.PlanPlanListHandler = apiPlan.PlanListHandlerFunc(handlers.PlanListHandler)
 	api.RestoreRestoreAddHandler = apiRestore.RestoreAddHandlerFunc(handlers.RestoreAddHandler)
 	api.RestoreRestoreDeleteHandler = apiRestore.RestoreDeleteHandlerFunc(handlers.RestoreDeleteHandler)
 	api.RestoreRestoreListHandler = apiRestore.RestoreListHandlerFunc(handlers.RestoreListHandler)
@@ -114,13 +124,32 @@ func Main(args []string) {
 	api.ServiceServiceCredentialsUpdateHandler = apiService.ServiceCredentialsUpdateHandlerFunc(handlers.ServiceCredentialsUpdateHandler)
 	api.ServiceServiceDeleteHandler = apiService.ServiceDeleteHandlerFunc(handlers.ServiceDeleteHandler)
 	api.ServiceServiceEditHandler = apiService.ServiceEditHandlerFunc(handlers.ServiceEditHandler)
+	api.ServiceServiceEnvListHandler = apiService.ServiceEnvListHandlerFunc(handlers.ServiceEnvListHandler)
+	api.ServiceServiceEnvSetHandler = apiService.ServiceEnvSetHandlerFunc(handlers.ServiceEnvSetHandler)
+	api.ServiceServiceEnvUnsetHandler = apiService.ServiceEnvUnsetHandlerFunc(handlers.ServiceEnvUnsetHandler)
+	api.ServiceServiceExecHandler = apiService.ServiceExecHandlerFunc(handlers.ServiceExecHandler)
 	api.ServiceServiceExplainHandler = apiService.ServiceExplainHandlerFunc(handlers.ServiceExplainHandler)
 	api.ServiceServiceGetHandler = apiService.ServiceGetHandlerFunc(handlers.ServiceGetHandler)
 	api.ServiceServiceListHandler = apiService.ServiceListHandlerFunc(handlers.ServiceListHandler)
+	api.ServiceServiceListWatchHandler = apiService.ServiceListWatchHandlerFunc(handlers.ServiceListWatchHandler)
 	api.ServiceServiceLogsHandler = apiService.ServiceLogsHandlerFunc(handlers.ServiceLogsHandler)
+	api.ServiceServiceLogsFollowHandler = apiService.ServiceLogsFollowHandlerFunc(handlers.ServiceLogsFollowHandler)
+	api.ServiceServiceMetricsHandler = apiService.ServiceMetricsHandlerFunc(handlers.ServiceMetricsHandler)
+	api.ServiceServiceSecretGetHandler = apiService.ServiceSecretGetHandlerFunc(handlers.ServiceSecretGetHandler)
+	api.ServiceServiceSecretRotateHandler = apiService.ServiceSecretRotateHandlerFunc(handlers.ServiceSecretRotateHandler)
+	api.ServiceServiceSecretSetHandler = apiService.ServiceSecretSetHandlerFunc(handlers.ServiceSecretSetHandler)
 	api.ServiceServiceSecretsListHandler = apiService.ServiceSecretsListHandlerFunc(handlers.ServiceSecretsListHandler)
+	api.ServiceServiceTypeListHandler = apiService.ServiceTypeListHandlerFunc(handlers.ServiceTypeListHandler)
 	api.ServiceServiceUnarchiveHandler = apiService.ServiceUnarchiveHandlerFunc(handlers.ServiceUnarchiveHandler)
-	//api.BearerAuth = handlers.BearerAuthentication
+	api.ServiceServiceWatchHandler = apiService.ServiceWatchHandlerFunc(handlers.ServiceWatchHandler)
+	api.TokenTokenAddHandler = apiToken.TokenAddHandlerFunc(handlers.TokenAddHandler)
+	api.TokenTokenDeleteHandler = apiToken.TokenDeleteHandlerFunc(handlers.TokenDeleteHandler)
+	api.TokenTokenListHandler = apiToken.TokenListHandlerFunc(handlers.TokenListHandler)
+	api.WebhookWebhookAddHandler = apiWebhook.WebhookAddHandlerFunc(handlers.WebhookAddHandler)
+	api.WebhookWebhookDeadLetterListHandler = apiWebhook.WebhookDeadLetterListHandlerFunc(handlers.WebhookDeadLetterListHandler)
+	api.WebhookWebhookDeleteHandler = apiWebhook.WebhookDeleteHandlerFunc(handlers.WebhookDeleteHandler)
+	api.WebhookWebhookListHandler = apiWebhook.WebhookListHandlerFunc(handlers.WebhookListHandler)
+	api.WebhookWebhookPingHandler = apiWebhook.WebhookPingHandlerFunc(handlers.WebhookPingHandler)
 	api.Logger = logging.WithComponentLogger("api").Infof
 	api.ServerShutdown = handlers.OnShutdown
 	server := restapi.NewServer(api)
@@ -147,7 +176,10 @@ func Main(args []string) {
 		os.Exit(code)
 	}
 
-	h := api.Serve(nil)
+	// retried requests are replayed to authenticated principals before they are audited
+	h := api.Serve(func(next http.Handler) http.Handler {
+		return handlers.IdempotencyMiddleware(api.Context(), handlers.AuditMiddleware(next))
+	})
 	r := chi.NewRouter()
 	r.Use(apiserverMiddleware.NewLoggingMiddleware)
 	r.Use(middleware.Recoverer)
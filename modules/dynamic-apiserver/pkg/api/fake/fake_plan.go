@@ -0,0 +1,90 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ */
+
+package fake
+
+import (
+	"context"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/api"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// FakePlans implements PlanInterface
+type FakePlans struct {
+	Fake *testing.Fake
+}
+
+var (
+	planResource = schema.GroupVersionResource{Group: "kuberlogic.com", Version: "v1alpha1", Resource: "kuberlogicserviceplans"}
+	planKind     = schema.GroupVersionKind{Group: "kuberlogic.com", Version: "v1alpha1", Kind: "KuberLogicServicePlan"}
+
+	_ api.PlanInterface = &FakePlans{}
+)
+
+// Get takes name of the plan, and returns the corresponding plan object, and an error if there is any.
+func (c *FakePlans) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KuberLogicServicePlan, err error) {
+	obj, err := c.Fake.Invokes(testing.NewRootGetAction(planResource, name), &v1alpha1.KuberLogicServicePlan{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KuberLogicServicePlan), err
+}
+
+// List takes label and field selectors, and returns the list of plan objects that match those selectors.
+func (c *FakePlans) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KuberLogicServicePlanList, err error) {
+	obj, err := c.Fake.Invokes(testing.NewRootListAction(planResource, planKind, opts), &v1alpha1.KuberLogicServicePlanList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.KuberLogicServicePlanList{ListMeta: obj.(*v1alpha1.KuberLogicServicePlanList).ListMeta}
+	for _, item := range obj.(*v1alpha1.KuberLogicServicePlanList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested plan objects.
+func (c *FakePlans) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(testing.NewRootWatchAction(planResource, opts))
+}
+
+// Create takes the representation of a plan object and creates it.  Returns the server's representation of the plan object, and an error, if there is any.
+func (c *FakePlans) Create(ctx context.Context, pod *v1alpha1.KuberLogicServicePlan, opts v1.CreateOptions) (result *v1alpha1.KuberLogicServicePlan, err error) {
+	obj, err := c.Fake.Invokes(testing.NewRootCreateAction(planResource, pod), &v1alpha1.KuberLogicServicePlan{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KuberLogicServicePlan), err
+}
+
+// Delete takes name of the plan object and deletes it. Returns an error if one occurs.
+func (c *FakePlans) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.Invokes(testing.NewRootDeleteAction(planResource, name), &v1alpha1.KuberLogicServicePlan{})
+	return err
+}
+
+// Patch applies the patch and returns the patched plan object.
+func (c *FakePlans) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KuberLogicServicePlan, err error) {
+	obj, err := c.Fake.Invokes(testing.NewRootPatchSubresourceAction(planResource, name, pt, data, subresources...), &v1alpha1.KuberLogicServicePlan{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KuberLogicServicePlan), err
+}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/testing"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/api"
@@ -58,12 +59,11 @@ func (c *FakeServices) List(ctx context.Context, opts v1.ListOptions) (result *v
 	return list, err
 }
 
-//// Watch returns a watch.Interface that watches the requested services.
-//func (c *FakeServices) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-//	return c.Fake.
-//		InvokesWatch(testing.NewRootWatchAction(serviceResource, opts))
-//
-//}
+// Watch returns a watch.Interface that watches the requested services.
+func (c *FakeServices) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(serviceResource, opts))
+}
 
 // Create takes the representation of a service and creates it.  Returns the server's representation of the service, and an error, if there is any.
 func (c *FakeServices) Create(ctx context.Context, pod *v1alpha1.KuberLogicService, opts v1.CreateOptions) (result *v1alpha1.KuberLogicService, err error) {
@@ -0,0 +1,116 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ */
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// PlanGetter has a method to return a PlanInterface.
+// A group's client should implement this interface.
+type PlanGetter interface {
+	Plans() PlanInterface
+}
+
+// PlanInterface has methods to work with Kuberlogic service plans resources.
+type PlanInterface interface {
+	Create(ctx context.Context, obj *v1alpha1.KuberLogicServicePlan, opts v1.CreateOptions) (*v1alpha1.KuberLogicServicePlan, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KuberLogicServicePlan, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KuberLogicServicePlanList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KuberLogicServicePlan, err error)
+}
+
+const planK8sResource = "kuberlogicserviceplans"
+
+type plans struct {
+	restClient rest.Interface
+}
+
+var _ PlanInterface = &plans{}
+
+// NewPlans returns a plans
+func NewPlans(c rest.Interface) PlanInterface {
+	return &plans{
+		restClient: c,
+	}
+}
+
+func (r *plans) Create(ctx context.Context, obj *v1alpha1.KuberLogicServicePlan, opts v1.CreateOptions) (*v1alpha1.KuberLogicServicePlan, error) {
+	result := &v1alpha1.KuberLogicServicePlan{}
+	err := r.restClient.Post().
+		Resource(planK8sResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *plans) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (*v1alpha1.KuberLogicServicePlan, error) {
+	result := &v1alpha1.KuberLogicServicePlan{}
+	err := r.restClient.Patch(pt).
+		Resource(planK8sResource).
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (r *plans) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return r.restClient.Delete().
+		Resource(planK8sResource).
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (r *plans) Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KuberLogicServicePlan, error) {
+	result := &v1alpha1.KuberLogicServicePlan{}
+	err := r.restClient.Get().
+		Resource(planK8sResource).
+		Name(name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+func (r *plans) List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KuberLogicServicePlanList, error) {
+	result := &v1alpha1.KuberLogicServicePlanList{}
+	err := r.restClient.Get().
+		Resource(planK8sResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Watch returns a watch.Interface that watches the requested plans.
+func (r *plans) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return r.restClient.Get().
+		Resource(planK8sResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
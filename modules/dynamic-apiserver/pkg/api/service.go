@@ -6,9 +6,11 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 
@@ -27,6 +29,7 @@ type ServiceInterface interface {
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KuberLogicService, error)
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KuberLogicServiceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KuberLogicService, err error)
 }
 
@@ -97,3 +100,16 @@ func (svc *services) List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.K
 		Into(result)
 	return result, err
 }
+
+func (svc *services) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return svc.restClient.Get().
+		Resource(serviceK8sResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
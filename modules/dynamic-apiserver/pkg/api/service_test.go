@@ -44,4 +44,15 @@ func TestService(t *testing.T) {
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
+
+	w, err := c.Watch(context.TODO(), v1.ListOptions{ResourceVersion: "42"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else {
+		w.Stop()
+	}
+	query := tt.Handler().RequestReceived.URL.Query()
+	if query.Get("watch") != "true" || query.Get("resourceVersion") != "42" {
+		t.Errorf("unexpected watch query: %v", query)
+	}
 }
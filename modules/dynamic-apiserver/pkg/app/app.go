@@ -17,47 +17,163 @@
 package app
 
 import (
+	"context"
+	"net/http"
+	"os"
+
+	apierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/api"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/config"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/idempotency"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/oidc"
 )
 
 type handlers struct {
 	clientset  kubernetes.Interface
 	restClient rest.Interface
+	restConfig *rest.Config
 	log        logging.Logger
 	config     *config.Config
 
 	services ExtendedServiceInterface
 	backups  ExtendedBackupInterface
 	restores ExtendedRestoreInterface
+	plans    api.PlanInterface
+
+	// oidc validates bearer tokens, it is nil if bearer authentication is not configured
+	oidc tokenVerifier
+	// roles maps bearer token roles to scopes
+	roles map[string][]string
+	// audit records mutating and sensitive calls, it is nil if auditing is disabled
+	audit *audit.Auditor
+	// notifier delivers webhook events
+	notifier *notify.Dispatcher
+	// idempotency keeps responses of POST requests sent with idempotency keys
+	idempotency *idempotency.Store
+	// metrics reads resource usage of service pods
+	metrics metricsSource
 }
 
+// sensitiveOperations are audited even though they don't change anything
+var sensitiveOperations = []string{"serviceSecretGet", "serviceExec"}
+
+// unreplayedOperations return secrets in responses, their responses are never kept by the idempotency store
+var unreplayedOperations = map[string]bool{"tokenAdd": true}
+
 var (
 	_ Handlers              = &handlers{}
 	_ ExtendedServiceGetter = &handlers{}
 	_ ExtendedRestoreGetter = &handlers{}
 	_ ExtendedBackupGetter  = &handlers{}
+	_ PlanGetter            = &handlers{}
 )
 
 func (h *handlers) GetLogger() logging.Logger {
 	return h.log
 }
 
-func New(cfg *config.Config, clientset kubernetes.Interface, client rest.Interface, log logging.Logger) Handlers {
-	return &handlers{
+func New(cfg *config.Config, clientset kubernetes.Interface, client rest.Interface, restConfig *rest.Config, log logging.Logger) Handlers {
+	h := &handlers{
 		clientset:  clientset,
 		restClient: client,
+		restConfig: restConfig,
 		log:        log,
 		config:     cfg,
 		services:   newServices(client),
 		backups:    newBackups(client),
 		restores:   newRestores(client),
+		plans:      api.NewPlans(client),
+		metrics:    &restMetricsSource{client: clientset.CoreV1().RESTClient()},
+	}
+	if cfg.Oidc.Issuer != "" {
+		h.oidc = oidc.NewVerifier(cfg.Oidc.Issuer, cfg.Oidc.JwksUrl, cfg.Oidc.Audience, nil)
+		h.roles = h.roleScopes(cfg.Oidc.Roles)
+	}
+	h.audit = newAuditor(cfg, log)
+	h.idempotency = idempotency.New(cfg.Idempotency.Ttl, cfg.Idempotency.MaxKeys)
+	h.notifier = notify.New(h.webhookEndpoints, nil, log, notify.Options{
+		Attempts:     cfg.Notifications.Attempts,
+		Backoff:      cfg.Notifications.Backoff,
+		Timeout:      cfg.Notifications.Timeout,
+		DeadLetters:  cfg.Notifications.DeadLetters,
+		AllowedHosts: cfg.Notifications.AllowedHosts,
+	})
+	if cfg.Notifications.Enabled {
+		go h.notifier.Run(context.Background())
+		go h.watchLifecycleEvents(context.Background())
+	}
+	return h
+}
+
+func newAuditor(cfg *config.Config, log logging.Logger) *audit.Auditor {
+	var sink audit.Sink
+	switch cfg.Audit.Sink {
+	case "none":
+		return nil
+	case "file":
+		fileSink, err := audit.NewFileSink(cfg.Audit.File)
+		if err != nil {
+			log.Errorw("error opening audit file, records are written to stdout", "file", cfg.Audit.File, "error", err)
+			sink = audit.NewWriterSink(os.Stdout)
+			break
+		}
+		sink = fileSink
+	case "webhook":
+		sink = audit.NewWebhookSink(context.Background(), cfg.Audit.WebhookUrl, nil, log)
+	default:
+		sink = audit.NewWriterSink(os.Stdout)
+	}
+	return audit.New(sink, cfg.Audit.BufferSize, log, sensitiveOperations...)
+}
+
+// AuditMiddleware records audited calls, it wraps the operation executor of the API
+func (h *handlers) AuditMiddleware(next http.Handler) http.Handler {
+	if h.audit == nil {
+		return next
 	}
+	return h.audit.Middleware(next)
+}
+
+// IdempotencyMiddleware replays responses of retried POST requests, it wraps the operation executor of the API.
+// Requests are authenticated and authorized by the API context before responses are replayed, keys are scoped by the principal.
+// The original request is passed to the operation, so the principal is authenticated again and recorded by the audit.
+func (h *handlers) IdempotencyMiddleware(api *middleware.Context, next http.Handler) http.Handler {
+	if h.idempotency == nil {
+		return next
+	}
+	replayed := h.idempotency.Middleware(func(rw http.ResponseWriter, r *http.Request) (string, bool) {
+		route := middleware.MatchedRouteFrom(r)
+		principal, _, err := api.Authorize(r, route)
+		if err != nil {
+			api.Respond(rw, r, route.Produces, route, err)
+			return "", false
+		}
+		p, ok := principal.(*models.Principal)
+		if !ok || p == nil {
+			api.Respond(rw, r, route.Produces, route, apierrors.New(http.StatusForbidden, "unknown principal"))
+			return "", false
+		}
+		return p.Tenant + "/" + p.Name, true
+	}, next)
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if route == nil || route.Operation == nil || unreplayedOperations[route.Operation.ID] {
+			next.ServeHTTP(rw, r)
+			return
+		}
+		replayed.ServeHTTP(rw, r)
+	})
 }
 
 func (h *handlers) Services() ExtendedServiceInterface {
@@ -72,6 +188,10 @@ func (h *handlers) Restores() ExtendedRestoreInterface {
 	return h.restores
 }
 
+func (h *handlers) Plans() api.PlanInterface {
+	return h.plans
+}
+
 func (h *handlers) OnShutdown() {
 	defer func() {
 		_ = h.log.Sync()
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiAudit "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/audit"
+)
+
+// defaultAuditLimit is the number of returned audit records when the limit is not set
+const defaultAuditLimit = 100
+
+func (h *handlers) AuditListHandler(params apiAudit.AuditListParams, principal *models.Principal) middleware.Responder {
+	if h.audit == nil {
+		return apiAudit.NewAuditListServiceUnavailable().WithPayload(&models.Error{
+			Message: "audit is disabled",
+		})
+	}
+
+	filter := audit.Filter{
+		// tenant principals see only calls made within their tenant
+		Tenant: principalTenant(principal),
+		Limit:  defaultAuditLimit,
+	}
+	if params.Principal != nil {
+		filter.Principal = *params.Principal
+	}
+	if params.Operation != nil {
+		filter.Operation = *params.Operation
+	}
+	if params.Target != nil {
+		filter.Target = *params.Target
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+	if params.Since != nil {
+		since, err := time.Parse(time.RFC3339, *params.Since)
+		if err != nil {
+			return apiAudit.NewAuditListBadRequest().WithPayload(&models.Error{
+				Message: "invalid since time, RFC3339 format is expected: " + *params.Since,
+			})
+		}
+		filter.Since = since
+	}
+
+	records, err := h.audit.Query(filter)
+	if err != nil {
+		h.log.Errorw("error querying audit records", "error", err)
+		return apiAudit.NewAuditListServiceUnavailable().WithPayload(&models.Error{
+			Message: "error querying audit records",
+		})
+	}
+	return apiAudit.NewAuditListOK().WithPayload(models.AuditRecords(records))
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations"
+	apiAudit "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/audit"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func TestAuditList(t *testing.T) {
+	at := time.Date(2022, 5, 10, 16, 0, 0, 0, time.UTC)
+	records := []*models.AuditRecord{
+		{Time: strfmt.DateTime(at), Principal: "bootstrap", Operation: "serviceAdd", Target: "services/first"},
+		{Time: strfmt.DateTime(at.Add(time.Minute)), Principal: "customer", Tenant: "acme", Operation: "serviceAdd", Target: "services/own"},
+		{Time: strfmt.DateTime(at.Add(2 * time.Minute)), Principal: "customer", Tenant: "acme", Operation: "serviceDelete", Target: "services/own"},
+	}
+
+	h := newFakeHandlers(t)
+	checkResponse(h.AuditListHandler(apiAudit.AuditListParams{
+		HTTPRequest: &http.Request{},
+	}, nil), t, 503, &models.Error{
+		Message: "audit is disabled",
+	})
+
+	sink := audit.NewMemorySink(10)
+	for _, r := range records {
+		_ = sink.Write(r)
+	}
+	h.Handlers.(*handlers).audit = audit.New(sink, 10, &TestLog{t: t})
+
+	checkResponse(h.AuditListHandler(apiAudit.AuditListParams{
+		HTTPRequest: &http.Request{},
+	}, nil), t, 200, models.AuditRecords{records[2], records[1], records[0]})
+	checkResponse(h.AuditListHandler(apiAudit.AuditListParams{
+		HTTPRequest: &http.Request{},
+		Since:       util.StrAsPointer("2022-05-10T16:00:30Z"),
+		Limit:       util.Int64AsPointer(1),
+	}, nil), t, 200, models.AuditRecords{records[2]})
+	checkResponse(h.AuditListHandler(apiAudit.AuditListParams{
+		HTTPRequest: &http.Request{},
+		Operation:   util.StrAsPointer("serviceAdd"),
+	}, tenantPrincipal), t, 200, models.AuditRecords{records[1]})
+	checkResponse(h.AuditListHandler(apiAudit.AuditListParams{
+		HTTPRequest: &http.Request{},
+		Since:       util.StrAsPointer("yesterday"),
+	}, nil), t, 400, &models.Error{
+		Message: "invalid since time, RFC3339 format is expected: yesterday",
+	})
+}
+
+func TestAuditDeleteChanges(t *testing.T) {
+	h := newFakeHandlers(t, testTokenSecret("deploy", "deploy.secret", nil, "services:write"))
+	sink := audit.NewMemorySink(10)
+	auditor := audit.New(sink, 10, &TestLog{t: t})
+
+	spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewKuberlogicAPI(spec)
+	api.KeyAuth = h.KeyAuthentication
+	api.APIAuthorizer = h
+	api.TokenTokenDeleteHandler = apiToken.TokenDeleteHandlerFunc(h.TokenDeleteHandler)
+	srv := api.Serve(auditor.Middleware)
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/tokens/deploy/", nil)
+	r.Header.Set("X-Token", testBootstrapToken)
+	rw := httptest.NewRecorder()
+	srv.ServeHTTP(rw, r)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("status does not equal: actual vs expected: %d vs %d: %s", rw.Code, http.StatusOK, rw.Body.String())
+	}
+
+	records, err := sink.Query(audit.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("records count does not equal: actual vs expected: %d vs 1", len(records))
+	}
+	expected := []*models.AuditChange{
+		{Field: "created_at", Old: `"0001-01-01T00:00:00.000Z"`},
+		{Field: "name", Old: `"deploy"`},
+		{Field: "scopes", Old: `["services:write"]`},
+	}
+	if !reflect.DeepEqual(records[0].Changes, expected) {
+		actual, _ := json.Marshal(records[0].Changes)
+		t.Errorf("changes do not equal expected: %s", actual)
+	}
+}
@@ -0,0 +1,264 @@
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	// scopeAdmin grants access to all operations
+	scopeAdmin = "admin"
+	// scopeReadOnly grants read access to all resources but tokens, webhooks and the audit log
+	scopeReadOnly = "read-only"
+
+	tokensResource   = "tokens"
+	auditResource    = "audit"
+	webhooksResource = "webhooks"
+
+	actionRead  = "read"
+	actionWrite = "write"
+	wildcard    = "*"
+
+	// bootstrapPrincipal is the name of the principal authenticated by the apiserver token from the config
+	bootstrapPrincipal = "bootstrap"
+
+	authenticationTimeout = 10 * time.Second
+)
+
+// operationPermissions maps operations to <resource>:<action> permissions required to call them.
+// Operations missing here are available only with the admin scope.
+var operationPermissions = map[string]string{
+	"backupList":   "backups:read",
+	"backupAdd":    "backups:write",
+	"backupDelete": "backups:write",
+
+	"restoreList":   "backups:read",
+	"restoreAdd":    "backups:write",
+	"restoreDelete": "backups:write",
+
+	"planList":   "plans:read",
+	"planGet":    "plans:read",
+	"planAdd":    "plans:write",
+	"planEdit":   "plans:write",
+	"planDelete": "plans:write",
+
+	"serviceList":              "services:read",
+	"serviceListWatch":         "services:read",
+	"serviceGet":               "services:read",
+	"serviceWatch":             "services:read",
+	"serviceExplain":           "services:read",
+	"serviceMetrics":           "services:read",
+	"serviceLogs":              "services:read",
+	"serviceLogsFollow":        "services:read",
+	"serviceAdd":               "services:write",
+	"serviceEdit":              "services:write",
+	"serviceDelete":            "services:write",
+	"serviceArchive":           "services:write",
+	"serviceUnarchive":         "services:write",
+	"serviceCredentialsUpdate": "services:write",
+	"serviceSecretsList":       "services:read",
+	"serviceSecretGet":         "services:write",
+	"serviceSecretSet":         "services:write",
+	"serviceSecretRotate":      "services:write",
+	"serviceEnvList":           "services:read",
+	"serviceEnvSet":            "services:write",
+	"serviceEnvUnset":          "services:write",
+	"serviceExec":              "services:write",
+	"serviceTypeList":          "services:read",
+
+	"tokenList":   tokensResource + ":read",
+	"tokenAdd":    tokensResource + ":write",
+	"tokenDelete": tokensResource + ":write",
+
+	"auditList": auditResource + ":read",
+
+	"webhookList":           webhooksResource + ":read",
+	"webhookDeadLetterList": webhooksResource + ":read",
+	"webhookAdd":            webhooksResource + ":write",
+	"webhookDelete":         webhooksResource + ":write",
+	"webhookPing":           webhooksResource + ":write",
+}
+
+// privateResources are not readable with the read-only scope, webhook urls may keep credentials of receivers
+var privateResources = map[string]bool{
+	tokensResource:   true,
+	auditResource:    true,
+	webhooksResource: true,
+}
+
+// validateScopes returns an error if any of scopes is unknown
+func validateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	resources := map[string]bool{wildcard: true}
+	for _, permission := range operationPermissions {
+		resources[strings.SplitN(permission, ":", 2)[0]] = true
+	}
+	for _, scope := range scopes {
+		if scope == scopeAdmin || scope == scopeReadOnly {
+			continue
+		}
+		parts := strings.SplitN(scope, ":", 2)
+		if len(parts) != 2 || !resources[parts[0]] || (parts[1] != actionRead && parts[1] != actionWrite && parts[1] != wildcard) {
+			return errors.Errorf("unknown scope: %s", scope)
+		}
+	}
+	return nil
+}
+
+// scopesAllow checks if any of scopes grants the <resource>:<action> permission.
+// Write access to a resource includes read access to it.
+func scopesAllow(scopes []string, permission string) bool {
+	parts := strings.SplitN(permission, ":", 2)
+	resource, action := parts[0], parts[1]
+	for _, scope := range scopes {
+		switch scope {
+		case scopeAdmin:
+			return true
+		case scopeReadOnly:
+			if action == actionRead && !privateResources[resource] {
+				return true
+			}
+			continue
+		}
+
+		granted := strings.SplitN(scope, ":", 2)
+		if len(granted) != 2 || (granted[0] != resource && granted[0] != wildcard) {
+			continue
+		}
+		if granted[1] == wildcard || granted[1] == action || (granted[1] == actionWrite && action == actionRead) {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeGrantable checks if a principal with scopes can create a token with the scope.
+// Tokens can't get more access than their creator, only admins can grant the admin scope.
+func scopeGrantable(scopes []string, scope string) bool {
+	if scope == scopeAdmin {
+		for _, s := range scopes {
+			if s == scopeAdmin {
+				return true
+			}
+		}
+		return false
+	}
+
+	resources := make(map[string]bool)
+	for _, permission := range operationPermissions {
+		resources[strings.SplitN(permission, ":", 2)[0]] = true
+	}
+	if scope == scopeReadOnly {
+		for resource := range resources {
+			if !privateResources[resource] && !scopesAllow(scopes, resource+":"+actionRead) {
+				return false
+			}
+		}
+		return true
+	}
+
+	parts := strings.SplitN(scope, ":", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	resource, action := parts[0], parts[1]
+	// write access includes read access, so the wildcard action is covered by write access
+	if action == wildcard {
+		action = actionWrite
+	}
+	if resource != wildcard {
+		return scopesAllow(scopes, resource+":"+action)
+	}
+	for resource := range resources {
+		if !scopesAllow(scopes, resource+":"+action) {
+			return false
+		}
+	}
+	return true
+}
+
+// KeyAuthentication returns the principal authenticated by the "x-token" header value.
+// The apiserver token from the config grants admin access, other tokens are looked up in the token secrets.
+func (h *handlers) KeyAuthentication(token string) (*models.Principal, error) {
+	if h.config.ApiserverToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(h.config.ApiserverToken)) == 1 {
+		return &models.Principal{Name: bootstrapPrincipal, Scopes: []string{scopeAdmin}}, nil
+	}
+
+	name, ok := parseTokenValue(token)
+	if !ok {
+		h.log.Warnw("access attempt with incorrect api key auth")
+		return nil, apierrors.New(http.StatusUnauthorized, "incorrect api key auth")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), authenticationTimeout)
+	defer cancel()
+	secret, err := h.clientset.CoreV1().Secrets(h.config.Namespace).Get(ctx, tokenSecretName(name), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		h.log.Warnw("access attempt with unknown api token", "token", name)
+		return nil, apierrors.New(http.StatusUnauthorized, "incorrect api key auth")
+	} else if err != nil {
+		h.log.Errorw("error getting api token", "token", name, "error", err)
+		return nil, apierrors.New(http.StatusServiceUnavailable, "error authenticating api token")
+	}
+	if secret.Labels[tokenLabel] != name || !tokenMatches(secret, token) {
+		h.log.Warnw("access attempt with incorrect api token", "token", name)
+		return nil, apierrors.New(http.StatusUnauthorized, "incorrect api key auth")
+	}
+
+	t, err := secretToToken(secret)
+	if err != nil {
+		h.log.Errorw("error decoding api token", "token", name, "error", err)
+		return nil, apierrors.New(http.StatusServiceUnavailable, "error authenticating api token")
+	}
+	if t.ExpiresAt != nil && time.Now().After(time.Time(*t.ExpiresAt)) {
+		return nil, apierrors.New(http.StatusUnauthorized, "api token is expired")
+	}
+	return &models.Principal{Name: name, Scopes: t.Scopes, Tenant: t.Tenant}, nil
+}
+
+// Authorize checks that the authenticated principal scopes allow the requested operation
+func (h *handlers) Authorize(r *http.Request, principal interface{}) error {
+	p, ok := principal.(*models.Principal)
+	if !ok || p == nil {
+		return apierrors.New(http.StatusForbidden, "unknown principal")
+	}
+	audit.SetPrincipal(r.Context(), p.Name, p.Tenant)
+
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil || route.Operation == nil {
+		return apierrors.New(http.StatusForbidden, "unknown operation")
+	}
+	operation := route.Operation.ID
+	permission, ok := operationPermissions[operation]
+	if !ok {
+		permission = scopeAdmin + ":" + operation
+	}
+
+	if !scopesAllow(p.Scopes, permission) {
+		h.log.Warnw("access denied", "principal", p.Name, "operation", operation, "permission", permission)
+		return apierrors.New(http.StatusForbidden, "%s permission is required", permission)
+	}
+	return nil
+}
+
+// principalName returns the name of the principal for logs
+func principalName(p *models.Principal) string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/idempotency"
+)
+
+func TestKeyAuthentication(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	valid := time.Now().Add(time.Hour)
+	objects := []runtime.Object{
+		testTokenSecret("ci", "ci.secret", &valid, "services:write"),
+		testTokenSecret("old", "old.secret", &expired, "read-only"),
+	}
+
+	cases := []struct {
+		name      string
+		token     string
+		principal *models.Principal
+		code      int32
+	}{
+		{name: "bootstrap", token: testBootstrapToken, principal: &models.Principal{Name: "bootstrap", Scopes: []string{"admin"}}},
+		{name: "token", token: "ci.secret", principal: &models.Principal{Name: "ci", Scopes: []string{"services:write"}}},
+		{name: "wrong-secret", token: "ci.other", code: 401},
+		{name: "unknown-token", token: "new.secret", code: 401},
+		{name: "malformed", token: "secret", code: 401},
+		{name: "empty", token: "", code: 401},
+		{name: "expired", token: "old.secret", code: 401},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			principal, err := newFakeHandlers(t, objects...).KeyAuthentication(tc.token)
+			if tc.code != 0 {
+				if e, ok := err.(apierrors.Error); !ok || e.Code() != tc.code {
+					t.Fatalf("expected error with code %d, got %v", tc.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(principal, tc.principal) {
+				t.Errorf("principal does not equal: actual vs expected\n%v\n%v", principal, tc.principal)
+			}
+		})
+	}
+}
+
+func TestScopesAllow(t *testing.T) {
+	cases := []struct {
+		scopes     []string
+		permission string
+		allowed    bool
+	}{
+		{[]string{"admin"}, "tokens:write", true},
+		{[]string{"admin"}, "admin:anything", true},
+		{[]string{"read-only"}, "services:read", true},
+		{[]string{"read-only"}, "services:write", false},
+		{[]string{"read-only"}, "admin:anything", false},
+		{[]string{"read-only"}, "tokens:read", false},
+		{[]string{"read-only"}, "audit:read", false},
+		{[]string{"services:write"}, "services:write", true},
+		{[]string{"services:write"}, "services:read", true},
+		{[]string{"services:write"}, "backups:read", false},
+		{[]string{"services:read"}, "services:write", false},
+		{[]string{"backups:*"}, "backups:write", true},
+		{[]string{"*:read"}, "tokens:read", true},
+		{[]string{"services:read", "backups:write"}, "backups:write", true},
+		{nil, "services:read", false},
+	}
+	for _, tc := range cases {
+		if allowed := scopesAllow(tc.scopes, tc.permission); allowed != tc.allowed {
+			t.Errorf("scopes %v allow %s: expected %v, got %v", tc.scopes, tc.permission, tc.allowed, allowed)
+		}
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	for _, scopes := range [][]string{{"admin"}, {"read-only"}, {"services:write", "backups:*"}, {"*:read"}, {"tokens:read"}} {
+		if err := validateScopes(scopes); err != nil {
+			t.Errorf("scopes %v are expected to be valid: %v", scopes, err)
+		}
+	}
+	for _, scopes := range [][]string{nil, {"write"}, {"pods:read"}, {"services:delete"}, {"services"}} {
+		if err := validateScopes(scopes); err == nil {
+			t.Errorf("scopes %v are expected to be invalid", scopes)
+		}
+	}
+}
+
+func TestOperationPermissions(t *testing.T) {
+	spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, paths := range spec.Analyzer.Operations() {
+		for _, op := range paths {
+			if _, ok := operationPermissions[op.ID]; !ok {
+				t.Errorf("operation %s has no permission", op.ID)
+			}
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	readOnly := time.Now().Add(time.Hour)
+	h := newFakeHandlers(t,
+		testTokenSecret("viewer", "viewer.secret", &readOnly, "read-only"),
+		testTokenSecret("ci", "ci.secret", nil, "services:write"),
+	)
+
+	spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewKuberlogicAPI(spec)
+	api.KeyAuth = h.KeyAuthentication
+	api.APIAuthorizer = h
+	api.ServiceServiceListHandler = apiService.ServiceListHandlerFunc(h.ServiceListHandler)
+	api.ServiceServiceDeleteHandler = apiService.ServiceDeleteHandlerFunc(h.ServiceDeleteHandler)
+	api.TokenTokenListHandler = apiToken.TokenListHandlerFunc(h.TokenListHandler)
+	srv := api.Serve(nil)
+
+	cases := []struct {
+		method, path, token string
+		status              int
+	}{
+		{http.MethodGet, "/api/v1/services/", "", http.StatusUnauthorized},
+		{http.MethodGet, "/api/v1/services/", "viewer.wrong", http.StatusUnauthorized},
+		{http.MethodGet, "/api/v1/services/", "viewer.secret", http.StatusOK},
+		{http.MethodDelete, "/api/v1/services/demo/", "viewer.secret", http.StatusForbidden},
+		{http.MethodGet, "/api/v1/tokens/", "viewer.secret", http.StatusForbidden},
+		{http.MethodGet, "/api/v1/services/", "ci.secret", http.StatusOK},
+		{http.MethodDelete, "/api/v1/services/demo/", "ci.secret", http.StatusNotFound},
+		{http.MethodGet, "/api/v1/tokens/", "ci.secret", http.StatusForbidden},
+		{http.MethodGet, "/api/v1/tokens/", testBootstrapToken, http.StatusOK},
+	}
+	for _, tc := range cases {
+		t.Run(tc.method+" "+tc.path+" "+strings.Split(tc.token, ".")[0], func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.token != "" {
+				r.Header.Set("X-Token", tc.token)
+			}
+			rw := httptest.NewRecorder()
+			srv.ServeHTTP(rw, r)
+			if rw.Code != tc.status {
+				t.Errorf("status does not equal: actual vs expected: %d vs %d: %s", rw.Code, tc.status, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	h := newFakeHandlers(t,
+		testTokenSecret("ci", "ci.secret", nil, "services:write"),
+	)
+	h.Handlers.(*handlers).idempotency = idempotency.New(time.Hour, 10)
+
+	spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewKuberlogicAPI(spec)
+	api.KeyAuth = h.KeyAuthentication
+	api.APIAuthorizer = h
+	api.TokenTokenAddHandler = apiToken.TokenAddHandlerFunc(h.TokenAddHandler)
+	api.WebhookWebhookAddHandler = apiWebhook.WebhookAddHandlerFunc(h.WebhookAddHandler)
+	srv := api.Serve(func(next http.Handler) http.Handler {
+		return h.IdempotencyMiddleware(api.Context(), next)
+	})
+
+	cases := []struct {
+		name, path, token, key, body string
+		status                       int
+		replayed                     bool
+	}{
+		{"webhook-created", "/api/v1/webhooks/", testBootstrapToken, "hook", `{"name":"hook","url":"https://example.com/hook"}`, http.StatusCreated, false},
+		{"webhook-replayed", "/api/v1/webhooks/", testBootstrapToken, "hook", `{"name":"hook","url":"https://example.com/hook"}`, http.StatusCreated, true},
+		{"unauthenticated", "/api/v1/webhooks/", "ci.wrong", "hook", `{"name":"hook","url":"https://example.com/hook"}`, http.StatusUnauthorized, false},
+		{"unauthorized", "/api/v1/webhooks/", "ci.secret", "hook", `{"name":"hook","url":"https://example.com/hook"}`, http.StatusForbidden, false},
+		{"token-created", "/api/v1/tokens/", testBootstrapToken, "token", `{"name":"deploy","scopes":["read-only"]}`, http.StatusCreated, false},
+		{"token-not-replayed", "/api/v1/tokens/", testBootstrapToken, "token", `{"name":"deploy","scopes":["read-only"]}`, http.StatusConflict, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("X-Token", tc.token)
+			r.Header.Set(idempotency.KeyHeader, tc.key)
+			rw := httptest.NewRecorder()
+			srv.ServeHTTP(rw, r)
+			if rw.Code != tc.status {
+				t.Errorf("status does not equal: actual vs expected: %d vs %d: %s", rw.Code, tc.status, rw.Body.String())
+			}
+			if replayed := rw.Header().Get(idempotency.ReplayedHeader) != ""; replayed != tc.replayed {
+				t.Errorf("replayed does not equal: actual vs expected: %t vs %t", replayed, tc.replayed)
+			}
+		})
+	}
+}
@@ -5,19 +5,20 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiBackup "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/backup"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func (h *handlers) BackupAddHandler(params apiBackup.BackupAddParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) BackupAddHandler(params apiBackup.BackupAddParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	klb := util.BackupToKuberlogic(params.BackupItem)
 	serviceName := klb.Spec.KuberlogicServiceName
-	if _, err := h.Services().Get(ctx, serviceName, v1.GetOptions{}); k8serrors.IsNotFound(err) {
+	audit.SetTarget(ctx, "services/"+serviceName)
+	if _, err := h.getService(ctx, principal, serviceName); k8serrors.IsNotFound(err) {
 		return apiBackup.NewBackupAddBadRequest().WithPayload(&models.Error{
 			Message: fmt.Sprintf("service `%s` not found", serviceName),
 		})
@@ -38,5 +39,8 @@ func (h *handlers) BackupAddHandler(params apiBackup.BackupAddParams, _ *models.
 			Message: err.Error(),
 		})
 	}
-	return apiBackup.NewBackupAddCreated().WithPayload(util.KuberlogicToBackup(klb))
+	audit.SetTarget(ctx, "backups/"+klb.GetName())
+	backup := util.KuberlogicToBackup(klb)
+	audit.SetChanges(ctx, audit.Changes(nil, backup))
+	return apiBackup.NewBackupAddCreated().WithPayload(backup)
 }
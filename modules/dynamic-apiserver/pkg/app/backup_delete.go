@@ -8,9 +8,20 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func (h *handlers) BackupDeleteHandler(params apiBackup.BackupDeleteParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) BackupDeleteHandler(params apiBackup.BackupDeleteParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
+	if _, err := h.getBackup(ctx, principal, params.BackupID); errors.IsNotFound(err) {
+		return apiBackup.NewBackupDeleteNotFound().WithPayload(&models.Error{
+			Message: "backup not found: " + params.BackupID,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting klb", "error", err, "name", params.BackupID)
+		return apiBackup.NewBackupDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error deleting backup",
+		})
+	}
+
 	if err := h.Backups().Delete(ctx, params.BackupID, v1.DeleteOptions{}); errors.IsNotFound(err) {
 		return apiBackup.NewBackupDeleteNotFound().WithPayload(&models.Error{
 			Message: "backup not found: " + params.BackupID,
@@ -1,30 +1,75 @@
 package app
 
 import (
+	"context"
+
 	"github.com/go-openapi/runtime/middleware"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiBackup "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/backup"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) BackupListHandler(params apiBackup.BackupListParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) BackupListHandler(params apiBackup.BackupListParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	opts := h.ListOptionsByKeyValue(util.BackupRestoreServiceField, params.ServiceID)
-	r, err := h.Backups().List(ctx, opts)
+	page, err := newPageRequest(params.Limit, params.Continue, params.Sort, nil)
+	if err != nil {
+		return apiBackup.NewBackupListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	filter, err := newListFilter(params.CreatedAfter, params.CreatedBefore)
+	if err != nil {
+		return apiBackup.NewBackupListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	services, err := h.tenantServices(ctx, principal)
 	if err != nil {
+		msg := "error listing tenant services"
+		h.log.Errorw(msg, "error", err)
+		return apiBackup.NewBackupListServiceUnavailable().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+	filter = append(filter, func(obj metav1.Object) bool {
+		klb := obj.(*v1alpha1.KuberlogicServiceBackup)
+		return (services == nil || services[klb.Spec.KuberlogicServiceName]) &&
+			(params.Status == nil || klb.Status.Phase == *params.Status)
+	})
+
+	opts := h.ListOptionsByKeyValue(util.BackupRestoreServiceField, params.ServiceID)
+	r, next, err := listPage(ctx, opts, page, filter, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error) {
+		list, err := h.Backups().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]metav1.Object, 0, len(list.Items))
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+		return items, list.Continue, nil
+	})
+	if _, ok := err.(*listRequestError); ok {
+		return apiBackup.NewBackupListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	} else if err != nil {
 		msg := "error listing backups"
-		h.log.Errorw(msg)
+		h.log.Errorw(msg, "error", err)
 		return apiBackup.NewBackupListServiceUnavailable().WithPayload(&models.Error{
 			Message: msg,
 		})
 	}
-	h.log.Debugw("found kuberlogicservicebackups objects", "count", len(r.Items), "objects", r)
+	h.log.Debugw("found kuberlogicservicebackups objects", "count", len(r))
 
 	items := make([]*models.Backup, 0)
-	for _, klb := range r.Items {
-		items = append(items, util.KuberlogicToBackup(&klb))
+	for _, klb := range r {
+		items = append(items, util.KuberlogicToBackup(klb.(*v1alpha1.KuberlogicServiceBackup)))
 	}
-	return apiBackup.NewBackupListOK().WithPayload(items)
+	return apiBackup.NewBackupListOK().WithPayload(items).WithXContinue(next)
 }
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	apierrors "github.com/go-openapi/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/oidc"
+)
+
+// tokenVerifier validates bearer tokens and returns their claims
+type tokenVerifier interface {
+	Verify(ctx context.Context, token string) (oidc.Claims, error)
+}
+
+// roleScopes parses <role>=<scope> mappings, mappings with unknown scopes are skipped
+func (h *handlers) roleScopes(mappings []string) map[string][]string {
+	result := make(map[string][]string)
+	for _, mapping := range mappings {
+		parts := strings.SplitN(mapping, "=", 2)
+		if len(parts) != 2 {
+			h.log.Warnw("invalid oidc role mapping", "mapping", mapping)
+			continue
+		}
+		if err := validateScopes(parts[1:]); err != nil {
+			h.log.Warnw("invalid oidc role mapping", "mapping", mapping, "error", err)
+			continue
+		}
+		result[parts[0]] = append(result[parts[0]], parts[1])
+	}
+	return result
+}
+
+// BearerAuthentication returns the principal authenticated by an OIDC token.
+// Token roles are mapped to scopes with the roles mapping from the config.
+func (h *handlers) BearerAuthentication(token string, _ []string) (*models.Principal, error) {
+	if h.oidc == nil {
+		return nil, apierrors.New(http.StatusUnauthorized, "bearer authentication is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), authenticationTimeout)
+	defer cancel()
+	claims, err := h.oidc.Verify(ctx, token)
+	if err != nil {
+		h.log.Warnw("access attempt with invalid bearer token", "error", err)
+		return nil, apierrors.New(http.StatusUnauthorized, "invalid bearer token")
+	}
+
+	principal := &models.Principal{Scopes: []string{}}
+	if names := claims.Strings(h.config.Oidc.UsernameClaim); len(names) > 0 {
+		principal.Name = names[0]
+	}
+	if principal.Name == "" {
+		return nil, apierrors.New(http.StatusUnauthorized, "bearer token has no %s claim", h.config.Oidc.UsernameClaim)
+	}
+	if claim := h.config.Oidc.TenantClaim; claim != "" {
+		if tenants := claims.Strings(claim); len(tenants) > 0 {
+			principal.Tenant = tenants[0]
+		}
+		if principal.Tenant == "" {
+			return nil, apierrors.New(http.StatusUnauthorized, "bearer token has no %s claim", claim)
+		}
+	}
+
+	granted := make(map[string]bool)
+	for _, role := range claims.Strings(h.config.Oidc.RolesClaim) {
+		for _, scope := range h.roles[role] {
+			if !granted[scope] {
+				granted[scope] = true
+				principal.Scopes = append(principal.Scopes, scope)
+			}
+		}
+	}
+	return principal, nil
+}
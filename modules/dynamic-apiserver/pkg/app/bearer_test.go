@@ -0,0 +1,191 @@
+package app
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	apierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/oidc"
+)
+
+// testIssuer signs tokens with a local key and serves the key with a stub JWKS endpoint
+type testIssuer struct {
+	url string
+	key jose.JSONWebKey
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &testIssuer{key: jose.JSONWebKey{Key: key, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig"}}
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(rw).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{issuer.key.Public()}})
+	}))
+	t.Cleanup(srv.Close)
+	issuer.url = srv.URL
+	return issuer
+}
+
+func (i *testIssuer) token(t *testing.T, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: i.key}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer: i.url,
+		Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// withIssuer configures bearer authentication of the handlers with the issuer
+func withIssuer(h *FakeHandlers, issuer *testIssuer, roles ...string) {
+	base := h.Handlers.(*handlers)
+	base.config.Oidc.Issuer = issuer.url
+	base.config.Oidc.UsernameClaim = "email"
+	base.config.Oidc.RolesClaim = "realm_access.roles"
+	base.oidc = oidc.NewVerifier(issuer.url, issuer.url, "", nil)
+	base.roles = base.roleScopes(roles)
+}
+
+func TestBearerAuthentication(t *testing.T) {
+	issuer := newTestIssuer(t)
+	h := newFakeHandlers(t)
+	withIssuer(h, issuer, "ops=services:write", "ops=backups:*", "viewers=read-only", "ops=services:delete", "broken")
+
+	cases := []struct {
+		name      string
+		token     string
+		principal *models.Principal
+	}{
+		{
+			name: "many-roles",
+			token: issuer.token(t, map[string]interface{}{
+				"email":        "ops@example.com",
+				"realm_access": map[string]interface{}{"roles": []string{"ops", "viewers", "unknown"}},
+			}),
+			principal: &models.Principal{Name: "ops@example.com", Scopes: []string{"services:write", "backups:*", "read-only"}},
+		},
+		{
+			name:      "no-roles",
+			token:     issuer.token(t, map[string]interface{}{"email": "user@example.com"}),
+			principal: &models.Principal{Name: "user@example.com", Scopes: []string{}},
+		},
+		{
+			name:  "no-username",
+			token: issuer.token(t, map[string]interface{}{"realm_access": map[string]interface{}{"roles": []string{"ops"}}}),
+		},
+		{
+			name:  "invalid",
+			token: "invalid",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			principal, err := h.BearerAuthentication(tc.token, nil)
+			if tc.principal == nil {
+				if e, ok := err.(apierrors.Error); !ok || e.Code() != http.StatusUnauthorized {
+					t.Fatalf("expected unauthorized error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(principal, tc.principal) {
+				t.Errorf("principal does not equal: actual vs expected\n%v\n%v", principal, tc.principal)
+			}
+		})
+	}
+}
+
+func TestBearerAuthenticationNotConfigured(t *testing.T) {
+	_, err := newFakeHandlers(t).BearerAuthentication("token", nil)
+	if e, ok := err.(apierrors.Error); !ok || e.Code() != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestBearerAuthenticationTenant(t *testing.T) {
+	issuer := newTestIssuer(t)
+	h := newFakeHandlers(t)
+	withIssuer(h, issuer, "customer=services:write")
+	h.Handlers.(*handlers).config.Oidc.TenantClaim = "org"
+
+	principal, err := h.BearerAuthentication(issuer.token(t, map[string]interface{}{
+		"email":        "user@example.com",
+		"org":          "acme",
+		"realm_access": map[string]interface{}{"roles": []string{"customer"}},
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &models.Principal{Name: "user@example.com", Scopes: []string{"services:write"}, Tenant: "acme"}
+	if !reflect.DeepEqual(principal, expected) {
+		t.Errorf("principal does not equal: actual vs expected\n%v\n%v", principal, expected)
+	}
+
+	_, err = h.BearerAuthentication(issuer.token(t, map[string]interface{}{"email": "user@example.com"}), nil)
+	if e, ok := err.(apierrors.Error); !ok || e.Code() != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized error for token without tenant, got %v", err)
+	}
+}
+
+func TestBearerAuthorize(t *testing.T) {
+	issuer := newTestIssuer(t)
+	h := newFakeHandlers(t)
+	withIssuer(h, issuer, "viewers=read-only")
+
+	spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewKuberlogicAPI(spec)
+	api.KeyAuth = h.KeyAuthentication
+	api.BearerAuth = h.BearerAuthentication
+	api.APIAuthorizer = h
+	api.ServiceServiceListHandler = apiService.ServiceListHandlerFunc(h.ServiceListHandler)
+	api.ServiceServiceDeleteHandler = apiService.ServiceDeleteHandlerFunc(h.ServiceDeleteHandler)
+	srv := api.Serve(nil)
+
+	viewer := issuer.token(t, map[string]interface{}{
+		"email":        "viewer@example.com",
+		"realm_access": map[string]interface{}{"roles": []string{"viewers"}},
+	})
+	cases := []struct {
+		method, path, authorization string
+		status                      int
+	}{
+		{http.MethodGet, "/api/v1/services/", "Bearer " + viewer, http.StatusOK},
+		{http.MethodDelete, "/api/v1/services/demo/", "Bearer " + viewer, http.StatusForbidden},
+		{http.MethodGet, "/api/v1/services/", "Bearer invalid", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		r.Header.Set("Authorization", tc.authorization)
+		rw := httptest.NewRecorder()
+		srv.ServeHTTP(rw, r)
+		if rw.Code != tc.status {
+			t.Errorf("%s %s: status does not equal: actual vs expected: %d vs %d: %s", tc.method, tc.path, rw.Code, tc.status, rw.Body.String())
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// findEnv returns an index of the service environment variable or -1 when it is not set
+func findEnv(kls *v1alpha1.KuberLogicService, name, container string) int {
+	for i, e := range kls.Spec.Env {
+		if e.Name == name && e.Container == container {
+			return i
+		}
+	}
+	return -1
+}
+
+func envVarModel(e v1alpha1.EnvVar) *models.EnvVar {
+	return &models.EnvVar{
+		Name:      e.Name,
+		Container: e.Container,
+		Value:     e.Value,
+		Secret:    e.Secret,
+	}
+}
+
+// patchEnv replaces service environment variables, the patch fails when the service is changed since it was read
+func (h *handlers) patchEnv(ctx context.Context, kls *v1alpha1.KuberLogicService, env []v1alpha1.EnvVar) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": kls.GetResourceVersion(),
+		},
+		"spec": map[string]interface{}{
+			"env": env,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = h.Services().Patch(ctx, kls.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// setEnvSecret keeps the value of a secret environment variable in the service namespace.
+// The secret is owned by the service, so the operator restarts the service when it is changed.
+func (h *handlers) setEnvSecret(ctx context.Context, kls *v1alpha1.KuberLogicService, key, value string) error {
+	secrets := h.clientset.CoreV1().Secrets(kls.Status.Namespace)
+	secret, err := secrets.Get(ctx, v1alpha1.EnvSecretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1alpha1.EnvSecretName,
+				Namespace: kls.Status.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         v1alpha1.GroupVersion.String(),
+						Kind:               "KuberLogicService",
+						Name:               kls.GetName(),
+						UID:                kls.GetUID(),
+						BlockOwnerDeletion: pointer.BoolPtr(true),
+						Controller:         pointer.BoolPtr(true),
+					},
+				},
+			},
+			Data: map[string][]byte{key: []byte(value)},
+		}
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{FieldManager: "kuberlogic"})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[key] = []byte(value)
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// envSecretValue returns the value of a secret environment variable, found is false if the value is not set
+func (h *handlers) envSecretValue(ctx context.Context, kls *v1alpha1.KuberLogicService, key string) (value []byte, found bool, err error) {
+	secret, err := h.clientset.CoreV1().Secrets(kls.Status.Namespace).Get(ctx, v1alpha1.EnvSecretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	value, found = secret.Data[key]
+	return value, found, nil
+}
+
+// restoreEnvSecret sets the previous value of a secret environment variable, the value is removed if it was not found
+func (h *handlers) restoreEnvSecret(ctx context.Context, kls *v1alpha1.KuberLogicService, key string, previous []byte, found bool) error {
+	if !found {
+		return h.unsetEnvSecret(ctx, kls, key)
+	}
+	return h.setEnvSecret(ctx, kls, key, string(previous))
+}
+
+// envRejected returns true if the env patch error is returned by the admission of the service plugin
+func envRejected(err error) bool {
+	return k8serrors.IsInvalid(err) || k8serrors.IsForbidden(err)
+}
+
+// unsetEnvSecret removes the value of a secret environment variable
+func (h *handlers) unsetEnvSecret(ctx context.Context, kls *v1alpha1.KuberLogicService, key string) error {
+	secrets := h.clientset.CoreV1().Secrets(kls.Status.Namespace)
+	secret, err := secrets.Get(ctx, v1alpha1.EnvSecretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if _, set := secret.Data[key]; !set {
+		return nil
+	}
+	delete(secret.Data, key)
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// serviceETag returns the ETag of the service, it is its resource version
+func serviceETag(kls *v1alpha1.KuberLogicService) string {
+	return strconv.Quote(kls.GetResourceVersion())
+}
+
+// etagMatches checks the If-Match header against the service, it accepts a list of ETags and "*"
+func etagMatches(ifMatch string, kls *v1alpha1.KuberLogicService) bool {
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		// resource versions are compared as is, weak tags are accepted too
+		tag = strings.Trim(strings.TrimPrefix(tag, "W/"), `"`)
+		if tag != "" && tag == kls.GetResourceVersion() {
+			return true
+		}
+	}
+	return false
+}
@@ -1,22 +1,39 @@
 package app
 
 import (
+	"net/http"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apiAudit "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/audit"
 	apiBackup "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/backup"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
 	apiRestore "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
 )
 
 type Handlers interface {
 	OnShutdown()
 	ListOptionsByKeyValue(key string, value *string) v1.ListOptions
+	KeyAuthentication(token string) (*models.Principal, error)
+	BearerAuthentication(token string, scopes []string) (*models.Principal, error)
+	Authorize(r *http.Request, principal interface{}) error
+	AuditMiddleware(next http.Handler) http.Handler
+	IdempotencyMiddleware(api *middleware.Context, next http.Handler) http.Handler
 
+	AuditListHandler(params apiAudit.AuditListParams, _ *models.Principal) middleware.Responder
 	BackupAddHandler(params apiBackup.BackupAddParams, _ *models.Principal) middleware.Responder
 	BackupDeleteHandler(params apiBackup.BackupDeleteParams, _ *models.Principal) middleware.Responder
 	BackupListHandler(params apiBackup.BackupListParams, _ *models.Principal) middleware.Responder
+	PlanAddHandler(params apiPlan.PlanAddParams, _ *models.Principal) middleware.Responder
+	PlanDeleteHandler(params apiPlan.PlanDeleteParams, _ *models.Principal) middleware.Responder
+	PlanEditHandler(params apiPlan.PlanEditParams, _ *models.Principal) middleware.Responder
+	PlanGetHandler(params apiPlan.PlanGetParams, _ *models.Principal) middleware.Responder
+	PlanListHandler(params apiPlan.PlanListParams, _ *models.Principal) middleware.Responder
 	RestoreAddHandler(params apiRestore.RestoreAddParams, _ *models.Principal) middleware.Responder
 	RestoreDeleteHandler(params apiRestore.RestoreDeleteParams, _ *models.Principal) middleware.Responder
 	RestoreListHandler(params apiRestore.RestoreListParams, _ *models.Principal) middleware.Responder
@@ -25,10 +42,30 @@ type Handlers interface {
 	ServiceCredentialsUpdateHandler(params apiService.ServiceCredentialsUpdateParams, _ *models.Principal) middleware.Responder
 	ServiceDeleteHandler(params apiService.ServiceDeleteParams, _ *models.Principal) middleware.Responder
 	ServiceEditHandler(params apiService.ServiceEditParams, _ *models.Principal) middleware.Responder
+	ServiceEnvListHandler(params apiService.ServiceEnvListParams, _ *models.Principal) middleware.Responder
+	ServiceEnvSetHandler(params apiService.ServiceEnvSetParams, _ *models.Principal) middleware.Responder
+	ServiceEnvUnsetHandler(params apiService.ServiceEnvUnsetParams, _ *models.Principal) middleware.Responder
+	ServiceExecHandler(params apiService.ServiceExecParams, _ *models.Principal) middleware.Responder
 	ServiceExplainHandler(params apiService.ServiceExplainParams, _ *models.Principal) middleware.Responder
 	ServiceGetHandler(params apiService.ServiceGetParams, _ *models.Principal) middleware.Responder
 	ServiceListHandler(params apiService.ServiceListParams, _ *models.Principal) middleware.Responder
+	ServiceListWatchHandler(params apiService.ServiceListWatchParams, _ *models.Principal) middleware.Responder
 	ServiceLogsHandler(params apiService.ServiceLogsParams, _ *models.Principal) middleware.Responder
+	ServiceLogsFollowHandler(params apiService.ServiceLogsFollowParams, _ *models.Principal) middleware.Responder
+	ServiceMetricsHandler(params apiService.ServiceMetricsParams, _ *models.Principal) middleware.Responder
+	ServiceSecretGetHandler(params apiService.ServiceSecretGetParams, _ *models.Principal) middleware.Responder
+	ServiceSecretRotateHandler(params apiService.ServiceSecretRotateParams, _ *models.Principal) middleware.Responder
+	ServiceSecretSetHandler(params apiService.ServiceSecretSetParams, _ *models.Principal) middleware.Responder
 	ServiceSecretsListHandler(params apiService.ServiceSecretsListParams, _ *models.Principal) middleware.Responder
+	ServiceTypeListHandler(params apiService.ServiceTypeListParams, _ *models.Principal) middleware.Responder
 	ServiceUnarchiveHandler(params apiService.ServiceUnarchiveParams, _ *models.Principal) middleware.Responder
+	ServiceWatchHandler(params apiService.ServiceWatchParams, _ *models.Principal) middleware.Responder
+	TokenAddHandler(params apiToken.TokenAddParams, _ *models.Principal) middleware.Responder
+	TokenDeleteHandler(params apiToken.TokenDeleteParams, _ *models.Principal) middleware.Responder
+	TokenListHandler(params apiToken.TokenListParams, _ *models.Principal) middleware.Responder
+	WebhookAddHandler(params apiWebhook.WebhookAddParams, _ *models.Principal) middleware.Responder
+	WebhookDeadLetterListHandler(params apiWebhook.WebhookDeadLetterListParams, _ *models.Principal) middleware.Responder
+	WebhookDeleteHandler(params apiWebhook.WebhookDeleteParams, _ *models.Principal) middleware.Responder
+	WebhookListHandler(params apiWebhook.WebhookListParams, _ *models.Principal) middleware.Responder
+	WebhookPingHandler(params apiWebhook.WebhookPingParams, _ *models.Principal) middleware.Responder
 }
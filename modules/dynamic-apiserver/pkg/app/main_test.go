@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,13 +17,17 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	clienttesting "k8s.io/client-go/testing"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/api"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/api/fake"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/config"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
 var _ clienttesting.FakeClient = &FakeHandlers{}
 
+const testBootstrapToken = "bootstrap-token"
+
 type TestLog struct {
 	t *testing.T
 }
@@ -144,6 +149,10 @@ func (h *FakeHandlers) Restores() ExtendedRestoreInterface {
 	return r
 }
 
+func (h *FakeHandlers) Plans() api.PlanInterface {
+	return &fake.FakePlans{Fake: &h.Fake}
+}
+
 func newSimpleClient(objects ...runtime.Object) *FakeHandlers {
 	o := clienttesting.NewObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder())
 	for _, obj := range objects {
@@ -177,7 +186,9 @@ func newFakeHandlersWithClientset(t *testing.T, clientset kubernetes.Interface,
 	baseHandlers := &handlers{
 		log: &TestLog{t: t},
 		config: &config.Config{
-			Domain: "kuberlogic.local",
+			Domain:         "kuberlogic.local",
+			Namespace:      "kuberlogic",
+			ApiserverToken: testBootstrapToken,
 		},
 		clientset: clientset,
 	}
@@ -185,7 +196,13 @@ func newFakeHandlersWithClientset(t *testing.T, clientset kubernetes.Interface,
 	baseHandlers.services = client.Services() // override services with fake
 	baseHandlers.backups = client.Backups()   // override backups with fake
 	baseHandlers.restores = client.Restores() // override restores with fake
+	baseHandlers.plans = client.Plans()       // override plans with fake
 	client.Handlers = baseHandlers            // set actual handlers
+	baseHandlers.notifier = notify.New(baseHandlers.webhookEndpoints, nil, baseHandlers.log, notify.Options{
+		Attempts:     1,
+		Timeout:      5 * time.Second,
+		AllowedHosts: []string{"127.0.0.1"},
+	})
 	return client
 }
 
@@ -195,7 +212,8 @@ func splitObjects(objects []runtime.Object) ([]runtime.Object, []runtime.Object)
 		switch obj.(type) {
 		case *v1alpha1.KuberLogicService, *v1alpha1.KuberLogicServiceList,
 			*v1alpha1.KuberlogicServiceBackup, *v1alpha1.KuberlogicServiceBackupList,
-			*v1alpha1.KuberlogicServiceRestore, *v1alpha1.KuberlogicServiceRestoreList:
+			*v1alpha1.KuberlogicServiceRestore, *v1alpha1.KuberlogicServiceRestoreList,
+			*v1alpha1.KuberLogicServicePlan, *v1alpha1.KuberLogicServicePlanList:
 			customObjects = append(customObjects, obj)
 		default:
 			internalObjects = append(internalObjects, obj)
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// podMetrics is a pod of the metrics.k8s.io PodMetricsList, only fields used by the apiserver are decoded
+type podMetrics struct {
+	Metadata   metav1.ObjectMeta  `json:"metadata"`
+	Timestamp  metav1.Time        `json:"timestamp"`
+	Containers []containerMetrics `json:"containers"`
+}
+
+type containerMetrics struct {
+	Name  string              `json:"name"`
+	Usage corev1.ResourceList `json:"usage"`
+}
+
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+// statsSummary is the part of the kubelet stats summary with volume usage of pods
+type statsSummary struct {
+	Pods []struct {
+		PodRef struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"podRef"`
+		Volumes []volumeStats `json:"volume"`
+	} `json:"pods"`
+}
+
+type volumeStats struct {
+	Name   string `json:"name"`
+	PVCRef *struct {
+		Name string `json:"name"`
+	} `json:"pvcRef"`
+	CapacityBytes  *uint64 `json:"capacityBytes"`
+	UsedBytes      *uint64 `json:"usedBytes"`
+	AvailableBytes *uint64 `json:"availableBytes"`
+}
+
+// metricsSource reads resource usage of pods, it is faked in tests
+type metricsSource interface {
+	// PodMetrics returns current usage of pods in the namespace reported by the metrics API
+	PodMetrics(ctx context.Context, namespace string) ([]podMetrics, error)
+	// StatsSummary returns the stats summary of the node kubelet
+	StatsSummary(ctx context.Context, node string) (*statsSummary, error)
+}
+
+// restMetricsSource reads the metrics API and kubelet summaries through the kubernetes apiserver
+type restMetricsSource struct {
+	client rest.Interface
+}
+
+func (s *restMetricsSource) PodMetrics(ctx context.Context, namespace string) ([]podMetrics, error) {
+	raw, err := s.client.Get().AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", namespace, "pods").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &podMetricsList{}
+	if err := json.Unmarshal(raw, list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (s *restMetricsSource) StatsSummary(ctx context.Context, node string) (*statsSummary, error) {
+	raw, err := s.client.Get().AbsPath("/api/v1/nodes", node, "proxy/stats/summary").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summary := &statsSummary{}
+	if err := json.Unmarshal(raw, summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// failedPhases are service phases reported as service.failed events
+var failedPhases = map[string]bool{
+	"ConfigurationError": true,
+	"ProvisioningError":  true,
+}
+
+// lifecycleEvents turns changes of services, backups and restores into webhook events
+type lifecycleEvents struct {
+	// started skips created events of objects listed when watching starts
+	started time.Time
+	// services keeps watched services to find tenants of backups and restores
+	services cache.Store
+	publish  func(event *models.WebhookEvent)
+}
+
+// onService publishes events of the service change, old is nil for added services
+func (l *lifecycleEvents) onService(old, kls *v1alpha1.KuberLogicService) {
+	if old == nil {
+		if !kls.GetCreationTimestamp().Time.Before(l.started.Truncate(time.Second)) {
+			l.publish(serviceEvent(notify.EventServiceCreated, kls))
+		}
+		return
+	}
+
+	phase := kls.Status.Phase
+	if phase != old.Status.Phase {
+		if phase == v1alpha1.ReadyCondType {
+			l.publish(serviceEvent(notify.EventServiceReady, kls))
+		} else if failedPhases[phase] {
+			l.publish(serviceEvent(notify.EventServiceFailed, kls))
+		}
+	}
+	if kls.PauseCompleted() && !old.PauseCompleted() {
+		l.publish(serviceEvent(notify.EventServicePaused, kls))
+	}
+	if kls.Archived() && !old.Archived() {
+		l.publish(serviceEvent(notify.EventServiceArchived, kls))
+	}
+}
+
+func (l *lifecycleEvents) onBackup(old, klb *v1alpha1.KuberlogicServiceBackup) {
+	var eventType string
+	if klb.IsSuccessful() && (old == nil || !old.IsSuccessful()) {
+		eventType = notify.EventBackupCompleted
+	} else if klb.IsFailed() && (old == nil || !old.IsFailed()) {
+		eventType = notify.EventBackupFailed
+	} else {
+		return
+	}
+	event := notify.NewEvent(eventType)
+	event.Service = klb.Spec.KuberlogicServiceName
+	event.Backup = klb.GetName()
+	event.Tenant = l.serviceTenant(event.Service)
+	event.Status = klb.Status.Phase
+	event.Message = conditionMessage(klb.Status.Conditions, klb.Status.Phase)
+	l.publish(event)
+}
+
+func (l *lifecycleEvents) onRestore(old, klr *v1alpha1.KuberlogicServiceRestore) {
+	var eventType string
+	if klr.IsSuccessful() && (old == nil || !old.IsSuccessful()) {
+		eventType = notify.EventRestoreCompleted
+	} else if klr.IsFailed() && (old == nil || !old.IsFailed()) {
+		eventType = notify.EventRestoreFailed
+	} else {
+		return
+	}
+	event := notify.NewEvent(eventType)
+	event.Service = klr.GetLabels()[util.BackupRestoreServiceField]
+	event.Backup = klr.Spec.KuberlogicServiceBackup
+	event.Restore = klr.GetName()
+	event.Tenant = l.serviceTenant(event.Service)
+	event.Status = klr.Status.Phase
+	event.Message = conditionMessage(klr.Status.Conditions, klr.Status.Phase)
+	l.publish(event)
+}
+
+// serviceTenant returns the tenant of the watched service, it is empty for unknown services
+func (l *lifecycleEvents) serviceTenant(name string) string {
+	if l.services == nil || name == "" {
+		return ""
+	}
+	obj, exists, err := l.services.GetByKey(name)
+	if err != nil || !exists {
+		return ""
+	}
+	return obj.(*v1alpha1.KuberLogicService).GetLabels()[util.TenantField]
+}
+
+func serviceEvent(eventType string, kls *v1alpha1.KuberLogicService) *models.WebhookEvent {
+	event := notify.NewEvent(eventType)
+	event.Service = kls.GetName()
+	event.Tenant = kls.GetLabels()[util.TenantField]
+	event.Status = kls.Status.Phase
+	if eventType == notify.EventServiceFailed {
+		// failure conditions are named after the phase
+		event.Message = conditionMessage(kls.Status.Conditions, kls.Status.Phase)
+	}
+	return event
+}
+
+func conditionMessage(conditions []v1.Condition, condType string) string {
+	if c := meta.FindStatusCondition(conditions, condType); c != nil {
+		return c.Message
+	}
+	return ""
+}
+
+// watchLifecycleEvents publishes webhook events of services, backups and restores until ctx is done
+func (h *handlers) watchLifecycleEvents(ctx context.Context) {
+	events := &lifecycleEvents{
+		started: time.Now(),
+		publish: func(event *models.WebhookEvent) {
+			if err := h.notifier.Publish(event); err != nil {
+				h.log.Errorw("error publishing webhook event", "error", err, "event", event.Type, "service", event.Service)
+			}
+		},
+	}
+
+	services, servicesInformer := cache.NewInformer(&cache.ListWatch{
+		ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+			return h.Services().List(ctx, opts)
+		},
+		WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+			return h.Services().Watch(ctx, opts)
+		},
+	}, &v1alpha1.KuberLogicService{}, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			events.onService(nil, obj.(*v1alpha1.KuberLogicService))
+		},
+		UpdateFunc: func(old, obj interface{}) {
+			events.onService(old.(*v1alpha1.KuberLogicService), obj.(*v1alpha1.KuberLogicService))
+		},
+	})
+	events.services = services
+
+	_, backupsInformer := cache.NewInformer(&cache.ListWatch{
+		ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+			return h.Backups().List(ctx, opts)
+		},
+		WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+			return h.Backups().Watch(ctx, opts)
+		},
+	}, &v1alpha1.KuberlogicServiceBackup{}, 0, cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, obj interface{}) {
+			events.onBackup(old.(*v1alpha1.KuberlogicServiceBackup), obj.(*v1alpha1.KuberlogicServiceBackup))
+		},
+	})
+
+	_, restoresInformer := cache.NewInformer(&cache.ListWatch{
+		ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+			return h.Restores().List(ctx, opts)
+		},
+		WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+			return h.Restores().Watch(ctx, opts)
+		},
+	}, &v1alpha1.KuberlogicServiceRestore{}, 0, cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, obj interface{}) {
+			events.onRestore(old.(*v1alpha1.KuberlogicServiceRestore), obj.(*v1alpha1.KuberlogicServiceRestore))
+		},
+	})
+
+	go servicesInformer.Run(ctx.Done())
+	// tenants of backups and restores are found among synced services
+	if !cache.WaitForCacheSync(ctx.Done(), servicesInformer.HasSynced) {
+		return
+	}
+	go backupsInformer.Run(ctx.Done())
+	go restoresInformer.Run(ctx.Done())
+}
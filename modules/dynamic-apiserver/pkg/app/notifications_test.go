@@ -0,0 +1,192 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// recordedEvent keeps event fields that don't change between runs
+type recordedEvent struct {
+	Type, Service, Backup, Restore, Tenant, Status, Message string
+}
+
+func newTestLifecycleEvents(started time.Time, services ...*v1alpha1.KuberLogicService) (*lifecycleEvents, *[]recordedEvent) {
+	var recorded []recordedEvent
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, kls := range services {
+		_ = store.Add(kls)
+	}
+	return &lifecycleEvents{
+		started:  started,
+		services: store,
+		publish: func(e *models.WebhookEvent) {
+			if e.ID == "" || time.Time(e.Time).IsZero() {
+				panic("event id and time are expected")
+			}
+			recorded = append(recorded, recordedEvent{e.Type, e.Service, e.Backup, e.Restore, e.Tenant, e.Status, e.Message})
+		},
+	}, &recorded
+}
+
+func testLifecycleService(created time.Time, mutate ...func(kls *v1alpha1.KuberLogicService)) *v1alpha1.KuberLogicService {
+	kls := &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "demo",
+			CreationTimestamp: v1.NewTime(created),
+			Labels:            map[string]string{util.TenantField: "acme"},
+		},
+	}
+	for _, m := range mutate {
+		m(kls)
+	}
+	return kls
+}
+
+func TestServiceLifecycleEvents(t *testing.T) {
+	started := time.Now()
+	notReady := func(kls *v1alpha1.KuberLogicService) { kls.MarkNotReady("starting") }
+
+	cases := []struct {
+		name     string
+		old, new *v1alpha1.KuberLogicService
+		expected []recordedEvent
+	}{
+		{
+			name: "created",
+			new:  testLifecycleService(started.Add(time.Second)),
+			expected: []recordedEvent{
+				{Type: notify.EventServiceCreated, Service: "demo", Tenant: "acme"},
+			},
+		},
+		{
+			name: "listed-on-start",
+			new:  testLifecycleService(started.Add(-time.Hour)),
+		},
+		{
+			name: "ready",
+			old:  testLifecycleService(started, notReady),
+			new:  testLifecycleService(started, func(kls *v1alpha1.KuberLogicService) { kls.MarkReady("ready") }),
+			expected: []recordedEvent{
+				{Type: notify.EventServiceReady, Service: "demo", Tenant: "acme", Status: "Ready"},
+			},
+		},
+		{
+			name: "still-ready",
+			old:  testLifecycleService(started, func(kls *v1alpha1.KuberLogicService) { kls.MarkReady("ready") }),
+			new:  testLifecycleService(started, func(kls *v1alpha1.KuberLogicService) { kls.MarkReady("ready") }),
+		},
+		{
+			name: "failed",
+			old:  testLifecycleService(started, notReady),
+			new:  testLifecycleService(started, func(kls *v1alpha1.KuberLogicService) { kls.ClusterSyncFailed("no storage class") }),
+			expected: []recordedEvent{
+				{Type: notify.EventServiceFailed, Service: "demo", Tenant: "acme", Status: "ProvisioningError", Message: "no storage class"},
+			},
+		},
+		{
+			name: "paused",
+			old:  testLifecycleService(started, notReady),
+			new: testLifecycleService(started, notReady, func(kls *v1alpha1.KuberLogicService) {
+				kls.Spec.Paused = true
+				kls.MarkPaused()
+			}),
+			expected: []recordedEvent{
+				{Type: notify.EventServicePaused, Service: "demo", Tenant: "acme", Status: "NotReady"},
+			},
+		},
+		{
+			name: "pause-requested",
+			old:  testLifecycleService(started, notReady),
+			new:  testLifecycleService(started, notReady, func(kls *v1alpha1.KuberLogicService) { kls.Spec.Paused = true }),
+		},
+		{
+			name: "archived",
+			old: testLifecycleService(started, notReady, func(kls *v1alpha1.KuberLogicService) {
+				kls.Spec.Archived = true
+			}),
+			new: testLifecycleService(started, func(kls *v1alpha1.KuberLogicService) {
+				kls.Spec.Archived = true
+				kls.MarkArchived()
+			}),
+			expected: []recordedEvent{
+				{Type: notify.EventServiceArchived, Service: "demo", Tenant: "acme", Status: "Archived"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, recorded := newTestLifecycleEvents(started)
+			events.onService(tc.old, tc.new)
+			if !reflect.DeepEqual(*recorded, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, *recorded)
+			}
+		})
+	}
+}
+
+func TestBackupLifecycleEvents(t *testing.T) {
+	backup := func(mutate func(klb *v1alpha1.KuberlogicServiceBackup)) *v1alpha1.KuberlogicServiceBackup {
+		klb := &v1alpha1.KuberlogicServiceBackup{
+			ObjectMeta: v1.ObjectMeta{Name: "demo-1"},
+			Spec:       v1alpha1.KuberlogicServiceBackupSpec{KuberlogicServiceName: "demo"},
+		}
+		mutate(klb)
+		return klb
+	}
+	requested := backup(func(klb *v1alpha1.KuberlogicServiceBackup) { klb.MarkRequested() })
+	successful := backup(func(klb *v1alpha1.KuberlogicServiceBackup) { klb.MarkSuccessful() })
+	failed := backup(func(klb *v1alpha1.KuberlogicServiceBackup) { klb.MarkFailed("bucket is not accessible") })
+
+	events, recorded := newTestLifecycleEvents(time.Now(), testLifecycleService(time.Now()))
+	events.onBackup(requested, successful)
+	events.onBackup(successful, successful)
+	events.onBackup(requested, failed)
+
+	expected := []recordedEvent{
+		{Type: notify.EventBackupCompleted, Service: "demo", Backup: "demo-1", Tenant: "acme", Status: "Successful"},
+		{Type: notify.EventBackupFailed, Service: "demo", Backup: "demo-1", Tenant: "acme", Status: "Failed", Message: "bucket is not accessible"},
+	}
+	if !reflect.DeepEqual(*recorded, expected) {
+		t.Errorf("expected %v, got %v", expected, *recorded)
+	}
+}
+
+func TestRestoreLifecycleEvents(t *testing.T) {
+	restore := func(mutate func(klr *v1alpha1.KuberlogicServiceRestore)) *v1alpha1.KuberlogicServiceRestore {
+		klr := &v1alpha1.KuberlogicServiceRestore{
+			ObjectMeta: v1.ObjectMeta{
+				Name:   "demo-1",
+				Labels: map[string]string{util.BackupRestoreServiceField: "demo"},
+			},
+			Spec: v1alpha1.KuberlogicServiceRestoreSpec{KuberlogicServiceBackup: "demo-1"},
+		}
+		mutate(klr)
+		return klr
+	}
+	requested := restore(func(klr *v1alpha1.KuberlogicServiceRestore) { klr.MarkRequested() })
+	successful := restore(func(klr *v1alpha1.KuberlogicServiceRestore) { klr.MarkSuccessful() })
+	failed := restore(func(klr *v1alpha1.KuberlogicServiceRestore) { klr.MarkFailed("backup is corrupted") })
+
+	// tenants of unknown services are empty
+	events, recorded := newTestLifecycleEvents(time.Now())
+	events.onRestore(requested, successful)
+	events.onRestore(requested, failed)
+	events.onRestore(failed, failed)
+
+	expected := []recordedEvent{
+		{Type: notify.EventRestoreCompleted, Service: "demo", Backup: "demo-1", Restore: "demo-1", Status: "Successful"},
+		{Type: notify.EventRestoreFailed, Service: "demo", Backup: "demo-1", Restore: "demo-1", Status: "Failed", Message: "backup is corrupted"},
+	}
+	if !reflect.DeepEqual(*recorded, expected) {
+		t.Errorf("expected %v, got %v", expected, *recorded)
+	}
+}
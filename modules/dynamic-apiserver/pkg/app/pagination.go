@@ -0,0 +1,231 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// sortByID is the default order of items as they are returned by the cluster
+	sortByID = "id"
+	// listChunkSize is the number of items requested from the cluster at once when all items have to be listed
+	listChunkSize = 500
+)
+
+// lessFunc reports whether a must sort before b
+type lessFunc func(a, b metav1.Object) bool
+
+// commonSortKeys are sort keys available for all listed objects
+var commonSortKeys = map[string]lessFunc{
+	sortByID: func(a, b metav1.Object) bool {
+		return a.GetName() < b.GetName()
+	},
+	"created_at": func(a, b metav1.Object) bool {
+		return a.GetCreationTimestamp().Time.Before(b.GetCreationTimestamp().Time)
+	},
+}
+
+// listChunkFunc returns listed objects and the continue token of the next chunk
+type listChunkFunc func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error)
+
+// listRequestError is returned for invalid page requests
+type listRequestError struct {
+	message string
+}
+
+func (e *listRequestError) Error() string {
+	return e.message
+}
+
+// pageRequest describes the requested page of a list operation
+type pageRequest struct {
+	limit int64
+	token string
+	// sortKey is empty when items are returned in the cluster order
+	sortKey string
+	desc    bool
+	less    lessFunc
+}
+
+// offsetToken is the continue token of sorted lists, sorted lists can't use cluster continue tokens
+type offsetToken struct {
+	Sort   string `json:"sort"`
+	Offset int    `json:"offset"`
+}
+
+// newPageRequest validates page parameters against available sort keys
+func newPageRequest(limit *int64, token, sortParam *string, keys map[string]lessFunc) (*pageRequest, error) {
+	page := &pageRequest{}
+	if limit != nil {
+		page.limit = *limit
+	}
+	if token != nil {
+		page.token = *token
+	}
+	if sortParam == nil || *sortParam == sortByID {
+		return page, nil
+	}
+
+	key := strings.TrimPrefix(*sortParam, "-")
+	less, ok := keys[key]
+	if !ok {
+		less, ok = commonSortKeys[key]
+	}
+	if !ok {
+		return nil, &listRequestError{message: fmt.Sprintf("unknown sort key: %s", key)}
+	}
+	page.sortKey, page.desc, page.less = *sortParam, key != *sortParam, less
+	return page, nil
+}
+
+// listFilter keeps objects matching all of its funcs
+type listFilter []func(obj metav1.Object) bool
+
+// newListFilter returns a filter by the creation time, after and before are in RFC3339 format
+func newListFilter(after, before *string) (listFilter, error) {
+	var filter listFilter
+	for _, param := range []struct {
+		name  string
+		value *string
+		keep  func(created, t time.Time) bool
+	}{
+		{"created_after", after, func(created, t time.Time) bool { return created.After(t) }},
+		{"created_before", before, func(created, t time.Time) bool { return created.Before(t) }},
+	} {
+		if param.value == nil {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, *param.value)
+		if err != nil {
+			return nil, &listRequestError{
+				message: fmt.Sprintf("invalid %s time, RFC3339 format is expected: %s", param.name, *param.value),
+			}
+		}
+		keep := param.keep
+		filter = append(filter, func(obj metav1.Object) bool {
+			return keep(obj.GetCreationTimestamp().Time, t)
+		})
+	}
+	return filter, nil
+}
+
+func (f listFilter) keep(obj metav1.Object) bool {
+	for _, keep := range f {
+		if !keep(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+// listPage returns the requested page of objects kept by the filter and the continue token of the next page.
+// Pages in the cluster order use cluster continue tokens, sorted pages require listing all objects.
+func listPage(ctx context.Context, opts metav1.ListOptions, page *pageRequest, filter listFilter, list listChunkFunc) ([]metav1.Object, string, error) {
+	if page.sortKey == "" {
+		return listClusterPage(ctx, opts, page, filter, list)
+	}
+
+	offset := 0
+	if page.token != "" {
+		t, err := decodeOffsetToken(page.token)
+		if err != nil || t.Sort != page.sortKey || t.Offset < 0 {
+			return nil, "", &listRequestError{message: "invalid continue token"}
+		}
+		offset = t.Offset
+	}
+
+	var items []metav1.Object
+	opts.Limit, opts.Continue = listChunkSize, ""
+	for {
+		chunk, next, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, obj := range chunk {
+			if filter.keep(obj) {
+				items = append(items, obj)
+			}
+		}
+		if next == "" {
+			break
+		}
+		opts.Continue = next
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if page.desc {
+			return page.less(items[j], items[i])
+		}
+		return page.less(items[i], items[j])
+	})
+	if offset >= len(items) {
+		return []metav1.Object{}, "", nil
+	}
+	items = items[offset:]
+	if page.limit == 0 || int64(len(items)) <= page.limit {
+		return items, "", nil
+	}
+	next, err := encodeOffsetToken(offsetToken{Sort: page.sortKey, Offset: offset + int(page.limit)})
+	if err != nil {
+		return nil, "", err
+	}
+	return items[:page.limit], next, nil
+}
+
+// listClusterPage requests objects from the cluster until the page is full
+// so the continue token points right after the last returned object
+func listClusterPage(ctx context.Context, opts metav1.ListOptions, page *pageRequest, filter listFilter, list listChunkFunc) ([]metav1.Object, string, error) {
+	items := make([]metav1.Object, 0)
+	opts.Continue = page.token
+	for {
+		opts.Limit = 0
+		if page.limit > 0 {
+			opts.Limit = page.limit - int64(len(items))
+		}
+		chunk, next, err := list(ctx, opts)
+		if k8serrors.IsResourceExpired(err) || k8serrors.IsGone(err) {
+			return nil, "", &listRequestError{message: "continue token is expired, list from the first page"}
+		} else if k8serrors.IsBadRequest(err) && opts.Continue != "" {
+			return nil, "", &listRequestError{message: "invalid continue token"}
+		} else if err != nil {
+			return nil, "", err
+		}
+		for _, obj := range chunk {
+			if filter.keep(obj) {
+				items = append(items, obj)
+			}
+		}
+		if next == "" || (page.limit > 0 && int64(len(items)) >= page.limit) {
+			return items, next, nil
+		}
+		opts.Continue = next
+	}
+}
+
+func encodeOffsetToken(t offsetToken) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeOffsetToken(token string) (*offsetToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	t := &offsetToken{}
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
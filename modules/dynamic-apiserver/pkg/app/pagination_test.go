@@ -0,0 +1,207 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+var paginationEpoch = time.Date(2022, 5, 10, 16, 0, 0, 0, time.UTC)
+
+// paginatedService returns a service created minutes after the pagination epoch
+func paginatedService(name, serviceType string, minutes int) *v1alpha1.KuberLogicService {
+	return &v1alpha1.KuberLogicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(paginationEpoch.Add(time.Duration(minutes) * time.Minute)),
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{Type: serviceType},
+	}
+}
+
+// chunkedList lists objects in the given order honoring the limit and continue options like the cluster does
+func chunkedList(objects ...metav1.Object) listChunkFunc {
+	return func(_ context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error) {
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+		end := len(objects)
+		if opts.Limit > 0 && start+int(opts.Limit) < end {
+			end = start + int(opts.Limit)
+		}
+		next := ""
+		if end < len(objects) {
+			next = strconv.Itoa(end)
+		}
+		return objects[start:end], next, nil
+	}
+}
+
+func objectNames(objects []metav1.Object) []string {
+	names := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		names = append(names, obj.GetName())
+	}
+	return names
+}
+
+func TestListPage(t *testing.T) {
+	list := chunkedList(
+		paginatedService("a", "postgresql", 3),
+		paginatedService("b", "mysql", 1),
+		paginatedService("c", "postgresql", 4),
+		paginatedService("d", "postgresql", 2),
+		paginatedService("e", "mysql", 0),
+	)
+	postgresql := listFilter{func(obj metav1.Object) bool {
+		return obj.(*v1alpha1.KuberLogicService).Spec.Type == "postgresql"
+	}}
+
+	cases := []struct {
+		name   string
+		limit  int64
+		sort   string
+		filter listFilter
+		pages  [][]string
+	}{
+		{
+			name:  "all",
+			pages: [][]string{{"a", "b", "c", "d", "e"}},
+		},
+		{
+			name:  "cluster-order",
+			limit: 2,
+			pages: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			name:   "cluster-order-filtered",
+			limit:  2,
+			filter: postgresql,
+			pages:  [][]string{{"a", "c"}, {"d"}},
+		},
+		{
+			name:  "sorted",
+			limit: 2,
+			sort:  "-created_at",
+			pages: [][]string{{"c", "a"}, {"d", "b"}, {"e"}},
+		},
+		{
+			name:   "sorted-filtered",
+			limit:  2,
+			sort:   "created_at",
+			filter: postgresql,
+			pages:  [][]string{{"d", "a"}, {"c"}},
+		},
+		{
+			name:  "sorted-by-type",
+			sort:  "type",
+			pages: [][]string{{"b", "e", "a", "c", "d"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sortParam *string
+			if tc.sort != "" {
+				sortParam = &tc.sort
+			}
+			var token *string
+			var pages [][]string
+			for i := 0; i < len(tc.pages)+1; i++ {
+				page, err := newPageRequest(&tc.limit, token, sortParam, serviceSortKeys)
+				if err != nil {
+					t.Fatal(err)
+				}
+				items, next, err := listPage(context.TODO(), metav1.ListOptions{}, page, tc.filter, list)
+				if err != nil {
+					t.Fatal(err)
+				}
+				pages = append(pages, objectNames(items))
+				if next == "" {
+					break
+				}
+				token = &next
+			}
+			if !reflect.DeepEqual(pages, tc.pages) {
+				t.Errorf("pages do not equal: actual vs expected\n%v\n%v", pages, tc.pages)
+			}
+		})
+	}
+}
+
+func TestListPageErrors(t *testing.T) {
+	sortKey, unknownKey := "-created_at", "size"
+	if _, err := newPageRequest(nil, nil, &unknownKey, serviceSortKeys); err == nil || err.Error() != "unknown sort key: size" {
+		t.Errorf("unknown sort key error is expected, got %v", err)
+	}
+	if _, err := newListFilter(util.StrAsPointer("yesterday"), nil); err == nil ||
+		err.Error() != "invalid created_after time, RFC3339 format is expected: yesterday" {
+		t.Errorf("invalid time error is expected, got %v", err)
+	}
+
+	// the token of a list sorted by other key can't be used
+	token, _ := encodeOffsetToken(offsetToken{Sort: "created_at", Offset: 1})
+	for _, token := range []string{token, "garbage"} {
+		page, err := newPageRequest(nil, &token, &sortKey, serviceSortKeys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := listPage(context.TODO(), metav1.ListOptions{}, page, nil, chunkedList()); err == nil || err.Error() != "invalid continue token" {
+			t.Errorf("invalid continue token error is expected, got %v", err)
+		}
+	}
+}
+
+func TestServiceListPagination(t *testing.T) {
+	h := newFakeHandlers(t, []runtime.Object{
+		paginatedService("a", "postgresql", 3),
+		paginatedService("b", "mysql", 1),
+		paginatedService("c", "postgresql", 4),
+		paginatedService("d", "postgresql", 2),
+	}...)
+
+	params := apiService.ServiceListParams{
+		HTTPRequest:  &http.Request{},
+		Limit:        util.Int64AsPointer(2),
+		Sort:         util.StrAsPointer("-created_at"),
+		Type:         util.StrAsPointer("postgresql"),
+		CreatedAfter: util.StrAsPointer(paginationEpoch.Add(time.Minute).Format(time.RFC3339)),
+	}
+	var pages [][]string
+	for {
+		ok, isOK := h.ServiceListHandler(params, nil).(*apiService.ServiceListOK)
+		if !isOK {
+			t.Fatal("list is expected to succeed")
+		}
+		var names []string
+		for _, svc := range ok.Payload {
+			names = append(names, *svc.ID)
+		}
+		pages = append(pages, names)
+		if ok.XContinue == "" {
+			break
+		}
+		params.Continue = &ok.XContinue
+	}
+	if expected := [][]string{{"c", "a"}, {"d"}}; !reflect.DeepEqual(pages, expected) {
+		t.Errorf("pages do not equal: actual vs expected\n%v\n%v", pages, expected)
+	}
+
+	checkResponse(h.ServiceListHandler(apiService.ServiceListParams{
+		HTTPRequest: &http.Request{},
+		Sort:        util.StrAsPointer("size"),
+	}, nil), t, 400, &models.Error{
+		Message: "unknown sort key: size",
+	})
+}
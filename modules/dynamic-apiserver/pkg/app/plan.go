@@ -0,0 +1,245 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ */
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/api"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+type PlanGetter interface {
+	Plans() api.PlanInterface
+}
+
+// planOverrides are service parameters set in service requests, they take precedence over plan parameters
+type planOverrides struct {
+	Version        string          `json:"version,omitempty"`
+	Replicas       *int64          `json:"replicas,omitempty"`
+	BackupSchedule string          `json:"backupSchedule,omitempty"`
+	Limits         *models.Limits  `json:"limits,omitempty"`
+	Advanced       models.Advanced `json:"advanced,omitempty"`
+}
+
+// newPlanOverrides returns parameters set in the service request on top of the previous overrides
+func newPlanOverrides(svc *models.Service, previous *planOverrides) *planOverrides {
+	o := &planOverrides{}
+	if previous != nil {
+		*o = *previous
+	}
+	if svc.Version != "" {
+		o.Version = svc.Version
+	}
+	if svc.Replicas != nil {
+		o.Replicas = svc.Replicas
+	}
+	if svc.BackupSchedule != "" {
+		o.BackupSchedule = svc.BackupSchedule
+	}
+	o.Limits = mergeLimits(o.Limits, svc.Limits)
+	o.Advanced = mergeAdvanced(o.Advanced, svc.Advanced)
+	return o
+}
+
+// serviceOverrides returns overrides stored in the service annotation
+func serviceOverrides(kls *v1alpha1.KuberLogicService) (*planOverrides, error) {
+	o := &planOverrides{}
+	value, ok := kls.GetAnnotations()[util.PlanOverridesAnnotation]
+	if !ok {
+		return o, nil
+	}
+	if err := json.Unmarshal([]byte(value), o); err != nil {
+		return nil, errors.Wrapf(err, "error decoding plan overrides of service %s", kls.GetName())
+	}
+	return o, nil
+}
+
+func (o *planOverrides) annotation() (string, error) {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return "", errors.Wrap(err, "error encoding plan overrides")
+	}
+	return string(data), nil
+}
+
+// applyPlan sets service parameters from the plan unless they are overridden.
+// Limits are merged per resource and advanced parameters are merged by key.
+func applyPlan(svc *models.Service, plan *v1alpha1.KuberLogicServicePlan, overrides *planOverrides) error {
+	if svc.Type != nil && *svc.Type != "" && *svc.Type != plan.Spec.Type {
+		return errors.Errorf("service type '%s' does not match plan '%s' type '%s'", *svc.Type, plan.GetName(), plan.Spec.Type)
+	}
+	base, err := util.KuberlogicToPlan(plan)
+	if err != nil {
+		return err
+	}
+
+	svc.Type = base.Type
+	svc.Plan = plan.GetName()
+	svc.Version = base.Version
+	if overrides.Version != "" {
+		svc.Version = overrides.Version
+	}
+	svc.Replicas = base.Replicas
+	if overrides.Replicas != nil {
+		svc.Replicas = overrides.Replicas
+	}
+	svc.BackupSchedule = base.BackupSchedule
+	if overrides.BackupSchedule != "" {
+		svc.BackupSchedule = overrides.BackupSchedule
+	}
+	svc.Limits = mergeLimits(base.Limits, overrides.Limits)
+	svc.Advanced = mergeAdvanced(base.Advanced, overrides.Advanced)
+	return nil
+}
+
+func mergeLimits(base, overrides *models.Limits) *models.Limits {
+	if base == nil && overrides == nil {
+		return nil
+	}
+	result := &models.Limits{}
+	if base != nil {
+		*result = *base
+	}
+	if overrides == nil {
+		return result
+	}
+	if overrides.CPU != "" {
+		result.CPU = overrides.CPU
+	}
+	if overrides.Memory != "" {
+		result.Memory = overrides.Memory
+	}
+	if overrides.Storage != "" {
+		result.Storage = overrides.Storage
+	}
+	return result
+}
+
+func mergeAdvanced(base, overrides models.Advanced) models.Advanced {
+	if base == nil && overrides == nil {
+		return nil
+	}
+	result := make(models.Advanced, len(base)+len(overrides))
+	for k, v := range base {
+		result[k] = v
+	}
+	for k, v := range overrides {
+		result[k] = v
+	}
+	return result
+}
+
+// planServicePatch returns the merge patch applying the plan to the service and storing overrides
+func planServicePatch(svc *models.Service, overrides *planOverrides) ([]byte, error) {
+	annotation, err := overrides.annotation()
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]interface{}{
+		"type":           *svc.Type,
+		"version":        nilIfEmpty(svc.Version),
+		"backupSchedule": nilIfEmpty(svc.BackupSchedule),
+		"replicas":       nil,
+		"advanced":       nil,
+	}
+	if svc.Replicas != nil {
+		spec["replicas"] = *svc.Replicas
+	}
+	if svc.Advanced != nil {
+		spec["advanced"] = svc.Advanced
+	}
+	if svc.Limits != nil {
+		limits := map[string]interface{}{
+			"cpu":     nilIfEmpty(svc.Limits.CPU),
+			"memory":  nilIfEmpty(svc.Limits.Memory),
+			"storage": nilIfEmpty(svc.Limits.Storage),
+		}
+		spec["limits"] = limits
+	}
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{
+				util.PlanField: svc.Plan,
+			},
+			"annotations": map[string]string{
+				util.PlanOverridesAnnotation: annotation,
+			},
+		},
+		"spec": spec,
+	})
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// rolloutPlan applies the plan to all services created with it and returns errors of services that failed.
+// Services that would exceed quotas of their tenants are skipped, reasons are returned with their names.
+func (h *handlers) rolloutPlan(ctx context.Context, plan *v1alpha1.KuberLogicServicePlan) (int, []string, []string, error) {
+	name := plan.GetName()
+	list, err := h.Services().List(ctx, h.ListOptionsByKeyValue(util.PlanField, &name))
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "error listing plan services")
+	}
+
+	var skipped, failures []string
+	for i := range list.Items {
+		kls := &list.Items[i]
+		reason, err := h.applyPlanToService(ctx, kls, plan)
+		if err != nil {
+			h.log.Errorw("error rolling out plan", "plan", name, "service", kls.GetName(), "error", err)
+			failures = append(failures, fmt.Sprintf("%s: %s", kls.GetName(), err))
+		} else if reason != "" {
+			h.log.Warnw("service is skipped by plan rollout", "plan", name, "service", kls.GetName(), "reason", reason)
+			skipped = append(skipped, fmt.Sprintf("%s: %s", kls.GetName(), reason))
+		}
+	}
+	return len(list.Items), skipped, failures, nil
+}
+
+// applyPlanToService patches the service with the plan, the service is not patched if it does not fit into its tenant quota then.
+// Tenant services are listed on every check, so services patched earlier by the rollout are counted with new limits.
+func (h *handlers) applyPlanToService(ctx context.Context, kls *v1alpha1.KuberLogicService, plan *v1alpha1.KuberLogicServicePlan) (string, error) {
+	overrides, err := serviceOverrides(kls)
+	if err != nil {
+		return "", err
+	}
+	svc := &models.Service{ID: util.StrAsPointer(kls.GetName()), Type: util.StrAsPointer(kls.Spec.Type)}
+	if err := applyPlan(svc, plan, overrides); err != nil {
+		return "", err
+	}
+
+	if svc.Limits != nil {
+		// limits are replaced by the patch
+		c, err := util.ServiceToKuberlogic(svc, h.config)
+		if err != nil {
+			return "", err
+		}
+		updated := kls.DeepCopy()
+		updated.Spec.Limits = c.Spec.Limits
+		if reason, err := h.checkTenantQuota(ctx, updated); err != nil || reason != "" {
+			return reason, err
+		}
+	}
+
+	patch, err := planServicePatch(svc, overrides)
+	if err != nil {
+		return "", err
+	}
+	_, err = h.Services().Patch(ctx, kls.GetName(), types.MergePatchType, patch, v1.PatchOptions{})
+	return "", err
+}
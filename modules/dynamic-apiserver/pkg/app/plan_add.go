@@ -0,0 +1,53 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func (h *handlers) PlanAddHandler(params apiPlan.PlanAddParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	audit.SetTarget(ctx, "plans/"+*params.PlanItem.Name)
+
+	// plans are shared by all tenants
+	if principalTenant(principal) != "" {
+		return apiPlan.NewPlanAddForbidden()
+	}
+
+	c, err := util.PlanToKuberlogic(params.PlanItem)
+	if err != nil {
+		return apiPlan.NewPlanAddBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	result, err := h.Plans().Create(ctx, c, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return apiPlan.NewPlanAddConflict().WithPayload(&models.Error{
+			Message: fmt.Sprintf("service plan already exists: %s", *params.PlanItem.Name),
+		})
+	} else if err != nil {
+		h.log.Errorw("error creating service plan", "error", err, "name", *params.PlanItem.Name)
+		return apiPlan.NewPlanAddServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	plan, err := util.KuberlogicToPlan(result)
+	if err != nil {
+		h.log.Errorw("error converting service plan to model", "error", err)
+		return apiPlan.NewPlanAddServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	audit.SetChanges(ctx, audit.Changes(nil, plan))
+	return apiPlan.NewPlanAddCreated().WithPayload(plan)
+}
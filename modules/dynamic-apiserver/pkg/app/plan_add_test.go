@@ -0,0 +1,86 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func TestPlanAdd(t *testing.T) {
+	cases := []testCase{
+		{
+			name:   "ok",
+			status: 201,
+			result: &models.ServicePlan{
+				Name:        util.StrAsPointer("small"),
+				Type:        util.StrAsPointer("postgresql"),
+				Description: "small postgresql",
+				Replicas:    util.Int64AsPointer(1),
+				Limits:      &models.Limits{CPU: "500m", Storage: "10Gi"},
+				Advanced:    models.Advanced{"key": "value"},
+			},
+			params: apiPlan.PlanAddParams{
+				HTTPRequest: &http.Request{},
+				PlanItem: &models.ServicePlan{
+					Name:        util.StrAsPointer("small"),
+					Type:        util.StrAsPointer("postgresql"),
+					Description: "small postgresql",
+					Replicas:    util.Int64AsPointer(1),
+					Limits:      &models.Limits{CPU: "500m", Storage: "10Gi"},
+					Advanced:    models.Advanced{"key": "value"},
+				},
+			},
+		},
+		{
+			name:   "invalid-limits",
+			status: 400,
+			result: &models.Error{
+				Message: "invalid cpu limit: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+			},
+			params: apiPlan.PlanAddParams{
+				HTTPRequest: &http.Request{},
+				PlanItem: &models.ServicePlan{
+					Name:   util.StrAsPointer("small"),
+					Type:   util.StrAsPointer("postgresql"),
+					Limits: &models.Limits{CPU: "half"},
+				},
+			},
+		},
+		{
+			name:    "already-exists",
+			status:  409,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result: &models.Error{
+				Message: "service plan already exists: small",
+			},
+			params: apiPlan.PlanAddParams{
+				HTTPRequest: &http.Request{},
+				PlanItem: &models.ServicePlan{
+					Name: util.StrAsPointer("small"),
+					Type: util.StrAsPointer("postgresql"),
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).PlanAddHandler(tc.params.(apiPlan.PlanAddParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
+
+func TestPlanAddTenant(t *testing.T) {
+	params := apiPlan.PlanAddParams{
+		HTTPRequest: &http.Request{},
+		PlanItem: &models.ServicePlan{
+			Name: util.StrAsPointer("small"),
+			Type: util.StrAsPointer("postgresql"),
+		},
+	}
+	checkResponse(newFakeHandlers(t).PlanAddHandler(params, &models.Principal{Tenant: "acme"}), t, 403, nil)
+}
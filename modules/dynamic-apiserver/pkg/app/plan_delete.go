@@ -0,0 +1,69 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func (h *handlers) PlanDeleteHandler(params apiPlan.PlanDeleteParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	audit.SetTarget(ctx, "plans/"+params.PlanName)
+
+	if principalTenant(principal) != "" {
+		return apiPlan.NewPlanDeleteForbidden()
+	}
+
+	kp, err := h.Plans().Get(ctx, params.PlanName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return apiPlan.NewPlanDeleteNotFound().WithPayload(&models.Error{
+			Message: "service plan not found: " + params.PlanName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting service plan", "error", err, "name", params.PlanName)
+		return apiPlan.NewPlanDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error getting service plan",
+		})
+	}
+
+	services, err := h.Services().List(ctx, h.ListOptionsByKeyValue(util.PlanField, &params.PlanName))
+	if err != nil {
+		h.log.Errorw("error listing plan services", "error", err, "name", params.PlanName)
+		return apiPlan.NewPlanDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error listing plan services",
+		})
+	}
+	if len(services.Items) > 0 {
+		names := make([]string, 0, len(services.Items))
+		for _, svc := range services.Items {
+			names = append(names, svc.GetName())
+		}
+		return apiPlan.NewPlanDeleteConflict().WithPayload(&models.Error{
+			Message: fmt.Sprintf("service plan is used by services: %s", strings.Join(names, ", ")),
+		})
+	}
+
+	if err := h.Plans().Delete(ctx, params.PlanName, metav1.DeleteOptions{}); k8serrors.IsNotFound(err) {
+		return apiPlan.NewPlanDeleteNotFound().WithPayload(&models.Error{
+			Message: "service plan not found: " + params.PlanName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error deleting service plan", "error", err, "name", params.PlanName)
+		return apiPlan.NewPlanDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error deleting service plan",
+		})
+	}
+
+	if before, err := util.KuberlogicToPlan(kp); err == nil {
+		audit.SetChanges(ctx, audit.Changes(before, nil))
+	}
+	return apiPlan.NewPlanDeleteOK()
+}
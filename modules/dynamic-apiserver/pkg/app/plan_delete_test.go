@@ -0,0 +1,67 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func TestPlanDelete(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "ok",
+			status:  200,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			params: apiPlan.PlanDeleteParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+			},
+		},
+		{
+			name:   "not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "service plan not found: small",
+			},
+			params: apiPlan.PlanDeleteParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+			},
+		},
+		{
+			name:   "used-by-services",
+			status: 409,
+			objects: []runtime.Object{
+				testPlan("small", "postgresql"),
+				&v1alpha1.KuberLogicService{
+					ObjectMeta: v1.ObjectMeta{
+						Name:   "demo",
+						Labels: map[string]string{util.PlanField: "small"},
+					},
+					Spec: v1alpha1.KuberLogicServiceSpec{
+						Type: "postgresql",
+					},
+				},
+			},
+			result: &models.Error{
+				Message: "service plan is used by services: demo",
+			},
+			params: apiPlan.PlanDeleteParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).PlanDeleteHandler(tc.params.(apiPlan.PlanDeleteParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func (h *handlers) PlanEditHandler(params apiPlan.PlanEditParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	audit.SetTarget(ctx, "plans/"+params.PlanName)
+
+	if principalTenant(principal) != "" {
+		return apiPlan.NewPlanEditForbidden()
+	}
+
+	kp, err := h.Plans().Get(ctx, params.PlanName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return apiPlan.NewPlanEditNotFound().WithPayload(&models.Error{
+			Message: "service plan not found: " + params.PlanName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting service plan", "error", err, "name", params.PlanName)
+		return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+			Message: "error getting service plan",
+		})
+	}
+	before, err := util.KuberlogicToPlan(kp)
+	if err != nil {
+		h.log.Errorw("error converting service plan to model", "error", err)
+		return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	item := params.PlanItem
+	if *item.Name != params.PlanName {
+		return apiPlan.NewPlanEditBadRequest().WithPayload(&models.Error{
+			Message: "service plan name cannot be changed",
+		})
+	}
+	if *item.Type != *before.Type {
+		return apiPlan.NewPlanEditBadRequest().WithPayload(&models.Error{
+			Message: "service plan type cannot be changed",
+		})
+	}
+
+	// parameters missing in the request are kept
+	updated := *before
+	if item.Description != "" {
+		updated.Description = item.Description
+	}
+	if item.Version != "" {
+		updated.Version = item.Version
+	}
+	if item.Replicas != nil {
+		updated.Replicas = item.Replicas
+	}
+	if item.BackupSchedule != "" {
+		updated.BackupSchedule = item.BackupSchedule
+	}
+	updated.Limits = mergeLimits(before.Limits, item.Limits)
+	if item.Advanced != nil {
+		updated.Advanced = item.Advanced
+	}
+
+	c, err := util.PlanToKuberlogic(&updated)
+	if err != nil {
+		return apiPlan.NewPlanEditBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	patch, err := json.Marshal(map[string]interface{}{"spec": c.Spec})
+	if err != nil {
+		h.log.Errorw("error encoding service plan patch", "error", err)
+		return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	result, err := h.Plans().Patch(ctx, params.PlanName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if k8serrors.IsNotFound(err) {
+		return apiPlan.NewPlanEditNotFound().WithPayload(&models.Error{
+			Message: "service plan not found: " + params.PlanName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error editing service plan", "error", err, "name", params.PlanName)
+		return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	after, err := util.KuberlogicToPlan(result)
+	if err != nil {
+		h.log.Errorw("error converting service plan to model", "error", err)
+		return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	audit.SetChanges(ctx, audit.Changes(before, after))
+
+	if params.Rollout != nil && *params.Rollout {
+		total, skipped, failures, err := h.rolloutPlan(ctx, result)
+		if err != nil {
+			h.log.Errorw("error rolling out service plan", "error", err, "name", params.PlanName)
+			return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+				Message: "service plan is updated, but services are not: " + err.Error(),
+			})
+		}
+		if len(failures) > 0 {
+			return apiPlan.NewPlanEditServiceUnavailable().WithPayload(&models.Error{
+				Message: fmt.Sprintf("service plan is updated, but %d of %d services are not: %s",
+					len(failures)+len(skipped), total, strings.Join(append(failures, skipped...), "; ")),
+			})
+		}
+		if len(skipped) > 0 {
+			return apiPlan.NewPlanEditUnprocessableEntity().WithPayload(&models.Error{
+				Message: fmt.Sprintf("service plan is updated, but %d of %d services are skipped: %s",
+					len(skipped), total, strings.Join(skipped, "; ")),
+			})
+		}
+		h.log.Infow("service plan is rolled out", "name", params.PlanName, "services", total)
+	}
+	return apiPlan.NewPlanEditOK().WithPayload(after)
+}
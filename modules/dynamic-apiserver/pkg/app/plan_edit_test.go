@@ -0,0 +1,180 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func TestPlanEdit(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "ok",
+			status:  200,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result: &models.ServicePlan{
+				Name:           util.StrAsPointer("small"),
+				Type:           util.StrAsPointer("postgresql"),
+				Version:        "14",
+				Replicas:       util.Int64AsPointer(2),
+				BackupSchedule: "0 * * * *",
+				Limits:         &models.Limits{CPU: "1", Storage: "10Gi"},
+				Advanced:       models.Advanced{"plan": "value", "shared": "plan"},
+			},
+			params: apiPlan.PlanEditParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+				PlanItem: &models.ServicePlan{
+					Name:    util.StrAsPointer("small"),
+					Type:    util.StrAsPointer("postgresql"),
+					Version: "14",
+					Limits:  &models.Limits{CPU: "1"},
+				},
+			},
+		},
+		{
+			name:    "type-changed",
+			status:  400,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result: &models.Error{
+				Message: "service plan type cannot be changed",
+			},
+			params: apiPlan.PlanEditParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+				PlanItem: &models.ServicePlan{
+					Name: util.StrAsPointer("small"),
+					Type: util.StrAsPointer("mysql"),
+				},
+			},
+		},
+		{
+			name:   "not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "service plan not found: small",
+			},
+			params: apiPlan.PlanEditParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+				PlanItem: &models.ServicePlan{
+					Name: util.StrAsPointer("small"),
+					Type: util.StrAsPointer("postgresql"),
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).PlanEditHandler(tc.params.(apiPlan.PlanEditParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
+
+func TestPlanEditRollout(t *testing.T) {
+	svc := &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "demo",
+			Labels:      map[string]string{util.PlanField: "small"},
+			Annotations: map[string]string{util.PlanOverridesAnnotation: `{"replicas":1}`},
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type:     "postgresql",
+			Version:  "13",
+			Replicas: 1,
+		},
+	}
+	other := &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{Name: "other"},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type:    "postgresql",
+			Version: "13",
+		},
+	}
+	h := newFakeHandlers(t, testPlan("small", "postgresql"), svc, other)
+
+	params := apiPlan.PlanEditParams{
+		HTTPRequest: &http.Request{},
+		PlanName:    "small",
+		PlanItem: &models.ServicePlan{
+			Name:    util.StrAsPointer("small"),
+			Type:    util.StrAsPointer("postgresql"),
+			Version: "14",
+		},
+		Rollout: util.BoolAsPointer(true),
+	}
+	checkResponse(h.PlanEditHandler(params, nil), t, 200, func(interface{}) {})
+
+	updated, err := h.Services().Get(context.TODO(), "demo", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Spec.Version != "14" || updated.Spec.Replicas != 1 || updated.Spec.BackupSchedule != "0 * * * *" {
+		t.Errorf("plan is not rolled out with overrides kept: %s", prettyPrint(updated.Spec))
+	}
+	if cpu := updated.Spec.Limits.Cpu().String(); cpu != "500m" {
+		t.Errorf("plan limits are not rolled out: %s", cpu)
+	}
+
+	untouched, err := h.Services().Get(context.TODO(), "other", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if untouched.Spec.Version != "13" {
+		t.Errorf("service without the plan is changed: %s", prettyPrint(untouched.Spec))
+	}
+}
+
+func TestPlanEditRolloutTenantQuota(t *testing.T) {
+	var services []runtime.Object
+	for _, name := range []string{"first", "second"} {
+		svc := tenantService(name, "acme", testLimits("500m", "1Gi", "5Gi"))
+		svc.Labels[util.PlanField] = "small"
+		services = append(services, svc)
+	}
+	h := newFakeHandlers(t, append(services, testPlan("small", "postgresql"))...)
+	h.Handlers.(*handlers).config.TenantQuota.Storage = "15Gi"
+
+	params := apiPlan.PlanEditParams{
+		HTTPRequest: &http.Request{},
+		PlanName:    "small",
+		PlanItem: &models.ServicePlan{
+			Name:    util.StrAsPointer("small"),
+			Type:    util.StrAsPointer("postgresql"),
+			Version: "14",
+			Limits:  &models.Limits{CPU: "500m", Storage: "10Gi"},
+		},
+		Rollout: util.BoolAsPointer(true),
+	}
+	checkResponse(h.PlanEditHandler(params, nil), t, 422, func(result interface{}) {
+		message := result.(*models.Error).Message
+		if !strings.HasPrefix(message, "service plan is updated, but 1 of 2 services are skipped: ") ||
+			!strings.Contains(message, "tenant quota exceeded: 20Gi storage requested, 15Gi allowed") {
+			t.Errorf("skipped services are not reported: %s", message)
+		}
+	})
+
+	list, err := h.Services().List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var versions []string
+	for _, item := range list.Items {
+		versions = append(versions, item.Spec.Version)
+	}
+	sort.Strings(versions)
+	if !reflect.DeepEqual(versions, []string{"", "14"}) {
+		t.Errorf("only the service that fits into the quota is expected to be rolled out: %v", versions)
+	}
+}
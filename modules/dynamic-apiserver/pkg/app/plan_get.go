@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func (h *handlers) PlanGetHandler(params apiPlan.PlanGetParams, _ *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kp, err := h.Plans().Get(ctx, params.PlanName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return apiPlan.NewPlanGetNotFound().WithPayload(&models.Error{
+			Message: "service plan not found: " + params.PlanName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting service plan", "error", err, "name", params.PlanName)
+		return apiPlan.NewPlanGetServiceUnavailable().WithPayload(&models.Error{
+			Message: "error getting service plan",
+		})
+	}
+
+	plan, err := util.KuberlogicToPlan(kp)
+	if err != nil {
+		h.log.Errorw("error converting service plan to model", "error", err)
+		return apiPlan.NewPlanGetServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	return apiPlan.NewPlanGetOK().WithPayload(plan)
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func TestPlanGet(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "ok",
+			status:  200,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result: &models.ServicePlan{
+				Name:           util.StrAsPointer("small"),
+				Type:           util.StrAsPointer("postgresql"),
+				Version:        "13",
+				Replicas:       util.Int64AsPointer(2),
+				BackupSchedule: "0 * * * *",
+				Limits:         &models.Limits{CPU: "500m", Storage: "10Gi"},
+				Advanced:       models.Advanced{"plan": "value", "shared": "plan"},
+			},
+			params: apiPlan.PlanGetParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+			},
+		},
+		{
+			name:   "not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "service plan not found: small",
+			},
+			params: apiPlan.PlanGetParams{
+				HTTPRequest: &http.Request{},
+				PlanName:    "small",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).PlanGetHandler(tc.params.(apiPlan.PlanGetParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
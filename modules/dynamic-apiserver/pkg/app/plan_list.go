@@ -0,0 +1,35 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func (h *handlers) PlanListHandler(params apiPlan.PlanListParams, _ *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	list, err := h.Plans().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		h.log.Errorw("error listing service plans", "error", err)
+		return apiPlan.NewPlanListServiceUnavailable().WithPayload(&models.Error{
+			Message: "error listing service plans",
+		})
+	}
+
+	plans := make(models.ServicePlans, 0, len(list.Items))
+	for i := range list.Items {
+		plan, err := util.KuberlogicToPlan(&list.Items[i])
+		if err != nil {
+			h.log.Errorw("error converting service plan to model", "error", err, "name", list.Items[i].GetName())
+			return apiPlan.NewPlanListServiceUnavailable().WithPayload(&models.Error{
+				Message: err.Error(),
+			})
+		}
+		plans = append(plans, plan)
+	}
+	return apiPlan.NewPlanListOK().WithPayload(plans)
+}
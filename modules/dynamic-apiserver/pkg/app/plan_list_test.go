@@ -0,0 +1,48 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiPlan "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func TestPlanList(t *testing.T) {
+	expected := &models.ServicePlan{
+		Name:           util.StrAsPointer("small"),
+		Type:           util.StrAsPointer("postgresql"),
+		Version:        "13",
+		Replicas:       util.Int64AsPointer(2),
+		BackupSchedule: "0 * * * *",
+		Limits:         &models.Limits{CPU: "500m", Storage: "10Gi"},
+		Advanced:       models.Advanced{"plan": "value", "shared": "plan"},
+	}
+	cases := []testCase{
+		{
+			name:   "empty",
+			status: 200,
+			result: models.ServicePlans{},
+			params: apiPlan.PlanListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+		{
+			name:    "ok",
+			status:  200,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result:  models.ServicePlans{expected},
+			params: apiPlan.PlanListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).PlanListHandler(tc.params.(apiPlan.PlanListParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func testPlan(name, typ string) *v1alpha1.KuberLogicServicePlan {
+	return &v1alpha1.KuberLogicServicePlan{
+		ObjectMeta: v1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.KuberLogicServicePlanSpec{
+			Type:           typ,
+			Version:        "13",
+			Replicas:       2,
+			BackupSchedule: "0 * * * *",
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:     resource.MustParse("500m"),
+				corev1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+			Advanced: v11.JSON{Raw: []byte(`{"plan":"value","shared":"plan"}`)},
+		},
+	}
+}
+
+func TestApplyPlan(t *testing.T) {
+	cases := []struct {
+		name      string
+		svc       *models.Service
+		previous  *planOverrides
+		expected  *models.Service
+		overrides *planOverrides
+		err       string
+	}{
+		{
+			name: "plan-only",
+			svc: &models.Service{
+				ID: util.StrAsPointer("demo"),
+			},
+			expected: &models.Service{
+				ID:             util.StrAsPointer("demo"),
+				Type:           util.StrAsPointer("postgresql"),
+				Plan:           "small",
+				Version:        "13",
+				Replicas:       util.Int64AsPointer(2),
+				BackupSchedule: "0 * * * *",
+				Limits:         &models.Limits{CPU: "500m", Storage: "10Gi"},
+				Advanced:       models.Advanced{"plan": "value", "shared": "plan"},
+			},
+			overrides: &planOverrides{},
+		},
+		{
+			name: "with-overrides",
+			svc: &models.Service{
+				ID:       util.StrAsPointer("demo"),
+				Type:     util.StrAsPointer("postgresql"),
+				Version:  "14",
+				Limits:   &models.Limits{Memory: "1Gi", Storage: "20Gi"},
+				Advanced: models.Advanced{"shared": "service"},
+			},
+			previous: &planOverrides{
+				Replicas: util.Int64AsPointer(1),
+			},
+			expected: &models.Service{
+				ID:             util.StrAsPointer("demo"),
+				Type:           util.StrAsPointer("postgresql"),
+				Plan:           "small",
+				Version:        "14",
+				Replicas:       util.Int64AsPointer(1),
+				BackupSchedule: "0 * * * *",
+				Limits:         &models.Limits{CPU: "500m", Memory: "1Gi", Storage: "20Gi"},
+				Advanced:       models.Advanced{"plan": "value", "shared": "service"},
+			},
+			overrides: &planOverrides{
+				Version:  "14",
+				Replicas: util.Int64AsPointer(1),
+				Limits:   &models.Limits{Memory: "1Gi", Storage: "20Gi"},
+				Advanced: models.Advanced{"shared": "service"},
+			},
+		},
+		{
+			name: "type-mismatch",
+			svc: &models.Service{
+				ID:   util.StrAsPointer("demo"),
+				Type: util.StrAsPointer("mysql"),
+			},
+			err: "service type 'mysql' does not match plan 'small' type 'postgresql'",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := newPlanOverrides(tc.svc, tc.previous)
+			err := applyPlan(tc.svc, testPlan("small", "postgresql"), overrides)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.svc, tc.expected) {
+				t.Errorf("service does not equal: actual vs expected\n%s\n%s", prettyPrint(tc.svc), prettyPrint(tc.expected))
+			}
+			if !reflect.DeepEqual(overrides, tc.overrides) {
+				t.Errorf("overrides do not equal: actual vs expected\n%s\n%s", prettyPrint(overrides), prettyPrint(tc.overrides))
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// tenantQuotasConfigMap keeps quotas of individual tenants in the apiserver namespace.
+// Keys are tenants, values are JSON encoded tenantQuota that replace the default quota from the config.
+const tenantQuotasConfigMap = "kuberlogic-tenant-quotas"
+
+// tenantQuota limits services of a tenant, zero values mean no limit
+type tenantQuota struct {
+	Services int    `json:"services,omitempty"`
+	CPU      string `json:"cpu,omitempty"`
+	Memory   string `json:"memory,omitempty"`
+	Storage  string `json:"storage,omitempty"`
+}
+
+func (q *tenantQuota) resources() (corev1.ResourceList, error) {
+	result := make(corev1.ResourceList)
+	for name, value := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:     q.CPU,
+		corev1.ResourceMemory:  q.Memory,
+		corev1.ResourceStorage: q.Storage,
+	} {
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s quota", name)
+		}
+		result[name] = quantity
+	}
+	return result, nil
+}
+
+// tenantQuota returns the quota of the tenant
+func (h *handlers) tenantQuota(ctx context.Context, tenant string) (*tenantQuota, error) {
+	quota := &tenantQuota{
+		Services: h.config.TenantQuota.Services,
+		CPU:      h.config.TenantQuota.Cpu,
+		Memory:   h.config.TenantQuota.Memory,
+		Storage:  h.config.TenantQuota.Storage,
+	}
+
+	cm, err := h.clientset.CoreV1().ConfigMaps(h.config.Namespace).Get(ctx, tenantQuotasConfigMap, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return quota, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "error getting tenant quotas")
+	}
+	if value, ok := cm.Data[tenant]; ok {
+		quota = &tenantQuota{}
+		if err := json.Unmarshal([]byte(value), quota); err != nil {
+			return nil, errors.Wrapf(err, "invalid quota of tenant %s", tenant)
+		}
+	}
+	return quota, nil
+}
+
+// serviceUsage returns resources counted by tenant quotas for the service.
+// Volumes can be sized apart from the storage limit, so the storage requested by volume claims is counted when it is above the limit.
+// The storage of new services is not known until their volume claims are created.
+func serviceUsage(kls *v1alpha1.KuberLogicService) corev1.ResourceList {
+	usage := kls.Spec.Limits.DeepCopy()
+	if usage == nil {
+		usage = make(corev1.ResourceList)
+	}
+	if requested := kls.Status.Storage; requested != nil && requested.Cmp(usage[corev1.ResourceStorage]) > 0 {
+		usage[corev1.ResourceStorage] = requested.DeepCopy()
+	}
+	return usage
+}
+
+// checkTenantQuota returns a reason if the service does not fit into its tenant quota.
+// Usage is the sum of limits declared by tenant services, the service replaces its previous version.
+func (h *handlers) checkTenantQuota(ctx context.Context, kls *v1alpha1.KuberLogicService) (string, error) {
+	tenant := kls.GetLabels()[util.TenantField]
+	if tenant == "" {
+		return "", nil
+	}
+	quota, err := h.tenantQuota(ctx, tenant)
+	if err != nil {
+		return "", err
+	}
+	limits, err := quota.resources()
+	if err != nil {
+		return "", err
+	}
+	if quota.Services == 0 && len(limits) == 0 {
+		return "", nil
+	}
+
+	list, err := h.Services().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{util.TenantField: tenant}.String(),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error listing tenant services")
+	}
+
+	count := 1
+	usage := serviceUsage(kls)
+	for i := range list.Items {
+		item := &list.Items[i]
+		if item.GetName() == kls.GetName() {
+			continue
+		}
+		count++
+		for name, quantity := range serviceUsage(item) {
+			total := usage[name]
+			total.Add(quantity)
+			usage[name] = total
+		}
+	}
+
+	if quota.Services > 0 && count > quota.Services {
+		return fmt.Sprintf("tenant quota exceeded: %d services are allowed", quota.Services), nil
+	}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceStorage} {
+		limit, ok := limits[name]
+		if !ok {
+			continue
+		}
+		if used := usage[name]; used.Cmp(limit) > 0 {
+			return fmt.Sprintf("tenant quota exceeded: %s %s requested, %s allowed", used.String(), name, limit.String()), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func testLimits(cpu, memory, storage string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:     resource.MustParse(cpu),
+		corev1.ResourceMemory:  resource.MustParse(memory),
+		corev1.ResourceStorage: resource.MustParse(storage),
+	}
+}
+
+// withRequestedStorage sets the storage requested by volume claims of the service
+func withRequestedStorage(kls *v1alpha1.KuberLogicService, storage string) *v1alpha1.KuberLogicService {
+	requested := resource.MustParse(storage)
+	kls.Status.Storage = &requested
+	return kls
+}
+
+func TestCheckTenantQuota(t *testing.T) {
+	existing := []runtime.Object{
+		tenantService("first", "acme", testLimits("1", "1Gi", "10Gi")),
+		tenantService("second", "acme", testLimits("500m", "512Mi", "5Gi")),
+		tenantService("foreign", "other", testLimits("4", "8Gi", "100Gi")),
+	}
+
+	cases := []struct {
+		name    string
+		quota   tenantQuota
+		objects []runtime.Object
+		service *v1alpha1.KuberLogicService
+		reason  string
+	}{
+		{
+			name:    "no-quota",
+			objects: existing,
+			service: tenantService("new", "acme", testLimits("8", "64Gi", "1Ti")),
+		},
+		{
+			name:    "no-tenant",
+			quota:   tenantQuota{Services: 1},
+			objects: existing,
+			service: tenantService("new", "", nil),
+		},
+		{
+			name:    "fits",
+			quota:   tenantQuota{Services: 3, CPU: "2", Memory: "2Gi", Storage: "20Gi"},
+			objects: existing,
+			service: tenantService("new", "acme", testLimits("500m", "512Mi", "5Gi")),
+		},
+		{
+			name:    "too-many-services",
+			quota:   tenantQuota{Services: 2},
+			objects: existing,
+			service: tenantService("new", "acme", nil),
+			reason:  "tenant quota exceeded: 2 services are allowed",
+		},
+		{
+			name:    "too-much-memory",
+			quota:   tenantQuota{Memory: "2Gi"},
+			objects: existing,
+			service: tenantService("new", "acme", testLimits("100m", "1Gi", "1Gi")),
+			reason:  "tenant quota exceeded: 2560Mi memory requested, 2Gi allowed",
+		},
+		{
+			name:    "updated-service-replaces-previous",
+			quota:   tenantQuota{Services: 2, CPU: "2"},
+			objects: existing,
+			service: tenantService("first", "acme", testLimits("1500m", "1Gi", "10Gi")),
+		},
+		{
+			name:    "sized-volumes",
+			quota:   tenantQuota{Storage: "40Gi"},
+			objects: append([]runtime.Object{withRequestedStorage(tenantService("sized", "acme", testLimits("100m", "128Mi", "1Gi")), "24Gi")}, existing...),
+			service: tenantService("new", "acme", testLimits("100m", "128Mi", "5Gi")),
+			reason:  "tenant quota exceeded: 44Gi storage requested, 40Gi allowed",
+		},
+		{
+			name:    "updated-service-with-sized-volumes",
+			quota:   tenantQuota{Storage: "40Gi"},
+			objects: existing,
+			service: withRequestedStorage(tenantService("second", "acme", testLimits("500m", "512Mi", "6Gi")), "31Gi"),
+			reason:  "tenant quota exceeded: 41Gi storage requested, 40Gi allowed",
+		},
+		{
+			name:  "tenant-override",
+			quota: tenantQuota{Services: 10},
+			objects: append([]runtime.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: tenantQuotasConfigMap, Namespace: "kuberlogic"},
+				Data:       map[string]string{"acme": `{"services": 2}`},
+			}}, existing...),
+			service: tenantService("new", "acme", nil),
+			reason:  "tenant quota exceeded: 2 services are allowed",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newFakeHandlers(t, tc.objects...)
+			cfg := &h.Handlers.(*handlers).config.TenantQuota
+			cfg.Services, cfg.Cpu, cfg.Memory, cfg.Storage = tc.quota.Services, tc.quota.CPU, tc.quota.Memory, tc.quota.Storage
+
+			reason, err := h.Handlers.(*handlers).checkTenantQuota(context.TODO(), tc.service)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reason != tc.reason {
+				t.Errorf("reason does not equal: actual vs expected\n%s\n%s", reason, tc.reason)
+			}
+		})
+	}
+}
+
+func TestTenantQuotaHandlers(t *testing.T) {
+	h := newFakeHandlers(t, tenantService("first", "acme", testLimits("1", "1Gi", "10Gi")))
+	h.Handlers.(*handlers).config.TenantQuota.Services = 1
+	h.Handlers.(*handlers).config.TenantQuota.Storage = "15Gi"
+
+	checkResponse(h.ServiceAddHandler(apiService.ServiceAddParams{
+		HTTPRequest: &http.Request{},
+		ServiceItem: &models.Service{ID: util.StrAsPointer("second"), Type: util.StrAsPointer("postgresql")},
+	}, tenantPrincipal), t, 403, &models.Error{
+		Message: "tenant quota exceeded: 1 services are allowed",
+	})
+	checkResponse(h.ServiceEditHandler(apiService.ServiceEditParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "first",
+		ServiceItem: &models.Service{
+			ID:     util.StrAsPointer("first"),
+			Type:   util.StrAsPointer("postgresql"),
+			Limits: &models.Limits{Storage: "20Gi"},
+		},
+	}, tenantPrincipal), t, 403, &models.Error{
+		Message: "tenant quota exceeded: 20Gi storage requested, 15Gi allowed",
+	})
+	checkResponse(h.ServiceEditHandler(apiService.ServiceEditParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "first",
+		ServiceItem: &models.Service{
+			ID:     util.StrAsPointer("first"),
+			Type:   util.StrAsPointer("postgresql"),
+			Limits: &models.Limits{Storage: "15Gi"},
+		},
+	}, tenantPrincipal), t, 200, nil)
+}
@@ -7,16 +7,18 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiRestore "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func (h *handlers) RestoreAddHandler(params apiRestore.RestoreAddParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) RestoreAddHandler(params apiRestore.RestoreAddParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	backupName := params.RestoreItem.BackupID
-	klb, err := h.Backups().Get(ctx, backupName, v1.GetOptions{})
+	audit.SetTarget(ctx, "backups/"+backupName)
+	klb, err := h.getBackup(ctx, principal, backupName)
 	if k8serrors.IsNotFound(err) {
 		return apiRestore.NewRestoreAddBadRequest().WithPayload(&models.Error{
 			Message: fmt.Sprintf("backup `%s` not found", backupName),
@@ -41,5 +43,8 @@ func (h *handlers) RestoreAddHandler(params apiRestore.RestoreAddParams, _ *mode
 			Message: err.Error(),
 		})
 	}
-	return apiRestore.NewRestoreAddCreated().WithPayload(util.KuberlogicToRestore(result))
+	audit.SetTarget(ctx, "restores/"+result.GetName())
+	restore := util.KuberlogicToRestore(result)
+	audit.SetChanges(ctx, audit.Changes(nil, restore))
+	return apiRestore.NewRestoreAddCreated().WithPayload(restore)
 }
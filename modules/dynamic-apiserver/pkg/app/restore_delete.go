@@ -9,9 +9,18 @@ import (
 	apiRestore "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
 )
 
-func (h *handlers) RestoreDeleteHandler(params apiRestore.RestoreDeleteParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) RestoreDeleteHandler(params apiRestore.RestoreDeleteParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
+	if _, err := h.getRestore(ctx, principal, params.RestoreID); errors.IsNotFound(err) {
+		return apiRestore.NewRestoreDeleteNotFound()
+	} else if err != nil {
+		h.log.Errorw("error getting klr", "error", err, "name", params.RestoreID)
+		return apiRestore.NewRestoreDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error deleting restore",
+		})
+	}
+
 	if err := h.Restores().Delete(ctx, params.RestoreID, v1.DeleteOptions{}); errors.IsNotFound(err) {
 		return apiRestore.NewRestoreDeleteNotFound()
 	} else if err != nil {
@@ -1,30 +1,75 @@
 package app
 
 import (
+	"context"
+
 	"github.com/go-openapi/runtime/middleware"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiRestore "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) RestoreListHandler(params apiRestore.RestoreListParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) RestoreListHandler(params apiRestore.RestoreListParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	opts := h.ListOptionsByKeyValue(util.BackupRestoreServiceField, params.ServiceID)
-	result, err := h.Restores().List(ctx, opts)
+	page, err := newPageRequest(params.Limit, params.Continue, params.Sort, nil)
+	if err != nil {
+		return apiRestore.NewRestoreListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	filter, err := newListFilter(params.CreatedAfter, params.CreatedBefore)
+	if err != nil {
+		return apiRestore.NewRestoreListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	backups, err := h.tenantBackups(ctx, principal)
 	if err != nil {
+		msg := "error listing tenant backups"
+		h.log.Errorw(msg, "error", err)
+		return apiRestore.NewRestoreListServiceUnavailable().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+	filter = append(filter, func(obj metav1.Object) bool {
+		klr := obj.(*v1alpha1.KuberlogicServiceRestore)
+		return (backups == nil || backups[klr.Spec.KuberlogicServiceBackup]) &&
+			(params.Status == nil || klr.Status.Phase == *params.Status)
+	})
+
+	opts := h.ListOptionsByKeyValue(util.BackupRestoreServiceField, params.ServiceID)
+	result, next, err := listPage(ctx, opts, page, filter, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error) {
+		list, err := h.Restores().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]metav1.Object, 0, len(list.Items))
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+		return items, list.Continue, nil
+	})
+	if _, ok := err.(*listRequestError); ok {
+		return apiRestore.NewRestoreListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	} else if err != nil {
 		msg := "error listing result"
-		h.log.Errorw(msg)
+		h.log.Errorw(msg, "error", err)
 		return apiRestore.NewRestoreListServiceUnavailable().WithPayload(&models.Error{
 			Message: msg,
 		})
 	}
-	h.log.Debugw("found kuberlogicservicerestores objects", "count", len(result.Items), "objects", result)
+	h.log.Debugw("found kuberlogicservicerestores objects", "count", len(result))
 
 	items := make([]*models.Restore, 0)
-	for _, klr := range result.Items {
-		items = append(items, util.KuberlogicToRestore(&klr))
+	for _, klr := range result {
+		items = append(items, util.KuberlogicToRestore(klr.(*v1alpha1.KuberlogicServiceRestore)))
 	}
-	return apiRestore.NewRestoreListOK().WithPayload(items)
+	return apiRestore.NewRestoreListOK().WithPayload(items).WithXContinue(next)
 }
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// declaredSecret returns the service secret key declared by the service plugin
+func declaredSecret(kls *v1alpha1.KuberLogicService, id string) (v1alpha1.ServiceSecretKey, bool) {
+	if kls.Status.Secrets == nil {
+		return v1alpha1.ServiceSecretKey{}, false
+	}
+	for _, key := range kls.Status.Secrets.Keys {
+		if key.ID == id {
+			return key, true
+		}
+	}
+	return v1alpha1.ServiceSecretKey{}, false
+}
+
+// secretStorage returns the Secret object that keeps service secrets, the service must declare secrets
+func (h *handlers) secretStorage(ctx context.Context, kls *v1alpha1.KuberLogicService) (*corev1.Secret, error) {
+	return h.clientset.CoreV1().Secrets(kls.Status.Namespace).Get(ctx, kls.Status.Secrets.Name, metav1.GetOptions{})
+}
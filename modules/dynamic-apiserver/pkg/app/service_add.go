@@ -7,13 +7,26 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func (h *handlers) ServiceAddHandler(params apiService.ServiceAddParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceAddHandler(params apiService.ServiceAddParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
+	audit.SetTarget(ctx, "services/"+*params.ServiceItem.ID)
+
+	if tenant := principalTenant(principal); tenant != "" {
+		if params.ServiceItem.Tenant == "" {
+			params.ServiceItem.Tenant = tenant
+		} else if params.ServiceItem.Tenant != tenant {
+			return apiService.NewServiceAddForbidden().WithPayload(
+				&models.Error{
+					Message: fmt.Sprintf("service can't be created for tenant '%s'", params.ServiceItem.Tenant),
+				})
+		}
+	}
 
 	if params.ServiceItem.Subscription != "" {
 		opts := h.ListOptionsByKeyValue(util.SubscriptionField, &params.ServiceItem.Subscription)
@@ -30,6 +43,30 @@ func (h *handlers) ServiceAddHandler(params apiService.ServiceAddParams, _ *mode
 		}
 	}
 
+	var overrides *planOverrides
+	if name := params.ServiceItem.Plan; name != "" {
+		plan, err := h.Plans().Get(ctx, name, v1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return apiService.NewServiceAddBadRequest().WithPayload(
+				&models.Error{
+					Message: fmt.Sprintf("service plan not found: %s", name),
+				})
+		} else if err != nil {
+			h.log.Errorw("error getting service plan", "error", err, "plan", name)
+			return apiService.NewServiceAddServiceUnavailable().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+		overrides = newPlanOverrides(params.ServiceItem, nil)
+		if err := applyPlan(params.ServiceItem, plan, overrides); err != nil {
+			return apiService.NewServiceAddBadRequest().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+	}
+
 	c, err := util.ServiceToKuberlogic(params.ServiceItem, h.config)
 	if err != nil {
 		h.log.Errorw("error converting service model to kuberlogic", "error", err)
@@ -38,6 +75,29 @@ func (h *handlers) ServiceAddHandler(params apiService.ServiceAddParams, _ *mode
 				Message: err.Error(),
 			})
 	}
+	if overrides != nil {
+		annotation, err := overrides.annotation()
+		if err != nil {
+			return apiService.NewServiceAddBadRequest().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+		c.SetAnnotations(map[string]string{util.PlanOverridesAnnotation: annotation})
+	}
+
+	if reason, err := h.checkTenantQuota(ctx, c); err != nil {
+		h.log.Errorw("error checking tenant quota", "error", err)
+		return apiService.NewServiceAddServiceUnavailable().WithPayload(
+			&models.Error{
+				Message: err.Error(),
+			})
+	} else if reason != "" {
+		return apiService.NewServiceAddForbidden().WithPayload(
+			&models.Error{
+				Message: reason,
+			})
+	}
 
 	result, err := h.Services().Create(ctx, c, v1.CreateOptions{})
 	if errors.IsAlreadyExists(err) {
@@ -60,5 +120,6 @@ func (h *handlers) ServiceAddHandler(params apiService.ServiceAddParams, _ *mode
 			})
 	}
 
+	audit.SetChanges(ctx, audit.Changes(nil, svc))
 	return apiService.NewServiceAddCreated().WithPayload(svc)
 }
@@ -157,6 +157,75 @@ func TestServiceAdd(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "ok-plan",
+			status:  201,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result: &models.Service{
+				ID:             util.StrAsPointer("simple"),
+				Type:           util.StrAsPointer("postgresql"),
+				Plan:           "small",
+				Version:        "14",
+				Replicas:       util.Int64AsPointer(2),
+				BackupSchedule: "0 * * * *",
+				Domain:         "simple.kuberlogic.local",
+				Limits: &models.Limits{
+					CPU:     "500m",
+					Memory:  "1Gi",
+					Storage: "10Gi",
+				},
+				Advanced: models.Advanced{
+					"plan":   "value",
+					"shared": "service",
+				},
+			},
+			params: apiService.ServiceAddParams{
+				HTTPRequest: &http.Request{},
+				ServiceItem: &models.Service{
+					ID:      util.StrAsPointer("simple"),
+					Type:    util.StrAsPointer("postgresql"),
+					Plan:    "small",
+					Version: "14",
+					Limits: &models.Limits{
+						Memory: "1Gi",
+					},
+					Advanced: models.Advanced{
+						"shared": "service",
+					},
+				},
+			},
+		},
+		{
+			name:   "plan-not-found",
+			status: 400,
+			result: &models.Error{
+				Message: "service plan not found: small",
+			},
+			params: apiService.ServiceAddParams{
+				HTTPRequest: &http.Request{},
+				ServiceItem: &models.Service{
+					ID:   util.StrAsPointer("simple"),
+					Type: util.StrAsPointer("postgresql"),
+					Plan: "small",
+				},
+			},
+		},
+		{
+			name:    "plan-type-mismatch",
+			status:  400,
+			objects: []runtime.Object{testPlan("small", "postgresql")},
+			result: &models.Error{
+				Message: "service type 'mysql' does not match plan 'small' type 'postgresql'",
+			},
+			params: apiService.ServiceAddParams{
+				HTTPRequest: &http.Request{},
+				ServiceItem: &models.Service{
+					ID:   util.StrAsPointer("simple"),
+					Type: util.StrAsPointer("mysql"),
+					Plan: "small",
+				},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
@@ -19,11 +20,11 @@ import (
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) ServiceArchiveHandler(params apiService.ServiceArchiveParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceArchiveHandler(params apiService.ServiceArchiveParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	// Check if service exists first
-	kls, err := h.Services().Get(ctx, params.ServiceID, v1.GetOptions{})
+	kls, err := h.getService(ctx, principal, params.ServiceID)
 	if k8serrors.IsNotFound(err) {
 		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
 		h.log.Errorw(msg, "error", err)
@@ -45,6 +46,10 @@ func (h *handlers) ServiceArchiveHandler(params apiService.ServiceArchiveParams,
 		})
 	}
 
+	after := kls.DeepCopy()
+	after.Spec.Archived = true
+	audit.SetChanges(ctx, audit.Changes(kls.Spec, after.Spec))
+
 	// Get service archive in background
 	go func() {
 		if err := h.archiveService(kls.GetName()); err != nil {
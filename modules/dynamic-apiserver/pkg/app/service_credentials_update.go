@@ -9,16 +9,17 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) ServiceCredentialsUpdateHandler(params apiService.ServiceCredentialsUpdateParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceCredentialsUpdateHandler(params apiService.ServiceCredentialsUpdateParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	// check for service existence
-	kls, err := h.Services().Get(ctx, params.ServiceID, v1.GetOptions{})
+	kls, err := h.getService(ctx, principal, params.ServiceID)
 	if k8serrors.IsNotFound(err) {
 		return apiService.NewServiceCredentialsUpdateBadRequest().WithPayload(&models.Error{
 			Message: "service does not exist",
@@ -28,6 +29,9 @@ func (h *handlers) ServiceCredentialsUpdateHandler(params apiService.ServiceCred
 		return apiService.NewServiceCredentialsUpdateServiceUnavailable()
 	}
 
+	// only changed fields are recorded, values are secret
+	audit.SetChanges(ctx, audit.Redact(audit.Changes(nil, params.ServiceCredentials)))
+
 	// create a credential secret
 	credentialsUpdateRequest := &corev1.Secret{
 		ObjectMeta: v1.ObjectMeta{
@@ -7,14 +7,17 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func (h *handlers) ServiceDeleteHandler(params apiService.ServiceDeleteParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceDeleteHandler(params apiService.ServiceDeleteParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	if _, err := h.Services().Get(ctx, params.ServiceID, v1.GetOptions{}); errors.IsNotFound(err) {
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if errors.IsNotFound(err) {
 		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
 		h.log.Warnw(msg, "error", err)
 		return apiService.NewServiceDeleteNotFound().WithPayload(&models.Error{
@@ -36,5 +39,8 @@ func (h *handlers) ServiceDeleteHandler(params apiService.ServiceDeleteParams, _
 		})
 	}
 
+	if before, err := util.KuberlogicToService(kls); err == nil {
+		audit.SetChanges(ctx, audit.Changes(before, nil))
+	}
 	return apiService.NewServiceDeleteOK()
 }
@@ -5,26 +5,102 @@ import (
 	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func (h *handlers) ServiceEditHandler(params apiService.ServiceEditParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceEditHandler(params apiService.ServiceEditParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	// TODO: check if service exists by params.ServiceID
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if errors.IsNotFound(err) {
+		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
+		h.log.Warnw(msg, "error", err)
+		return apiService.NewServiceEditNotFound().WithPayload(&models.Error{
+			Message: msg,
+		})
+	} else if err != nil {
+		msg := "error finding service"
+		h.log.Errorw(msg, "error", err)
+		return apiService.NewServiceEditServiceUnavailable().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+
+	if params.IfMatch != nil && !etagMatches(*params.IfMatch, kls) {
+		return apiService.NewServiceEditPreconditionFailed().WithPayload(&models.Error{
+			Message: fmt.Sprintf("service %s was changed, its current ETag is %s", params.ServiceID, serviceETag(kls)),
+		})
+	}
 
+	if *params.ServiceItem.ID != params.ServiceID {
+		return apiService.NewServiceEditBadRequest().WithPayload(
+			&models.Error{
+				Message: "service id cannot be changed",
+			})
+	}
 	if params.ServiceItem.Subscription != "" {
 		return apiService.NewServiceEditBadRequest().WithPayload(
 			&models.Error{
 				Message: "subscription cannot be changed",
 			})
 	}
+	if params.ServiceItem.Tenant != "" && params.ServiceItem.Tenant != kls.GetLabels()[util.TenantField] {
+		return apiService.NewServiceEditBadRequest().WithPayload(
+			&models.Error{
+				Message: "tenant cannot be changed",
+			})
+	}
+
+	// services created with a plan keep the request parameters as overrides of the plan
+	var overrides *planOverrides
+	planName := params.ServiceItem.Plan
+	if planName == "" {
+		planName = kls.GetLabels()[util.PlanField]
+	}
+	if planName != "" {
+		plan, err := h.Plans().Get(ctx, planName, v1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return apiService.NewServiceEditBadRequest().WithPayload(
+				&models.Error{
+					Message: fmt.Sprintf("service plan not found: %s", planName),
+				})
+		} else if err != nil {
+			h.log.Errorw("error getting service plan", "error", err, "plan", planName)
+			return apiService.NewServiceEditServiceUnavailable().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+		if plan.Spec.Type != kls.Spec.Type {
+			return apiService.NewServiceEditBadRequest().WithPayload(
+				&models.Error{
+					Message: fmt.Sprintf("service type '%s' does not match plan '%s' type '%s'", kls.Spec.Type, planName, plan.Spec.Type),
+				})
+		}
+		previous, err := serviceOverrides(kls)
+		if err != nil {
+			h.log.Errorw("error decoding plan overrides", "error", err)
+			return apiService.NewServiceEditServiceUnavailable().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+		overrides = newPlanOverrides(params.ServiceItem, previous)
+		if err := applyPlan(params.ServiceItem, plan, overrides); err != nil {
+			return apiService.NewServiceEditBadRequest().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+	}
 
 	c, err := util.ServiceToKuberlogic(params.ServiceItem, h.config)
 	if err != nil {
@@ -34,7 +110,42 @@ func (h *handlers) ServiceEditHandler(params apiService.ServiceEditParams, _ *mo
 				Message: err.Error(),
 			})
 	}
+	if overrides != nil {
+		annotation, err := overrides.annotation()
+		if err != nil {
+			return apiService.NewServiceEditBadRequest().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		}
+		c.SetAnnotations(map[string]string{util.PlanOverridesAnnotation: annotation})
+	}
+
+	if c.Spec.Limits != nil {
+		// limits missing in the request are kept by the merge patch
+		updated := kls.DeepCopy()
+		if updated.Spec.Limits == nil {
+			updated.Spec.Limits = make(corev1.ResourceList)
+		}
+		for name, quantity := range c.Spec.Limits {
+			updated.Spec.Limits[name] = quantity
+		}
+		if reason, err := h.checkTenantQuota(ctx, updated); err != nil {
+			h.log.Errorw("error checking tenant quota", "error", err)
+			return apiService.NewServiceEditServiceUnavailable().WithPayload(
+				&models.Error{
+					Message: err.Error(),
+				})
+		} else if reason != "" {
+			return apiService.NewServiceEditForbidden().WithPayload(
+				&models.Error{
+					Message: reason,
+				})
+		}
+	}
 
+	// the patch is applied to the read version only, so changes made since then are not overwritten
+	c.SetResourceVersion(kls.GetResourceVersion())
 	patch, err := json.Marshal(c)
 	if err != nil {
 		h.log.Errorw("service decode error", "error", err)
@@ -44,12 +155,21 @@ func (h *handlers) ServiceEditHandler(params apiService.ServiceEditParams, _ *mo
 			})
 	}
 
-	if _, err = h.Services().Patch(ctx, c.GetName(), types.MergePatchType, patch, v1.PatchOptions{}); errors.IsNotFound(err) {
+	result, err := h.Services().Patch(ctx, c.GetName(), types.MergePatchType, patch, v1.PatchOptions{})
+	if errors.IsNotFound(err) {
 		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
 		h.log.Warnw(msg, "error", err)
 		return apiService.NewServiceEditNotFound().WithPayload(&models.Error{
 			Message: msg,
 		})
+	} else if errors.IsConflict(err) && params.IfMatch != nil {
+		return apiService.NewServiceEditPreconditionFailed().WithPayload(&models.Error{
+			Message: fmt.Sprintf("service %s was changed, get it again to edit", params.ServiceID),
+		})
+	} else if errors.IsConflict(err) {
+		return apiService.NewServiceEditConflict().WithPayload(&models.Error{
+			Message: fmt.Sprintf("service %s was changed by a concurrent request, retry the edit", params.ServiceID),
+		})
 	} else if err != nil {
 		h.log.Errorw("error creating kuberlogicservice", "error", err)
 		return apiService.NewServiceEditBadRequest().WithPayload(
@@ -58,5 +178,10 @@ func (h *handlers) ServiceEditHandler(params apiService.ServiceEditParams, _ *mo
 			})
 	}
 
-	return apiService.NewServiceEditOK()
+	before, beforeErr := util.KuberlogicToService(kls)
+	after, afterErr := util.KuberlogicToService(result)
+	if beforeErr == nil && afterErr == nil {
+		audit.SetChanges(ctx, audit.Changes(before, after))
+	}
+	return apiService.NewServiceEditOK().WithETag(serviceETag(result))
 }
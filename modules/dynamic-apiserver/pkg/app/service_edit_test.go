@@ -1,11 +1,16 @@
 package app
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
@@ -119,3 +124,107 @@ func TestServiceEdit(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceEditPlan(t *testing.T) {
+	svc := &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "demo",
+			Labels:      map[string]string{util.PlanField: "small"},
+			Annotations: map[string]string{util.PlanOverridesAnnotation: `{"version":"14"}`},
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type:     "postgresql",
+			Version:  "14",
+			Replicas: 2,
+		},
+	}
+	h := newFakeHandlers(t, testPlan("small", "postgresql"), svc)
+
+	params := apiService.ServiceEditParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "demo",
+		ServiceItem: &models.Service{
+			ID:       util.StrAsPointer("demo"),
+			Type:     util.StrAsPointer("postgresql"),
+			Replicas: util.Int64AsPointer(3),
+		},
+	}
+	checkResponse(h.ServiceEditHandler(params, nil), t, 200, nil)
+
+	updated, err := h.Services().Get(context.TODO(), "demo", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Spec.Version != "14" || updated.Spec.Replicas != 3 || updated.Spec.BackupSchedule != "0 * * * *" {
+		t.Errorf("plan is not merged with overrides: %s", prettyPrint(updated.Spec))
+	}
+	if overrides := updated.GetAnnotations()[util.PlanOverridesAnnotation]; overrides != `{"version":"14","replicas":3}` {
+		t.Errorf("unexpected overrides: %s", overrides)
+	}
+}
+
+func TestServiceEditPreconditions(t *testing.T) {
+	svc := &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            "demo",
+			ResourceVersion: "5",
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type:     "postgresql",
+			Replicas: 1,
+		},
+	}
+	params := func(ifMatch *string) apiService.ServiceEditParams {
+		return apiService.ServiceEditParams{
+			HTTPRequest: &http.Request{},
+			ServiceID:   "demo",
+			IfMatch:     ifMatch,
+			ServiceItem: &models.Service{
+				ID:       util.StrAsPointer("demo"),
+				Type:     util.StrAsPointer("postgresql"),
+				Replicas: util.Int64AsPointer(2),
+			},
+		}
+	}
+
+	t.Run("stale", func(t *testing.T) {
+		h := newFakeHandlers(t, svc)
+		checkResponse(h.ServiceEditHandler(params(util.StrAsPointer(`"4"`)), nil), t, 412, &models.Error{
+			Message: `service demo was changed, its current ETag is "5"`,
+		})
+	})
+
+	t.Run("matching", func(t *testing.T) {
+		h := newFakeHandlers(t, svc)
+		var patch map[string]interface{}
+		h.PrependReactor("patch", "kuberlogicservices", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			if err := json.Unmarshal(action.(clienttesting.PatchAction).GetPatch(), &patch); err != nil {
+				t.Fatal(err)
+			}
+			return false, nil, nil
+		})
+		for _, ifMatch := range []string{`"5"`, `W/"5"`, `"3", "5"`, "*"} {
+			resp := h.ServiceEditHandler(params(util.StrAsPointer(ifMatch)), nil)
+			checkResponse(resp, t, 200, nil)
+			if etag := resp.(*apiService.ServiceEditOK).ETag; etag == "" {
+				t.Errorf("%s: etag is not set", ifMatch)
+			}
+		}
+		if rv := patch["metadata"].(map[string]interface{})["resourceVersion"]; rv != "5" {
+			t.Errorf("patch is not conditional on the read version: %v", patch)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		h := newFakeHandlers(t, svc)
+		h.PrependReactor("patch", "kuberlogicservices", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.NewConflict(schema.GroupResource{Resource: "kuberlogicservices"}, "demo", nil)
+		})
+		checkResponse(h.ServiceEditHandler(params(nil), nil), t, 409, &models.Error{
+			Message: "service demo was changed by a concurrent request, retry the edit",
+		})
+		checkResponse(h.ServiceEditHandler(params(util.StrAsPointer(`"5"`)), nil), t, 412, &models.Error{
+			Message: "service demo was changed, get it again to edit",
+		})
+	})
+}
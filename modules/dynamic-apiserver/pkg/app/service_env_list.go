@@ -0,0 +1,46 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func (h *handlers) ServiceEnvListHandler(params apiService.ServiceEnvListParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceEnvListNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to get service", "error", err.Error())
+		return apiService.NewServiceEnvListServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to get service: " + err.Error(),
+		})
+	}
+
+	result := &models.ServiceEnv{
+		Variables:  make([]*models.EnvVar, 0, len(kls.Spec.Env)),
+		Parameters: make([]*models.ParameterSchema, 0, len(kls.Status.Parameters)),
+	}
+	for _, e := range kls.Spec.Env {
+		result.Variables = append(result.Variables, envVarModel(e))
+	}
+	for _, p := range kls.Status.Parameters {
+		result.Parameters = append(result.Parameters, &models.ParameterSchema{
+			Name:        p.Name,
+			Container:   p.Container,
+			Description: p.Description,
+			Type:        p.Type,
+			Default:     p.Default,
+			Secret:      p.Secret,
+		})
+	}
+	return apiService.NewServiceEnvListOK().WithPayload(result)
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// testEnvService returns a provisioned service with environment variables env
+func testEnvService(env ...v1alpha1.EnvVar) *v1alpha1.KuberLogicService {
+	return &v1alpha1.KuberLogicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "env-test",
+			UID:  "env-test-uid",
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type: "demo",
+			Env:  env,
+		},
+		Status: v1alpha1.KuberLogicServiceStatus{
+			Namespace: "env-test",
+			Parameters: []v1alpha1.ParameterSchema{
+				{Name: "LOG_LEVEL", Type: "string", Default: "info"},
+				{Name: "SMTP_PASSWORD", Type: "string", Secret: true},
+			},
+		},
+	}
+}
+
+func TestServiceEnvList(t *testing.T) {
+	cases := []testCase{
+		{
+			name:   "service-not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "kuberlogic service not found: env-test",
+			},
+			params: apiService.ServiceEnvListParams{
+				HTTPRequest: &http.Request{},
+				ServiceID:   "env-test",
+			},
+		}, {
+			name:   "ok",
+			status: 200,
+			objects: []runtime.Object{
+				testEnvService(
+					v1alpha1.EnvVar{Name: "LOG_LEVEL", Value: "debug"},
+					v1alpha1.EnvVar{Name: "SMTP_PASSWORD", Container: "app", Secret: true},
+				),
+			},
+			result: &models.ServiceEnv{
+				Variables: []*models.EnvVar{
+					{Name: "LOG_LEVEL", Value: "debug"},
+					{Name: "SMTP_PASSWORD", Container: "app", Secret: true},
+				},
+				Parameters: []*models.ParameterSchema{
+					{Name: "LOG_LEVEL", Type: "string", Default: "info"},
+					{Name: "SMTP_PASSWORD", Type: "string", Secret: true},
+				},
+			},
+			params: apiService.ServiceEnvListParams{
+				HTTPRequest: &http.Request{},
+				ServiceID:   "env-test",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).ServiceEnvListHandler(tc.params.(apiService.ServiceEnvListParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
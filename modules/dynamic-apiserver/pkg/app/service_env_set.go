@@ -0,0 +1,115 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func (h *handlers) ServiceEnvSetHandler(params apiService.ServiceEnvSetParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceEnvSetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to get service", "error", err.Error())
+		return apiService.NewServiceEnvSetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to get service: " + err.Error(),
+		})
+	}
+
+	e := v1alpha1.EnvVar{
+		Name:   params.EnvName,
+		Value:  params.EnvValue.Value,
+		Secret: params.EnvValue.Secret,
+	}
+	if params.Container != nil {
+		e.Container = *params.Container
+	}
+
+	changes := audit.Changes(nil, params.EnvValue)
+	if e.Secret {
+		changes = audit.Redact(changes)
+	}
+	audit.SetChanges(ctx, changes)
+
+	// the secret value is set before the variable refers to it and restored when the variable is rejected
+	var previousValue []byte
+	var previousFound bool
+	if e.Secret {
+		if kls.Status.Namespace == "" {
+			return apiService.NewServiceEnvSetBadRequest().WithPayload(&models.Error{
+				Message: fmt.Sprintf("service %s is not provisioned yet, secret variables can't be set", params.ServiceID),
+			})
+		}
+		if previousValue, previousFound, err = h.envSecretValue(ctx, kls, e.SecretKey()); err != nil {
+			h.log.Errorw("failed to get secret variable", "error", err.Error())
+			return apiService.NewServiceEnvSetServiceUnavailable().WithPayload(&models.Error{
+				Message: "failed to set secret variable",
+			})
+		}
+		if err := h.setEnvSecret(ctx, kls, e.SecretKey(), e.Value); k8serrors.IsConflict(err) {
+			return apiService.NewServiceEnvSetConflict().WithPayload(&models.Error{
+				Message: fmt.Sprintf("variable %s was changed by a concurrent request, retry the change", e.Name),
+			})
+		} else if err != nil {
+			h.log.Errorw("failed to set secret variable", "error", err.Error())
+			return apiService.NewServiceEnvSetServiceUnavailable().WithPayload(&models.Error{
+				Message: "failed to set secret variable",
+			})
+		}
+		// the value is kept in the secret only
+		e.Value = ""
+	}
+
+	var previous v1alpha1.EnvVar
+	env := append([]v1alpha1.EnvVar{}, kls.Spec.Env...)
+	if i := findEnv(kls, e.Name, e.Container); i >= 0 {
+		previous, env[i] = env[i], e
+	} else {
+		env = append(env, e)
+	}
+
+	if err := h.patchEnv(ctx, kls, env); err != nil {
+		if e.Secret {
+			if err := h.restoreEnvSecret(ctx, kls, e.SecretKey(), previousValue, previousFound); err != nil {
+				h.log.Errorw("failed to restore secret variable value", "error", err.Error(), "variable", e.Name)
+			}
+		}
+		switch {
+		case k8serrors.IsNotFound(err):
+			return apiService.NewServiceEnvSetNotFound().WithPayload(&models.Error{
+				Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+			})
+		case k8serrors.IsConflict(err):
+			return apiService.NewServiceEnvSetConflict().WithPayload(&models.Error{
+				Message: fmt.Sprintf("service %s was changed by a concurrent request, retry the change", params.ServiceID),
+			})
+		case envRejected(err):
+			// variables are validated by the service plugin
+			return apiService.NewServiceEnvSetBadRequest().WithPayload(&models.Error{
+				Message: err.Error(),
+			})
+		}
+		h.log.Errorw("failed to set variable", "error", err.Error(), "variable", e.Name)
+		return apiService.NewServiceEnvSetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to set variable",
+		})
+	}
+
+	if previous.Secret && !e.Secret {
+		if err := h.unsetEnvSecret(ctx, kls, e.SecretKey()); err != nil {
+			h.log.Errorw("failed to remove secret variable value", "error", err.Error(), "variable", e.Name)
+		}
+	}
+	return apiService.NewServiceEnvSetOK().WithPayload(envVarModel(e))
+}
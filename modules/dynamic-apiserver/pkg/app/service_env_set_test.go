@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func TestServiceEnvSet(t *testing.T) {
+	params := func(name, container string, value *models.EnvValue) apiService.ServiceEnvSetParams {
+		p := apiService.ServiceEnvSetParams{
+			HTTPRequest: &http.Request{},
+			ServiceID:   "env-test",
+			EnvName:     name,
+			EnvValue:    value,
+		}
+		if container != "" {
+			p.Container = util.StrAsPointer(container)
+		}
+		return p
+	}
+
+	t.Run("service-not-found", func(t *testing.T) {
+		h := newFakeHandlers(t)
+		checkResponse(h.ServiceEnvSetHandler(params("LOG_LEVEL", "", &models.EnvValue{Value: "debug"}), nil), t, 404, &models.Error{
+			Message: "kuberlogic service not found: env-test",
+		})
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		h := newFakeHandlers(t, testEnvService(v1alpha1.EnvVar{Name: "LOG_LEVEL", Value: "info"}))
+		checkResponse(h.ServiceEnvSetHandler(params("LOG_LEVEL", "", &models.EnvValue{Value: "debug"}), nil), t, 200,
+			&models.EnvVar{Name: "LOG_LEVEL", Value: "debug"})
+		checkResponse(h.ServiceEnvSetHandler(params("LOG_LEVEL", "worker", &models.EnvValue{Value: "warn"}), nil), t, 200,
+			&models.EnvVar{Name: "LOG_LEVEL", Container: "worker", Value: "warn"})
+
+		kls, err := h.Services().Get(context.TODO(), "env-test", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []v1alpha1.EnvVar{
+			{Name: "LOG_LEVEL", Value: "debug"},
+			{Name: "LOG_LEVEL", Container: "worker", Value: "warn"},
+		}
+		if !reflect.DeepEqual(kls.Spec.Env, expected) {
+			t.Errorf("expected vs actual: %+v vs %+v", expected, kls.Spec.Env)
+		}
+	})
+
+	t.Run("secret", func(t *testing.T) {
+		h := newFakeHandlers(t, testEnvService())
+		checkResponse(h.ServiceEnvSetHandler(params("SMTP_PASSWORD", "app", &models.EnvValue{Value: "password", Secret: true}), nil), t, 200,
+			&models.EnvVar{Name: "SMTP_PASSWORD", Container: "app", Secret: true})
+
+		kls, err := h.Services().Get(context.TODO(), "env-test", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []v1alpha1.EnvVar{{Name: "SMTP_PASSWORD", Container: "app", Secret: true}}
+		if !reflect.DeepEqual(kls.Spec.Env, expected) {
+			t.Errorf("expected vs actual: %+v vs %+v", expected, kls.Spec.Env)
+		}
+
+		secret, err := h.Handlers.(*handlers).clientset.CoreV1().Secrets("env-test").Get(context.TODO(), v1alpha1.EnvSecretName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(secret.Data["app.SMTP_PASSWORD"]) != "password" {
+			t.Errorf("unexpected secret data: %v", secret.Data)
+		}
+		if owner := secret.GetOwnerReferences(); len(owner) != 1 || owner[0].Name != "env-test" || owner[0].UID != "env-test-uid" {
+			t.Errorf("unexpected secret owner: %+v", owner)
+		}
+
+		// changing the variable to a plain one removes the secret value
+		checkResponse(h.ServiceEnvSetHandler(params("SMTP_PASSWORD", "app", &models.EnvValue{Value: "plain"}), nil), t, 200,
+			&models.EnvVar{Name: "SMTP_PASSWORD", Container: "app", Value: "plain"})
+		secret, err = h.Handlers.(*handlers).clientset.CoreV1().Secrets("env-test").Get(context.TODO(), v1alpha1.EnvSecretName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, set := secret.Data["app.SMTP_PASSWORD"]; set {
+			t.Errorf("secret value is not removed: %v", secret.Data)
+		}
+	})
+
+	t.Run("not-provisioned", func(t *testing.T) {
+		kls := testEnvService()
+		kls.Status = v1alpha1.KuberLogicServiceStatus{}
+		h := newFakeHandlers(t, kls)
+		checkResponse(h.ServiceEnvSetHandler(params("SMTP_PASSWORD", "", &models.EnvValue{Value: "password", Secret: true}), nil), t, 400, &models.Error{
+			Message: "service env-test is not provisioned yet, secret variables can't be set",
+		})
+	})
+
+	t.Run("existing-secret", func(t *testing.T) {
+		h := newFakeHandlers(t, testEnvService(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.EnvSecretName, Namespace: "env-test"},
+			Data:       map[string][]byte{"OTHER": []byte("value")},
+		})
+		checkResponse(h.ServiceEnvSetHandler(params("SMTP_PASSWORD", "", &models.EnvValue{Value: "password", Secret: true}), nil), t, 200,
+			&models.EnvVar{Name: "SMTP_PASSWORD", Secret: true})
+		secret, err := h.Handlers.(*handlers).clientset.CoreV1().Secrets("env-test").Get(context.TODO(), v1alpha1.EnvSecretName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(secret.Data["SMTP_PASSWORD"]) != "password" || string(secret.Data["OTHER"]) != "value" {
+			t.Errorf("unexpected secret data: %v", secret.Data)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		h := newFakeHandlers(t, testEnvService(v1alpha1.EnvVar{Name: "SMTP_PASSWORD", Secret: true}), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.EnvSecretName, Namespace: "env-test"},
+			Data:       map[string][]byte{"SMTP_PASSWORD": []byte("password")},
+		})
+		h.PrependReactor("patch", "kuberlogicservices", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "kuberlogicservices"}, "env-test",
+				errors.New("variable SMTP_PASSWORD can't be set"))
+		})
+		checkResponse(h.ServiceEnvSetHandler(params("SMTP_PASSWORD", "", &models.EnvValue{Value: "rejected", Secret: true}), nil), t, 400, &models.Error{
+			Message: `kuberlogicservices "env-test" is forbidden: variable SMTP_PASSWORD can't be set`,
+		})
+		checkResponse(h.ServiceEnvSetHandler(params("API_TOKEN", "", &models.EnvValue{Value: "token", Secret: true}), nil), t, 400, &models.Error{
+			Message: `kuberlogicservices "env-test" is forbidden: variable SMTP_PASSWORD can't be set`,
+		})
+
+		// values of rejected variables are restored
+		secret, err := h.Handlers.(*handlers).clientset.CoreV1().Secrets("env-test").Get(context.TODO(), v1alpha1.EnvSecretName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(secret.Data, map[string][]byte{"SMTP_PASSWORD": []byte("password")}) {
+			t.Errorf("unexpected secret data: %v", secret.Data)
+		}
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		h := newFakeHandlers(t, testEnvService())
+		h.PrependReactor("patch", "kuberlogicservices", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewServiceUnavailable("etcdserver: request timed out")
+		})
+		checkResponse(h.ServiceEnvSetHandler(params("LOG_LEVEL", "", &models.EnvValue{Value: "debug"}), nil), t, 503, &models.Error{
+			Message: "failed to set variable",
+		})
+	})
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func (h *handlers) ServiceEnvUnsetHandler(params apiService.ServiceEnvUnsetParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceEnvUnsetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to get service", "error", err.Error())
+		return apiService.NewServiceEnvUnsetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to get service: " + err.Error(),
+		})
+	}
+
+	var container string
+	if params.Container != nil {
+		container = *params.Container
+	}
+	i := findEnv(kls, params.EnvName, container)
+	if i < 0 {
+		return apiService.NewServiceEnvUnsetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("environment variable not found: %s", params.EnvName),
+		})
+	}
+	removed := kls.Spec.Env[i]
+	env := append(append([]v1alpha1.EnvVar{}, kls.Spec.Env[:i]...), kls.Spec.Env[i+1:]...)
+
+	if err := h.patchEnv(ctx, kls, env); k8serrors.IsNotFound(err) {
+		return apiService.NewServiceEnvUnsetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if k8serrors.IsConflict(err) {
+		return apiService.NewServiceEnvUnsetConflict().WithPayload(&models.Error{
+			Message: fmt.Sprintf("service %s was changed by a concurrent request, retry the change", params.ServiceID),
+		})
+	} else if envRejected(err) {
+		// variables are validated by the service plugin
+		return apiService.NewServiceEnvUnsetBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to unset variable", "error", err.Error(), "variable", removed.Name)
+		return apiService.NewServiceEnvUnsetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to unset variable",
+		})
+	}
+
+	changes := audit.Changes(envVarModel(removed), nil)
+	if removed.Secret {
+		changes = audit.Redact(changes)
+	}
+	audit.SetChanges(ctx, changes)
+
+	if removed.Secret {
+		if err := h.unsetEnvSecret(ctx, kls, removed.SecretKey()); err != nil {
+			h.log.Errorw("failed to remove secret variable value", "error", err.Error(), "variable", removed.Name)
+		}
+	}
+	return apiService.NewServiceEnvUnsetOK()
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func TestServiceEnvUnset(t *testing.T) {
+	params := func(name, container string) apiService.ServiceEnvUnsetParams {
+		p := apiService.ServiceEnvUnsetParams{
+			HTTPRequest: &http.Request{},
+			ServiceID:   "env-test",
+			EnvName:     name,
+		}
+		if container != "" {
+			p.Container = util.StrAsPointer(container)
+		}
+		return p
+	}
+	newHandlers := func(t *testing.T) *FakeHandlers {
+		return newFakeHandlers(t,
+			testEnvService(
+				v1alpha1.EnvVar{Name: "LOG_LEVEL", Value: "info"},
+				v1alpha1.EnvVar{Name: "SMTP_PASSWORD", Container: "app", Secret: true},
+			),
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.EnvSecretName, Namespace: "env-test"},
+				Data:       map[string][]byte{"app.SMTP_PASSWORD": []byte("password")},
+			},
+		)
+	}
+
+	t.Run("not-found", func(t *testing.T) {
+		h := newHandlers(t)
+		checkResponse(h.ServiceEnvUnsetHandler(params("SMTP_PASSWORD", ""), nil), t, 404, &models.Error{
+			Message: "environment variable not found: SMTP_PASSWORD",
+		})
+	})
+
+	t.Run("secret", func(t *testing.T) {
+		h := newHandlers(t)
+		checkResponse(h.ServiceEnvUnsetHandler(params("SMTP_PASSWORD", "app"), nil), t, 200, nil)
+
+		kls, err := h.Services().Get(context.TODO(), "env-test", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []v1alpha1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}}
+		if !reflect.DeepEqual(kls.Spec.Env, expected) {
+			t.Errorf("expected vs actual: %+v vs %+v", expected, kls.Spec.Env)
+		}
+		secret, err := h.Handlers.(*handlers).clientset.CoreV1().Secrets("env-test").Get(context.TODO(), v1alpha1.EnvSecretName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(secret.Data) != 0 {
+			t.Errorf("secret value is not removed: %v", secret.Data)
+		}
+	})
+}
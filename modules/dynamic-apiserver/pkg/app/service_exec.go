@@ -0,0 +1,400 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+)
+
+// exec session channels, every WebSocket message starts with one of them
+const (
+	execStdinChannel byte = iota
+	execStdoutChannel
+	execStderrChannel
+	execStatusChannel
+	execResizeChannel
+)
+
+// exec session limits, they are changed in tests
+var (
+	// execSessionTimeout limits the duration of a session
+	execSessionTimeout = 4 * time.Hour
+	// execIdleTimeout closes a session when the client neither sends messages nor answers pings, it also limits a single write
+	execIdleTimeout = time.Minute
+	// execPingPeriod is the interval of pings sent to the client
+	execPingPeriod = 20 * time.Second
+)
+
+// newPodExecutor returns an executor running a command in a pod container, it is replaced in tests.
+// The stream of the executor is closed once ctx is done.
+var newPodExecutor = func(ctx context.Context, h *handlers, pod *corev1.Pod, opts *corev1.PodExecOptions) (remotecommand.Executor, error) {
+	req := h.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(opts, scheme.ParameterCodec)
+	transport, upgrader, err := spdy.RoundTripperFor(h.restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewSPDYExecutorForTransports(transport, &contextUpgrader{Upgrader: upgrader, ctx: ctx}, "POST", req.URL())
+}
+
+// contextUpgrader closes upgraded connections once the context is done
+type contextUpgrader struct {
+	spdy.Upgrader
+	ctx context.Context
+}
+
+func (u *contextUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := u.Upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-u.ctx.Done():
+			_ = conn.Close()
+		case <-conn.CloseChan():
+		}
+	}()
+	return conn, nil
+}
+
+var execUpgrader = websocket.Upgrader{
+	// the connection is authenticated by the token header, which browsers do not send cross-origin
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+func (h *handlers) ServiceExecHandler(params apiService.ServiceExecParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceExecBadRequest().WithPayload(&models.Error{
+			Message: "service does not exist",
+		})
+	} else if err != nil {
+		e := errors.Wrap(err, "failed to get service")
+		h.log.Errorw(e.Error())
+		return apiService.NewServiceExecServiceUnavailable().WithPayload(&models.Error{
+			Message: e.Error(),
+		})
+	}
+
+	if !websocket.IsWebSocketUpgrade(params.HTTPRequest) {
+		return apiService.NewServiceExecBadRequest().WithPayload(&models.Error{
+			Message: "websocket upgrade is required",
+		})
+	}
+
+	pods, err := h.servicePods(ctx, kls, params.PodName)
+	if err != nil {
+		h.log.Errorw(err.Error())
+		return apiService.NewServiceExecServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	pod, container := execTarget(pods, params.ContainerName)
+	if pod == nil {
+		msg := "no running pod is available"
+		if params.ContainerName != nil {
+			msg = fmt.Sprintf("no running pod with container '%s' is available", *params.ContainerName)
+		}
+		return apiService.NewServiceExecBadRequest().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+
+	opts := &corev1.PodExecOptions{
+		Container: container,
+		Command:   params.Command,
+		Stdout:    true,
+		Stderr:    true,
+	}
+	if params.Stdin != nil {
+		opts.Stdin = *params.Stdin
+	}
+	if params.Tty != nil {
+		opts.TTY = *params.Tty
+		// stderr is merged into stdout by the terminal
+		opts.Stderr = !opts.TTY
+	}
+	// the session is over when the client disconnects or the session times out
+	sessionCtx, cancel := context.WithTimeout(ctx, execSessionTimeout)
+	executor, err := newPodExecutor(sessionCtx, h, pod, opts)
+	if err != nil {
+		cancel()
+		e := errors.Wrap(err, "failed to create executor")
+		h.log.Errorw(e.Error())
+		return apiService.NewServiceExecServiceUnavailable().WithPayload(&models.Error{
+			Message: e.Error(),
+		})
+	}
+
+	return &execSession{
+		request:  params.HTTPRequest,
+		ctx:      sessionCtx,
+		cancel:   cancel,
+		executor: executor,
+		opts:     opts,
+		log:      h.log,
+		audit: []interface{}{
+			"service", params.ServiceID,
+			"pod", pod.Name,
+			"container", container,
+			"command", strings.Join(params.Command, " "),
+			"tty", opts.TTY,
+			"remote", params.HTTPRequest.RemoteAddr,
+		},
+	}
+}
+
+// execTarget returns the first running pod with the container, the first container of the pod is used if it is not set
+func execTarget(pods []corev1.Pod, container *string) (*corev1.Pod, string) {
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		for _, c := range pod.Spec.Containers {
+			if container == nil || c.Name == *container {
+				return pod, c.Name
+			}
+		}
+	}
+	return nil, ""
+}
+
+// execSession attaches a WebSocket connection to a command executed in a pod container
+type execSession struct {
+	request *http.Request
+	// the executor stream is closed once ctx is done
+	ctx      context.Context
+	cancel   context.CancelFunc
+	executor remotecommand.Executor
+	opts     *corev1.PodExecOptions
+	log      logging.Logger
+	// audit holds the session details logged on its start and finish
+	audit []interface{}
+}
+
+var _ middleware.Responder = &execSession{}
+
+func (s *execSession) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer s.cancel()
+	conn, err := execUpgrader.Upgrade(rw, s.request, nil)
+	if err != nil {
+		// the upgrader has already replied with an error
+		s.log.Errorw("failed to upgrade connection", append(s.audit, "error", err)...)
+		return
+	}
+	// goroutines of the session are done once the connection is closed
+	var wg sync.WaitGroup
+	defer func() {
+		s.cancel()
+		_ = conn.Close()
+		wg.Wait()
+	}()
+	// sessions are limited by the session timeouts instead of the server ones
+	_ = conn.SetReadDeadline(time.Now().Add(execIdleTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(execIdleTimeout))
+	})
+
+	started := time.Now()
+	s.log.Infow("exec session started", s.audit...)
+
+	stdin, stdinWriter := io.Pipe()
+	sizes := newTerminalSizeQueue()
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		s.readInput(conn, stdinWriter, sizes)
+	}()
+	go func() {
+		defer wg.Done()
+		s.ping(conn)
+	}()
+
+	ws := &execWriter{conn: conn}
+	options := remotecommand.StreamOptions{
+		Stdout: ws.channel(execStdoutChannel),
+		Tty:    s.opts.TTY,
+	}
+	if s.opts.Stdin {
+		options.Stdin = stdin
+	}
+	if s.opts.Stderr {
+		options.Stderr = ws.channel(execStderrChannel)
+	}
+	if s.opts.TTY {
+		options.TerminalSizeQueue = sizes
+	}
+	err = s.executor.Stream(options)
+
+	status := &models.ExecStatus{}
+	if exitErr, ok := err.(utilexec.ExitError); ok {
+		status.ExitCode = int64(exitErr.ExitStatus())
+		status.Message = exitErr.Error()
+	} else if err != nil {
+		status.ExitCode = -1
+		status.Message = err.Error()
+	}
+	if s.ctx.Err() == context.DeadlineExceeded {
+		status.ExitCode = -1
+		status.Message = "exec session timed out"
+	}
+	s.log.Infow("exec session finished", append(s.audit, "took", time.Since(started), "exitCode", status.ExitCode, "error", status.Message)...)
+
+	data, _ := json.Marshal(status)
+	if err := ws.write(execStatusChannel, data); err != nil {
+		s.log.Errorw("failed to send exec status", "error", err)
+		return
+	}
+	_ = ws.close()
+}
+
+// readInput forwards stdin and terminal size messages until the connection is closed or idle, the session is over then
+func (s *execSession) readInput(conn *websocket.Conn, stdin *io.PipeWriter, sizes *terminalSizeQueue) {
+	defer sizes.close()
+	defer stdin.Close()
+	defer s.cancel()
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(execIdleTimeout))
+		if len(data) == 0 {
+			continue
+		}
+		switch data[0] {
+		case execStdinChannel:
+			if !s.opts.Stdin {
+				continue
+			}
+			if len(data) == 1 {
+				// client closed its stdin
+				_ = stdin.Close()
+				continue
+			}
+			if _, err := stdin.Write(data[1:]); err != nil {
+				s.log.Debugw("failed to forward stdin", "error", err)
+			}
+		case execResizeChannel:
+			size := remotecommand.TerminalSize{}
+			if err := json.Unmarshal(data[1:], &size); err != nil {
+				s.log.Warnw("invalid terminal size", "error", err)
+				continue
+			}
+			sizes.push(size)
+		}
+	}
+}
+
+// ping sends pings to the client until the session is over, the client answers them while it is connected
+func (s *execSession) ping(conn *websocket.Conn) {
+	ticker := time.NewTicker(execPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(execIdleTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// execWriter writes channel messages to a WebSocket connection
+type execWriter struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (w *execWriter) write(channel byte, p []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_ = w.conn.SetWriteDeadline(time.Now().Add(execIdleTimeout))
+	return w.conn.WriteMessage(websocket.BinaryMessage, append([]byte{channel}, p...))
+}
+
+func (w *execWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_ = w.conn.SetWriteDeadline(time.Now().Add(execIdleTimeout))
+	return w.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+func (w *execWriter) channel(channel byte) io.Writer {
+	return writerFunc(func(p []byte) (int, error) {
+		if err := w.write(channel, p); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+// terminalSizeQueue passes terminal resize events to the executor
+type terminalSizeQueue struct {
+	sizes chan remotecommand.TerminalSize
+}
+
+var _ remotecommand.TerminalSizeQueue = &terminalSizeQueue{}
+
+func newTerminalSizeQueue() *terminalSizeQueue {
+	return &terminalSizeQueue{sizes: make(chan remotecommand.TerminalSize, 1)}
+}
+
+func (q *terminalSizeQueue) push(size remotecommand.TerminalSize) {
+	// only the latest size matters
+	select {
+	case <-q.sizes:
+	default:
+	}
+	q.sizes <- size
+}
+
+func (q *terminalSizeQueue) close() {
+	close(q.sizes)
+}
+
+// Next returns the next terminal size or nil when the session is over
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q.sizes
+	if !ok {
+		return nil
+	}
+	return &size
+}
@@ -0,0 +1,321 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+type fakeExecutor func(options remotecommand.StreamOptions) error
+
+func (f fakeExecutor) Stream(options remotecommand.StreamOptions) error {
+	return f(options)
+}
+
+// withFakeExecutor replaces pod executors with stream for the duration of the test, stream gets the context of the executor
+func withFakeExecutor(t *testing.T, stream func(ctx context.Context, options remotecommand.StreamOptions) error) *v1.PodExecOptions {
+	opts := &v1.PodExecOptions{}
+	original := newPodExecutor
+	newPodExecutor = func(ctx context.Context, _ *handlers, _ *v1.Pod, o *v1.PodExecOptions) (remotecommand.Executor, error) {
+		*opts = *o
+		return fakeExecutor(func(options remotecommand.StreamOptions) error {
+			return stream(ctx, options)
+		}), nil
+	}
+	t.Cleanup(func() {
+		newPodExecutor = original
+	})
+	return opts
+}
+
+func runningPod(name string, containers ...string) *v1.Pod {
+	pod := logsTestPod(name, containers...)
+	pod.Status.Phase = v1.PodRunning
+	return pod
+}
+
+func upgradeRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/services/logs-test/exec", nil)
+	r.Header.Set("Connection", "Upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	return r
+}
+
+func TestServiceExec(t *testing.T) {
+	cases := []testCase{
+		{
+			name:   "service-not-found",
+			status: 400,
+			result: &models.Error{
+				Message: "service does not exist",
+			},
+			params: apiService.ServiceExecParams{
+				HTTPRequest: upgradeRequest(),
+				ServiceID:   "logs-test",
+				Command:     []string{"sh"},
+			},
+		},
+		{
+			name:    "no-upgrade",
+			status:  400,
+			objects: []runtime.Object{logsTestService(), runningPod("first", "a")},
+			result: &models.Error{
+				Message: "websocket upgrade is required",
+			},
+			params: apiService.ServiceExecParams{
+				HTTPRequest: &http.Request{},
+				ServiceID:   "logs-test",
+				Command:     []string{"sh"},
+			},
+		},
+		{
+			name:    "pod-not-running",
+			status:  400,
+			objects: []runtime.Object{logsTestService(), logsTestPod("first", "a")},
+			result: &models.Error{
+				Message: "no running pod is available",
+			},
+			params: apiService.ServiceExecParams{
+				HTTPRequest: upgradeRequest(),
+				ServiceID:   "logs-test",
+				Command:     []string{"sh"},
+			},
+		},
+		{
+			name:    "container-not-found",
+			status:  400,
+			objects: []runtime.Object{logsTestService(), runningPod("first", "a")},
+			result: &models.Error{
+				Message: "no running pod with container 'b' is available",
+			},
+			params: apiService.ServiceExecParams{
+				HTTPRequest:   upgradeRequest(),
+				ServiceID:     "logs-test",
+				ContainerName: util.StrAsPointer("b"),
+				Command:       []string{"sh"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).ServiceExecHandler(tc.params.(apiService.ServiceExecParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
+
+// execTestServer serves exec sessions of the handlers with the params
+func execTestServer(t *testing.T, h *FakeHandlers, params apiService.ServiceExecParams) *websocket.Conn {
+	conn, _ := execTestSession(t, h, params)
+	return conn
+}
+
+// execTestSession serves exec sessions of the handlers with the params, the returned channel is closed once the session is over
+func execTestSession(t *testing.T, h *FakeHandlers, params apiService.ServiceExecParams) (*websocket.Conn, <-chan struct{}) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		params.HTTPRequest = r
+		h.ServiceExecHandler(params, nil).WriteResponse(rw, nil)
+		close(done)
+	}))
+	t.Cleanup(srv.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		<-done
+	})
+	return conn, done
+}
+
+// readExecSession returns output of the exec session by channels
+func readExecSession(t *testing.T, conn *websocket.Conn) map[byte]string {
+	output := make(map[byte]string)
+	for {
+		_, data, err := conn.ReadMessage()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return output
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		output[data[0]] += string(data[1:])
+	}
+}
+
+func TestServiceExecSession(t *testing.T) {
+	opts := withFakeExecutor(t, func(_ context.Context, options remotecommand.StreamOptions) error {
+		input, err := ioutil.ReadAll(options.Stdin)
+		if err != nil {
+			return err
+		}
+		_, _ = options.Stdout.Write([]byte("hello " + string(input)))
+		_, _ = options.Stderr.Write([]byte("warning"))
+		return utilexec.CodeExitError{Err: errors.New("command terminated with exit code 3"), Code: 3}
+	})
+
+	conn := execTestServer(t, newFakeHandlers(t, logsTestService(), logsTestPod("first", "a"), runningPod("second", "a", "b")),
+		apiService.ServiceExecParams{
+			ServiceID:     "logs-test",
+			ContainerName: util.StrAsPointer("b"),
+			Command:       []string{"cat", "-"},
+			Stdin:         util.BoolAsPointer(true),
+		})
+
+	for _, msg := range [][]byte{append([]byte{execStdinChannel}, "world"...), {execStdinChannel}} {
+		if err := conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	output := readExecSession(t, conn)
+
+	status, _ := json.Marshal(&models.ExecStatus{ExitCode: 3, Message: "command terminated with exit code 3"})
+	expected := map[byte]string{
+		execStdoutChannel: "hello world",
+		execStderrChannel: "warning",
+		execStatusChannel: string(status),
+	}
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("session output does not equal: actual vs expected\n%v\n%v", output, expected)
+	}
+
+	expectedOpts := &v1.PodExecOptions{
+		Container: "b",
+		Command:   []string{"cat", "-"},
+		Stdin:     true,
+		Stdout:    true,
+		Stderr:    true,
+	}
+	if !reflect.DeepEqual(opts, expectedOpts) {
+		t.Errorf("exec options do not equal: actual vs expected\n%+v\n%+v", opts, expectedOpts)
+	}
+}
+
+func TestServiceExecSessionTty(t *testing.T) {
+	withFakeExecutor(t, func(_ context.Context, options remotecommand.StreamOptions) error {
+		if options.Stderr != nil {
+			return errors.New("stderr must be merged into stdout")
+		}
+		size := options.TerminalSizeQueue.Next()
+		if size == nil {
+			return errors.New("terminal size is not received")
+		}
+		_, err := options.Stdout.Write([]byte(strings.Repeat("#", int(size.Width))))
+		return err
+	})
+
+	conn := execTestServer(t, newFakeHandlers(t, logsTestService(), runningPod("first", "a")),
+		apiService.ServiceExecParams{
+			ServiceID: "logs-test",
+			Command:   []string{"sh"},
+			Tty:       util.BoolAsPointer(true),
+		})
+
+	if err := conn.WriteMessage(websocket.BinaryMessage, append([]byte{execResizeChannel}, `{"Width": 5, "Height": 2}`...)); err != nil {
+		t.Fatal(err)
+	}
+	output := readExecSession(t, conn)
+
+	status, _ := json.Marshal(&models.ExecStatus{})
+	expected := map[byte]string{
+		execStdoutChannel: "#####",
+		execStatusChannel: string(status),
+	}
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("session output does not equal: actual vs expected\n%v\n%v", output, expected)
+	}
+}
+
+func TestServiceExecSessionDisconnect(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		disconnect func(conn *websocket.Conn)
+	}{
+		{
+			name: "closed",
+			disconnect: func(conn *websocket.Conn) {
+				_ = conn.Close()
+			},
+		},
+		{
+			// the client does not read, so it doesn't answer pings
+			name:       "idle",
+			disconnect: func(*websocket.Conn) {},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			idleTimeout, pingPeriod := execIdleTimeout, execPingPeriod
+			execIdleTimeout, execPingPeriod = 200*time.Millisecond, 50*time.Millisecond
+			t.Cleanup(func() {
+				execIdleTimeout, execPingPeriod = idleTimeout, pingPeriod
+			})
+
+			started := make(chan struct{})
+			withFakeExecutor(t, func(ctx context.Context, options remotecommand.StreamOptions) error {
+				// a command ignoring its stdin, like tail -f
+				close(started)
+				<-ctx.Done()
+				return errors.New("connection closed")
+			})
+
+			conn, done := execTestSession(t, newFakeHandlers(t, logsTestService(), runningPod("first", "a")),
+				apiService.ServiceExecParams{
+					ServiceID: "logs-test",
+					Command:   []string{"tail", "-f", "/var/log/app.log"},
+					Tty:       util.BoolAsPointer(true),
+				})
+			<-started
+			tc.disconnect(conn)
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("exec session is not over after the client is disconnected")
+			}
+		})
+	}
+}
+
+func TestServiceExecSessionTimeout(t *testing.T) {
+	sessionTimeout := execSessionTimeout
+	execSessionTimeout = 100 * time.Millisecond
+	t.Cleanup(func() {
+		execSessionTimeout = sessionTimeout
+	})
+
+	withFakeExecutor(t, func(ctx context.Context, options remotecommand.StreamOptions) error {
+		<-ctx.Done()
+		return errors.New("connection closed")
+	})
+
+	conn := execTestServer(t, newFakeHandlers(t, logsTestService(), runningPod("first", "a")),
+		apiService.ServiceExecParams{
+			ServiceID: "logs-test",
+			Command:   []string{"top"},
+		})
+	output := readExecSession(t, conn)
+
+	status, _ := json.Marshal(&models.ExecStatus{ExitCode: -1, Message: "exec session timed out"})
+	if output[execStatusChannel] != string(status) {
+		t.Errorf("session status does not equal: actual vs expected\n%v\n%v", output[execStatusChannel], string(status))
+	}
+}
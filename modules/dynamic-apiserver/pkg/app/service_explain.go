@@ -2,128 +2,274 @@ package app
 
 import (
 	"context"
-	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
-	v12 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) ServiceExplainHandler(params apiService.ServiceExplainParams, _ *models.Principal) middleware.Responder {
+// maxExplainEvents is the number of the most recent namespace events included into the explain report
+const maxExplainEvents = 20
+
+func (h *handlers) ServiceExplainHandler(params apiService.ServiceExplainParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	kls, err := h.Services().Get(ctx, params.ServiceID, metav1.GetOptions{})
+	kls, err := h.getService(ctx, principal, params.ServiceID)
 	if k8serrors.IsNotFound(err) {
-		return apiService.NewServiceLogsBadRequest().WithPayload(&models.Error{
-			Message: "service does not exist",
+		return apiService.NewServiceExplainNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
 		})
 	} else if err != nil {
 		e := errors.Wrap(err, "failed to get service")
 		h.log.Errorw(e.Error())
-		return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
+		return apiService.NewServiceExplainServiceUnavailable().WithPayload(&models.Error{
 			Message: e.Error(),
 		})
 	}
 
-	listOptions := h.ListOptionsByKeyValue("docker-compose.service/name", &params.ServiceID)
-	result := new(models.Explain)
-	pod, err := firstPod(ctx, h.clientset, kls.Namespace, listOptions)
-	if err != nil {
-		result.Pod = &models.ExplainPod{
-			Error: errors.Wrap(err, "failed to get pod").Error(),
-		}
-	} else {
-		containersInfo := make([]*models.ExplainPodContainersItems0, 0)
-		for _, c := range pod.Status.ContainerStatuses {
-			containersInfo = append(containersInfo, &models.ExplainPodContainersItems0{
-				Name:         c.Name,
-				RestartCount: util.Int64AsPointer(int64(c.RestartCount)),
-				Status:       containerStatus(c.State),
-			})
+	result := &models.Explain{
+		Namespace:  kls.Status.Namespace,
+		Phase:      kls.Status.Phase,
+		Conditions: make([]*models.ExplainCondition, 0),
+		Components: make([]*models.ExplainComponent, 0),
+		Objects:    make([]*models.ExplainObject, 0),
+		Pods:       make([]*models.ExplainPod, 0),
+		Events:     make([]*models.ExplainEvent, 0),
+		Warnings:   make([]string, 0),
+	}
+	for _, c := range kls.Status.Conditions {
+		result.Conditions = append(result.Conditions, &models.ExplainCondition{
+			Type:               c.Type,
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: strfmt.DateTime(c.LastTransitionTime.UTC()),
+		})
+	}
+	for _, c := range kls.Status.Components {
+		result.Components = append(result.Components, &models.ExplainComponent{
+			Kind:    c.Kind,
+			Name:    c.Name,
+			Ready:   c.Ready,
+			Message: c.Message,
+		})
+	}
+	if e := kls.Status.LastError; e != nil {
+		result.LastError = &models.ExplainError{
+			Message: e.Message,
+			Time:    strfmt.DateTime(e.Time.UTC()),
 		}
+	}
 
-		result.Pod = &models.ExplainPod{
-			Containers: containersInfo,
-		}
+	ns := kls.Status.Namespace
+	if ns == "" {
+		result.Warnings = append(result.Warnings, "service is not provisioned yet")
+		return apiService.NewServiceExplainOK().WithPayload(result)
 	}
 
-	pvc, err := firstPVC(ctx, h.clientset, kls.Namespace, listOptions)
-	if err != nil {
-		result.Pvc = &models.ExplainPvc{
-			Error: errors.Wrap(err, "failed to get pvc").Error(),
+	if len(kls.Status.Objects) == 0 {
+		result.Warnings = append(result.Warnings, "service objects are not reported by the operator yet")
+	}
+	for _, o := range kls.Status.Objects {
+		object := &models.ExplainObject{
+			APIVersion: o.APIVersion,
+			Kind:       o.Kind,
+			Name:       o.Name,
 		}
-	} else {
-		result.Pvc = &models.ExplainPvc{
-			StorageClass: *pvc.Spec.StorageClassName,
-			Phase:        string(pvc.Status.Phase),
-			Size:         pvc.Status.Capacity.Storage().String(),
+		status, err := h.objectStatus(ctx, ns, o)
+		if k8serrors.IsNotFound(err) {
+			object.Error = "object does not exist"
+		} else if err != nil {
+			object.Error = err.Error()
 		}
+		object.Status = status
+		result.Objects = append(result.Objects, object)
 	}
 
-	ingress, err := firstIngress(ctx, h.clientset, kls.Namespace, listOptions)
+	// the namespace is dedicated to the service, all its pods belong to the service
+	pods, err := h.clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		result.Ingress = &models.ExplainIngress{
-			Error: errors.Wrap(err, "failed to get ingress").Error(),
-		}
+		h.log.Errorw("error listing service pods", "error", err, "namespace", ns)
+		result.Warnings = append(result.Warnings, errors.Wrap(err, "failed to list pods").Error())
 	} else {
-		hosts := make([]string, 0)
-		for _, rule := range ingress.Spec.Rules {
-			hosts = append(hosts, rule.Host)
+		for _, pod := range pods.Items {
+			result.Pods = append(result.Pods, explainPod(pod))
 		}
+		sort.Slice(result.Pods, func(i, j int) bool {
+			return result.Pods[i].Name < result.Pods[j].Name
+		})
+	}
 
-		result.Ingress = &models.ExplainIngress{
-			Hosts:        hosts,
-			IngressClass: *ingress.Spec.IngressClassName,
-		}
+	events, err := h.clientset.CoreV1().Events(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		h.log.Errorw("error listing service events", "error", err, "namespace", ns)
+		result.Warnings = append(result.Warnings, errors.Wrap(err, "failed to list events").Error())
+	} else {
+		result.Events = recentEvents(events.Items, maxExplainEvents)
 	}
 
 	return apiService.NewServiceExplainOK().WithPayload(result)
 }
 
-func firstPod(ctx context.Context, clientset kubernetes.Interface, ns string, listOptions metav1.ListOptions) (*v1.Pod, error) {
-	objects, err := clientset.CoreV1().Pods(ns).List(ctx, listOptions)
-	if err != nil {
-		return nil, errors.Wrap(err, "error listing pod objects")
+// objectStatus returns a short summary of the object state, kinds without a summary are only checked for existence
+func (h *handlers) objectStatus(ctx context.Context, ns string, o v1alpha1.ManagedObject) (string, error) {
+	opts := metav1.GetOptions{}
+	switch o.Kind {
+	case "Deployment":
+		obj, err := h.clientset.AppsV1().Deployments(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		return replicasStatus(obj.Status.ReadyReplicas, obj.Spec.Replicas), nil
+	case "StatefulSet":
+		obj, err := h.clientset.AppsV1().StatefulSets(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		return replicasStatus(obj.Status.ReadyReplicas, obj.Spec.Replicas), nil
+	case "Service":
+		obj, err := h.clientset.CoreV1().Services(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		ports := make([]string, 0, len(obj.Spec.Ports))
+		for _, p := range obj.Spec.Ports {
+			ports = append(ports, fmt.Sprintf("%d/%s", p.Port, p.Protocol))
+		}
+		return fmt.Sprintf("%s, ports %s", obj.Spec.Type, strings.Join(ports, ", ")), nil
+	case "Ingress":
+		obj, err := h.clientset.NetworkingV1().Ingresses(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		hosts := make([]string, 0, len(obj.Spec.Rules))
+		for _, rule := range obj.Spec.Rules {
+			hosts = append(hosts, rule.Host)
+		}
+		return fmt.Sprintf("hosts %s, ingress class %s",
+			strings.Join(hosts, ", "), stringValueOr(obj.Spec.IngressClassName, "default")), nil
+	case "PersistentVolumeClaim":
+		obj, err := h.clientset.CoreV1().PersistentVolumeClaims(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		size := obj.Spec.Resources.Requests.Storage().String()
+		if capacity, ok := obj.Status.Capacity[v1.ResourceStorage]; ok {
+			size = capacity.String()
+		}
+		return fmt.Sprintf("%s, %s, storage class %s",
+			obj.Status.Phase, size, stringValueOr(obj.Spec.StorageClassName, "default")), nil
+	case "Secret":
+		obj, err := h.clientset.CoreV1().Secrets(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d keys", len(obj.Data)), nil
+	case "ConfigMap":
+		obj, err := h.clientset.CoreV1().ConfigMaps(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d keys", len(obj.Data)+len(obj.BinaryData)), nil
+	case "Job":
+		obj, err := h.clientset.BatchV1().Jobs(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d active, %d succeeded, %d failed", obj.Status.Active, obj.Status.Succeeded, obj.Status.Failed), nil
+	case "CronJob":
+		obj, err := h.clientset.BatchV1().CronJobs(ns).Get(ctx, o.Name, opts)
+		if err != nil {
+			return "", err
+		}
+		status := "schedule " + obj.Spec.Schedule
+		if obj.Status.LastScheduleTime != nil {
+			status += ", last run " + obj.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+		}
+		return status, nil
+	default:
+		return "", nil
 	}
+}
 
-	if len(objects.Items) < 1 {
-		return nil, errors.New("no pod is available")
+func replicasStatus(ready int32, replicas *int32) string {
+	desired := int32(1)
+	if replicas != nil {
+		desired = *replicas
 	}
-	obj := objects.Items[0]
-	return &obj, nil
+	return fmt.Sprintf("%d/%d replicas are ready", ready, desired)
 }
 
-func firstPVC(ctx context.Context, clientset kubernetes.Interface, ns string, listOptions metav1.ListOptions) (*v1.PersistentVolumeClaim, error) {
-	objects, err := clientset.CoreV1().PersistentVolumeClaims(ns).List(ctx, listOptions)
-	if err != nil {
-		return nil, errors.Wrap(err, "error listing pvc objects")
+func stringValueOr(s *string, defaultValue string) string {
+	if s == nil || *s == "" {
+		return defaultValue
 	}
+	return *s
+}
 
-	if len(objects.Items) < 1 {
-		return nil, errors.New("no pvc is available")
+func explainPod(pod v1.Pod) *models.ExplainPod {
+	result := &models.ExplainPod{
+		Name:       pod.Name,
+		Phase:      string(pod.Status.Phase),
+		Node:       pod.Spec.NodeName,
+		Containers: make([]*models.ExplainContainer, 0),
+	}
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, c := range statuses {
+		result.Containers = append(result.Containers, &models.ExplainContainer{
+			Name:         c.Name,
+			RestartCount: util.Int64AsPointer(int64(c.RestartCount)),
+			Status:       containerStatus(c.State),
+			Reason:       containerReason(c.State),
+		})
 	}
-	obj := objects.Items[0]
-	return &obj, nil
+	return result
 }
 
-func firstIngress(ctx context.Context, clientset kubernetes.Interface, ns string, listOptions metav1.ListOptions) (*v12.Ingress, error) {
-	objects, err := clientset.NetworkingV1().Ingresses(ns).List(ctx, listOptions)
-	if err != nil {
-		return nil, errors.Wrap(err, "error listing ingresses objects")
+// recentEvents returns at most limit events, newest first
+func recentEvents(events []v1.Event, limit int) []*models.ExplainEvent {
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i]).After(eventTime(events[j]))
+	})
+	if len(events) > limit {
+		events = events[:limit]
 	}
 
-	if len(objects.Items) < 1 {
-		return nil, errors.New("no ingress is available")
+	result := make([]*models.ExplainEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, &models.ExplainEvent{
+			Time:    strfmt.DateTime(eventTime(e).UTC()),
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Object:  e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+			Message: e.Message,
+			Count:   int64(e.Count),
+		})
+	}
+	return result
+}
+
+func eventTime(e v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
 	}
-	obj := objects.Items[0]
-	return &obj, nil
 }
 
 func containerStatus(state v1.ContainerState) string {
@@ -138,3 +284,14 @@ func containerStatus(state v1.ContainerState) string {
 		return "unknown"
 	}
 }
+
+func containerReason(state v1.ContainerState) string {
+	switch {
+	case state.Waiting != nil:
+		return state.Waiting.Reason
+	case state.Terminated != nil:
+		return state.Terminated.Reason
+	default:
+		return ""
+	}
+}
@@ -5,166 +5,243 @@
 package app
 
 import (
-	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"net/http"
-	"testing"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
 func TestServiceExplain(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	replicas := int32(1)
+	kls := &v1alpha1.KuberLogicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "explain-test",
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type: "demo",
+		},
+		Status: v1alpha1.KuberLogicServiceStatus{
+			Phase:     "NotReady",
+			Namespace: "explain-ns",
+			Conditions: []metav1.Condition{
+				{
+					Type:               "Ready",
+					Status:             metav1.ConditionFalse,
+					Reason:             "ReadyConditionNotMet",
+					LastTransitionTime: metav1.NewTime(now),
+				},
+			},
+			Objects: []v1alpha1.ManagedObject{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "explain-test"},
+				{APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "explain-test"},
+				{APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Name: "explain-test"},
+				{APIVersion: "v1", Kind: "Secret", Name: "explain-test"},
+				{APIVersion: "example.com/v1", Kind: "Custom", Name: "explain-test"},
+			},
+			Components: []v1alpha1.ComponentStatus{
+				{Kind: "Deployment", Name: "explain-test", Message: "0/1 replicas are ready"},
+			},
+			LastError: &v1alpha1.ReconcileError{
+				Message: "failed to syc Deployment explain-ns/explain-test",
+				Time:    metav1.NewTime(now),
+			},
+		},
+	}
+
 	cases := []testCase{
 		{
 			name:   "service-not-found",
-			status: 400,
+			status: 404,
 			result: &models.Error{
-				Message: "service does not exist",
+				Message: "kuberlogic service not found: explain-test",
 			},
 			params: apiService.ServiceExplainParams{
 				HTTPRequest: &http.Request{},
-				ServiceID:   "service",
+				ServiceID:   "explain-test",
 			},
 		},
 		{
-			name:   "all-not-found",
+			name:   "not-provisioned",
 			status: 200,
 			objects: []runtime.Object{
 				&v1alpha1.KuberLogicService{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "logs-test",
+						Name: "explain-test",
 					},
 					Spec: v1alpha1.KuberLogicServiceSpec{
 						Type: "demo",
 					},
-					Status: v1alpha1.KuberLogicServiceStatus{
-						Namespace: "secrets-test",
-					},
 				},
 			},
 			result: &models.Explain{
-				Pod: &models.ExplainPod{
-					Error: "failed to get pod: no pod is available",
-				},
-				Pvc: &models.ExplainPvc{
-					Error: "failed to get pvc: no pvc is available",
-				},
-				Ingress: &models.ExplainIngress{
-					Error: "failed to get ingress: no ingress is available",
-				},
+				Conditions: []*models.ExplainCondition{},
+				Components: []*models.ExplainComponent{},
+				Objects:    []*models.ExplainObject{},
+				Pods:       []*models.ExplainPod{},
+				Events:     []*models.ExplainEvent{},
+				Warnings:   []string{"service is not provisioned yet"},
 			},
 			params: apiService.ServiceExplainParams{
 				HTTPRequest: &http.Request{},
-				ServiceID:   "logs-test",
+				ServiceID:   "explain-test",
 			},
 		},
 		{
 			name:   "ok",
 			status: 200,
 			objects: []runtime.Object{
-				&v1alpha1.KuberLogicService{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "logs-test",
-					},
-					Spec: v1alpha1.KuberLogicServiceSpec{
-						Type: "demo",
+				kls,
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "explain-test", Namespace: "explain-ns"},
+					Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+				},
+				&v1.PersistentVolumeClaim{
+					// storage class and capacity are not set until the claim is bound
+					ObjectMeta: metav1.ObjectMeta{Name: "explain-test", Namespace: "explain-ns"},
+					Spec: v1.PersistentVolumeClaimSpec{
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+						},
 					},
-					Status: v1alpha1.KuberLogicServiceStatus{
-						Namespace: "default",
+					Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+				&v12.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Name: "explain-test", Namespace: "explain-ns"},
+					Spec: v12.IngressSpec{
+						Rules: []v12.IngressRule{{Host: "kuberlogic.com"}, {Host: "kuberlogic.org"}},
 					},
 				},
 				&v1.Pod{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-pod",
-						Namespace: "default",
-						Labels:    map[string]string{"docker-compose.service/name": "logs-test"},
-					},
+					ObjectMeta: metav1.ObjectMeta{Name: "explain-test-b", Namespace: "explain-ns"},
+					Spec:       v1.PodSpec{NodeName: "node-1"},
 					Status: v1.PodStatus{
+						Phase: v1.PodRunning,
 						ContainerStatuses: []v1.ContainerStatus{
 							{
 								Name:         "a",
 								RestartCount: 5,
 								State: v1.ContainerState{
-									Running: &v1.ContainerStateRunning{},
+									Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
 								},
 							},
 							{
-								Name:         "b",
-								RestartCount: 0,
-								State: v1.ContainerState{
-									Waiting: &v1.ContainerStateWaiting{},
-								},
+								Name:  "b",
+								State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
 							},
 						},
 					},
 				},
-				&v1.PersistentVolumeClaim{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-pvc",
-						Namespace: "default",
-						Labels:    map[string]string{"docker-compose.service/name": "logs-test"},
-					},
-					Spec: v1.PersistentVolumeClaimSpec{
-						StorageClassName: util.StrAsPointer("test"),
-					},
-					Status: v1.PersistentVolumeClaimStatus{
-						Phase: v1.ClaimBound,
-						Capacity: v1.ResourceList{
-							v1.ResourceStorage: resource.MustParse("1Gi"),
-						},
+				&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "explain-test-a", Namespace: "explain-ns"},
+					Status:     v1.PodStatus{Phase: v1.PodPending},
+				},
+				&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+				},
+				&v1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "old", Namespace: "explain-ns"},
+					InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "explain-test-b"},
+					Type:           "Normal",
+					Reason:         "Pulled",
+					Message:        "Container image pulled",
+					Count:          1,
+					LastTimestamp:  metav1.NewTime(now.Add(-time.Hour)),
+				},
+				&v1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "new", Namespace: "explain-ns"},
+					InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "explain-test-b"},
+					Type:           "Warning",
+					Reason:         "BackOff",
+					Message:        "Back-off restarting failed container",
+					Count:          3,
+					LastTimestamp:  metav1.NewTime(now),
+				},
+			},
+			result: &models.Explain{
+				Namespace: "explain-ns",
+				Phase:     "NotReady",
+				Conditions: []*models.ExplainCondition{
+					{
+						Type:               "Ready",
+						Status:             "False",
+						Reason:             "ReadyConditionNotMet",
+						LastTransitionTime: strfmt.DateTime(now),
 					},
 				},
-				&v12.Ingress{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-ingress",
-						Namespace: "default",
-						Labels:    map[string]string{"docker-compose.service/name": "logs-test"},
+				Components: []*models.ExplainComponent{
+					{Kind: "Deployment", Name: "explain-test", Message: "0/1 replicas are ready"},
+				},
+				Objects: []*models.ExplainObject{
+					{APIVersion: "apps/v1", Kind: "Deployment", Name: "explain-test", Status: "0/1 replicas are ready"},
+					{APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "explain-test", Status: "Pending, 1Gi, storage class default"},
+					{APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Name: "explain-test", Status: "hosts kuberlogic.com, kuberlogic.org, ingress class default"},
+					{APIVersion: "v1", Kind: "Secret", Name: "explain-test", Error: "object does not exist"},
+					{APIVersion: "example.com/v1", Kind: "Custom", Name: "explain-test"},
+				},
+				Pods: []*models.ExplainPod{
+					{
+						Name:       "explain-test-a",
+						Phase:      "Pending",
+						Containers: []*models.ExplainContainer{},
 					},
-					Spec: v12.IngressSpec{
-						IngressClassName: util.StrAsPointer("test-ingress-class"),
-						Rules: []v12.IngressRule{
+					{
+						Name:  "explain-test-b",
+						Phase: "Running",
+						Node:  "node-1",
+						Containers: []*models.ExplainContainer{
 							{
-								Host: "kuberlogic.com",
+								Name:         "a",
+								RestartCount: util.Int64AsPointer(5),
+								Status:       "waiting",
+								Reason:       "CrashLoopBackOff",
 							}, {
-								Host: "kuberlogic.org",
+								Name:         "b",
+								RestartCount: util.Int64AsPointer(0),
+								Status:       "running",
 							},
 						},
 					},
 				},
-			},
-			result: &models.Explain{
-				Pod: &models.ExplainPod{
-					Containers: []*models.ExplainPodContainersItems0{
-						{
-							Name:         "a",
-							RestartCount: util.Int64AsPointer(5),
-							Status:       "running",
-						}, {
-							Name:         "b",
-							RestartCount: util.Int64AsPointer(0),
-							Status:       "waiting",
-						},
+				Events: []*models.ExplainEvent{
+					{
+						Time:    strfmt.DateTime(now),
+						Type:    "Warning",
+						Reason:  "BackOff",
+						Object:  "Pod/explain-test-b",
+						Message: "Back-off restarting failed container",
+						Count:   3,
+					},
+					{
+						Time:    strfmt.DateTime(now.Add(-time.Hour)),
+						Type:    "Normal",
+						Reason:  "Pulled",
+						Object:  "Pod/explain-test-b",
+						Message: "Container image pulled",
+						Count:   1,
 					},
 				},
-				Pvc: &models.ExplainPvc{
-					StorageClass: "test",
-					Phase:        "Bound",
-					Size:         "1Gi",
-				},
-				Ingress: &models.ExplainIngress{
-					Hosts:        []string{"kuberlogic.com", "kuberlogic.org"},
-					IngressClass: "test-ingress-class",
+				LastError: &models.ExplainError{
+					Message: "failed to syc Deployment explain-ns/explain-test",
+					Time:    strfmt.DateTime(now),
 				},
+				Warnings: []string{},
 			},
 			params: apiService.ServiceExplainParams{
 				HTTPRequest: &http.Request{},
-				ServiceID:   "logs-test",
+				ServiceID:   "explain-test",
 			},
 		},
 	}
@@ -6,17 +6,16 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func (h *handlers) ServiceGetHandler(params apiService.ServiceGetParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceGetHandler(params apiService.ServiceGetParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	result, err := h.Services().Get(ctx, params.ServiceID, v1.GetOptions{})
+	result, err := h.getService(ctx, principal, params.ServiceID)
 	if errors.IsNotFound(err) {
 		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
 		h.log.Warnw(msg, "error", err)
@@ -41,5 +40,5 @@ func (h *handlers) ServiceGetHandler(params apiService.ServiceGetParams, _ *mode
 			})
 	}
 
-	return apiService.NewServiceGetOK().WithPayload(service)
+	return apiService.NewServiceGetOK().WithPayload(service).WithETag(serviceETag(result))
 }
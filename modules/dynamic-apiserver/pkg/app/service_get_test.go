@@ -97,3 +97,22 @@ func TestServiceGet(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceGetETag(t *testing.T) {
+	h := newFakeHandlers(t, &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            "one",
+			ResourceVersion: "42",
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type: "postgresql",
+		},
+	})
+	resp := h.ServiceGetHandler(apiService.ServiceGetParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "one",
+	}, nil)
+	if etag := resp.(*apiService.ServiceGetOK).ETag; etag != `"42"` {
+		t.Errorf("unexpected etag: %s", etag)
+	}
+}
@@ -1,30 +1,80 @@
 package app
 
 import (
+	"context"
+
 	"github.com/go-openapi/runtime/middleware"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) ServiceListHandler(params apiService.ServiceListParams, _ *models.Principal) middleware.Responder {
+// serviceSortKeys are sort keys of services in addition to the common ones
+var serviceSortKeys = map[string]lessFunc{
+	"type": func(a, b metav1.Object) bool {
+		return a.(*v1alpha1.KuberLogicService).Spec.Type < b.(*v1alpha1.KuberLogicService).Spec.Type
+	},
+	"domain": func(a, b metav1.Object) bool {
+		return a.(*v1alpha1.KuberLogicService).Spec.Domain < b.(*v1alpha1.KuberLogicService).Spec.Domain
+	},
+	"status": func(a, b metav1.Object) bool {
+		return a.(*v1alpha1.KuberLogicService).Status.Phase < b.(*v1alpha1.KuberLogicService).Status.Phase
+	},
+}
+
+func (h *handlers) ServiceListHandler(params apiService.ServiceListParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	opts := h.ListOptionsByKeyValue(util.SubscriptionField, params.SubscriptionID)
-	res, err := h.Services().List(ctx, opts)
+	page, err := newPageRequest(params.Limit, params.Continue, params.Sort, serviceSortKeys)
 	if err != nil {
+		return apiService.NewServiceListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	filter, err := newListFilter(params.CreatedAfter, params.CreatedBefore)
+	if err != nil {
+		return apiService.NewServiceListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	filter = append(filter, func(obj metav1.Object) bool {
+		kls := obj.(*v1alpha1.KuberLogicService)
+		return (params.Type == nil || kls.Spec.Type == *params.Type) &&
+			(params.Domain == nil || kls.Spec.Domain == *params.Domain) &&
+			(params.Status == nil || kls.Status.Phase == *params.Status)
+	})
+
+	opts := tenantListOptions(principal, h.ListOptionsByKeyValue(util.SubscriptionField, params.SubscriptionID))
+	res, next, err := listPage(ctx, opts, page, filter, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, string, error) {
+		list, err := h.Services().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]metav1.Object, 0, len(list.Items))
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+		return items, list.Continue, nil
+	})
+	if _, ok := err.(*listRequestError); ok {
+		return apiService.NewServiceListBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	} else if err != nil {
 		msg := "error listing service"
-		h.log.Errorw(msg)
+		h.log.Errorw(msg, "error", err)
 		return apiService.NewServiceListServiceUnavailable().WithPayload(&models.Error{
 			Message: msg,
 		})
 	}
-	h.log.Debugw("found kuberlogicservice objects", "length", len(res.Items), "objects", res)
+	h.log.Debugw("found kuberlogicservice objects", "length", len(res))
 
 	var result []*models.Service
-	for _, r := range res.Items {
-		service, err := util.KuberlogicToService(&r)
+	for _, r := range res {
+		service, err := util.KuberlogicToService(r.(*v1alpha1.KuberLogicService))
 		if err != nil {
 			msg := "error converting service object"
 			h.log.Errorw(msg)
@@ -35,5 +85,5 @@ func (h *handlers) ServiceListHandler(params apiService.ServiceListParams, _ *mo
 		result = append(result, service)
 	}
 
-	return apiService.NewServiceListOK().WithPayload(result)
+	return apiService.NewServiceListOK().WithPayload(result).WithXContinue(next)
 }
@@ -1,23 +1,44 @@
 package app
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/go-openapi/runtime/middleware"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) ServiceLogsHandler(params apiService.ServiceLogsParams, _ *models.Principal) middleware.Responder {
+// serviceLogsFollowTimeout closes log streams before the server write timeout (60s by default) does.
+// Clients are expected to reconnect passing the last received event id.
+var serviceLogsFollowTimeout = 50 * time.Second
+
+// streamPodLogs returns logs of the pod container requested with opts, it is replaced in tests
+var streamPodLogs = func(ctx context.Context, h *handlers, pod *corev1.Pod, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return h.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+}
+
+func (h *handlers) ServiceLogsHandler(params apiService.ServiceLogsParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	kls, err := h.Services().Get(ctx, params.ServiceID, metav1.GetOptions{})
+	kls, err := h.getService(ctx, principal, params.ServiceID)
 	if k8serrors.IsNotFound(err) {
 		return apiService.NewServiceLogsBadRequest().WithPayload(&models.Error{
 			Message: "service does not exist",
@@ -30,58 +51,336 @@ func (h *handlers) ServiceLogsHandler(params apiService.ServiceLogsParams, _ *mo
 		})
 	}
 
-	listOptions := h.ListOptionsByKeyValue("docker-compose.service/name", &params.ServiceID)
-	pods, err := h.clientset.CoreV1().Pods(kls.Status.Namespace).List(ctx, listOptions)
+	pods, err := h.servicePods(ctx, kls, params.PodName)
 	if err != nil {
-		e := errors.Wrap(err, "error getting service pods")
-		h.log.Errorw(e.Error())
+		h.log.Errorw(err.Error())
 		return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
-			Message: e.Error(),
+			Message: err.Error(),
 		})
 	}
-	if len(pods.Items) < 1 {
-		msg := "error listing service pods, no pod is available"
-		h.log.Errorw(msg)
-		return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
-			Message: msg,
-		})
-	}
-	pod := pods.Items[0] // filtering by labels should return only one pod
+
 	response := models.Logs{}
-	for _, container := range pod.Spec.Containers {
-		if params.ContainerName != nil && container.Name != *params.ContainerName {
-			continue
+	for i := range pods {
+		pod := &pods[i]
+		for _, container := range pod.Spec.Containers {
+			if params.ContainerName != nil && container.Name != *params.ContainerName {
+				continue
+			}
+			opts := &corev1.PodLogOptions{
+				Container:    container.Name,
+				TailLines:    params.TailLines,
+				SinceSeconds: params.SinceSeconds,
+			}
+			if params.Previous != nil {
+				opts.Previous = *params.Previous
+			}
+			podLogs, err := streamPodLogs(ctx, h, pod, opts)
+			if opts.Previous && k8serrors.IsBadRequest(err) {
+				// containers that were not restarted have no previous logs
+				h.log.Warnw("skipping container without previous logs", "pod", pod.Name, "container", container.Name, "error", err)
+				continue
+			} else if err != nil {
+				e := errors.Wrapf(err, "error listing container '%s' logs", container.Name)
+				h.log.Errorw(e.Error(), "pod", pod.Name)
+				return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
+					Message: e.Error(),
+				})
+			}
+			buf := new(bytes.Buffer)
+			_, err = io.Copy(buf, podLogs)
+
+			if podLogsErr := podLogs.Close(); podLogsErr != nil {
+				e := errors.Wrapf(podLogsErr, "error closing pod logs stream for container '%s'", container.Name)
+				h.log.Errorw(e.Error(), "pod", pod.Name)
+				return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
+					Message: e.Error(),
+				})
+			}
+
+			if err != nil {
+				e := errors.Wrapf(err, "error obtaining container '%s' logs", container.Name)
+				h.log.Errorw(e.Error(), "pod", pod.Name)
+				return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
+					Message: e.Error(),
+				})
+			}
+			response = append(response, &models.Log{PodName: pod.Name, ContainerName: container.Name, Logs: buf.String()})
 		}
-		req := h.clientset.CoreV1().Pods(kls.Status.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
-			Container: container.Name,
+	}
+	return apiService.NewServiceLogsOK().WithPayload(response)
+}
+
+func (h *handlers) ServiceLogsFollowHandler(params apiService.ServiceLogsFollowParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceLogsFollowBadRequest().WithPayload(&models.Error{
+			Message: "service does not exist",
+		})
+	} else if err != nil {
+		e := errors.Wrap(err, "failed to get service")
+		h.log.Errorw(e.Error())
+		return apiService.NewServiceLogsFollowServiceUnavailable().WithPayload(&models.Error{
+			Message: e.Error(),
 		})
-		podLogs, err := req.Stream(ctx)
+	}
+
+	cursor := logCursor{}
+	if params.LastEventID != nil {
+		cursor, err = parseLogCursor(*params.LastEventID)
 		if err != nil {
-			e := errors.Wrapf(err, "error listing container '%s' logs", container.Name)
-			h.log.Errorw(e.Error())
-			return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
-				Message: e.Error(),
+			return apiService.NewServiceLogsFollowBadRequest().WithPayload(&models.Error{
+				Message: fmt.Sprintf("invalid last event id: %s", *params.LastEventID),
 			})
 		}
-		buf := new(bytes.Buffer)
-		_, err = io.Copy(buf, podLogs)
-
-		if podLogsErr := podLogs.Close(); err != nil {
-			e := errors.Wrapf(podLogsErr, "error closing pod logs stream for container '%s'", container.Name)
-			h.log.Errorw(e.Error())
-			return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
-				Message: e.Error(),
+	}
+
+	pods, err := h.servicePods(ctx, kls, params.PodName)
+	if err != nil {
+		h.log.Errorw(err.Error())
+		return apiService.NewServiceLogsFollowServiceUnavailable().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+
+	// the stream is bound to its own context, so it can be closed before the write timeout
+	streamCtx, cancel := context.WithTimeout(ctx, serviceLogsFollowTimeout)
+	stream := &serviceLogStream{ctx: streamCtx, cancel: cancel, cursor: cursor, log: h.log}
+	for i := range pods {
+		pod := &pods[i]
+		for _, container := range pod.Spec.Containers {
+			if params.ContainerName != nil && container.Name != *params.ContainerName {
+				continue
+			}
+			opts := &corev1.PodLogOptions{
+				Container:    container.Name,
+				Follow:       true,
+				Timestamps:   true,
+				TailLines:    params.TailLines,
+				SinceSeconds: params.SinceSeconds,
+			}
+			since := cursor.since(pod.Name, container.Name)
+			if !since.IsZero() {
+				// resumed streams continue from the last received line of the container instead
+				sinceTime := metav1.NewTime(since)
+				opts.SinceTime, opts.SinceSeconds, opts.TailLines = &sinceTime, nil, nil
+			}
+			podLogs, err := streamPodLogs(streamCtx, h, pod, opts)
+			if err != nil {
+				stream.close()
+				e := errors.Wrapf(err, "error following container '%s' logs", container.Name)
+				h.log.Errorw(e.Error(), "pod", pod.Name)
+				return apiService.NewServiceLogsFollowServiceUnavailable().WithPayload(&models.Error{
+					Message: e.Error(),
+				})
+			}
+			stream.sources = append(stream.sources, &logSource{
+				pod:       pod.Name,
+				container: container.Name,
+				since:     since,
+				logs:      podLogs,
 			})
 		}
+	}
+	return stream
+}
 
+// servicePods returns pods of the service, optionally filtered by pod name.
+// Every service lives in its own namespace, so all pods of the namespace belong to the service.
+func (h *handlers) servicePods(ctx context.Context, kls *v1alpha1.KuberLogicService, podName *string) ([]corev1.Pod, error) {
+	pods, err := h.clientset.CoreV1().Pods(kls.Status.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting service pods")
+	}
+
+	var result []corev1.Pod
+	for _, pod := range pods.Items {
+		if podName != nil && pod.Name != *podName {
+			continue
+		}
+		result = append(result, pod)
+	}
+	if len(result) < 1 {
+		return nil, errors.New("error listing service pods, no pod is available")
+	}
+	return result, nil
+}
+
+// logSource is a followed log of a single pod container
+type logSource struct {
+	pod       string
+	container string
+	// since is the timestamp of the last line sent before the stream is resumed
+	since time.Time
+	logs  io.ReadCloser
+}
+
+// logCursor keeps timestamps of the last sent lines keyed by pod and container
+type logCursor map[string]time.Time
+
+func logCursorKey(pod, container string) string {
+	return pod + "/" + container
+}
+
+// parseLogCursor decodes the cursor of the event id.
+// Ids of streams of previous versions are timestamps of the last sent line of any container.
+func parseLogCursor(id string) (logCursor, error) {
+	if t, err := time.Parse(time.RFC3339Nano, id); err == nil {
+		return logCursor{"": t}, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return nil, err
+	}
+	timestamps := make(map[string]string)
+	if err := json.Unmarshal(data, &timestamps); err != nil {
+		return nil, err
+	}
+	cursor := make(logCursor, len(timestamps))
+	for key, timestamp := range timestamps {
+		t, err := time.Parse(time.RFC3339Nano, timestamp)
 		if err != nil {
-			e := errors.Wrapf(err, "error obtaining container '%s' logs", container.Name)
-			h.log.Errorw(e.Error())
-			return apiService.NewServiceLogsServiceUnavailable().WithPayload(&models.Error{
-				Message: e.Error(),
-			})
+			return nil, err
 		}
-		response = append(response, &models.Log{ContainerName: container.Name, Logs: buf.String()})
+		cursor[key] = t
 	}
-	return apiService.NewServiceLogsOK().WithPayload(response)
+	return cursor, nil
+}
+
+// since returns the timestamp of the last sent line of the pod container.
+// Lines of containers without sent lines are sent from the earliest timestamp of the cursor.
+func (c logCursor) since(pod, container string) time.Time {
+	if t, found := c[logCursorKey(pod, container)]; found {
+		return t
+	}
+	var earliest time.Time
+	for _, t := range c {
+		if earliest.IsZero() || t.Before(earliest) {
+			earliest = t
+		}
+	}
+	return earliest
+}
+
+// id encodes the cursor as an event id, it is empty when no line is sent yet
+func (c logCursor) id() string {
+	if len(c) == 0 {
+		return ""
+	}
+	// the json encoder sorts keys, so ids of equal cursors are equal
+	timestamps := make(map[string]string, len(c))
+	for key, t := range c {
+		timestamps[key] = t.Format(time.RFC3339Nano)
+	}
+	data, _ := json.Marshal(timestamps)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// serviceLogStream writes lines of followed container logs as server-sent events.
+// Each event carries the cursor of the last sent lines of every container as its id.
+type serviceLogStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	// cursor is updated with every sent line
+	cursor  logCursor
+	sources []*logSource
+	log     logging.Logger
+}
+
+var _ middleware.Responder = &serviceLogStream{}
+
+// logLine is a single timestamped line read from a log source
+type logLine struct {
+	timestamp time.Time
+	log       *models.Log
+}
+
+func (s *serviceLogStream) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer s.close()
+
+	rw.Header().Set(runtime.HeaderContentType, "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	flush(rw)
+
+	lines := make(chan logLine)
+	var wg sync.WaitGroup
+	for _, source := range s.sources {
+		wg.Add(1)
+		go func(source *logSource) {
+			defer wg.Done()
+			s.read(source, lines)
+		}(source)
+	}
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+
+	for line := range lines {
+		if !line.timestamp.IsZero() {
+			s.cursor[logCursorKey(line.log.PodName, line.log.ContainerName)] = line.timestamp
+		}
+		if err := writeServerSentEvent(rw, s.cursor.id(), "log", line.log); err != nil {
+			s.log.Errorw("error streaming service logs", "error", err)
+			// unblock readers, lines is drained below
+			s.cancel()
+			continue
+		}
+		flush(rw)
+	}
+
+	// let clients know that containers are gone and there is no need to reconnect
+	if s.ctx.Err() == nil {
+		if err := writeServerSentEvent(rw, "", "eof", struct{}{}); err != nil {
+			s.log.Errorw("error streaming service logs", "error", err)
+		}
+		flush(rw)
+	}
+}
+
+// read sends lines of the source to the lines channel until the source is exhausted or the stream is closed
+func (s *serviceLogStream) read(source *logSource, lines chan<- logLine) {
+	scanner := bufio.NewScanner(source.logs)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		timestamp, text := splitLogTimestamp(scanner.Text())
+		t, _ := time.Parse(time.RFC3339Nano, timestamp)
+		// lines up to the resume point of the source have already been sent
+		if !source.since.IsZero() && !t.IsZero() && !t.After(source.since) {
+			continue
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case lines <- logLine{
+			timestamp: t,
+			log:       &models.Log{PodName: source.pod, ContainerName: source.container, Logs: text},
+		}:
+		}
+	}
+	if err := scanner.Err(); err != nil && s.ctx.Err() == nil {
+		s.log.Errorw("error reading container logs", "pod", source.pod, "container", source.container, "error", err)
+	}
+}
+
+func (s *serviceLogStream) close() {
+	s.cancel()
+	for _, source := range s.sources {
+		_ = source.logs.Close()
+	}
+}
+
+// splitLogTimestamp splits a line of logs requested with timestamps into the timestamp and the line itself
+func splitLogTimestamp(line string) (string, string) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return "", line
+	}
+	if _, err := time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		return "", line
+	}
+	return parts[0], parts[1]
 }
@@ -1,18 +1,56 @@
 package app
 
 import (
+	"context"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
+func logsTestService() *v1alpha1.KuberLogicService {
+	return &v1alpha1.KuberLogicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "logs-test",
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type: "demo",
+		},
+		Status: v1alpha1.KuberLogicServiceStatus{
+			Namespace: "logs-test",
+		},
+	}
+}
+
+func logsTestPod(name string, containers ...string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "logs-test",
+		},
+	}
+	for _, c := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Name: c})
+	}
+	return pod
+}
+
 func TestServiceLogs(t *testing.T) {
 	containerName := "a"
 
@@ -71,7 +109,6 @@ func TestServiceLogs(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "test-pod",
 						Namespace: "secrets-test",
-						Labels:    map[string]string{"docker-compose.service/name": "logs-test"},
 					},
 					Spec: v1.PodSpec{
 						Containers: []v1.Container{
@@ -83,8 +120,8 @@ func TestServiceLogs(t *testing.T) {
 				},
 			},
 			result: models.Logs{
-				{ContainerName: "a", Logs: "fake logs"},
-				{ContainerName: "b", Logs: "fake logs"},
+				{PodName: "test-pod", ContainerName: "a", Logs: "fake logs"},
+				{PodName: "test-pod", ContainerName: "b", Logs: "fake logs"},
 			},
 			params: apiService.ServiceLogsParams{
 				HTTPRequest: &http.Request{},
@@ -110,7 +147,6 @@ func TestServiceLogs(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "test-pod",
 						Namespace: "secrets-test",
-						Labels:    map[string]string{"docker-compose.service/name": "logs-test"},
 					},
 					Spec: v1.PodSpec{
 						Containers: []v1.Container{
@@ -122,14 +158,55 @@ func TestServiceLogs(t *testing.T) {
 				},
 			},
 			result: models.Logs{
-				{ContainerName: "a", Logs: "fake logs"},
+				{PodName: "test-pod", ContainerName: "a", Logs: "fake logs"},
+			},
+			params: apiService.ServiceLogsParams{
+				HTTPRequest:   &http.Request{},
+				ServiceID:     "logs-test",
+				ContainerName: &containerName,
+			},
+		},
+		{
+			name:    "multiple-pods",
+			status:  200,
+			objects: []runtime.Object{logsTestService(), logsTestPod("first", "a"), logsTestPod("second", "a", "b")},
+			result: models.Logs{
+				{PodName: "first", ContainerName: "a", Logs: "fake logs"},
+				{PodName: "second", ContainerName: "a", Logs: "fake logs"},
+				{PodName: "second", ContainerName: "b", Logs: "fake logs"},
+			},
+			params: apiService.ServiceLogsParams{
+				HTTPRequest: &http.Request{},
+				ServiceID:   "logs-test",
+			},
+		},
+		{
+			name:    "single-pod",
+			status:  200,
+			objects: []runtime.Object{logsTestService(), logsTestPod("first", "a"), logsTestPod("second", "a", "b")},
+			result: models.Logs{
+				{PodName: "second", ContainerName: "a", Logs: "fake logs"},
 			},
 			params: apiService.ServiceLogsParams{
 				HTTPRequest:   &http.Request{},
 				ServiceID:     "logs-test",
+				PodName:       util.StrAsPointer("second"),
 				ContainerName: &containerName,
 			},
 		},
+		{
+			name:    "unknown-pod",
+			status:  503,
+			objects: []runtime.Object{logsTestService(), logsTestPod("first", "a")},
+			result: &models.Error{
+				Message: "error listing service pods, no pod is available",
+			},
+			params: apiService.ServiceLogsParams{
+				HTTPRequest: &http.Request{},
+				ServiceID:   "logs-test",
+				PodName:     util.StrAsPointer("second"),
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -137,3 +214,208 @@ func TestServiceLogs(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceLogsOptions(t *testing.T) {
+	clientset := fake.NewSimpleClientset(logsTestPod("first", "a"))
+	h := newFakeHandlersWithClientset(t, clientset, logsTestService())
+
+	tailLines, sinceSeconds, previous := int64(10), int64(60), true
+	checkResponse(h.ServiceLogsHandler(apiService.ServiceLogsParams{
+		HTTPRequest:  &http.Request{},
+		ServiceID:    "logs-test",
+		TailLines:    &tailLines,
+		SinceSeconds: &sinceSeconds,
+		Previous:     &previous,
+	}, nil), t, 200, models.Logs{
+		{PodName: "first", ContainerName: "a", Logs: "fake logs"},
+	})
+
+	expected := &v1.PodLogOptions{
+		Container:    "a",
+		TailLines:    &tailLines,
+		SinceSeconds: &sinceSeconds,
+		Previous:     true,
+	}
+	if opts := logOptionsOf(t, clientset); !reflect.DeepEqual(opts, expected) {
+		t.Errorf("log options do not equal: actual vs expected\n%+v\n%+v", opts, expected)
+	}
+}
+
+func TestServiceLogsFollow(t *testing.T) {
+	clientset := fake.NewSimpleClientset(logsTestPod("first", "a", "b"))
+	h := newFakeHandlersWithClientset(t, clientset, logsTestService())
+
+	containerName := "b"
+	tailLines := int64(5)
+	responder := h.ServiceLogsFollowHandler(apiService.ServiceLogsFollowParams{
+		HTTPRequest:   &http.Request{},
+		ServiceID:     "logs-test",
+		ContainerName: &containerName,
+		TailLines:     &tailLines,
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, nil)
+
+	if rec.Code != 200 {
+		t.Errorf("unexpected status code: %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("unexpected content type: %s", ct)
+	}
+	expected := "event: log\ndata: {\"containerName\":\"b\",\"logs\":\"fake logs\",\"podName\":\"first\"}\n\n" +
+		"event: eof\ndata: {}\n\n"
+	if rec.Body.String() != expected {
+		t.Errorf("stream does not equal: actual vs expected\n%s\n%s", rec.Body.String(), expected)
+	}
+
+	opts := logOptionsOf(t, clientset)
+	if !opts.Follow || !opts.Timestamps || opts.TailLines == nil || *opts.TailLines != tailLines {
+		t.Errorf("unexpected log options: %+v", opts)
+	}
+}
+
+func TestServiceLogsFollowResume(t *testing.T) {
+	clientset := fake.NewSimpleClientset(logsTestPod("first", "a"))
+	h := newFakeHandlersWithClientset(t, clientset, logsTestService())
+
+	tailLines := int64(5)
+	lastEventID := "2022-07-01T10:00:00.123456789Z"
+	h.ServiceLogsFollowHandler(apiService.ServiceLogsFollowParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "logs-test",
+		TailLines:   &tailLines,
+		LastEventID: &lastEventID,
+	}, nil).WriteResponse(httptest.NewRecorder(), nil)
+
+	opts := logOptionsOf(t, clientset)
+	if opts.TailLines != nil {
+		t.Errorf("tail lines must not be used on resume: %d", *opts.TailLines)
+	}
+	if opts.SinceTime == nil || opts.SinceTime.Format(time.RFC3339Nano) != lastEventID {
+		t.Errorf("logs are not resumed from %s: %v", lastEventID, opts.SinceTime)
+	}
+
+	invalid := "yesterday"
+	checkResponse(h.ServiceLogsFollowHandler(apiService.ServiceLogsFollowParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "logs-test",
+		LastEventID: &invalid,
+	}, nil), t, 400, &models.Error{
+		Message: "invalid last event id: yesterday",
+	})
+}
+
+// withFakePodLogs replaces pod logs with logs of containers for the duration of the test, containers without logs fail with errs.
+// Options of requests are recorded by containers.
+func withFakePodLogs(t *testing.T, logs map[string]string, errs map[string]error) map[string]*v1.PodLogOptions {
+	requested := make(map[string]*v1.PodLogOptions)
+	original := streamPodLogs
+	streamPodLogs = func(_ context.Context, _ *handlers, _ *v1.Pod, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+		requested[opts.Container] = opts
+		if err := errs[opts.Container]; err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(strings.NewReader(logs[opts.Container])), nil
+	}
+	t.Cleanup(func() {
+		streamPodLogs = original
+	})
+	return requested
+}
+
+func TestServiceLogsPrevious(t *testing.T) {
+	withFakePodLogs(t, map[string]string{"a": "crashed"}, map[string]error{
+		"b": k8serrors.NewBadRequest(`previous terminated container "b" in pod "first" not found`),
+	})
+	h := newFakeHandlers(t, logsTestService(), logsTestPod("first", "a", "b"))
+
+	previous := true
+	checkResponse(h.ServiceLogsHandler(apiService.ServiceLogsParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "logs-test",
+		Previous:    &previous,
+	}, nil), t, 200, models.Logs{
+		{PodName: "first", ContainerName: "a", Logs: "crashed"},
+	})
+}
+
+func TestServiceLogsFollowCursor(t *testing.T) {
+	// the line of b is older than the last sent line of a, but it was not sent before the client disconnected
+	requested := withFakePodLogs(t, map[string]string{
+		"a": "2022-07-01T10:00:01Z sent\n2022-07-01T10:00:03Z sent\n2022-07-01T10:00:04Z new",
+		"b": "2022-07-01T10:00:01Z sent\n2022-07-01T10:00:02Z not sent",
+	}, nil)
+	h := newFakeHandlers(t, logsTestService(), logsTestPod("first", "a", "b"))
+
+	sent := logCursor{
+		"first/a": time.Date(2022, 7, 1, 10, 0, 3, 0, time.UTC),
+		"first/b": time.Date(2022, 7, 1, 10, 0, 1, 0, time.UTC),
+	}
+	lastEventID := sent.id()
+	rec := httptest.NewRecorder()
+	h.ServiceLogsFollowHandler(apiService.ServiceLogsFollowParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "logs-test",
+		LastEventID: &lastEventID,
+	}, nil).WriteResponse(rec, nil)
+
+	for container, since := range map[string]string{"a": "2022-07-01T10:00:03Z", "b": "2022-07-01T10:00:01Z"} {
+		if opts := requested[container]; opts.SinceTime == nil || opts.SinceTime.UTC().Format(time.RFC3339Nano) != since {
+			t.Errorf("logs of %s are not resumed from %s: %v", container, since, opts.SinceTime)
+		}
+	}
+
+	var lines []string
+	var cursor logCursor
+	for _, event := range strings.Split(rec.Body.String(), "\n\n") {
+		if !strings.HasPrefix(event, "id: ") {
+			continue
+		}
+		fields := strings.SplitN(event, "\n", 3)
+		var err error
+		if cursor, err = parseLogCursor(strings.TrimPrefix(fields[0], "id: ")); err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, fields[2])
+	}
+	if len(lines) != 2 || !strings.Contains(strings.Join(lines, ""), `"logs":"not sent"`) || !strings.Contains(strings.Join(lines, ""), `"logs":"new"`) {
+		t.Errorf("unexpected lines after resume: %v", lines)
+	}
+	expected := logCursor{
+		"first/a": time.Date(2022, 7, 1, 10, 0, 4, 0, time.UTC),
+		"first/b": time.Date(2022, 7, 1, 10, 0, 2, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(cursor, expected) {
+		t.Errorf("cursor does not equal: actual vs expected\n%v\n%v", cursor, expected)
+	}
+}
+
+func TestSplitLogTimestamp(t *testing.T) {
+	for line, expected := range map[string][2]string{
+		"2022-07-01T10:00:00.5Z hello world": {"2022-07-01T10:00:00.5Z", "hello world"},
+		"hello world":                        {"", "hello world"},
+		"":                                   {"", ""},
+	} {
+		if ts, text := splitLogTimestamp(line); ts != expected[0] || text != expected[1] {
+			t.Errorf("%q is split to %q %q", line, ts, text)
+		}
+	}
+}
+
+// logOptionsOf returns options of the last logs request made with the clientset
+func logOptionsOf(t *testing.T, clientset *fake.Clientset) *v1.PodLogOptions {
+	var opts *v1.PodLogOptions
+	for _, action := range clientset.Actions() {
+		if action.GetSubresource() != "log" {
+			continue
+		}
+		if a, ok := action.(clienttesting.GenericAction); ok {
+			opts = a.GetValue().(*v1.PodLogOptions)
+		}
+	}
+	if opts == nil {
+		t.Fatal("logs are not requested")
+	}
+	return opts
+}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func (h *handlers) ServiceMetricsHandler(params apiService.ServiceMetricsParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceMetricsNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("error finding service", "error", err)
+		return apiService.NewServiceMetricsServiceUnavailable().WithPayload(&models.Error{
+			Message: "error finding service",
+		})
+	}
+	ns := kls.Status.Namespace
+	if ns == "" {
+		return apiService.NewServiceMetricsServiceUnavailable().WithPayload(&models.Error{
+			Message: "service is not provisioned yet",
+		})
+	}
+
+	// the namespace is dedicated to the service, all its pods belong to the service
+	pods, err := h.clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		h.log.Errorw("error listing service pods", "error", err, "namespace", ns)
+		return apiService.NewServiceMetricsServiceUnavailable().WithPayload(&models.Error{
+			Message: errors.Wrap(err, "error listing service pods").Error(),
+		})
+	}
+	usage, err := h.metrics.PodMetrics(ctx, ns)
+	if err != nil {
+		h.log.Errorw("error getting pod metrics", "error", err, "namespace", ns)
+		return apiService.NewServiceMetricsServiceUnavailable().WithPayload(&models.Error{
+			Message: errors.Wrap(err, "metrics are not available").Error(),
+		})
+	}
+
+	result := &models.ServiceMetrics{
+		Containers: make([]*models.ContainerUsage, 0),
+		Volumes:    make([]*models.VolumeUsage, 0),
+		Warnings:   make([]string, 0),
+	}
+	var cpu, memory int64
+	for _, pod := range usage {
+		if pod.Timestamp.After(time.Time(result.Time)) {
+			result.Time = strfmt.DateTime(pod.Timestamp.UTC())
+		}
+		for _, c := range pod.Containers {
+			cpu += c.Usage.Cpu().MilliValue()
+			memory += c.Usage.Memory().Value()
+			result.Containers = append(result.Containers, &models.ContainerUsage{
+				Pod:    pod.Metadata.Name,
+				Name:   c.Name,
+				CPU:    milliQuantity(c.Usage.Cpu().MilliValue()),
+				Memory: binaryQuantity(c.Usage.Memory().Value()),
+			})
+		}
+	}
+	sort.Slice(result.Containers, func(i, j int) bool {
+		a, b := result.Containers[i], result.Containers[j]
+		return a.Pod < b.Pod || a.Pod == b.Pod && a.Name < b.Name
+	})
+
+	var storage int64
+	result.Volumes, storage, result.Warnings = h.volumeUsage(ctx, ns, pods.Items)
+
+	result.CPU = resourceUsage(milliQuantity(cpu), float64(cpu), kls.Spec.Limits, corev1.ResourceCPU, true)
+	result.Memory = resourceUsage(binaryQuantity(memory), float64(memory), kls.Spec.Limits, corev1.ResourceMemory, false)
+	result.Storage = resourceUsage(binaryQuantity(storage), float64(storage), kls.Spec.Limits, corev1.ResourceStorage, false)
+	return apiService.NewServiceMetricsOK().WithPayload(result)
+}
+
+// volumeUsage returns usage of persistent volumes mounted by pods as reported by kubelets of pod nodes.
+// Volumes shared by pods are counted once in the total, nodes that can't be queried are reported as warnings.
+func (h *handlers) volumeUsage(ctx context.Context, ns string, pods []corev1.Pod) ([]*models.VolumeUsage, int64, []string) {
+	volumes, warnings := make([]*models.VolumeUsage, 0), make([]string, 0)
+
+	nodes := make(map[string]bool)
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			nodes[pod.Spec.NodeName] = true
+		}
+	}
+	names := make([]string, 0, len(nodes))
+	for node := range nodes {
+		names = append(names, node)
+	}
+	sort.Strings(names)
+
+	claims := make(map[string]int64)
+	for _, node := range names {
+		summary, err := h.metrics.StatsSummary(ctx, node)
+		if err != nil {
+			h.log.Errorw("error getting node stats summary", "error", err, "node", node)
+			warnings = append(warnings, fmt.Sprintf("volume usage of node %s is not available: %s", node, err))
+			continue
+		}
+		for _, pod := range summary.Pods {
+			if pod.PodRef.Namespace != ns {
+				continue
+			}
+			for _, v := range pod.Volumes {
+				if v.PVCRef == nil {
+					continue
+				}
+				used := uint64Value(v.UsedBytes)
+				if used > claims[v.PVCRef.Name] {
+					claims[v.PVCRef.Name] = used
+				}
+				volumes = append(volumes, &models.VolumeUsage{
+					Pod:       pod.PodRef.Name,
+					Name:      v.Name,
+					Claim:     v.PVCRef.Name,
+					Used:      binaryQuantity(used),
+					Capacity:  binaryQuantity(uint64Value(v.CapacityBytes)),
+					Available: binaryQuantity(uint64Value(v.AvailableBytes)),
+				})
+			}
+		}
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		a, b := volumes[i], volumes[j]
+		return a.Pod < b.Pod || a.Pod == b.Pod && a.Name < b.Name
+	})
+
+	var total int64
+	for _, used := range claims {
+		total += used
+	}
+	return volumes, total, warnings
+}
+
+// resourceUsage compares the usage with the service limit of the resource
+func resourceUsage(used string, value float64, limits corev1.ResourceList, name corev1.ResourceName, milli bool) *models.ResourceUsage {
+	usage := &models.ResourceUsage{Used: used}
+	limit, ok := limits[name]
+	if !ok || limit.IsZero() {
+		return usage
+	}
+	usage.Limit = limit.String()
+	max := float64(limit.Value())
+	if milli {
+		max = float64(limit.MilliValue())
+	}
+	percent := math.Round(value/max*1000) / 10
+	usage.Percent = &percent
+	return usage
+}
+
+func milliQuantity(value int64) string {
+	return resource.NewMilliQuantity(value, resource.DecimalSI).String()
+}
+
+// binaryQuantity formats bytes rounded down to KiB
+func binaryQuantity(value int64) string {
+	return resource.NewQuantity(value/1024*1024, resource.BinarySI).String()
+}
+
+func uint64Value(v *uint64) int64 {
+	if v == nil {
+		return 0
+	}
+	return int64(*v)
+}
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// fakeMetrics replaces the metrics API and kubelets
+type fakeMetrics struct {
+	pods      []podMetrics
+	podsErr   error
+	summaries map[string]*statsSummary
+}
+
+func (f *fakeMetrics) PodMetrics(_ context.Context, namespace string) ([]podMetrics, error) {
+	var result []podMetrics
+	for _, p := range f.pods {
+		if p.Metadata.Namespace == namespace {
+			result = append(result, p)
+		}
+	}
+	return result, f.podsErr
+}
+
+func (f *fakeMetrics) StatsSummary(_ context.Context, node string) (*statsSummary, error) {
+	summary, ok := f.summaries[node]
+	if !ok {
+		return nil, errors.New("node is not reachable")
+	}
+	return summary, nil
+}
+
+func testPodMetrics(ns, name string, ts time.Time, cpu, memory string) podMetrics {
+	return podMetrics{
+		Metadata:  v1.ObjectMeta{Name: name, Namespace: ns},
+		Timestamp: v1.NewTime(ts),
+		Containers: []containerMetrics{{Name: "app", Usage: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}}},
+	}
+}
+
+func testStatsSummary(ns, pod, claim string, used, capacity uint64) *statsSummary {
+	raw, err := json.Marshal(map[string]interface{}{
+		"pods": []interface{}{map[string]interface{}{
+			"podRef": map[string]string{"name": pod, "namespace": ns},
+			"volume": []interface{}{
+				map[string]interface{}{"name": "tmp", "usedBytes": 4096},
+				map[string]interface{}{
+					"name":           "data",
+					"pvcRef":         map[string]string{"name": claim, "namespace": ns},
+					"usedBytes":      used,
+					"capacityBytes":  capacity,
+					"availableBytes": capacity - used,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	summary := &statsSummary{}
+	if err := json.Unmarshal(raw, summary); err != nil {
+		panic(err)
+	}
+	return summary
+}
+
+func testServicePod(ns, name, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: ns},
+		Spec:       corev1.PodSpec{NodeName: node},
+	}
+}
+
+func TestServiceMetrics(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	kls := &v1alpha1.KuberLogicService{
+		ObjectMeta: v1.ObjectMeta{Name: "demo"},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type: "postgresql",
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:     resource.MustParse("1"),
+				corev1.ResourceMemory:  resource.MustParse("1Gi"),
+				corev1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+		Status: v1alpha1.KuberLogicServiceStatus{Namespace: "demo"},
+	}
+	metrics := &fakeMetrics{
+		pods: []podMetrics{
+			testPodMetrics("demo", "demo-1", now, "250m", "256Mi"),
+			testPodMetrics("demo", "demo-0", now.Add(-time.Minute), "150m", "256Mi"),
+			testPodMetrics("other", "other-0", now, "1", "1Gi"),
+		},
+		summaries: map[string]*statsSummary{
+			"node-1": testStatsSummary("demo", "demo-0", "data-demo", 1<<30, 10<<30),
+		},
+	}
+	h := newFakeHandlers(t, kls,
+		testServicePod("demo", "demo-0", "node-1"),
+		testServicePod("demo", "demo-1", "node-2"),
+	)
+	h.Handlers.(*handlers).metrics = metrics
+
+	percent := func(v float64) *float64 { return &v }
+	params := apiService.ServiceMetricsParams{HTTPRequest: &http.Request{}, ServiceID: "demo"}
+	checkResponse(h.ServiceMetricsHandler(params, nil), t, 200, &models.ServiceMetrics{
+		Time:    strfmt.DateTime(now),
+		CPU:     &models.ResourceUsage{Used: "400m", Limit: "1", Percent: percent(40)},
+		Memory:  &models.ResourceUsage{Used: "512Mi", Limit: "1Gi", Percent: percent(50)},
+		Storage: &models.ResourceUsage{Used: "1Gi", Limit: "10Gi", Percent: percent(10)},
+		Containers: []*models.ContainerUsage{
+			{Pod: "demo-0", Name: "app", CPU: "150m", Memory: "256Mi"},
+			{Pod: "demo-1", Name: "app", CPU: "250m", Memory: "256Mi"},
+		},
+		Volumes: []*models.VolumeUsage{
+			{Pod: "demo-0", Name: "data", Claim: "data-demo", Used: "1Gi", Capacity: "10Gi", Available: "9Gi"},
+		},
+		Warnings: []string{"volume usage of node node-2 is not available: node is not reachable"},
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		unlimited := kls.DeepCopy()
+		unlimited.Spec.Limits = nil
+		h := newFakeHandlers(t, unlimited)
+		h.Handlers.(*handlers).metrics = metrics
+		checkResponse(h.ServiceMetricsHandler(params, nil), t, 200, func(payload interface{}) {
+			m := payload.(*models.ServiceMetrics)
+			if !reflect.DeepEqual(m.CPU, &models.ResourceUsage{Used: "400m"}) || m.Storage.Used != "0" {
+				t.Errorf("unexpected usage: %s", prettyPrint(m))
+			}
+		})
+	})
+
+	t.Run("metrics-not-available", func(t *testing.T) {
+		h := newFakeHandlers(t, kls)
+		h.Handlers.(*handlers).metrics = &fakeMetrics{podsErr: errors.New("the server could not find the requested resource")}
+		checkResponse(h.ServiceMetricsHandler(params, nil), t, 503, &models.Error{
+			Message: "metrics are not available: the server could not find the requested resource",
+		})
+	})
+
+	t.Run("not-provisioned", func(t *testing.T) {
+		pending := kls.DeepCopy()
+		pending.Status.Namespace = ""
+		h := newFakeHandlers(t, pending)
+		checkResponse(h.ServiceMetricsHandler(params, nil), t, 503, &models.Error{
+			Message: "service is not provisioned yet",
+		})
+	})
+
+	t.Run("not-found", func(t *testing.T) {
+		h := newFakeHandlers(t)
+		checkResponse(h.ServiceMetricsHandler(params, nil), t, 404, &models.Error{
+			Message: "kuberlogic service not found: demo",
+		})
+	})
+}
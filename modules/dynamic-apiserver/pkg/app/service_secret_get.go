@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func (h *handlers) ServiceSecretGetHandler(params apiService.ServiceSecretGetParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceSecretGetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to get service", "error", err.Error())
+		return apiService.NewServiceSecretGetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to get service: " + err.Error(),
+		})
+	}
+
+	// hidden secrets are not revealed, they are reported as missing
+	key, found := declaredSecret(kls, params.SecretID)
+	if !found || !key.Visible {
+		return apiService.NewServiceSecretGetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret not found: %s", params.SecretID),
+		})
+	}
+
+	storage, err := h.secretStorage(ctx, kls)
+	if err != nil {
+		h.log.Errorw("failed to get service secret object", "error", err.Error())
+		return apiService.NewServiceSecretGetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to retrieve service secrets",
+		})
+	}
+	value, set := storage.Data[key.ID]
+	if !set {
+		return apiService.NewServiceSecretGetServiceUnavailable().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret %s is not generated yet", key.ID),
+		})
+	}
+
+	return apiService.NewServiceSecretGetOK().WithPayload(&models.ServiceSecret{
+		ID:        key.ID,
+		Value:     string(value),
+		Visible:   key.Visible,
+		Rotatable: key.Rotatable,
+	})
+}
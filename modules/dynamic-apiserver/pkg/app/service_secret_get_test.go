@@ -0,0 +1,65 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func TestServiceSecretGet(t *testing.T) {
+	objects := testSecretsService(map[string][]byte{"token": []byte("a"), "password": []byte("b")})
+	params := func(id string) apiService.ServiceSecretGetParams {
+		return apiService.ServiceSecretGetParams{
+			HTTPRequest: &http.Request{},
+			ServiceID:   "secrets-test",
+			SecretID:    id,
+		}
+	}
+	cases := []testCase{
+		{
+			name:   "service-not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "kuberlogic service not found: secrets-test",
+			},
+			params: params("password"),
+		}, {
+			name:    "not-declared",
+			status:  404,
+			objects: objects,
+			result: &models.Error{
+				Message: "secret not found: unknown",
+			},
+			params: params("unknown"),
+		}, {
+			name:    "hidden",
+			status:  404,
+			objects: objects,
+			result: &models.Error{
+				Message: "secret not found: token",
+			},
+			params: params("token"),
+		}, {
+			name:    "not-generated",
+			status:  503,
+			objects: objects,
+			result: &models.Error{
+				Message: "secret key is not generated yet",
+			},
+			params: params("key"),
+		}, {
+			name:    "ok",
+			status:  200,
+			objects: objects,
+			result:  &models.ServiceSecret{ID: "password", Value: "b", Visible: true},
+			params:  params("password"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).ServiceSecretGetHandler(tc.params.(apiService.ServiceSecretGetParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
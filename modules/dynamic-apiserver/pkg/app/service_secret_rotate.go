@@ -0,0 +1,68 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func (h *handlers) ServiceSecretRotateHandler(params apiService.ServiceSecretRotateParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceSecretRotateNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to get service", "error", err.Error())
+		return apiService.NewServiceSecretRotateServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to get service: " + err.Error(),
+		})
+	}
+
+	key, found := declaredSecret(kls, params.SecretID)
+	if !found {
+		return apiService.NewServiceSecretRotateNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret not found: %s", params.SecretID),
+		})
+	}
+	if !key.Rotatable {
+		return apiService.NewServiceSecretRotateUnprocessableEntity().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret %s can't be rotated", key.ID),
+		})
+	}
+
+	storage, err := h.secretStorage(ctx, kls)
+	if err != nil {
+		h.log.Errorw("failed to get service secret object", "error", err.Error())
+		return apiService.NewServiceSecretRotateServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to retrieve service secrets",
+		})
+	}
+	// the plugin generates missing secrets from their templates and restarts the service
+	if _, set := storage.Data[key.ID]; !set {
+		return apiService.NewServiceSecretRotateAccepted()
+	}
+	before := map[string]string{key.ID: string(storage.Data[key.ID])}
+	delete(storage.Data, key.ID)
+	if _, err := h.clientset.CoreV1().Secrets(storage.GetNamespace()).Update(ctx, storage, metav1.UpdateOptions{}); k8serrors.IsConflict(err) {
+		return apiService.NewServiceSecretRotateConflict().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret %s was changed by a concurrent request, retry the rotation", key.ID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to rotate service secret", "error", err.Error())
+		return apiService.NewServiceSecretRotateServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to rotate secret",
+		})
+	}
+	// the value is generated again by the plugin, the removed value is not kept in the record
+	audit.SetChanges(ctx, audit.Redact(audit.Changes(before, nil)))
+	return apiService.NewServiceSecretRotateAccepted()
+}
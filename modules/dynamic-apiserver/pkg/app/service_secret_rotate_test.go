@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func TestServiceSecretRotate(t *testing.T) {
+	params := func(id string) apiService.ServiceSecretRotateParams {
+		return apiService.ServiceSecretRotateParams{
+			HTTPRequest: &http.Request{},
+			ServiceID:   "secrets-test",
+			SecretID:    id,
+		}
+	}
+
+	t.Run("rotated", func(t *testing.T) {
+		h := newFakeHandlers(t, testSecretsService(map[string][]byte{"token": []byte("a"), "password": []byte("b")})...)
+		checkResponse(h.ServiceSecretRotateHandler(params("token"), nil), t, 202, nil)
+
+		// the plugin generates the removed secret again
+		secret, err := h.Handlers.(*handlers).clientset.CoreV1().Secrets("secrets-test").Get(context.TODO(), "secrets-storage", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, set := secret.Data["token"]; set || string(secret.Data["password"]) != "b" {
+			t.Errorf("unexpected secret data: %v", secret.Data)
+		}
+
+		// rotation is already requested
+		checkResponse(h.ServiceSecretRotateHandler(params("token"), nil), t, 202, nil)
+	})
+
+	t.Run("not-rotatable", func(t *testing.T) {
+		h := newFakeHandlers(t, testSecretsService(map[string][]byte{"password": []byte("b")})...)
+		checkResponse(h.ServiceSecretRotateHandler(params("password"), nil), t, 422, &models.Error{
+			Message: "secret password can't be rotated",
+		})
+	})
+
+	t.Run("not-declared", func(t *testing.T) {
+		h := newFakeHandlers(t, testSecretsService(nil)...)
+		checkResponse(h.ServiceSecretRotateHandler(params("unknown"), nil), t, 404, &models.Error{
+			Message: "secret not found: unknown",
+		})
+	})
+}
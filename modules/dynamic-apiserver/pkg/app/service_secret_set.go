@@ -0,0 +1,68 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+)
+
+func (h *handlers) ServiceSecretSetHandler(params apiService.ServiceSecretSetParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	kls, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceSecretSetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to get service", "error", err.Error())
+		return apiService.NewServiceSecretSetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to get service: " + err.Error(),
+		})
+	}
+
+	key, found := declaredSecret(kls, params.SecretID)
+	if !found {
+		return apiService.NewServiceSecretSetNotFound().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret not found: %s", params.SecretID),
+		})
+	}
+	if !key.Rotatable {
+		return apiService.NewServiceSecretSetUnprocessableEntity().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret %s can't be changed", key.ID),
+		})
+	}
+
+	// only the changed field is recorded, the value is secret
+	audit.SetChanges(ctx, audit.Redact(audit.Changes(nil, params.SecretValue)))
+
+	storage, err := h.secretStorage(ctx, kls)
+	if err != nil {
+		h.log.Errorw("failed to get service secret object", "error", err.Error())
+		return apiService.NewServiceSecretSetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to retrieve service secrets",
+		})
+	}
+	if storage.Data == nil {
+		storage.Data = make(map[string][]byte)
+	}
+	// the service is restarted by the operator when the secret is changed
+	storage.Data[key.ID] = []byte(*params.SecretValue.Value)
+	if _, err := h.clientset.CoreV1().Secrets(storage.GetNamespace()).Update(ctx, storage, metav1.UpdateOptions{}); k8serrors.IsConflict(err) {
+		return apiService.NewServiceSecretSetConflict().WithPayload(&models.Error{
+			Message: fmt.Sprintf("secret %s was changed by a concurrent request, retry the change", key.ID),
+		})
+	} else if err != nil {
+		h.log.Errorw("failed to set service secret", "error", err.Error())
+		return apiService.NewServiceSecretSetServiceUnavailable().WithPayload(&models.Error{
+			Message: "failed to set secret",
+		})
+	}
+	return apiService.NewServiceSecretSetOK()
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func TestServiceSecretSet(t *testing.T) {
+	params := func(id, value string) apiService.ServiceSecretSetParams {
+		return apiService.ServiceSecretSetParams{
+			HTTPRequest: &http.Request{},
+			ServiceID:   "secrets-test",
+			SecretID:    id,
+			SecretValue: &models.SecretValue{Value: util.StrAsPointer(value)},
+		}
+	}
+
+	t.Run("set", func(t *testing.T) {
+		h := newFakeHandlers(t, testSecretsService(nil)...)
+		checkResponse(h.ServiceSecretSetHandler(params("key", "new"), nil), t, 200, nil)
+
+		secret, err := h.Handlers.(*handlers).clientset.CoreV1().Secrets("secrets-test").Get(context.TODO(), "secrets-storage", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(secret.Data["key"]) != "new" {
+			t.Errorf("unexpected secret data: %v", secret.Data)
+		}
+	})
+
+	t.Run("not-rotatable", func(t *testing.T) {
+		h := newFakeHandlers(t, testSecretsService(nil)...)
+		checkResponse(h.ServiceSecretSetHandler(params("password", "new"), nil), t, 422, &models.Error{
+			Message: "secret password can't be changed",
+		})
+	})
+
+	t.Run("service-not-found", func(t *testing.T) {
+		h := newFakeHandlers(t)
+		checkResponse(h.ServiceSecretSetHandler(params("key", "new"), nil), t, 404, &models.Error{
+			Message: "kuberlogic service not found: secrets-test",
+		})
+	})
+}
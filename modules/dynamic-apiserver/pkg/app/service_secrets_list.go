@@ -5,16 +5,15 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 )
 
-func (h *handlers) ServiceSecretsListHandler(params apiService.ServiceSecretsListParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceSecretsListHandler(params apiService.ServiceSecretsListParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	kls, err := h.Services().Get(ctx, params.ServiceID, metav1.GetOptions{})
+	kls, err := h.getService(ctx, principal, params.ServiceID)
 	if k8serrors.IsNotFound(err) {
 		return apiService.NewServiceSecretsListBadRequest().WithPayload(&models.Error{
 			Message: "service does not exist",
@@ -26,22 +25,16 @@ func (h *handlers) ServiceSecretsListHandler(params apiService.ServiceSecretsLis
 		})
 	}
 
-	// fixme: abstraction leak, secret is defined inside the plugin
-	secretStorage, err := h.clientset.CoreV1().
-		Secrets(kls.Status.Namespace).
-		Get(params.HTTPRequest.Context(), kls.GetName(), metav1.GetOptions{})
-	if err != nil {
-		h.log.Errorw("failed to get service secret object", "error", err.Error())
-		return apiService.NewServiceSecretsListServiceUnavailable().WithPayload(&models.Error{
-			Message: "failed to retrieve service secrets",
-		})
-	}
+	// secrets are declared by the service plugin, values are revealed one by one
 	secrets := models.ServiceSecrets{}
-	for secId, secData := range secretStorage.Data {
-		secrets = append(secrets, &models.ServiceSecret{
-			ID:    secId,
-			Value: string(secData),
-		})
+	if kls.Status.Secrets != nil {
+		for _, key := range kls.Status.Secrets.Keys {
+			secrets = append(secrets, &models.ServiceSecret{
+				ID:        key.ID,
+				Visible:   key.Visible,
+				Rotatable: key.Rotatable,
+			})
+		}
 	}
 	sort.Slice(secrets, func(i, j int) bool {
 		return secrets[i].ID < secrets[j].ID
@@ -13,6 +13,38 @@ import (
 	cloudlinuxv1alpha1 "github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
+// testSecretsService returns a service with declared secrets and the Secret that keeps them
+func testSecretsService(data map[string][]byte) []runtime.Object {
+	return []runtime.Object{
+		&cloudlinuxv1alpha1.KuberLogicService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "secrets-test",
+			},
+			Spec: cloudlinuxv1alpha1.KuberLogicServiceSpec{
+				Type: "demo",
+			},
+			Status: cloudlinuxv1alpha1.KuberLogicServiceStatus{
+				Namespace: "secrets-test",
+				Secrets: &cloudlinuxv1alpha1.ServiceSecrets{
+					Name: "secrets-storage",
+					Keys: []cloudlinuxv1alpha1.ServiceSecretKey{
+						{ID: "token", Rotatable: true},
+						{ID: "password", Visible: true},
+						{ID: "key", Visible: true, Rotatable: true},
+					},
+				},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secrets-storage",
+				Namespace: "secrets-test",
+			},
+			Data: data,
+		},
+	}
+}
+
 func TestServiceSecretsList(t *testing.T) {
 	cases := []testCase{
 		{
@@ -26,30 +58,7 @@ func TestServiceSecretsList(t *testing.T) {
 				ServiceID:   "service",
 			},
 		}, {
-			name: "secret-not-found",
-			objects: []runtime.Object{
-				&cloudlinuxv1alpha1.KuberLogicService{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "service",
-					},
-					Spec: cloudlinuxv1alpha1.KuberLogicServiceSpec{
-						Type: "demo",
-					},
-					Status: cloudlinuxv1alpha1.KuberLogicServiceStatus{
-						Namespace: "secrets-test",
-					},
-				},
-			},
-			status: 503,
-			result: &models.Error{
-				Message: "failed to retrieve service secrets",
-			},
-			params: apiService.ServiceSecretsListParams{
-				HTTPRequest: &http.Request{},
-				ServiceID:   "service",
-			},
-		}, {
-			name:   "empty",
+			name:   "not-declared",
 			status: 200,
 			objects: []runtime.Object{
 				&cloudlinuxv1alpha1.KuberLogicService{
@@ -63,13 +72,6 @@ func TestServiceSecretsList(t *testing.T) {
 						Namespace: "secrets-test",
 					},
 				},
-				&corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "secrets-test",
-						Namespace: "secrets-test",
-					},
-					Data: map[string][]byte{},
-				},
 			},
 			result: models.ServiceSecrets{},
 			params: apiService.ServiceSecretsListParams{
@@ -77,34 +79,13 @@ func TestServiceSecretsList(t *testing.T) {
 				ServiceID:   "secrets-test",
 			},
 		}, {
-			name:   "many",
-			status: 200,
-			objects: []runtime.Object{
-				&cloudlinuxv1alpha1.KuberLogicService{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "secrets-test",
-					},
-					Spec: cloudlinuxv1alpha1.KuberLogicServiceSpec{
-						Type: "demo",
-					},
-					Status: cloudlinuxv1alpha1.KuberLogicServiceStatus{
-						Namespace: "secrets-test",
-					},
-				},
-				&corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "secrets-test",
-						Namespace: "secrets-test",
-					},
-					Data: map[string][]byte{
-						"a": []byte("b"),
-						"c": []byte("d"),
-					},
-				},
-			},
+			name:    "many",
+			status:  200,
+			objects: testSecretsService(map[string][]byte{"token": []byte("a"), "password": []byte("b")}),
 			result: models.ServiceSecrets{
-				{ID: "a", Value: "b"},
-				{ID: "c", Value: "d"},
+				{ID: "key", Visible: true, Rotatable: true},
+				{ID: "password", Visible: true},
+				{ID: "token", Rotatable: true},
 			},
 			params: apiService.ServiceSecretsListParams{
 				HTTPRequest: &http.Request{},
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// ServiceTypeListHandler lists service types with versions published by the operator, types are ordered by name
+func (h *handlers) ServiceTypeListHandler(params apiService.ServiceTypeListParams, _ *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	serviceTypes := make(models.ServiceTypes, 0)
+	cm, err := h.clientset.CoreV1().ConfigMaps(h.config.Namespace).Get(ctx, v1alpha1.ServiceVersionsConfigMap, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return apiService.NewServiceTypeListOK().WithPayload(serviceTypes)
+	} else if err != nil {
+		h.log.Errorw("error getting service versions", "error", err)
+		return apiService.NewServiceTypeListServiceUnavailable().WithPayload(&models.Error{
+			Message: "error listing service types",
+		})
+	}
+
+	for serviceType, value := range cm.Data {
+		versions := &v1alpha1.ServiceTypeVersions{}
+		if err := json.Unmarshal([]byte(value), versions); err != nil {
+			h.log.Errorw("error decoding service versions", "error", err, "type", serviceType)
+			return apiService.NewServiceTypeListServiceUnavailable().WithPayload(&models.Error{
+				Message: "error listing service types",
+			})
+		}
+		serviceTypes = append(serviceTypes, &models.ServiceType{
+			Type:     serviceType,
+			Default:  versions.Default,
+			Versions: versions.Versions,
+		})
+	}
+	sort.Slice(serviceTypes, func(i, j int) bool {
+		return serviceTypes[i].Type < serviceTypes[j].Type
+	})
+	return apiService.NewServiceTypeListOK().WithPayload(serviceTypes)
+}
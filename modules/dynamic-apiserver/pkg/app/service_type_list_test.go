@@ -0,0 +1,66 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// testServiceVersions returns the config map of versions published by the operator
+func testServiceVersions(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ServiceVersionsConfigMap, Namespace: "kuberlogic"},
+		Data:       data,
+	}
+}
+
+func TestServiceTypeList(t *testing.T) {
+	cases := []testCase{
+		{
+			name:   "not-published",
+			status: 200,
+			result: models.ServiceTypes{},
+			params: apiService.ServiceTypeListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+		{
+			name:   "ok",
+			status: 200,
+			objects: []runtime.Object{testServiceVersions(map[string]string{
+				"redis":    `{}`,
+				"postgres": `{"default":"13","versions":["12","13","14"]}`,
+			})},
+			result: models.ServiceTypes{
+				{Type: "postgres", Default: "13", Versions: []string{"12", "13", "14"}},
+				{Type: "redis"},
+			},
+			params: apiService.ServiceTypeListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+		{
+			name:    "invalid-versions",
+			status:  503,
+			objects: []runtime.Object{testServiceVersions(map[string]string{"postgres": `13`})},
+			result: &models.Error{
+				Message: "error listing service types",
+			},
+			params: apiService.ServiceTypeListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).ServiceTypeListHandler(tc.params.(apiService.ServiceTypeListParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
@@ -20,10 +21,10 @@ import (
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
 )
 
-func (h *handlers) ServiceUnarchiveHandler(params apiService.ServiceUnarchiveParams, _ *models.Principal) middleware.Responder {
+func (h *handlers) ServiceUnarchiveHandler(params apiService.ServiceUnarchiveParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 	// Check if service exists first
-	service, err := h.Services().Get(ctx, params.ServiceID, v1.GetOptions{})
+	service, err := h.getService(ctx, principal, params.ServiceID)
 	if k8serrors.IsNotFound(err) {
 		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
 		h.log.Errorw(msg, "error", err)
@@ -45,6 +46,10 @@ func (h *handlers) ServiceUnarchiveHandler(params apiService.ServiceUnarchivePar
 		})
 	}
 
+	after := service.DeepCopy()
+	after.Spec.Archived = false
+	audit.SetChanges(ctx, audit.Changes(service.Spec, after.Spec))
+
 	// Unarchive the service in background
 	go func() {
 		if err := h.UnarchiveKuberlogicService(service.GetName()); err != nil {
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// serviceWatchTimeout closes event streams before the server write timeout (60s by default) does.
+// Clients are expected to reconnect passing the last received event id.
+var serviceWatchTimeout int64 = 50
+
+func (h *handlers) ServiceListWatchHandler(params apiService.ServiceListWatchParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	opts := tenantListOptions(principal, h.ListOptionsByKeyValue(util.SubscriptionField, params.SubscriptionID))
+	opts.ResourceVersion = watchResourceVersion(params.ResourceVersion, params.LastEventID)
+	opts.AllowWatchBookmarks = true
+	opts.TimeoutSeconds = &serviceWatchTimeout
+
+	w, err := h.Services().Watch(ctx, opts)
+	if err != nil {
+		msg := "error watching services"
+		h.log.Errorw(msg, "error", err)
+		return apiService.NewServiceListWatchServiceUnavailable().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+	return &serviceEventStream{ctx: ctx, watcher: w, log: h.log}
+}
+
+func (h *handlers) ServiceWatchHandler(params apiService.ServiceWatchParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	_, err := h.getService(ctx, principal, params.ServiceID)
+	if k8serrors.IsNotFound(err) {
+		msg := fmt.Sprintf("kuberlogic service not found: %s", params.ServiceID)
+		h.log.Warnw(msg, "error", err)
+		return apiService.NewServiceWatchNotFound().WithPayload(&models.Error{
+			Message: msg,
+		})
+	} else if err != nil {
+		msg := "error finding service"
+		h.log.Errorw(msg, "error", err)
+		return apiService.NewServiceWatchServiceUnavailable().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+
+	w, err := h.Services().Watch(ctx, metav1.ListOptions{
+		FieldSelector:       fields.OneTermEqualSelector("metadata.name", params.ServiceID).String(),
+		ResourceVersion:     watchResourceVersion(params.ResourceVersion, params.LastEventID),
+		AllowWatchBookmarks: true,
+		TimeoutSeconds:      &serviceWatchTimeout,
+	})
+	if err != nil {
+		msg := "error watching service"
+		h.log.Errorw(msg, "error", err)
+		return apiService.NewServiceWatchServiceUnavailable().WithPayload(&models.Error{
+			Message: msg,
+		})
+	}
+	return &serviceEventStream{ctx: ctx, watcher: w, log: h.log}
+}
+
+// watchResourceVersion picks the version to resume from.
+// An explicit query parameter wins over the id that event stream clients send on reconnect.
+func watchResourceVersion(resourceVersion, lastEventID *string) string {
+	if resourceVersion != nil {
+		return *resourceVersion
+	}
+	if lastEventID != nil {
+		return *lastEventID
+	}
+	return ""
+}
+
+// serviceEventStream writes kuberlogicservice watch events as server-sent events.
+// Each event is named after the watch event type and carries the service resourceVersion as its id.
+type serviceEventStream struct {
+	ctx     context.Context
+	watcher watch.Interface
+	log     logging.Logger
+}
+
+var _ middleware.Responder = &serviceEventStream{}
+
+func (s *serviceEventStream) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer s.watcher.Stop()
+
+	rw.Header().Set(runtime.HeaderContentType, "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	flush(rw)
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case event, ok := <-s.watcher.ResultChan():
+			if !ok {
+				return
+			}
+			if err := s.writeEvent(rw, event); err != nil {
+				s.log.Errorw("error streaming service event", "error", err)
+				return
+			}
+			flush(rw)
+		}
+	}
+}
+
+func (s *serviceEventStream) writeEvent(rw http.ResponseWriter, event watch.Event) error {
+	if event.Type == watch.Error {
+		status := k8serrors.FromObject(event.Object)
+		if err := writeServerSentEvent(rw, "", "error", &models.Error{Message: status.Error()}); err != nil {
+			return err
+		}
+		return errors.Wrap(status, "watch failed")
+	}
+
+	kls, ok := event.Object.(*v1alpha1.KuberLogicService)
+	if !ok {
+		return errors.Errorf("unexpected watch object: %T", event.Object)
+	}
+	if event.Type == watch.Bookmark {
+		// an id without data only moves the client resume point
+		_, err := fmt.Fprintf(rw, "id: %s\n\n", kls.ResourceVersion)
+		return err
+	}
+
+	service, err := util.KuberlogicToService(kls)
+	if err != nil {
+		return errors.Wrap(err, "error converting kuberlogicservice")
+	}
+	return writeServerSentEvent(rw, kls.ResourceVersion, strings.ToLower(string(event.Type)), service)
+}
+
+func writeServerSentEvent(rw http.ResponseWriter, id, event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(rw, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+func flush(rw http.ResponseWriter) {
+	if f, ok := rw.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,182 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+func watchTestService(phase, resourceVersion string) *v1alpha1.KuberLogicService {
+	return &v1alpha1.KuberLogicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "watch",
+			ResourceVersion: resourceVersion,
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type:     "demo",
+			Replicas: 1,
+		},
+		Status: v1alpha1.KuberLogicServiceStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func watchTestEvent(t *testing.T, id, event string, kls *v1alpha1.KuberLogicService) string {
+	service, err := util.KuberlogicToService(kls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", id, event, data)
+}
+
+// withFakeWatch makes every watch of the handlers return w
+func withFakeWatch(h *FakeHandlers, w watch.Interface, err error) {
+	h.PrependWatchReactor("*", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		return true, w, err
+	})
+}
+
+func TestServiceListWatch(t *testing.T) {
+	fakeWatch := watch.NewRaceFreeFake()
+	h := newFakeHandlers(t)
+	withFakeWatch(h, fakeWatch, nil)
+
+	subscription := "sub"
+	resourceVersion := "10"
+	responder := h.ServiceListWatchHandler(apiService.ServiceListWatchParams{
+		HTTPRequest:     &http.Request{},
+		SubscriptionID:  &subscription,
+		ResourceVersion: &resourceVersion,
+	}, nil)
+
+	fakeWatch.Add(watchTestService("Provisioning", "11"))
+	fakeWatch.Modify(watchTestService("Ready", "12"))
+	fakeWatch.Action(watch.Bookmark, &v1alpha1.KuberLogicService{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "13"}})
+	fakeWatch.Delete(watchTestService("Ready", "14"))
+	fakeWatch.Stop()
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, nil)
+
+	if rec.Code != 200 {
+		t.Errorf("unexpected status code: %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("unexpected content type: %s", ct)
+	}
+	expected := watchTestEvent(t, "11", "added", watchTestService("Provisioning", "11")) +
+		watchTestEvent(t, "12", "modified", watchTestService("Ready", "12")) +
+		"id: 13\n\n" +
+		watchTestEvent(t, "14", "deleted", watchTestService("Ready", "14"))
+	if rec.Body.String() != expected {
+		t.Errorf("stream does not equal: actual vs expected\n%s\n%s", rec.Body.String(), expected)
+	}
+
+	var opts metav1.ListOptions
+	for _, action := range h.Actions() {
+		if a, ok := action.(clienttesting.WatchAction); ok {
+			opts.ResourceVersion = a.GetWatchRestrictions().ResourceVersion
+			opts.LabelSelector = a.GetWatchRestrictions().Labels.String()
+		}
+	}
+	if opts.ResourceVersion != resourceVersion {
+		t.Errorf("watch is not resumed from %s: %s", resourceVersion, opts.ResourceVersion)
+	}
+	if opts.LabelSelector != util.SubscriptionField+"="+subscription {
+		t.Errorf("unexpected label selector: %s", opts.LabelSelector)
+	}
+}
+
+func TestServiceListWatchFailed(t *testing.T) {
+	h := newFakeHandlers(t)
+	withFakeWatch(h, nil, errors.New("watch failed"))
+
+	checkResponse(h.ServiceListWatchHandler(apiService.ServiceListWatchParams{
+		HTTPRequest: &http.Request{},
+	}, nil), t, 503, &models.Error{
+		Message: "error watching services",
+	})
+}
+
+func TestServiceWatch(t *testing.T) {
+	cases := []testCase{
+		{
+			name:   "not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "kuberlogic service not found: watch",
+			},
+			params: apiService.ServiceWatchParams{
+				HTTPRequest: &http.Request{},
+				ServiceID:   "watch",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).ServiceWatchHandler(tc.params.(apiService.ServiceWatchParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
+
+func TestServiceWatchResume(t *testing.T) {
+	fakeWatch := watch.NewRaceFreeFake()
+	h := newFakeHandlers(t, watchTestService("Ready", "1"))
+	withFakeWatch(h, fakeWatch, nil)
+
+	lastEventID := "20"
+	responder := h.ServiceWatchHandler(apiService.ServiceWatchParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "watch",
+		LastEventID: &lastEventID,
+	}, nil)
+
+	fakeWatch.Modify(watchTestService("Failed", "21"))
+	fakeWatch.Error(&metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: "too old resource version: 20 (21)",
+		Reason:  metav1.StatusReasonExpired,
+		Code:    410,
+	})
+	// must not be streamed after the error
+	fakeWatch.Modify(watchTestService("Ready", "22"))
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, nil)
+
+	expected := watchTestEvent(t, "21", "modified", watchTestService("Failed", "21")) +
+		"event: error\ndata: {\"message\":\"too old resource version: 20 (21)\"}\n\n"
+	if rec.Body.String() != expected {
+		t.Errorf("stream does not equal: actual vs expected\n%s\n%s", rec.Body.String(), expected)
+	}
+
+	for _, action := range h.Actions() {
+		if a, ok := action.(clienttesting.WatchAction); ok {
+			restrictions := a.GetWatchRestrictions()
+			if restrictions.ResourceVersion != lastEventID {
+				t.Errorf("watch is not resumed from %s: %s", lastEventID, restrictions.ResourceVersion)
+			}
+			if s := restrictions.Fields.String(); s != "metadata.name=watch" {
+				t.Errorf("unexpected field selector: %s", s)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+// Services are owned by the tenant from their tenant label.
+// Backups and restores belong to the tenant of their service, so scheduled backups are covered as well.
+// Objects of other tenants are reported as not found to not disclose their existence.
+
+// principalTenant returns the tenant the principal is limited to, an empty tenant grants access to all tenants
+func principalTenant(p *models.Principal) string {
+	if p == nil {
+		return ""
+	}
+	return p.Tenant
+}
+
+// tenantListOptions restricts opts to services of the principal tenant
+func tenantListOptions(p *models.Principal, opts metav1.ListOptions) metav1.ListOptions {
+	tenant := principalTenant(p)
+	if tenant == "" {
+		return opts
+	}
+	selector := labels.Set{util.TenantField: tenant}.String()
+	if opts.LabelSelector != "" {
+		selector = opts.LabelSelector + "," + selector
+	}
+	opts.LabelSelector = selector
+	return opts
+}
+
+// ownsService checks if the principal is allowed to access the service
+func ownsService(p *models.Principal, kls *v1alpha1.KuberLogicService) bool {
+	tenant := principalTenant(p)
+	return tenant == "" || kls.GetLabels()[util.TenantField] == tenant
+}
+
+func notFound(resource, name string) error {
+	return k8serrors.NewNotFound(v1alpha1.GroupVersion.WithResource(resource).GroupResource(), name)
+}
+
+// getService returns the service if the principal is allowed to access it
+func (h *handlers) getService(ctx context.Context, p *models.Principal, name string) (*v1alpha1.KuberLogicService, error) {
+	kls, err := h.Services().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !ownsService(p, kls) {
+		return nil, notFound("kuberlogicservices", name)
+	}
+	return kls, nil
+}
+
+// getBackup returns the backup if the principal is allowed to access its service
+func (h *handlers) getBackup(ctx context.Context, p *models.Principal, name string) (*v1alpha1.KuberlogicServiceBackup, error) {
+	klb, err := h.Backups().Get(ctx, name, metav1.GetOptions{})
+	if err != nil || principalTenant(p) == "" {
+		return klb, err
+	}
+	if _, err := h.getService(ctx, p, klb.Spec.KuberlogicServiceName); k8serrors.IsNotFound(err) {
+		return nil, notFound("kuberlogicservicebackups", name)
+	} else if err != nil {
+		return nil, err
+	}
+	return klb, nil
+}
+
+// getRestore returns the restore if the principal is allowed to access its backup
+func (h *handlers) getRestore(ctx context.Context, p *models.Principal, name string) (*v1alpha1.KuberlogicServiceRestore, error) {
+	klr, err := h.Restores().Get(ctx, name, metav1.GetOptions{})
+	if err != nil || principalTenant(p) == "" {
+		return klr, err
+	}
+	if _, err := h.getBackup(ctx, p, klr.Spec.KuberlogicServiceBackup); k8serrors.IsNotFound(err) {
+		return nil, notFound("kuberlogicservicerestores", name)
+	} else if err != nil {
+		return nil, err
+	}
+	return klr, nil
+}
+
+// tenantServices returns names of services the principal is allowed to access, nil is returned for all services
+func (h *handlers) tenantServices(ctx context.Context, p *models.Principal) (map[string]bool, error) {
+	if principalTenant(p) == "" {
+		return nil, nil
+	}
+	list, err := h.Services().List(ctx, tenantListOptions(p, metav1.ListOptions{}))
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list.Items))
+	for _, item := range list.Items {
+		result[item.GetName()] = true
+	}
+	return result, nil
+}
+
+// tenantBackups returns names of backups the principal is allowed to access, nil is returned for all backups
+func (h *handlers) tenantBackups(ctx context.Context, p *models.Principal) (map[string]bool, error) {
+	services, err := h.tenantServices(ctx, p)
+	if err != nil || services == nil {
+		return nil, err
+	}
+	list, err := h.Backups().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool)
+	for _, item := range list.Items {
+		if services[item.Spec.KuberlogicServiceName] {
+			result[item.GetName()] = true
+		}
+	}
+	return result, nil
+}
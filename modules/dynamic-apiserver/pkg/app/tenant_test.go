@@ -0,0 +1,274 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiBackup "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/backup"
+	apiRestore "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+)
+
+var tenantPrincipal = &models.Principal{Name: "customer", Scopes: []string{scopeAdmin}, Tenant: "acme"}
+
+// tenantService returns a service owned by the tenant, the service has no tenant if it is empty
+func tenantService(name, tenant string, limits corev1.ResourceList) *v1alpha1.KuberLogicService {
+	kls := &v1alpha1.KuberLogicService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.KuberLogicServiceSpec{
+			Type:     "postgresql",
+			Replicas: 1,
+			Limits:   limits,
+		},
+	}
+	if tenant != "" {
+		kls.Labels = map[string]string{util.TenantField: tenant}
+	}
+	return kls
+}
+
+func tenantBackup(name, service string) *v1alpha1.KuberlogicServiceBackup {
+	return &v1alpha1.KuberlogicServiceBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{util.BackupRestoreServiceField: service},
+		},
+		Spec: v1alpha1.KuberlogicServiceBackupSpec{
+			KuberlogicServiceName: service,
+		},
+	}
+}
+
+func tenantRestore(name, backup string) *v1alpha1.KuberlogicServiceRestore {
+	return &v1alpha1.KuberlogicServiceRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.KuberlogicServiceRestoreSpec{
+			KuberlogicServiceBackup: backup,
+		},
+	}
+}
+
+// tenantObjects returns services, backups and restores of the acme tenant, another tenant and without a tenant
+func tenantObjects() []runtime.Object {
+	return []runtime.Object{
+		tenantService("own", "acme", nil),
+		tenantService("foreign", "other", nil),
+		tenantService("shared", "", nil),
+		tenantBackup("own-backup", "own"),
+		tenantBackup("foreign-backup", "foreign"),
+		tenantBackup("orphan-backup", "deleted"),
+		tenantRestore("own-restore", "own-backup"),
+		tenantRestore("foreign-restore", "foreign-backup"),
+	}
+}
+
+func TestTenantServiceList(t *testing.T) {
+	cases := []struct {
+		name      string
+		principal *models.Principal
+		expected  []string
+	}{
+		{
+			name:      "tenant",
+			principal: tenantPrincipal,
+			expected:  []string{"own"},
+		},
+		{
+			name:      "all-tenants",
+			principal: &models.Principal{Name: bootstrapPrincipal, Scopes: []string{scopeAdmin}},
+			expected:  []string{"foreign", "own", "shared"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newFakeHandlers(t, tenantObjects()...)
+			checkResponse(h.ServiceListHandler(apiService.ServiceListParams{HTTPRequest: &http.Request{}}, tc.principal), t, 200, func(payload interface{}) {
+				var names []string
+				for _, svc := range payload.(models.Services) {
+					names = append(names, *svc.ID)
+				}
+				if !equalStrings(names, tc.expected) {
+					t.Errorf("services do not equal: actual vs expected\n%v\n%v", names, tc.expected)
+				}
+			})
+		})
+	}
+}
+
+func TestTenantBackupRestoreList(t *testing.T) {
+	h := newFakeHandlers(t, tenantObjects()...)
+	checkResponse(h.BackupListHandler(apiBackup.BackupListParams{HTTPRequest: &http.Request{}}, tenantPrincipal), t, 200, func(payload interface{}) {
+		backups := payload.(models.Backups)
+		if len(backups) != 1 || backups[0].ID != "own-backup" {
+			t.Errorf("unexpected tenant backups: %v", prettyPrint(backups))
+		}
+	})
+	checkResponse(h.RestoreListHandler(apiRestore.RestoreListParams{HTTPRequest: &http.Request{}}, tenantPrincipal), t, 200, func(payload interface{}) {
+		restores := payload.(models.Restores)
+		if len(restores) != 1 || restores[0].ID != "own-restore" {
+			t.Errorf("unexpected tenant restores: %v", prettyPrint(restores))
+		}
+	})
+}
+
+func TestTenantNotFound(t *testing.T) {
+	h := newFakeHandlers(t, tenantObjects()...)
+	checkResponse(h.ServiceGetHandler(apiService.ServiceGetParams{HTTPRequest: &http.Request{}, ServiceID: "foreign"}, tenantPrincipal), t, 404, &models.Error{
+		Message: "kuberlogic service not found: foreign",
+	})
+	checkResponse(h.ServiceGetHandler(apiService.ServiceGetParams{HTTPRequest: &http.Request{}, ServiceID: "shared"}, tenantPrincipal), t, 404, &models.Error{
+		Message: "kuberlogic service not found: shared",
+	})
+	checkResponse(h.ServiceDeleteHandler(apiService.ServiceDeleteParams{HTTPRequest: &http.Request{}, ServiceID: "foreign"}, tenantPrincipal), t, 404, &models.Error{
+		Message: "kuberlogic service not found: foreign",
+	})
+	checkResponse(h.BackupAddHandler(apiBackup.BackupAddParams{HTTPRequest: &http.Request{}, BackupItem: &models.Backup{ServiceID: "foreign"}}, tenantPrincipal), t, 400, &models.Error{
+		Message: "service `foreign` not found",
+	})
+	checkResponse(h.BackupDeleteHandler(apiBackup.BackupDeleteParams{HTTPRequest: &http.Request{}, BackupID: "orphan-backup"}, tenantPrincipal), t, 404, &models.Error{
+		Message: "backup not found: orphan-backup",
+	})
+	checkResponse(h.RestoreAddHandler(apiRestore.RestoreAddParams{HTTPRequest: &http.Request{}, RestoreItem: &models.Restore{BackupID: "foreign-backup"}}, tenantPrincipal), t, 400, &models.Error{
+		Message: "backup `foreign-backup` not found",
+	})
+	checkResponse(h.RestoreDeleteHandler(apiRestore.RestoreDeleteParams{HTTPRequest: &http.Request{}, RestoreID: "foreign-restore"}, tenantPrincipal), t, 404, nil)
+
+	// objects of the tenant are still available
+	checkResponse(h.BackupDeleteHandler(apiBackup.BackupDeleteParams{HTTPRequest: &http.Request{}, BackupID: "own-backup"}, tenantPrincipal), t, 200, nil)
+	checkResponse(h.ServiceDeleteHandler(apiService.ServiceDeleteParams{HTTPRequest: &http.Request{}, ServiceID: "own"}, tenantPrincipal), t, 200, nil)
+}
+
+func TestTenantServiceAdd(t *testing.T) {
+	cases := []testCase{
+		{
+			name:   "tenant-is-set",
+			status: 201,
+			result: &models.Service{
+				ID:       util.StrAsPointer("new"),
+				Type:     util.StrAsPointer("postgresql"),
+				Replicas: util.Int64AsPointer(1),
+				Domain:   "new.kuberlogic.local",
+				Tenant:   "acme",
+			},
+			params: apiService.ServiceAddParams{
+				HTTPRequest: &http.Request{},
+				ServiceItem: &models.Service{
+					ID:       util.StrAsPointer("new"),
+					Type:     util.StrAsPointer("postgresql"),
+					Replicas: util.Int64AsPointer(1),
+				},
+			},
+		},
+		{
+			name:   "foreign-tenant",
+			status: 403,
+			result: &models.Error{
+				Message: "service can't be created for tenant 'other'",
+			},
+			params: apiService.ServiceAddParams{
+				HTTPRequest: &http.Request{},
+				ServiceItem: &models.Service{
+					ID:     util.StrAsPointer("new"),
+					Type:   util.StrAsPointer("postgresql"),
+					Tenant: "other",
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).ServiceAddHandler(tc.params.(apiService.ServiceAddParams), tenantPrincipal), t, tc.status, tc.result)
+		})
+	}
+}
+
+func TestTenantServiceEdit(t *testing.T) {
+	h := newFakeHandlers(t, tenantObjects()...)
+	checkResponse(h.ServiceEditHandler(apiService.ServiceEditParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "foreign",
+		ServiceItem: &models.Service{ID: util.StrAsPointer("foreign"), Type: util.StrAsPointer("postgresql")},
+	}, tenantPrincipal), t, 404, &models.Error{
+		Message: "kuberlogic service not found: foreign",
+	})
+	checkResponse(h.ServiceEditHandler(apiService.ServiceEditParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "own",
+		ServiceItem: &models.Service{ID: util.StrAsPointer("foreign"), Type: util.StrAsPointer("postgresql")},
+	}, tenantPrincipal), t, 400, &models.Error{
+		Message: "service id cannot be changed",
+	})
+	checkResponse(h.ServiceEditHandler(apiService.ServiceEditParams{
+		HTTPRequest: &http.Request{},
+		ServiceID:   "own",
+		ServiceItem: &models.Service{ID: util.StrAsPointer("own"), Type: util.StrAsPointer("postgresql"), Tenant: "other"},
+	}, tenantPrincipal), t, 400, &models.Error{
+		Message: "tenant cannot be changed",
+	})
+}
+
+func TestTenantTokens(t *testing.T) {
+	own := testTokenSecret("own", "own.value", nil, "read-only")
+	own.Data[tokenTenantKey] = []byte("acme")
+	h := newFakeHandlers(t, own, testTokenSecret("global", "global.value", nil, "admin"))
+
+	checkResponse(h.TokenListHandler(apiToken.TokenListParams{HTTPRequest: &http.Request{}}, tenantPrincipal), t, 200, func(payload interface{}) {
+		tokens := payload.(models.Tokens)
+		if len(tokens) != 1 || *tokens[0].Name != "own" || tokens[0].Tenant != "acme" {
+			t.Errorf("unexpected tenant tokens: %v", prettyPrint(tokens))
+		}
+	})
+	checkResponse(h.TokenDeleteHandler(apiToken.TokenDeleteParams{HTTPRequest: &http.Request{}, TokenName: "global"}, tenantPrincipal), t, 404, &models.Error{
+		Message: "token not found: global",
+	})
+	checkResponse(h.TokenAddHandler(apiToken.TokenAddParams{
+		HTTPRequest: &http.Request{},
+		TokenItem:   &models.Token{Name: util.StrAsPointer("escalate"), Scopes: []string{scopeAdmin}, Tenant: "other"},
+	}, tenantPrincipal), t, 400, &models.Error{
+		Message: "token can't be created for tenant 'other'",
+	})
+	checkResponse(h.TokenAddHandler(apiToken.TokenAddParams{
+		HTTPRequest: &http.Request{},
+		TokenItem:   &models.Token{Name: util.StrAsPointer("ci"), Scopes: []string{"services:write"}},
+	}, tenantPrincipal), t, 201, func(payload interface{}) {
+		if tenant := payload.(*models.Token).Tenant; tenant != "acme" {
+			t.Errorf("unexpected token tenant: %s", tenant)
+		}
+	})
+
+	principal, err := h.KeyAuthentication("own.value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if principal.Tenant != "acme" {
+		t.Errorf("unexpected principal tenant: %s", principal.Tenant)
+	}
+}
+
+func equalStrings(actual, expected []string) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	seen := make(map[string]bool)
+	for _, s := range actual {
+		seen[s] = true
+	}
+	for _, s := range expected {
+		if !seen[s] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// API tokens are kept hashed in secrets of the apiserver namespace, one secret per token
+const (
+	tokenLabel        = "kuberlogic.com/api-token"
+	tokenSecretPrefix = "kuberlogic-token-"
+
+	tokenHashKey      = "hash"
+	tokenScopesKey    = "scopes"
+	tokenExpiresAtKey = "expiresAt"
+	tokenTenantKey    = "tenant"
+
+	// tokenSeparator separates the token name from its random part
+	tokenSeparator = "."
+	tokenLength    = 32
+)
+
+func tokenSecretName(name string) string {
+	return tokenSecretPrefix + name
+}
+
+// newTokenValue returns a random token value in the <name>.<random> form.
+// The name allows to find the token secret without iterating over all tokens.
+func newTokenValue(name string) (string, error) {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return name + tokenSeparator + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// parseTokenValue returns the token name, false is returned if the value is not a named token
+func parseTokenValue(value string) (string, bool) {
+	parts := strings.SplitN(value, tokenSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func hashTokenValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenToSecret returns a secret storing the token with the hashed value
+func tokenToSecret(token *models.Token, value, namespace string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenSecretName(*token.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				tokenLabel: *token.Name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			tokenHashKey:   hashTokenValue(value),
+			tokenScopesKey: strings.Join(token.Scopes, ","),
+		},
+	}
+	if token.ExpiresAt != nil {
+		secret.StringData[tokenExpiresAtKey] = time.Time(*token.ExpiresAt).UTC().Format(time.RFC3339)
+	}
+	if token.Tenant != "" {
+		secret.StringData[tokenTenantKey] = token.Tenant
+	}
+	return secret
+}
+
+// secretToToken returns the token stored in the secret, the token value is never returned
+func secretToToken(secret *corev1.Secret) (*models.Token, error) {
+	name := secret.Labels[tokenLabel]
+	token := &models.Token{
+		Name:      &name,
+		Scopes:    []string{},
+		CreatedAt: strfmt.DateTime(secret.CreationTimestamp.Time),
+		Tenant:    tokenSecretValue(secret, tokenTenantKey),
+	}
+	if scopes := tokenSecretValue(secret, tokenScopesKey); scopes != "" {
+		token.Scopes = strings.Split(scopes, ",")
+	}
+	if expiresAt := tokenSecretValue(secret, tokenExpiresAtKey); expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		dt := strfmt.DateTime(t)
+		token.ExpiresAt = &dt
+	}
+	return token, nil
+}
+
+// tokenSecretValue returns the secret value by key, StringData is checked for secrets that were not stored yet
+func tokenSecretValue(secret *corev1.Secret, key string) string {
+	if value, ok := secret.Data[key]; ok {
+		return string(value)
+	}
+	return secret.StringData[key]
+}
+
+// ownsToken checks that the secret keeps the named token available to the principal
+func ownsToken(p *models.Principal, secret *corev1.Secret, name string) bool {
+	if secret.Labels[tokenLabel] != name {
+		return false
+	}
+	tenant := principalTenant(p)
+	return tenant == "" || tokenSecretValue(secret, tokenTenantKey) == tenant
+}
+
+// tokenMatches checks the token value against the hash stored in the secret
+func tokenMatches(secret *corev1.Secret, value string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashTokenValue(value)), []byte(tokenSecretValue(secret, tokenHashKey))) == 1
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+)
+
+func (h *handlers) TokenAddHandler(params apiToken.TokenAddParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	item := params.TokenItem
+	audit.SetTarget(ctx, "tokens/"+*item.Name)
+
+	// the token name is found by the part of the token value before the separator
+	if strings.Contains(*item.Name, tokenSeparator) {
+		return apiToken.NewTokenAddBadRequest().WithPayload(&models.Error{
+			Message: fmt.Sprintf("token name can't contain '%s'", tokenSeparator),
+		})
+	}
+	if err := validateScopes(item.Scopes); err != nil {
+		return apiToken.NewTokenAddBadRequest().WithPayload(&models.Error{
+			Message: err.Error(),
+		})
+	}
+	// tokens can't get scopes their creator doesn't have
+	var granted []string
+	if principal != nil {
+		granted = principal.Scopes
+	}
+	for _, scope := range item.Scopes {
+		if !scopeGrantable(granted, scope) {
+			return apiToken.NewTokenAddBadRequest().WithPayload(&models.Error{
+				Message: fmt.Sprintf("scope '%s' can't be granted", scope),
+			})
+		}
+	}
+	// tenant principals can create tokens only for their tenant
+	if tenant := principalTenant(principal); tenant != "" {
+		if item.Tenant == "" {
+			item.Tenant = tenant
+		} else if item.Tenant != tenant {
+			return apiToken.NewTokenAddBadRequest().WithPayload(&models.Error{
+				Message: fmt.Sprintf("token can't be created for tenant '%s'", item.Tenant),
+			})
+		}
+	}
+	if errs := validation.IsValidLabelValue(item.Tenant); len(errs) > 0 {
+		return apiToken.NewTokenAddBadRequest().WithPayload(&models.Error{
+			Message: fmt.Sprintf("invalid tenant '%s': %s", item.Tenant, strings.Join(errs, ", ")),
+		})
+	}
+	if item.ExpiresAt != nil && !time.Time(*item.ExpiresAt).After(time.Now()) {
+		return apiToken.NewTokenAddBadRequest().WithPayload(&models.Error{
+			Message: "token expiration time must be in the future",
+		})
+	}
+
+	value, err := newTokenValue(*item.Name)
+	if err != nil {
+		h.log.Errorw("error generating api token", "error", err)
+		return apiToken.NewTokenAddServiceUnavailable().WithPayload(&models.Error{
+			Message: "error generating api token",
+		})
+	}
+
+	secret, err := h.clientset.CoreV1().Secrets(h.config.Namespace).Create(ctx, tokenToSecret(item, value, h.config.Namespace), metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return apiToken.NewTokenAddConflict().WithPayload(&models.Error{
+			Message: "token already exists: " + *item.Name,
+		})
+	} else if err != nil {
+		h.log.Errorw("error creating api token", "error", err, "name", *item.Name)
+		return apiToken.NewTokenAddServiceUnavailable().WithPayload(&models.Error{
+			Message: "error creating api token",
+		})
+	}
+
+	token, err := secretToToken(secret)
+	if err != nil {
+		h.log.Errorw("error decoding api token", "error", err, "name", *item.Name)
+		return apiToken.NewTokenAddServiceUnavailable().WithPayload(&models.Error{
+			Message: "error decoding api token",
+		})
+	}
+	audit.SetChanges(ctx, audit.Changes(nil, token))
+	token.Token = value
+	h.log.Infow("api token created", "name", *item.Name, "scopes", item.Scopes, "tenant", item.Tenant, "principal", principalName(principal))
+	return apiToken.NewTokenAddCreated().WithPayload(token)
+}
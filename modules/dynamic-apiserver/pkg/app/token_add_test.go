@@ -0,0 +1,194 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+// testAdminPrincipal is allowed to grant any scope
+var testAdminPrincipal = &models.Principal{Name: "admin", Scopes: []string{scopeAdmin}}
+
+func TestTokenAdd(t *testing.T) {
+	past := strfmt.DateTime(time.Now().Add(-time.Hour))
+	cases := []testCase{
+		{
+			name:   "no-scopes",
+			status: 400,
+			result: &models.Error{
+				Message: "at least one scope is required",
+			},
+			params: apiToken.TokenAddParams{
+				HTTPRequest: &http.Request{},
+				TokenItem: &models.Token{
+					Name: util.StrAsPointer("ci"),
+				},
+			},
+		},
+		{
+			name:   "name-with-separator",
+			status: 400,
+			result: &models.Error{
+				Message: "token name can't contain '.'",
+			},
+			params: apiToken.TokenAddParams{
+				HTTPRequest: &http.Request{},
+				TokenItem: &models.Token{
+					Name:   util.StrAsPointer("ci.bot"),
+					Scopes: []string{"read-only"},
+				},
+			},
+		},
+		{
+			name:   "unknown-scope",
+			status: 400,
+			result: &models.Error{
+				Message: "unknown scope: services:delete",
+			},
+			params: apiToken.TokenAddParams{
+				HTTPRequest: &http.Request{},
+				TokenItem: &models.Token{
+					Name:   util.StrAsPointer("ci"),
+					Scopes: []string{"read-only", "services:delete"},
+				},
+			},
+		},
+		{
+			name:   "expired",
+			status: 400,
+			result: &models.Error{
+				Message: "token expiration time must be in the future",
+			},
+			params: apiToken.TokenAddParams{
+				HTTPRequest: &http.Request{},
+				TokenItem: &models.Token{
+					Name:      util.StrAsPointer("ci"),
+					Scopes:    []string{"read-only"},
+					ExpiresAt: &past,
+				},
+			},
+		},
+		{
+			name:    "already-exists",
+			status:  409,
+			objects: []runtime.Object{testTokenSecret("ci", "ci.value", nil, "read-only")},
+			result: &models.Error{
+				Message: "token already exists: ci",
+			},
+			params: apiToken.TokenAddParams{
+				HTTPRequest: &http.Request{},
+				TokenItem: &models.Token{
+					Name:   util.StrAsPointer("ci"),
+					Scopes: []string{"services:write"},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).TokenAddHandler(tc.params.(apiToken.TokenAddParams), testAdminPrincipal), t, tc.status, tc.result)
+		})
+	}
+}
+
+func TestTokenNameValidation(t *testing.T) {
+	for name, valid := range map[string]bool{"ci-bot": true, "ci.bot": false, "ci bot": false, "-ci": false} {
+		err := (&models.Token{Name: util.StrAsPointer(name), Scopes: []string{"read-only"}}).Validate(strfmt.Default)
+		if valid != (err == nil) {
+			t.Errorf("token name %s: expected valid %v, got %v", name, valid, err)
+		}
+	}
+}
+
+func TestTokenAddGrantsOwnScopes(t *testing.T) {
+	cases := []struct {
+		name      string
+		principal *models.Principal
+		scopes    []string
+		status    int
+	}{
+		{"admin-by-non-admin", &models.Principal{Name: "ops", Scopes: []string{"*:*"}}, []string{"admin"}, 400},
+		{"admin-by-tenant-non-admin", &models.Principal{Name: "customer", Scopes: []string{"tokens:write"}, Tenant: "acme"}, []string{"admin"}, 400},
+		{"write-by-reader", &models.Principal{Name: "ops", Scopes: []string{"tokens:write", "services:read"}}, []string{"services:write"}, 400},
+		{"read-only-by-partial-reader", &models.Principal{Name: "ops", Scopes: []string{"tokens:write", "services:read"}}, []string{"read-only"}, 400},
+		{"wildcard-by-partial-writer", &models.Principal{Name: "ops", Scopes: []string{"tokens:write", "services:write"}}, []string{"*:read"}, 400},
+		{"own-scopes", &models.Principal{Name: "ops", Scopes: []string{"tokens:write", "services:write"}}, []string{"services:read"}, 201},
+		{"read-only-by-read-only", &models.Principal{Name: "ops", Scopes: []string{"tokens:write", "read-only"}}, []string{"read-only", "backups:read"}, 201},
+		{"admin-by-admin", testAdminPrincipal, []string{"admin"}, 201},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var result interface{} = &models.Error{
+				Message: fmt.Sprintf("scope '%s' can't be granted", tc.scopes[0]),
+			}
+			if tc.status == 201 {
+				result = func(payload interface{}) {
+					if scopes := payload.(*models.Token).Scopes; !reflect.DeepEqual(scopes, tc.scopes) {
+						t.Errorf("unexpected token scopes: %v", scopes)
+					}
+				}
+			}
+			checkResponse(newFakeHandlers(t).TokenAddHandler(apiToken.TokenAddParams{
+				HTTPRequest: &http.Request{},
+				TokenItem: &models.Token{
+					Name:   util.StrAsPointer("ci"),
+					Scopes: tc.scopes,
+				},
+			}, tc.principal), t, tc.status, result)
+		})
+	}
+}
+
+func TestTokenAddStoresHash(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	h := newFakeHandlersWithClientset(t, clientset)
+	expiresAt := strfmt.DateTime(time.Now().Add(time.Hour).Truncate(time.Second).UTC())
+
+	var value string
+	checkResponse(h.TokenAddHandler(apiToken.TokenAddParams{
+		HTTPRequest: &http.Request{},
+		TokenItem: &models.Token{
+			Name:      util.StrAsPointer("ci"),
+			Scopes:    []string{"services:write", "backups:*"},
+			ExpiresAt: &expiresAt,
+		},
+	}, testAdminPrincipal), t, 201, func(payload interface{}) {
+		token := payload.(*models.Token)
+		value = token.Token
+		if !strings.HasPrefix(value, "ci.") || len(value) < 40 {
+			t.Errorf("unexpected token value: %s", value)
+		}
+		if !reflect.DeepEqual(token.Scopes, []string{"services:write", "backups:*"}) {
+			t.Errorf("unexpected token scopes: %v", token.Scopes)
+		}
+		if token.ExpiresAt == nil || !time.Time(*token.ExpiresAt).Equal(time.Time(expiresAt)) {
+			t.Errorf("unexpected token expiration time: %v", token.ExpiresAt)
+		}
+	})
+
+	secret, err := clientset.CoreV1().Secrets("kuberlogic").Get(context.TODO(), "kuberlogic-token-ci", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, stored := range secret.StringData {
+		if strings.Contains(stored, value) {
+			t.Errorf("token value is stored in plain text in %s", key)
+		}
+	}
+	if !tokenMatches(secret, value) {
+		t.Errorf("stored hash does not match the token value")
+	}
+}
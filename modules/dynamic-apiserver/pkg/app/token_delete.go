@@ -0,0 +1,45 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+)
+
+func (h *handlers) TokenDeleteHandler(params apiToken.TokenDeleteParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	secrets := h.clientset.CoreV1().Secrets(h.config.Namespace)
+
+	// only secrets of api tokens can be deleted here
+	secret, err := secrets.Get(ctx, tokenSecretName(params.TokenName), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) || (err == nil && !ownsToken(principal, secret, params.TokenName)) {
+		return apiToken.NewTokenDeleteNotFound().WithPayload(&models.Error{
+			Message: "token not found: " + params.TokenName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting api token", "error", err, "name", params.TokenName)
+		return apiToken.NewTokenDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error getting api token",
+		})
+	}
+
+	if err := secrets.Delete(ctx, secret.Name, metav1.DeleteOptions{}); k8serrors.IsNotFound(err) {
+		return apiToken.NewTokenDeleteNotFound().WithPayload(&models.Error{
+			Message: "token not found: " + params.TokenName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error deleting api token", "error", err, "name", params.TokenName)
+		return apiToken.NewTokenDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error deleting api token",
+		})
+	}
+	if before, err := secretToToken(secret); err == nil {
+		audit.SetChanges(ctx, audit.Changes(before, nil))
+	}
+	h.log.Infow("api token revoked", "name", params.TokenName, "principal", principalName(principal))
+	return apiToken.NewTokenDeleteOK()
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+)
+
+func TestTokenDelete(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "ok",
+			status:  200,
+			objects: []runtime.Object{testTokenSecret("ci", "ci.value", nil, "read-only")},
+			params: apiToken.TokenDeleteParams{
+				HTTPRequest: &http.Request{},
+				TokenName:   "ci",
+			},
+		},
+		{
+			name:   "not-found",
+			status: 404,
+			result: &models.Error{
+				Message: "token not found: ci",
+			},
+			params: apiToken.TokenDeleteParams{
+				HTTPRequest: &http.Request{},
+				TokenName:   "ci",
+			},
+		},
+		{
+			name:   "not-a-token",
+			status: 404,
+			objects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kuberlogic-token-ci",
+						Namespace: "kuberlogic",
+					},
+				},
+			},
+			result: &models.Error{
+				Message: "token not found: ci",
+			},
+			params: apiToken.TokenDeleteParams{
+				HTTPRequest: &http.Request{},
+				TokenName:   "ci",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).TokenDeleteHandler(tc.params.(apiToken.TokenDeleteParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
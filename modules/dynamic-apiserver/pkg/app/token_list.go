@@ -0,0 +1,39 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+)
+
+func (h *handlers) TokenListHandler(params apiToken.TokenListParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	secrets, err := h.clientset.CoreV1().Secrets(h.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: tokenLabel,
+	})
+	if err != nil {
+		h.log.Errorw("error listing api tokens", "error", err)
+		return apiToken.NewTokenListServiceUnavailable().WithPayload(&models.Error{
+			Message: "error listing api tokens",
+		})
+	}
+
+	tokens := models.Tokens{}
+	for i := range secrets.Items {
+		token, err := secretToToken(&secrets.Items[i])
+		if err != nil {
+			h.log.Errorw("error decoding api token", "error", err, "secret", secrets.Items[i].Name)
+			return apiToken.NewTokenListServiceUnavailable().WithPayload(&models.Error{
+				Message: "error decoding api token",
+			})
+		}
+		if tenant := principalTenant(principal); tenant != "" && token.Tenant != tenant {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return apiToken.NewTokenListOK().WithPayload(tokens)
+}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiToken "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+// testTokenSecret returns a secret of the api token as it is stored by the cluster
+func testTokenSecret(name, value string, expiresAt *time.Time, scopes ...string) *corev1.Secret {
+	token := &models.Token{Name: &name, Scopes: scopes}
+	if expiresAt != nil {
+		dt := strfmt.DateTime(*expiresAt)
+		token.ExpiresAt = &dt
+	}
+	secret := tokenToSecret(token, value, "kuberlogic")
+	secret.Data = make(map[string][]byte)
+	for k, v := range secret.StringData {
+		secret.Data[k] = []byte(v)
+	}
+	secret.StringData = nil
+	return secret
+}
+
+func TestTokenList(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	expiresAtDt := strfmt.DateTime(expiresAt)
+
+	cases := []testCase{
+		{
+			name:   "empty",
+			status: 200,
+			result: models.Tokens{},
+			params: apiToken.TokenListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+		{
+			name:   "many",
+			status: 200,
+			objects: []runtime.Object{
+				testTokenSecret("ci", "ci.value", &expiresAt, "services:write", "backups:*"),
+				testTokenSecret("monitoring", "monitoring.value", nil, "read-only"),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kuberlogic-config",
+						Namespace: "kuberlogic",
+					},
+				},
+			},
+			result: models.Tokens{
+				{
+					Name:      util.StrAsPointer("ci"),
+					Scopes:    []string{"services:write", "backups:*"},
+					ExpiresAt: &expiresAtDt,
+				},
+				{
+					Name:   util.StrAsPointer("monitoring"),
+					Scopes: []string{"read-only"},
+				},
+			},
+			params: apiToken.TokenListParams{
+				HTTPRequest: &http.Request{},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).TokenListHandler(tc.params.(apiToken.TokenListParams), nil), t, tc.status, tc.result)
+		})
+	}
+}
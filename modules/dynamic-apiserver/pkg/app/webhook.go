@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+)
+
+// Webhooks are kept in secrets of the apiserver namespace, one secret per webhook
+const (
+	webhookLabel        = "kuberlogic.com/webhook"
+	webhookSecretPrefix = "kuberlogic-webhook-"
+
+	webhookURLKey    = "url"
+	webhookSecretKey = "secret"
+	webhookEventsKey = "events"
+	webhookTenantKey = "tenant"
+
+	webhookSecretLength = 32
+)
+
+func webhookSecretName(name string) string {
+	return webhookSecretPrefix + name
+}
+
+// newWebhookSecret returns a random signing secret
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// webhookToSecret returns a secret storing the webhook with its signing secret
+func webhookToSecret(webhook *models.Webhook, namespace string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      webhookSecretName(*webhook.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				webhookLabel: *webhook.Name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			webhookURLKey:    *webhook.URL,
+			webhookSecretKey: webhook.Secret,
+			webhookEventsKey: strings.Join(webhook.Events, ","),
+		},
+	}
+	if webhook.Tenant != "" {
+		secret.StringData[webhookTenantKey] = webhook.Tenant
+	}
+	return secret
+}
+
+// secretToWebhook returns the webhook stored in the secret, the signing secret is never returned
+func secretToWebhook(secret *corev1.Secret) *models.Webhook {
+	name := secret.Labels[webhookLabel]
+	url := tokenSecretValue(secret, webhookURLKey)
+	webhook := &models.Webhook{
+		Name:      &name,
+		URL:       &url,
+		Events:    []string{},
+		Tenant:    tokenSecretValue(secret, webhookTenantKey),
+		CreatedAt: strfmt.DateTime(secret.CreationTimestamp.Time),
+	}
+	if events := tokenSecretValue(secret, webhookEventsKey); events != "" {
+		webhook.Events = strings.Split(events, ",")
+	}
+	return webhook
+}
+
+func secretToEndpoint(secret *corev1.Secret) notify.Endpoint {
+	webhook := secretToWebhook(secret)
+	return notify.Endpoint{
+		Name:   *webhook.Name,
+		URL:    *webhook.URL,
+		Secret: tokenSecretValue(secret, webhookSecretKey),
+		Events: webhook.Events,
+		Tenant: webhook.Tenant,
+	}
+}
+
+// ownsWebhook checks that the secret keeps the named webhook available to the principal
+func ownsWebhook(p *models.Principal, secret *corev1.Secret, name string) bool {
+	if secret.Labels[webhookLabel] != name {
+		return false
+	}
+	tenant := principalTenant(p)
+	return tenant == "" || tokenSecretValue(secret, webhookTenantKey) == tenant
+}
+
+// webhookEndpoints returns registered webhooks, it is the endpoint lister of the notifications dispatcher
+func (h *handlers) webhookEndpoints(ctx context.Context) ([]notify.Endpoint, error) {
+	secrets, err := h.clientset.CoreV1().Secrets(h.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: webhookLabel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]notify.Endpoint, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		endpoints = append(endpoints, secretToEndpoint(&secrets.Items[i]))
+	}
+	return endpoints, nil
+}
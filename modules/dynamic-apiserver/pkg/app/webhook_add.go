@@ -0,0 +1,85 @@
+package app
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+)
+
+func (h *handlers) WebhookAddHandler(params apiWebhook.WebhookAddParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	item := params.WebhookItem
+	audit.SetTarget(ctx, "webhooks/"+*item.Name)
+
+	if u, err := url.Parse(*item.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apiWebhook.NewWebhookAddBadRequest().WithPayload(&models.Error{
+			Message: fmt.Sprintf("invalid webhook url '%s', http or https url is expected", *item.URL),
+		})
+	}
+	// webhooks are delivered from the apiserver pod, so urls of the cluster network and of the pod itself are rejected
+	if err := h.notifier.CheckURL(ctx, *item.URL); err != nil {
+		return apiWebhook.NewWebhookAddBadRequest().WithPayload(&models.Error{
+			Message: fmt.Sprintf("invalid webhook url '%s': %s", *item.URL, err),
+		})
+	}
+	for _, event := range item.Events {
+		if !notify.ValidSubscription(event) {
+			return apiWebhook.NewWebhookAddBadRequest().WithPayload(&models.Error{
+				Message: fmt.Sprintf("unknown event '%s', expected one of: %s", event, strings.Join(notify.EventTypes, ", ")),
+			})
+		}
+	}
+	// tenant principals can register webhooks only for their tenant
+	if tenant := principalTenant(principal); tenant != "" {
+		if item.Tenant == "" {
+			item.Tenant = tenant
+		} else if item.Tenant != tenant {
+			return apiWebhook.NewWebhookAddBadRequest().WithPayload(&models.Error{
+				Message: fmt.Sprintf("webhook can't be registered for tenant '%s'", item.Tenant),
+			})
+		}
+	}
+	if errs := validation.IsValidLabelValue(item.Tenant); len(errs) > 0 {
+		return apiWebhook.NewWebhookAddBadRequest().WithPayload(&models.Error{
+			Message: fmt.Sprintf("invalid tenant '%s': %s", item.Tenant, strings.Join(errs, ", ")),
+		})
+	}
+	if item.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			h.log.Errorw("error generating webhook secret", "error", err)
+			return apiWebhook.NewWebhookAddServiceUnavailable().WithPayload(&models.Error{
+				Message: "error generating webhook secret",
+			})
+		}
+		item.Secret = secret
+	}
+
+	secret, err := h.clientset.CoreV1().Secrets(h.config.Namespace).Create(ctx, webhookToSecret(item, h.config.Namespace), metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return apiWebhook.NewWebhookAddConflict().WithPayload(&models.Error{
+			Message: "webhook already exists: " + *item.Name,
+		})
+	} else if err != nil {
+		h.log.Errorw("error creating webhook", "error", err, "name", *item.Name)
+		return apiWebhook.NewWebhookAddServiceUnavailable().WithPayload(&models.Error{
+			Message: "error creating webhook",
+		})
+	}
+
+	webhook := secretToWebhook(secret)
+	audit.SetChanges(ctx, audit.Changes(nil, webhook))
+	webhook.Secret = item.Secret
+	h.log.Infow("webhook registered", "name", *item.Name, "url", *item.URL, "events", item.Events, "tenant", item.Tenant, "principal", principalName(principal))
+	return apiWebhook.NewWebhookAddCreated().WithPayload(webhook)
+}
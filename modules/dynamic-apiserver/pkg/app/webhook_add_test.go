@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func TestWebhookAdd(t *testing.T) {
+	cases := []struct {
+		testCase
+		principal *models.Principal
+	}{
+		{
+			testCase: testCase{
+				name:   "invalid-url",
+				status: 400,
+				result: &models.Error{
+					Message: "invalid webhook url 'ftp://example.com', http or https url is expected",
+				},
+				params: &models.Webhook{
+					Name: util.StrAsPointer("billing"),
+					URL:  util.StrAsPointer("ftp://example.com"),
+				},
+			},
+		},
+		{
+			testCase: testCase{
+				name:   "cluster-url",
+				status: 400,
+				result: &models.Error{
+					Message: "invalid webhook url 'http://169.254.169.254/latest/meta-data': 169.254.169.254: webhook address is not allowed",
+				},
+				params: &models.Webhook{
+					Name: util.StrAsPointer("billing"),
+					URL:  util.StrAsPointer("http://169.254.169.254/latest/meta-data"),
+				},
+			},
+			principal: &models.Principal{Name: "acme-admin", Tenant: "acme"},
+		},
+		{
+			testCase: testCase{
+				name:   "unknown-event",
+				status: 400,
+				result: &models.Error{
+					Message: "unknown event 'service.deleted', expected one of: service.created, service.ready, service.failed, service.paused, service.archived, backup.completed, backup.failed, restore.completed, restore.failed",
+				},
+				params: &models.Webhook{
+					Name:   util.StrAsPointer("billing"),
+					URL:    util.StrAsPointer("https://example.com"),
+					Events: []string{"service.*", "service.deleted"},
+				},
+			},
+		},
+		{
+			testCase: testCase{
+				name:   "other-tenant",
+				status: 400,
+				result: &models.Error{
+					Message: "webhook can't be registered for tenant 'other'",
+				},
+				params: &models.Webhook{
+					Name:   util.StrAsPointer("billing"),
+					URL:    util.StrAsPointer("https://example.com"),
+					Tenant: "other",
+				},
+			},
+			principal: &models.Principal{Name: "acme-admin", Tenant: "acme"},
+		},
+		{
+			testCase: testCase{
+				name:    "already-exists",
+				status:  409,
+				objects: []runtime.Object{testWebhookSecret("billing", "https://example.com", "secret", "")},
+				result: &models.Error{
+					Message: "webhook already exists: billing",
+				},
+				params: &models.Webhook{
+					Name: util.StrAsPointer("billing"),
+					URL:  util.StrAsPointer("https://example.com"),
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).WebhookAddHandler(apiWebhook.WebhookAddParams{
+				HTTPRequest: &http.Request{},
+				WebhookItem: tc.params.(*models.Webhook),
+			}, tc.principal), t, tc.status, tc.result)
+		})
+	}
+}
+
+func TestWebhookAddStoresSecret(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	h := newFakeHandlersWithClientset(t, clientset)
+
+	var generated string
+	checkResponse(h.WebhookAddHandler(apiWebhook.WebhookAddParams{
+		HTTPRequest: &http.Request{},
+		WebhookItem: &models.Webhook{
+			Name:   util.StrAsPointer("crm"),
+			URL:    util.StrAsPointer("https://crm.example.com/hooks"),
+			Events: []string{"service.ready"},
+		},
+	}, &models.Principal{Name: "acme-admin", Tenant: "acme"}), t, 201, func(payload interface{}) {
+		webhook := payload.(*models.Webhook)
+		generated = webhook.Secret
+		if len(generated) < 40 {
+			t.Errorf("unexpected generated secret: %s", generated)
+		}
+		if webhook.Tenant != "acme" || !reflect.DeepEqual(webhook.Events, []string{"service.ready"}) {
+			t.Errorf("unexpected webhook: %+v", webhook)
+		}
+	})
+
+	secret, err := clientset.CoreV1().Secrets("kuberlogic").Get(context.TODO(), "kuberlogic-webhook-crm", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint := secretToEndpoint(secret)
+	if endpoint.Secret != generated || endpoint.URL != "https://crm.example.com/hooks" || endpoint.Tenant != "acme" {
+		t.Errorf("unexpected stored webhook: %+v", endpoint)
+	}
+}
@@ -0,0 +1,17 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+)
+
+func (h *handlers) WebhookDeadLetterListHandler(params apiWebhook.WebhookDeadLetterListParams, principal *models.Principal) middleware.Responder {
+	webhook := ""
+	if params.Webhook != nil {
+		webhook = *params.Webhook
+	}
+	// tenant principals see dead letters of their tenant webhooks only
+	return apiWebhook.NewWebhookDeadLetterListOK().WithPayload(h.notifier.DeadLetters(webhook, principalTenant(principal)))
+}
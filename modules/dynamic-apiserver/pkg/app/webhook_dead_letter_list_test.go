@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+)
+
+func TestWebhookDeadLetterList(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	h := newFakeHandlers(t,
+		testWebhookSecret("billing", receiver.URL, "secret", ""),
+		testWebhookSecret("crm", receiver.URL, "secret", "acme"),
+	)
+	notifier := h.Handlers.(*handlers).notifier
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go notifier.Run(ctx)
+
+	event := notify.NewEvent(notify.EventServiceReady)
+	event.Tenant = "acme"
+	if err := notifier.Publish(event); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 500 && len(notifier.DeadLetters("", "")) < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cases := []struct {
+		name      string
+		webhook   *string
+		principal *models.Principal
+		expected  []string
+	}{
+		{"all", nil, nil, []string{"billing", "crm"}},
+		{"webhook", &[]string{"billing"}[0], nil, []string{"billing"}},
+		{"tenant", nil, &models.Principal{Name: "acme-admin", Tenant: "acme"}, []string{"crm"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(h.WebhookDeadLetterListHandler(apiWebhook.WebhookDeadLetterListParams{
+				HTTPRequest: &http.Request{},
+				Webhook:     tc.webhook,
+			}, tc.principal), t, 200, func(payload interface{}) {
+				deliveries := payload.(models.WebhookDeliveries)
+				webhooks := map[string]bool{}
+				for _, d := range deliveries {
+					webhooks[d.Webhook] = true
+					if d.Event.ID != event.ID || d.Status != http.StatusInternalServerError {
+						t.Errorf("unexpected dead letter: %+v", d)
+					}
+				}
+				if len(deliveries) != len(tc.expected) {
+					t.Fatalf("expected dead letters of %v, got %d", tc.expected, len(deliveries))
+				}
+				for _, name := range tc.expected {
+					if !webhooks[name] {
+						t.Errorf("dead letter of %s is expected", name)
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/audit"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+)
+
+func (h *handlers) WebhookDeleteHandler(params apiWebhook.WebhookDeleteParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	secrets := h.clientset.CoreV1().Secrets(h.config.Namespace)
+
+	// only secrets of webhooks can be deleted here
+	secret, err := secrets.Get(ctx, webhookSecretName(params.WebhookName), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) || (err == nil && !ownsWebhook(principal, secret, params.WebhookName)) {
+		return apiWebhook.NewWebhookDeleteNotFound().WithPayload(&models.Error{
+			Message: "webhook not found: " + params.WebhookName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting webhook", "error", err, "name", params.WebhookName)
+		return apiWebhook.NewWebhookDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error getting webhook",
+		})
+	}
+
+	if err := secrets.Delete(ctx, secret.Name, metav1.DeleteOptions{}); k8serrors.IsNotFound(err) {
+		return apiWebhook.NewWebhookDeleteNotFound().WithPayload(&models.Error{
+			Message: "webhook not found: " + params.WebhookName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error deleting webhook", "error", err, "name", params.WebhookName)
+		return apiWebhook.NewWebhookDeleteServiceUnavailable().WithPayload(&models.Error{
+			Message: "error deleting webhook",
+		})
+	}
+	audit.SetChanges(ctx, audit.Changes(secretToWebhook(secret), nil))
+	h.log.Infow("webhook deleted", "name", params.WebhookName, "principal", principalName(principal))
+	return apiWebhook.NewWebhookDeleteOK()
+}
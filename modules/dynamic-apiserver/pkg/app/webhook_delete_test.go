@@ -0,0 +1,73 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+)
+
+func TestWebhookDelete(t *testing.T) {
+	cases := []struct {
+		testCase
+		principal *models.Principal
+	}{
+		{
+			testCase: testCase{
+				name:    "ok",
+				status:  200,
+				objects: []runtime.Object{testWebhookSecret("billing", "https://example.com", "secret", "")},
+			},
+		},
+		{
+			testCase: testCase{
+				name:   "not-found",
+				status: 404,
+				result: &models.Error{
+					Message: "webhook not found: billing",
+				},
+			},
+		},
+		{
+			testCase: testCase{
+				name:   "not-a-webhook",
+				status: 404,
+				objects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "kuberlogic-webhook-billing",
+							Namespace: "kuberlogic",
+						},
+					},
+				},
+				result: &models.Error{
+					Message: "webhook not found: billing",
+				},
+			},
+		},
+		{
+			testCase: testCase{
+				name:    "other-tenant",
+				status:  404,
+				objects: []runtime.Object{testWebhookSecret("billing", "https://example.com", "secret", "")},
+				result: &models.Error{
+					Message: "webhook not found: billing",
+				},
+			},
+			principal: &models.Principal{Name: "acme-admin", Tenant: "acme"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).WebhookDeleteHandler(apiWebhook.WebhookDeleteParams{
+				HTTPRequest: &http.Request{},
+				WebhookName: "billing",
+			}, tc.principal), t, tc.status, tc.result)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+)
+
+func (h *handlers) WebhookListHandler(params apiWebhook.WebhookListParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	secrets, err := h.clientset.CoreV1().Secrets(h.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: webhookLabel,
+	})
+	if err != nil {
+		h.log.Errorw("error listing webhooks", "error", err)
+		return apiWebhook.NewWebhookListServiceUnavailable().WithPayload(&models.Error{
+			Message: "error listing webhooks",
+		})
+	}
+
+	webhooks := models.Webhooks{}
+	for i := range secrets.Items {
+		webhook := secretToWebhook(&secrets.Items[i])
+		if tenant := principalTenant(principal); tenant != "" && webhook.Tenant != tenant {
+			continue
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return apiWebhook.NewWebhookListOK().WithPayload(webhooks)
+}
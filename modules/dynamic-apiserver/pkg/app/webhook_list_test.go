@@ -0,0 +1,73 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+)
+
+func testWebhookSecret(name, url, secret, tenant string, events ...string) *corev1.Secret {
+	s := webhookToSecret(&models.Webhook{
+		Name:   &name,
+		URL:    &url,
+		Secret: secret,
+		Tenant: tenant,
+		Events: events,
+	}, "kuberlogic")
+	s.Data = make(map[string][]byte)
+	for k, v := range s.StringData {
+		s.Data[k] = []byte(v)
+	}
+	s.StringData = nil
+	return s
+}
+
+func TestWebhookList(t *testing.T) {
+	billing, crm := "billing", "crm"
+	billingURL, crmURL := "https://billing.example.com/hooks", "http://crm.example.com/"
+	objects := []runtime.Object{
+		testWebhookSecret(billing, billingURL, "secret", "", "service.ready", "backup.*"),
+		testWebhookSecret(crm, crmURL, "secret", "acme"),
+	}
+
+	cases := []struct {
+		name      string
+		objects   []runtime.Object
+		principal *models.Principal
+		result    models.Webhooks
+	}{
+		{
+			name:   "empty",
+			result: models.Webhooks{},
+		},
+		{
+			name:    "all",
+			objects: objects,
+			result: models.Webhooks{
+				{Name: &billing, URL: &billingURL, Events: []string{"service.ready", "backup.*"}, CreatedAt: strfmt.DateTime{}},
+				{Name: &crm, URL: &crmURL, Events: []string{}, Tenant: "acme", CreatedAt: strfmt.DateTime{}},
+			},
+		},
+		{
+			name:      "tenant",
+			objects:   objects,
+			principal: &models.Principal{Name: "acme-admin", Tenant: "acme"},
+			result: models.Webhooks{
+				{Name: &crm, URL: &crmURL, Events: []string{}, Tenant: "acme", CreatedAt: strfmt.DateTime{}},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkResponse(newFakeHandlers(t, tc.objects...).WebhookListHandler(apiWebhook.WebhookListParams{
+				HTTPRequest: &http.Request{},
+			}, tc.principal), t, 200, tc.result)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+)
+
+func (h *handlers) WebhookPingHandler(params apiWebhook.WebhookPingParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	secret, err := h.clientset.CoreV1().Secrets(h.config.Namespace).Get(ctx, webhookSecretName(params.WebhookName), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) || (err == nil && !ownsWebhook(principal, secret, params.WebhookName)) {
+		return apiWebhook.NewWebhookPingNotFound().WithPayload(&models.Error{
+			Message: "webhook not found: " + params.WebhookName,
+		})
+	} else if err != nil {
+		h.log.Errorw("error getting webhook", "error", err, "name", params.WebhookName)
+		return apiWebhook.NewWebhookPingServiceUnavailable().WithPayload(&models.Error{
+			Message: "error getting webhook",
+		})
+	}
+
+	event := notify.NewEvent(notify.EventPing)
+	event.Tenant = tokenSecretValue(secret, webhookTenantKey)
+	delivery := h.notifier.Deliver(ctx, secretToEndpoint(secret), event)
+	return apiWebhook.NewWebhookPingOK().WithPayload(delivery)
+}
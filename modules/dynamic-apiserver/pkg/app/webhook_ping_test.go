@@ -0,0 +1,47 @@
+package app
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	apiWebhook "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/notify"
+)
+
+func TestWebhookPing(t *testing.T) {
+	var verifyErr error
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		verifyErr = notify.Verify("secret", r.Header, body, time.Minute)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+
+	h := newFakeHandlers(t, testWebhookSecret("billing", receiver.URL, "secret", "acme"))
+	checkResponse(h.WebhookPingHandler(apiWebhook.WebhookPingParams{
+		HTTPRequest: &http.Request{},
+		WebhookName: "billing",
+	}, nil), t, 200, func(payload interface{}) {
+		delivery := payload.(*models.WebhookDelivery)
+		if delivery.Status != http.StatusAccepted || delivery.Error != "" || delivery.Attempts != 1 {
+			t.Errorf("unexpected delivery: %+v", delivery)
+		}
+		if delivery.Event.Type != notify.EventPing || delivery.Event.Tenant != "acme" {
+			t.Errorf("unexpected event: %+v", delivery.Event)
+		}
+	})
+	if verifyErr != nil {
+		t.Errorf("invalid signature: %s", verifyErr)
+	}
+
+	checkResponse(h.WebhookPingHandler(apiWebhook.WebhookPingParams{
+		HTTPRequest: &http.Request{},
+		WebhookName: "billing",
+	}, &models.Principal{Name: "other-admin", Tenant: "other"}), t, 404, &models.Error{
+		Message: "webhook not found: billing",
+	})
+}
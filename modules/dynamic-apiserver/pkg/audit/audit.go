@@ -0,0 +1,240 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit records who called mutating and sensitive API operations and with which result
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	openapimiddleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+)
+
+// results of audited calls
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+	ResultDenied  = "denied"
+)
+
+// maxErrorBody limits the size of error responses read for the record error message
+const maxErrorBody = 4096
+
+var pathParam = regexp.MustCompile(`{[^}]+}`)
+
+type contextKey struct{}
+
+// Auditor writes records of audited API calls to the sink
+type Auditor struct {
+	sink      Sink
+	recent    *MemorySink
+	sensitive map[string]bool
+	log       logging.Logger
+}
+
+// New returns an auditor writing records to the sink.
+// Calls of operations with other than GET methods and of sensitive operations are audited.
+// The last bufferSize records are kept in memory for queries if the sink can't be queried.
+func New(sink Sink, bufferSize int, log logging.Logger, sensitive ...string) *Auditor {
+	a := &Auditor{
+		sink:      sink,
+		sensitive: make(map[string]bool),
+		log:       log,
+	}
+	if _, ok := sink.(Querier); !ok {
+		a.recent = NewMemorySink(bufferSize)
+	}
+	for _, op := range sensitive {
+		a.sensitive[op] = true
+	}
+	return a
+}
+
+// Query returns records matching the filter, newest first
+func (a *Auditor) Query(filter Filter) ([]*models.AuditRecord, error) {
+	if q, ok := a.sink.(Querier); ok {
+		return q.Query(filter)
+	}
+	return a.recent.Query(filter)
+}
+
+func (a *Auditor) audited(r *http.Request, route *openapimiddleware.MatchedRoute) bool {
+	if route == nil || route.Operation == nil {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return a.sensitive[route.Operation.ID]
+	}
+	return true
+}
+
+// Middleware records calls of audited operations.
+// It must wrap the operation executor so the matched route is known, see KuberlogicAPI.Serve.
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		route := openapimiddleware.MatchedRouteFrom(r)
+		if !a.audited(r, route) {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		rec := &record{
+			AuditRecord: models.AuditRecord{
+				Time:      strfmt.DateTime(time.Now().UTC()),
+				Operation: route.Operation.ID,
+				Method:    r.Method,
+				Path:      r.URL.Path,
+				Target:    routeTarget(route),
+				Changes:   []*models.AuditChange{},
+			},
+		}
+		ww := middleware.NewWrapResponseWriter(rw, r.ProtoMajor)
+		body := &limitedBuffer{limit: maxErrorBody}
+		ww.Tee(body)
+
+		defer func() {
+			rec.finish(ww.Status(), body.Bytes(), r)
+			if err := a.write(&rec.AuditRecord); err != nil {
+				a.log.Errorw("error writing audit record", "error", err, "operation", rec.Operation, "target", rec.Target)
+			}
+		}()
+		next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), contextKey{}, rec)))
+	})
+}
+
+func (a *Auditor) write(r *models.AuditRecord) error {
+	if a.recent != nil {
+		_ = a.recent.Write(r)
+	}
+	return a.sink.Write(r)
+}
+
+// record is an audit record filled by the middleware and the called operation
+type record struct {
+	models.AuditRecord
+	mu sync.Mutex
+}
+
+func (r *record) finish(status int, body []byte, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if status == 0 {
+		// hijacked connections like WebSocket sessions don't report the status
+		status = http.StatusOK
+		if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+			status = http.StatusSwitchingProtocols
+		}
+	}
+	r.Status = int64(status)
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		r.Result = ResultDenied
+	case status >= http.StatusBadRequest:
+		r.Result = ResultFailure
+	default:
+		r.Result = ResultSuccess
+	}
+	if status >= http.StatusBadRequest {
+		e := &models.Error{}
+		if err := json.Unmarshal(body, e); err == nil {
+			r.Error = e.Message
+		}
+		if r.Error == "" {
+			r.Error = http.StatusText(status)
+		}
+	}
+}
+
+// routeTarget returns the path of the object the operation is applied to, e.g. services/demo for /services/{ServiceID}/archive
+func routeTarget(route *openapimiddleware.MatchedRoute) string {
+	pattern := strings.TrimPrefix(route.PathPattern, strings.TrimSuffix(route.BasePath, "/"))
+	locations := pathParam.FindAllStringIndex(pattern, -1)
+	if len(locations) == 0 {
+		return ""
+	}
+	pattern = pattern[:locations[len(locations)-1][1]]
+	target := pathParam.ReplaceAllStringFunc(pattern, func(param string) string {
+		value, _, _ := route.Params.GetOK(strings.Trim(param, "{}"))
+		if len(value) == 0 {
+			return ""
+		}
+		return value[0]
+	})
+	return strings.Trim(target, "/")
+}
+
+func fromContext(ctx context.Context) *record {
+	r, _ := ctx.Value(contextKey{}).(*record)
+	return r
+}
+
+// SetPrincipal sets the principal of the audited call, it is a no-op for calls that are not audited
+func SetPrincipal(ctx context.Context, name, tenant string) {
+	if r := fromContext(ctx); r != nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.Principal, r.Tenant = name, tenant
+	}
+}
+
+// SetTarget overrides the target of the audited call taken from the path
+func SetTarget(ctx context.Context, target string) {
+	if r := fromContext(ctx); r != nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.Target = target
+	}
+}
+
+// SetChanges sets changes made by the audited call
+func SetChanges(ctx context.Context, changes []*models.AuditChange) {
+	if r := fromContext(ctx); r != nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.Changes = changes
+	}
+}
+
+// limitedBuffer keeps the first limit bytes written to it
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room > 0 {
+		if len(p) > room {
+			b.Buffer.Write(p[:room])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,249 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations"
+	apiService "github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+)
+
+type authorizer struct{}
+
+func (authorizer) Authorize(r *http.Request, principal interface{}) error {
+	p := principal.(*models.Principal)
+	SetPrincipal(r.Context(), p.Name, p.Tenant)
+	return nil
+}
+
+func newTestAPI(t *testing.T, auditor *Auditor) http.Handler {
+	spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewKuberlogicAPI(spec)
+	api.KeyAuth = func(token string) (*models.Principal, error) {
+		if token != "secret" {
+			return nil, errorUnauthorized
+		}
+		return &models.Principal{Name: "ci", Tenant: "acme"}, nil
+	}
+	api.APIAuthorizer = authorizer{}
+	api.ServiceServiceListHandler = apiService.ServiceListHandlerFunc(func(params apiService.ServiceListParams, _ *models.Principal) middleware.Responder {
+		return apiService.NewServiceListOK()
+	})
+	api.ServiceServiceAddHandler = apiService.ServiceAddHandlerFunc(func(params apiService.ServiceAddParams, _ *models.Principal) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		SetTarget(ctx, "services/"+*params.ServiceItem.ID)
+		SetChanges(ctx, Changes(nil, params.ServiceItem))
+		return apiService.NewServiceAddCreated().WithPayload(params.ServiceItem)
+	})
+	api.ServiceServiceDeleteHandler = apiService.ServiceDeleteHandlerFunc(func(params apiService.ServiceDeleteParams, _ *models.Principal) middleware.Responder {
+		return apiService.NewServiceDeleteNotFound().WithPayload(&models.Error{Message: "kuberlogic service not found: " + params.ServiceID})
+	})
+	api.ServiceServiceSecretsListHandler = apiService.ServiceSecretsListHandlerFunc(func(params apiService.ServiceSecretsListParams, _ *models.Principal) middleware.Responder {
+		return apiService.NewServiceSecretsListOK()
+	})
+	return api.Serve(auditor.Middleware)
+}
+
+var errorUnauthorized = &unauthorized{}
+
+type unauthorized struct{}
+
+func (*unauthorized) Error() string { return "incorrect api key auth" }
+func (*unauthorized) Code() int32   { return http.StatusUnauthorized }
+
+func TestMiddleware(t *testing.T) {
+	sink := NewMemorySink(10)
+	srv := newTestAPI(t, New(sink, 10, logging.WithComponentLogger("audit"), "serviceSecretsList"))
+
+	requests := []struct {
+		method, path, token, body string
+	}{
+		{http.MethodGet, "/api/v1/services/", "secret", ""},
+		{http.MethodPost, "/api/v1/services/", "secret", `{"id": "demo", "type": "postgresql"}`},
+		{http.MethodDelete, "/api/v1/services/demo/", "secret", ""},
+		{http.MethodDelete, "/api/v1/services/demo/", "wrong", ""},
+		{http.MethodGet, "/api/v1/services/demo/secrets", "secret", ""},
+	}
+	for _, req := range requests {
+		r := httptest.NewRequest(req.method, req.path, strings.NewReader(req.body))
+		r.Header.Set("X-Token", req.token)
+		if req.body != "" {
+			r.Header.Set("Content-Type", "application/json")
+		}
+		srv.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	records, err := sink.Query(Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range records {
+		r.Time = strfmt.DateTime{}
+	}
+	expected := []*models.AuditRecord{
+		{
+			Principal: "ci", Tenant: "acme", Operation: "serviceSecretsList", Method: http.MethodGet,
+			Path: "/api/v1/services/demo/secrets", Target: "services/demo", Changes: []*models.AuditChange{},
+			Status: 200, Result: ResultSuccess,
+		},
+		{
+			Operation: "serviceDelete", Method: http.MethodDelete,
+			Path: "/api/v1/services/demo/", Target: "services/demo", Changes: []*models.AuditChange{},
+			Status: 401, Result: ResultDenied, Error: "incorrect api key auth",
+		},
+		{
+			Principal: "ci", Tenant: "acme", Operation: "serviceDelete", Method: http.MethodDelete,
+			Path: "/api/v1/services/demo/", Target: "services/demo", Changes: []*models.AuditChange{},
+			Status: 404, Result: ResultFailure, Error: "kuberlogic service not found: demo",
+		},
+		{
+			Principal: "ci", Tenant: "acme", Operation: "serviceAdd", Method: http.MethodPost,
+			Path: "/api/v1/services/", Target: "services/demo", Changes: []*models.AuditChange{
+				{Field: "created_at", New: `"0001-01-01T00:00:00.000Z"`},
+				{Field: "id", New: `"demo"`},
+				{Field: "type", New: `"postgresql"`},
+			},
+			Status: 201, Result: ResultSuccess,
+		},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		actual, _ := json.MarshalIndent(records, "", "  ")
+		t.Errorf("records do not equal expected:\n%s", actual)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	before := &models.Service{
+		ID:      strPtr("demo"),
+		Version: "13",
+		Limits:  &models.Limits{CPU: "1", Memory: "1Gi"},
+	}
+	after := &models.Service{
+		ID:      strPtr("demo"),
+		Version: "14",
+		Limits:  &models.Limits{CPU: "1", Storage: "10Gi"},
+	}
+	expected := []*models.AuditChange{
+		{Field: "limits.memory", Old: `"1Gi"`},
+		{Field: "limits.storage", New: `"10Gi"`},
+		{Field: "version", Old: `"13"`, New: `"14"`},
+	}
+	if actual := Changes(before, after); !reflect.DeepEqual(actual, expected) {
+		data, _ := json.Marshal(actual)
+		t.Errorf("changes do not equal expected: %s", data)
+	}
+
+	var deleted *models.Service
+	if actual := Changes(before, deleted); len(actual) != 5 || actual[0].New != "" {
+		data, _ := json.Marshal(actual)
+		t.Errorf("deletion changes are unexpected: %s", data)
+	}
+
+	redacted := Redact(Changes(nil, map[string]string{"password": "qwerty"}))
+	if len(redacted) != 1 || redacted[0].Field != "password" || redacted[0].New != Redacted || redacted[0].Old != "" {
+		data, _ := json.Marshal(redacted)
+		t.Errorf("changes are not redacted: %s", data)
+	}
+}
+
+func testRecord(principal, target string, at time.Time) *models.AuditRecord {
+	return &models.AuditRecord{
+		Time:      strfmt.DateTime(at),
+		Principal: principal,
+		Operation: "serviceAdd",
+		Target:    target,
+		Result:    ResultSuccess,
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().UTC().Truncate(time.Second)
+	for i, principal := range []string{"ci", "admin", "ci", "ci"} {
+		if err := sink.Write(testRecord(principal, "services/demo", start.Add(time.Duration(i)*time.Minute))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := sink.Query(Filter{Principal: "ci", Since: start, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !time.Time(records[0].Time).Equal(start.Add(3*time.Minute)) {
+		t.Errorf("the newest record is expected, got %v", records)
+	}
+	records, err = sink.Query(Filter{Principal: "ci", Since: start})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Errorf("2 records newer than the first one are expected, got %d", len(records))
+	}
+}
+
+func TestMemorySink(t *testing.T) {
+	sink := NewMemorySink(2)
+	for _, target := range []string{"services/a", "services/b", "services/c"} {
+		_ = sink.Write(testRecord("ci", target, time.Now()))
+	}
+	records, _ := sink.Query(Filter{})
+	if len(records) != 2 || records[0].Target != "services/c" || records[1].Target != "services/b" {
+		t.Errorf("last 2 records are expected newest first, got %v", records)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan *models.AuditRecord, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		record := &models.AuditRecord{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(record); err != nil {
+			t.Errorf("error decoding record: %v", err)
+		}
+		received <- record
+	}))
+	defer receiver.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := NewWebhookSink(ctx, receiver.URL, receiver.Client(), logging.WithComponentLogger("audit"))
+	if err := sink.Write(testRecord("ci", "services/demo", time.Now())); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case record := <-received:
+		if record.Principal != "ci" || record.Target != "services/demo" {
+			t.Errorf("unexpected record: %v", record)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("record is not received")
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
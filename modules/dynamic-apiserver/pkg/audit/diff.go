@@ -0,0 +1,117 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// Redacted replaces values that must not be kept in audit records like passwords
+const Redacted = `"<redacted>"`
+
+// Changes returns fields that differ between the JSON representations of before and after.
+// Nested fields are joined with dots, nil before or after stands for a created or deleted object.
+func Changes(before, after interface{}) []*models.AuditChange {
+	previous, current := flatten(before), flatten(after)
+	fields := make(map[string]bool)
+	for field := range previous {
+		fields[field] = true
+	}
+	for field := range current {
+		fields[field] = true
+	}
+
+	changes := make([]*models.AuditChange, 0)
+	for field := range fields {
+		o, oOk := previous[field]
+		n, nOk := current[field]
+		if oOk == nOk && reflect.DeepEqual(o, n) {
+			continue
+		}
+		change := &models.AuditChange{Field: field}
+		if oOk {
+			change.Old = encode(o)
+		}
+		if nOk {
+			change.New = encode(n)
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// Redact hides values of changes keeping changed fields
+func Redact(changes []*models.AuditChange) []*models.AuditChange {
+	for _, change := range changes {
+		if change.Old != "" {
+			change.Old = Redacted
+		}
+		if change.New != "" {
+			change.New = Redacted
+		}
+	}
+	return changes
+}
+
+func flatten(v interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return result
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return result
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return result
+	}
+	flattenInto(result, "", decoded)
+	return result
+}
+
+func flattenInto(result map[string]interface{}, prefix string, v interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok || len(m) == 0 {
+		if prefix != "" && v != nil {
+			result[prefix] = v
+		}
+		return
+	}
+	for key, value := range m {
+		field := key
+		if prefix != "" {
+			field = prefix + "." + key
+		}
+		flattenInto(result, field, value)
+	}
+}
+
+func encode(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,242 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+)
+
+// Sink receives audit records
+type Sink interface {
+	Write(record *models.AuditRecord) error
+}
+
+// Querier is implemented by sinks able to return stored records
+type Querier interface {
+	Query(filter Filter) ([]*models.AuditRecord, error)
+}
+
+// Filter selects audit records, empty fields match all records
+type Filter struct {
+	Principal string
+	Tenant    string
+	Operation string
+	Target    string
+	Since     time.Time
+	// Limit is the maximum number of returned records, all records are returned if it is zero
+	Limit int
+}
+
+func (f Filter) matches(r *models.AuditRecord) bool {
+	return (f.Principal == "" || r.Principal == f.Principal) &&
+		(f.Tenant == "" || r.Tenant == f.Tenant) &&
+		(f.Operation == "" || r.Operation == f.Operation) &&
+		(f.Target == "" || r.Target == f.Target) &&
+		(f.Since.IsZero() || time.Time(r.Time).After(f.Since))
+}
+
+// writerSink writes records as JSON lines
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink returns a sink writing records as JSON lines, e.g. to stdout
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Write(record *models.AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+// FileSink appends records as JSON lines to a file
+type FileSink struct {
+	writerSink
+	path string
+}
+
+var _ Querier = &FileSink{}
+
+// NewFileSink returns a sink appending records to the file at path
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening audit file")
+	}
+	return &FileSink{writerSink: writerSink{w: f}, path: path}, nil
+}
+
+// Query scans the file for records matching the filter
+func (s *FileSink) Query(filter Filter) ([]*models.AuditRecord, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening audit file")
+	}
+	defer f.Close()
+
+	var matched []*models.AuditRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		r := &models.AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), r); err != nil {
+			continue
+		}
+		if filter.matches(r) {
+			matched = append(matched, r)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading audit file")
+	}
+	return newestFirst(matched, filter.Limit), nil
+}
+
+// MemorySink keeps the last records in memory
+type MemorySink struct {
+	mu      sync.Mutex
+	records []*models.AuditRecord
+	size    int
+}
+
+var _ Querier = &MemorySink{}
+
+// NewMemorySink returns a sink keeping the last size records
+func NewMemorySink(size int) *MemorySink {
+	return &MemorySink{size: size}
+}
+
+func (s *MemorySink) Write(record *models.AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
+	if len(s.records) > s.size {
+		s.records = s.records[len(s.records)-s.size:]
+	}
+	return nil
+}
+
+// Query returns kept records matching the filter
+func (s *MemorySink) Query(filter Filter) ([]*models.AuditRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matched []*models.AuditRecord
+	for _, r := range s.records {
+		if filter.matches(r) {
+			matched = append(matched, r)
+		}
+	}
+	return newestFirst(matched, filter.Limit), nil
+}
+
+func newestFirst(records []*models.AuditRecord, limit int) []*models.AuditRecord {
+	result := make([]*models.AuditRecord, 0, len(records))
+	for i := len(records) - 1; i >= 0 && (limit == 0 || len(result) < limit); i-- {
+		result = append(result, records[i])
+	}
+	return result
+}
+
+// webhookSink posts records to a URL in the background so API calls are not delayed by the receiver
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan *models.AuditRecord
+	log    logging.Logger
+}
+
+// webhookQueueSize is the number of records waiting to be sent, new records are dropped when the queue is full
+const webhookQueueSize = 1000
+
+// NewWebhookSink returns a sink posting records as JSON to the url until ctx is done
+func NewWebhookSink(ctx context.Context, url string, client *http.Client, log logging.Logger) Sink {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	s := &webhookSink{
+		url:    url,
+		client: client,
+		queue:  make(chan *models.AuditRecord, webhookQueueSize),
+		log:    log,
+	}
+	go s.run(ctx)
+	return s
+}
+
+func (s *webhookSink) Write(record *models.AuditRecord) error {
+	select {
+	case s.queue <- record:
+		return nil
+	default:
+		return errors.New("audit webhook queue is full, record is dropped")
+	}
+}
+
+func (s *webhookSink) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case record := <-s.queue:
+			if err := s.post(ctx, record); err != nil {
+				s.log.Errorw("error sending audit record", "error", err, "operation", record.Operation, "target", record.Target)
+			}
+		}
+	}
+}
+
+func (s *webhookSink) post(ctx context.Context, record *models.AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("unexpected status %d from %s", resp.StatusCode, s.url)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/audit"
+)
+
+const (
+	auditPrincipalFlag = "principal"
+	auditOperationFlag = "operation"
+	auditTargetFlag    = "target"
+	auditLimitFlag     = "limit"
+)
+
+// makeAuditListCmd returns a cmd to handle operation auditList
+func makeAuditListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auditList",
+		Short:   `List audit records, newest first`,
+		Aliases: []string{"list"},
+		RunE:    runAuditList(apiClientFunc),
+	}
+	_ = cmd.PersistentFlags().String(auditPrincipalFlag, "", "Show only calls of the principal")
+	_ = cmd.PersistentFlags().String(auditOperationFlag, "", "Show only calls of the operation like serviceAdd")
+	_ = cmd.PersistentFlags().String(auditTargetFlag, "", "Show only calls on the target like services/demo")
+	_ = cmd.PersistentFlags().Duration(sinceFlag, 0, "Show only calls newer than a relative duration like 5m or 3h")
+	_ = cmd.PersistentFlags().Int64(auditLimitFlag, 0, "Maximum number of records. The server default is used if not set")
+	return cmd
+}
+
+// runAuditList uses cmd flags to call endpoint api
+func runAuditList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := audit.NewAuditListParams()
+		if params.Principal, err = getString(cmd, auditPrincipalFlag); err != nil {
+			return err
+		}
+		if params.Operation, err = getString(cmd, auditOperationFlag); err != nil {
+			return err
+		}
+		if params.Target, err = getString(cmd, auditTargetFlag); err != nil {
+			return err
+		}
+		if value, err := cmd.Flags().GetDuration(sinceFlag); err != nil {
+			return err
+		} else if value > 0 {
+			since := time.Now().Add(-value).UTC().Format(time.RFC3339)
+			params.Since = &since
+		}
+		if value, err := cmd.Flags().GetInt64(auditLimitFlag); err != nil {
+			return err
+		} else if value > 0 {
+			params.Limit = &value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Audit.AuditList(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{"№", "Time", "Principal", "Operation", "Target", "Status", "Result", "Error"})
+			table.SetBorder(false)
+			table.SetAutoWrapText(false)
+			for i, item := range payload {
+				table.Append([]string{
+					strconv.Itoa(i), item.Time.String(), item.Principal, item.Operation, item.Target,
+					strconv.FormatInt(item.Status, 10), item.Result, item.Error})
+			}
+			table.Render()
+		} else {
+			return printResult(cmd, formatResponse, payload)
+		}
+		return nil
+	}
+}
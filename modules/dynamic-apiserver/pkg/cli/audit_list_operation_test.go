@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuditListFormatStr(t *testing.T) {
+	var query map[string][]string
+	data, _ := json.Marshal([]map[string]interface{}{
+		{
+			"time":      "2022-05-10T16:00:53.000Z",
+			"principal": "ci",
+			"operation": "serviceDelete",
+			"method":    "DELETE",
+			"path":      "/api/v1/services/demo/",
+			"target":    "services/demo",
+			"status":    404,
+			"result":    "failure",
+			"error":     "kuberlogic service not found: demo",
+		},
+	})
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		query = req.URL.Query()
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     make(http.Header),
+		}
+	})
+	cmd, err := MakeRootCmd(httpClient, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"audit", "list", "--principal", "ci", "--target", "services/demo", "--since", "1h", "--limit", "10"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"serviceDelete", "services/demo", "404", "failure", "kuberlogic service not found: demo"} {
+		if !strings.Contains(b.String(), expected) {
+			t.Errorf("%s is expected in the output:\n%s", expected, b.String())
+		}
+	}
+
+	if query["principal"][0] != "ci" || query["target"][0] != "services/demo" || query["limit"][0] != "10" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	since, err := time.Parse(time.RFC3339, query["since"][0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(since); d < time.Hour || d > time.Hour+time.Minute {
+		t.Errorf("since is expected an hour ago, got %s", since)
+	}
+	if _, ok := query["operation"]; ok {
+		t.Errorf("operation is not expected in the query: %v", query)
+	}
+}
@@ -23,6 +23,8 @@ func makeBackupListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.
 	}
 
 	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Service id to filter by")
+	_ = cmd.PersistentFlags().String(listStatusFlag, "", "Backup status to filter by")
+	addListFlags(cmd)
 	return cmd
 }
 
@@ -43,6 +45,20 @@ func runBackupList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *c
 		} else if value != nil {
 			params.ServiceID = value
 		}
+		for flag, param := range map[string]**string{
+			listStatusFlag:        &params.Status,
+			listContinueFlag:      &params.Continue,
+			listSortFlag:          &params.Sort,
+			listCreatedAfterFlag:  &params.CreatedAfter,
+			listCreatedBeforeFlag: &params.CreatedBefore,
+		} {
+			if *param, err = getString(cmd, flag); err != nil {
+				return err
+			}
+		}
+		if params.Limit, err = setInt64(cmd, listLimitFlag); err != nil {
+			return err
+		}
 
 		var formatResponse format
 		if value, err := getString(cmd, formatFlag); err != nil {
@@ -63,6 +79,7 @@ func runBackupList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *c
 		}
 
 		payload := response.GetPayload()
+		printNextPage(cmd, response.XContinue)
 		if isDefaultPrintFormat(formatResponse) {
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
 			table.SetHeader([]string{"№", "ID", "Service ID", "Created", "Status"})
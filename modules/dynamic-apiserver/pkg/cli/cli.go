@@ -57,6 +57,8 @@ func makeClientClosure(httpClient *http.Client) func() (*client.ServiceAPI, erro
 
 		r.Consumers["application/json"] = runtime.JSONConsumer()
 		r.Producers["application/json"] = runtime.JSONProducer()
+		// watch operations write the event stream into the passed writer
+		r.Consumers["text/event-stream"] = runtime.ByteStreamConsumer()
 
 		appCli := client.New(r, strfmt.Default)
 		logDebugf("Server url: %v://%v", scheme, hostname)
@@ -84,7 +86,7 @@ func MakeRootCmd(httpClient *http.Client, k8sclient kubernetes.Interface) (*cobr
 	if err != nil {
 		return nil, err
 	}
-	rootCmd.PersistentFlags().String(tokenFlag, "8ZTjsD3t2Q3Yq-C4-hoahcFn", "Specify KuberLogic API server authentication token.\nThe authentication token is used for authentication to KuberLogic API every time an API request is made. Like passwords, Authentication tokens should remain a secret.")
+	rootCmd.PersistentFlags().String(tokenFlag, "", "Specify KuberLogic API server authentication token.\nThe authentication token is used for authentication to KuberLogic API every time an API request is made. Like passwords, Authentication tokens should remain a secret.")
 	err = viper.BindPFlag(tokenFlag, rootCmd.PersistentFlags().Lookup(tokenFlag))
 	if err != nil {
 		return nil, err
@@ -106,6 +108,10 @@ func MakeRootCmd(httpClient *http.Client, k8sclient kubernetes.Interface) (*cobr
 		makeServiceCmd(makeClientClosure(httpClient)),
 		makeBackupCmd(makeClientClosure(httpClient)),
 		makeRestoreCmd(makeClientClosure(httpClient)),
+		makePlanCmd(makeClientClosure(httpClient)),
+		makeTokenCmd(makeClientClosure(httpClient)),
+		makeWebhookCmd(makeClientClosure(httpClient)),
+		makeAuditCmd(makeClientClosure(httpClient)),
 
 		makeInstallCmd(k8sclient),
 		makeDiagCmd(),
@@ -141,13 +147,23 @@ func makeServiceCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Com
 		makeServiceEditCmd(apiClientFunc),
 		makeServiceDeleteCmd(apiClientFunc),
 		makeServiceListCmd(apiClientFunc),
+		makeServiceTypeListCmd(apiClientFunc),
 		makeServiceBackupCmd(apiClientFunc),
 		makeServiceCredentialsUpdateCmd(apiClientFunc),
 		makeServiceSecretsListCmd(apiClientFunc),
+		makeServiceSecretGetCmd(apiClientFunc),
+		makeServiceSecretRotateCmd(apiClientFunc),
+		makeServiceSecretSetCmd(apiClientFunc),
+		makeServiceEnvListCmd(apiClientFunc),
+		makeServiceEnvSetCmd(apiClientFunc),
+		makeServiceEnvUnsetCmd(apiClientFunc),
 		makeServiceArchiveCmd(apiClientFunc),
 		makeServiceUnarchiveCmd(apiClientFunc),
 		makeServiceLogsCmd(apiClientFunc),
 		makeServiceExplainCmd(apiClientFunc),
+		makeServiceMetricsCmd(apiClientFunc),
+		makeServiceWatchCmd(apiClientFunc),
+		makeServiceExecCmd(),
 	)
 
 	return operationGroupServiceCmd
@@ -180,3 +196,61 @@ func makeRestoreCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Com
 	)
 	return operationGroupRestoreCmd
 }
+
+func makePlanCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	operationGroupPlanCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Service plans related operations",
+	}
+
+	operationGroupPlanCmd.AddCommand(
+		makePlanAddCmd(apiClientFunc),
+		makePlanListCmd(apiClientFunc),
+		makePlanGetCmd(apiClientFunc),
+		makePlanEditCmd(apiClientFunc),
+		makePlanDeleteCmd(apiClientFunc),
+	)
+	return operationGroupPlanCmd
+}
+
+func makeTokenCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	operationGroupTokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "API tokens related operations",
+	}
+
+	operationGroupTokenCmd.AddCommand(
+		makeTokenAddCmd(apiClientFunc),
+		makeTokenListCmd(apiClientFunc),
+		makeTokenDeleteCmd(apiClientFunc),
+	)
+	return operationGroupTokenCmd
+}
+
+func makeWebhookCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	operationGroupWebhookCmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Webhook notifications related operations",
+	}
+
+	operationGroupWebhookCmd.AddCommand(
+		makeWebhookAddCmd(apiClientFunc),
+		makeWebhookListCmd(apiClientFunc),
+		makeWebhookDeleteCmd(apiClientFunc),
+		makeWebhookPingCmd(apiClientFunc),
+		makeWebhookDeadLetterListCmd(apiClientFunc),
+	)
+	return operationGroupWebhookCmd
+}
+
+func makeAuditCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	operationGroupAuditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log related operations",
+	}
+
+	operationGroupAuditCmd.AddCommand(
+		makeAuditListCmd(apiClientFunc),
+	)
+	return operationGroupAuditCmd
+}
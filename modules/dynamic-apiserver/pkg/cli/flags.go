@@ -5,9 +5,23 @@ const (
 	serviceIdFlag  = "service_id"
 	backupIdFlag   = "backup_id"
 	subscriptionId = "subscription_id"
+	tenantFlag     = "tenant"
+	planFlag       = "plan"
 
 	tokenFlag   = "token"
 	apiHostFlag = "hostname"
 	schemeFlag  = "scheme"
 	formatFlag  = "format"
 )
+
+// list pagination and filtering flags
+const (
+	listLimitFlag         = "limit"
+	listContinueFlag      = "continue"
+	listSortFlag          = "sort"
+	listTypeFlag          = "type"
+	listStatusFlag        = "status"
+	listDomainFlag        = "domain"
+	listCreatedAfterFlag  = "created-after"
+	listCreatedBeforeFlag = "created-before"
+)
@@ -198,7 +198,12 @@ func runInstall(k8sclient kubernetes.Interface) func(command *cobra.Command, arg
 			klParams.Set(installDeploymentId, uuid.New().String())
 		}
 
-		if value, err := getStringPrompt(command, tokenFlag, viper.GetString(tokenFlag), true, nil); err != nil {
+		defaultToken := viper.GetString(tokenFlag)
+		if defaultToken == "" {
+			// suggest a random token instead of a well known one
+			defaultToken = uuid.New().String()
+		}
+		if value, err := getStringPrompt(command, tokenFlag, defaultToken, true, nil); err != nil {
 			return errors.Wrapf(err, "error processing %s flag", tokenFlag)
 		} else if value != "" {
 
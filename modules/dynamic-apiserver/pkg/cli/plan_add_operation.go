@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	planNameFlag        = "name"
+	planDescriptionFlag = "description"
+	planRolloutFlag     = "rollout"
+)
+
+// makePlanAddCmd returns a cmd to handle operation planAdd
+func makePlanAddCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "planAdd",
+		Short:   `Create a service plan`,
+		Aliases: []string{"add"},
+		RunE:    runPlanAdd(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(planNameFlag, "", "Required. Plan name")
+	_ = cmd.MarkFlagRequired(planNameFlag)
+	_ = cmd.PersistentFlags().String("type", "", "Required. Service type of the plan")
+	_ = cmd.MarkFlagRequired("type")
+	addPlanFlags(cmd)
+	return cmd
+}
+
+// addPlanFlags adds flags of service parameters bundled by a plan
+func addPlanFlags(cmd *cobra.Command) {
+	_ = cmd.PersistentFlags().String(planDescriptionFlag, "", "Plan description")
+	_ = cmd.PersistentFlags().Int64("replicas", 1, "Service replicas count")
+	_ = cmd.PersistentFlags().String("version", "", "Service version")
+	_ = cmd.PersistentFlags().String("backup_schedule", "", "Backup schedule in cron format")
+
+	// limits
+	_ = cmd.PersistentFlags().String("limits.cpu", "", "CPU limits")
+	_ = cmd.PersistentFlags().String("limits.memory", "", "Memory limits")
+	_ = cmd.PersistentFlags().String("limits.storage", "", "Storage limits")
+}
+
+// planFromFlags fills the plan with parameters set by flags
+func planFromFlags(cmd *cobra.Command, item *models.ServicePlan) error {
+	if value, err := getString(cmd, planNameFlag); err != nil {
+		return err
+	} else if value != nil {
+		item.Name = value
+	} else {
+		return errors.New("Plan name is required")
+	}
+
+	if value, err := getString(cmd, "type"); err != nil {
+		return err
+	} else if value != nil {
+		item.Type = value
+	}
+
+	if value, err := getString(cmd, planDescriptionFlag); err != nil {
+		return err
+	} else if value != nil {
+		item.Description = *value
+	}
+
+	if value, err := setInt64(cmd, "replicas"); err != nil {
+		return err
+	} else if value != nil {
+		item.Replicas = value
+	}
+
+	if value, err := getString(cmd, "version"); err != nil {
+		return err
+	} else if value != nil {
+		item.Version = *value
+	}
+
+	if value, err := getString(cmd, "backup_schedule"); err != nil {
+		return err
+	} else if value != nil {
+		item.BackupSchedule = *value
+	}
+
+	limits := new(models.Limits)
+	if value, err := getString(cmd, "limits.cpu"); err != nil {
+		return err
+	} else if value != nil {
+		limits.CPU = *value
+	}
+
+	if value, err := getString(cmd, "limits.memory"); err != nil {
+		return err
+	} else if value != nil {
+		limits.Memory = *value
+	}
+
+	if value, err := getString(cmd, "limits.storage"); err != nil {
+		return err
+	} else if value != nil {
+		limits.Storage = *value
+	}
+	if *limits != (models.Limits{}) {
+		item.Limits = limits
+	}
+	return nil
+}
+
+// runPlanAdd uses cmd flags to call endpoint api
+func runPlanAdd(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := plan.NewPlanAddParams()
+		params.PlanItem = new(models.ServicePlan)
+		if err := planFromFlags(cmd, params.PlanItem); err != nil {
+			return err
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.PlanItem)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Plan.PlanAdd(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Plan '%s' successfully created\n", *payload.Name)
+			return err
+		}
+		return printResult(cmd, formatResponse, payload)
+	}
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+func TestPlanAdd(t *testing.T) {
+	var requested *models.ServicePlan
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		requested = new(models.ServicePlan)
+		_ = json.NewDecoder(req.Body).Decode(requested)
+		data, _ := json.Marshal(requested)
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"plan", "add",
+		"--name", "small",
+		"--type", "postgresql",
+		"--version", "13",
+		"--limits.cpu", "500m",
+	})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if *requested.Name != "small" || *requested.Type != "postgresql" || requested.Version != "13" ||
+		requested.Replicas != nil || requested.Limits == nil || requested.Limits.CPU != "500m" {
+		t.Errorf("unexpected plan request: %+v", requested)
+	}
+	expected := "Plan 'small' successfully created\n"
+	if b.String() != expected {
+		t.Errorf("expected vs actual: %s vs %s", expected, b.String())
+	}
+}
+
+func TestServiceAddPlan(t *testing.T) {
+	var requested *models.Service
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		code, data := 201, []byte(nil)
+		if req.Method == http.MethodGet {
+			code = 200
+			data, _ = json.Marshal(map[string]interface{}{
+				"name": "small",
+				"type": "postgresql",
+			})
+		} else {
+			requested = new(models.Service)
+			_ = json.NewDecoder(req.Body).Decode(requested)
+			data, _ = json.Marshal(requested)
+		}
+		return &http.Response{
+			StatusCode: code,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"service", "add", "--id", "demo", "--plan", "small"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if requested == nil || requested.Plan != "small" || requested.Type == nil || *requested.Type != "postgresql" || requested.Replicas != nil {
+		t.Errorf("unexpected service request: %+v", requested)
+	}
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
+)
+
+// makePlanDeleteCmd returns a cmd to handle operation planDelete
+func makePlanDeleteCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "planDelete",
+		Short:   `Delete a service plan not used by services`,
+		Aliases: []string{"delete"},
+		RunE:    runPlanDelete(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(planNameFlag, "", "Required. Plan name")
+	_ = cmd.MarkFlagRequired(planNameFlag)
+	return cmd
+}
+
+// runPlanDelete uses cmd flags to call endpoint api
+func runPlanDelete(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := plan.NewPlanDeleteParams()
+		if value, err := getString(cmd, planNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.PlanName = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.PlanName)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Plan.PlanDelete(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Plan '%s' successfully deleted\n", params.PlanName)
+			return err
+		}
+		return printResult(cmd, formatResponse, response)
+	}
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// makePlanEditCmd returns a cmd to handle operation planEdit
+func makePlanEditCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "planEdit",
+		Short:   `Edit a service plan`,
+		Aliases: []string{"edit"},
+		RunE:    runPlanEdit(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(planNameFlag, "", "Required. Plan name")
+	_ = cmd.MarkFlagRequired(planNameFlag)
+	addPlanFlags(cmd)
+	_ = cmd.PersistentFlags().Bool(planRolloutFlag, false, "Apply plan changes to all services created with the plan")
+	return cmd
+}
+
+// runPlanEdit uses cmd flags to call endpoint api
+func runPlanEdit(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := plan.NewPlanEditParams()
+		params.PlanItem = new(models.ServicePlan)
+		if err := planFromFlags(cmd, params.PlanItem); err != nil {
+			return err
+		}
+		params.PlanName = *params.PlanItem.Name
+
+		if value, err := getBool(cmd, planRolloutFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.Rollout = value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.PlanItem)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		// the plan type is required, but it can't be changed
+		getParams := plan.NewPlanGetParams()
+		getParams.PlanName = params.PlanName
+		current, err := apiClient.Plan.PlanGet(getParams,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		params.PlanItem.Type = current.GetPayload().Type
+
+		response, err := apiClient.Plan.PlanEdit(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			msg := "Plan '%s' successfully edited\n"
+			if params.Rollout != nil && *params.Rollout {
+				msg = "Plan '%s' successfully edited and rolled out\n"
+			}
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), msg, *payload.Name)
+			return err
+		}
+		return printResult(cmd, formatResponse, payload)
+	}
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+func TestPlanEditRollout(t *testing.T) {
+	var requested *models.ServicePlan
+	var rollout string
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		data, _ := json.Marshal(map[string]interface{}{
+			"name": "small",
+			"type": "postgresql",
+		})
+		if req.Method == http.MethodPatch {
+			requested = new(models.ServicePlan)
+			_ = json.NewDecoder(req.Body).Decode(requested)
+			rollout = req.URL.Query().Get("rollout")
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"plan", "edit", "--name", "small", "--version", "14", "--rollout"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if requested == nil || *requested.Type != "postgresql" || requested.Version != "14" || rollout != "true" {
+		t.Errorf("unexpected plan request: %+v, rollout %s", requested, rollout)
+	}
+	expected := "Plan 'small' successfully edited and rolled out\n"
+	if b.String() != expected {
+		t.Errorf("expected vs actual: %s vs %s", expected, b.String())
+	}
+}
+
+func TestPlanEditRolloutFailed(t *testing.T) {
+	expected := "service plan is updated, but 1 of 2 services are not: demo: error"
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		code, payload := 200, map[string]interface{}{"name": "small", "type": "postgresql"}
+		if req.Method == http.MethodPatch {
+			code, payload = 503, map[string]interface{}{"message": expected}
+		}
+		data, _ := json.Marshal(payload)
+		return &http.Response{
+			StatusCode: code,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"plan", "edit", "--name", "small", "--rollout"})
+	err = cmd.Execute()
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected vs actual: %v vs %v", expected, err)
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
+)
+
+// makePlanGetCmd returns a cmd to handle operation planGet
+func makePlanGetCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "planGet",
+		Short:   `Show a service plan`,
+		Aliases: []string{"get"},
+		RunE:    runPlanGet(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(planNameFlag, "", "Required. Plan name")
+	_ = cmd.MarkFlagRequired(planNameFlag)
+	return cmd
+}
+
+// runPlanGet uses cmd flags to call endpoint api
+func runPlanGet(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := plan.NewPlanGetParams()
+		if value, err := getString(cmd, planNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.PlanName = *value
+		}
+
+		formatResponse := yamlFormat
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil && !isDefaultPrintFormat(format(*value)) {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.PlanName)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Plan.PlanGet(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		return printResult(cmd, formatResponse, response.GetPayload())
+	}
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"strconv"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
+)
+
+// makePlanListCmd returns a cmd to handle operation planList
+func makePlanListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "planList",
+		Short:   `List service plans`,
+		Aliases: []string{"list"},
+		RunE:    runPlanList(apiClientFunc),
+	}
+	return cmd
+}
+
+// runPlanList uses cmd flags to call endpoint api
+func runPlanList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := plan.NewPlanListParams()
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Plan.PlanList(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{"№", "Name", "Type", "Version", "Replicas", "Description"})
+			table.SetBorder(false)
+			for i, item := range payload {
+				replicas := ""
+				if item.Replicas != nil {
+					replicas = strconv.FormatInt(*item.Replicas, 10)
+				}
+				table.Append([]string{
+					strconv.Itoa(i), *item.Name, *item.Type, item.Version, replicas, item.Description})
+			}
+			table.Render()
+		} else {
+			return printResult(cmd, formatResponse, payload)
+		}
+		return nil
+	}
+}
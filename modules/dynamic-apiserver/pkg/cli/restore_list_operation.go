@@ -23,6 +23,8 @@ func makeRestoreListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra
 	}
 
 	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Service id to filter by")
+	_ = cmd.PersistentFlags().String(listStatusFlag, "", "Restore status to filter by")
+	addListFlags(cmd)
 	return cmd
 }
 
@@ -42,6 +44,20 @@ func runRestoreList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 		} else if value != nil {
 			params.ServiceID = value
 		}
+		for flag, param := range map[string]**string{
+			listStatusFlag:        &params.Status,
+			listContinueFlag:      &params.Continue,
+			listSortFlag:          &params.Sort,
+			listCreatedAfterFlag:  &params.CreatedAfter,
+			listCreatedBeforeFlag: &params.CreatedBefore,
+		} {
+			if *param, err = getString(cmd, flag); err != nil {
+				return err
+			}
+		}
+		if params.Limit, err = setInt64(cmd, listLimitFlag); err != nil {
+			return err
+		}
 
 		var formatResponse format
 		if value, err := getString(cmd, formatFlag); err != nil {
@@ -62,6 +78,7 @@ func runRestoreList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 		}
 
 		payload := response.GetPayload()
+		printNextPage(cmd, response.XContinue)
 		if isDefaultPrintFormat(formatResponse) {
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
 			table.SetHeader([]string{"№", "ID", "Backup ID", "Created", "Status"})
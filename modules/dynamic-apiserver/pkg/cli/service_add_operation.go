@@ -8,6 +8,7 @@ import (
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 
@@ -25,14 +26,15 @@ func makeServiceAddCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.
 
 	_ = cmd.PersistentFlags().String(idFlag, "", "Required. Service id")
 	_ = cmd.MarkFlagRequired(idFlag)
-	_ = cmd.PersistentFlags().String("type", "", "Required. Supported service type")
-	_ = cmd.MarkFlagRequired("type")
+	_ = cmd.PersistentFlags().String("type", "", "Required unless a plan is set. Supported service type")
+	_ = cmd.PersistentFlags().String(planFlag, "", "Service plan. Plan parameters are used unless they are set by flags")
 	_ = cmd.PersistentFlags().Int64("replicas", 1, "Service replicas count")
 	_ = cmd.PersistentFlags().String("version", "", "Service version")
 	_ = cmd.PersistentFlags().String("backup_schedule", "", "Backup schedule in cron format")
 	_ = cmd.PersistentFlags().String("domain", "", "Custom domain for a service")
 	_ = cmd.PersistentFlags().Bool("insecure", false, "Use HTTP protocol instead of HTTPS")
 	_ = cmd.PersistentFlags().String(subscriptionId, "", "Subscription ID")
+	_ = cmd.PersistentFlags().String(tenantFlag, "", "Tenant owning the service. The tenant of the API token is used if not set")
  	_ = cmd.PersistentFlags().Bool("use_letsencrypt", false, "use Let's Encrypt for service as TLS certificate issuer")
 
 	// limits
@@ -72,6 +74,12 @@ func runServiceAdd(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *c
 			svc.Type = value
 		}
 
+		if value, err := getString(cmd, planFlag); err != nil {
+			return err
+		} else if value != nil {
+			svc.Plan = *value
+		}
+
 		if value, err := setInt64(cmd, "replicas"); err != nil {
 			return err
 		} else if value != nil {
@@ -114,6 +122,12 @@ func runServiceAdd(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *c
 			svc.Subscription = *value
 		}
 
+		if value, err := getString(cmd, tenantFlag); err != nil {
+			return err
+		} else if value != nil {
+			svc.Tenant = *value
+		}
+
 		if value, err := getString(cmd, "limits.cpu"); err != nil {
 			return err
 		} else if value != nil {
@@ -146,6 +160,18 @@ func runServiceAdd(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *c
 			return nil
 		}
 
+		// the service type is taken from the plan if it is not set
+		if svc.Type == nil && svc.Plan != "" {
+			planParams := plan.NewPlanGetParams()
+			planParams.PlanName = svc.Plan
+			planResponse, err := apiClient.Plan.PlanGet(planParams,
+				client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+			if err != nil {
+				return humanizeError(err)
+			}
+			svc.Type = planResponse.GetPayload().Type
+		}
+
 		// make request and then print result
 		response, err := apiClient.Service.ServiceAdd(params,
 			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
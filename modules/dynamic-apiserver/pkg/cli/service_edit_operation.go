@@ -31,6 +31,7 @@ func makeServiceEditCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra
 	_ = cmd.PersistentFlags().String("backup_schedule", "", "Backup schedule in cron format")
 	_ = cmd.PersistentFlags().Bool("insecure", false, "Use HTTP protocol instead of HTTPS")
 	_ = cmd.PersistentFlags().String("domain", "", "Custom domain for a service")
+	_ = cmd.PersistentFlags().String(planFlag, "", "Service plan. Parameters set by flags override the plan ones")
 
 	// limits
 	_ = cmd.PersistentFlags().String("limits.cpu", "", "CPU limits")
@@ -98,6 +99,12 @@ func runServiceEdit(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 			svc.Domain = *value
 		}
 
+		if value, err := getString(cmd, planFlag); err != nil {
+			return err
+		} else if value != nil {
+			svc.Plan = *value
+		}
+
 		if value, err := getString(cmd, "limits.cpu"); err != nil {
 			return err
 		} else if value != nil {
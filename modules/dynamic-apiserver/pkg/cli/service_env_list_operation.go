@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"strconv"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+)
+
+// makeServiceEnvListCmd returns a cmd to handle operation serviceEnvList
+func makeServiceEnvListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceEnvList",
+		Short:   `Lists environment variables of a service and parameters supported by the service type`,
+		Aliases: []string{"env"},
+		RunE:    runServiceEnvList(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+
+	return cmd
+}
+
+// runServiceEnvList uses cmd flags to call endpoint api
+func runServiceEnvList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		// retrieve flag values from cmd and fill params
+		params := service.NewServiceEnvListParams()
+
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		// make request and then print result
+		response, err := apiClient.Service.ServiceEnvList(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if !isDefaultPrintFormat(formatResponse) {
+			return printResult(cmd, formatResponse, payload)
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.SetHeader([]string{"Name", "Container", "Value"})
+		table.SetBorder(false)
+		for _, item := range payload.Variables {
+			value := item.Value
+			if item.Secret {
+				value = "<secret>"
+			}
+			table.Append([]string{item.Name, item.Container, value})
+		}
+		table.Render()
+
+		if len(payload.Parameters) == 0 {
+			return nil
+		}
+		cmd.Println()
+		cmd.Println("Supported parameters:")
+		table = tablewriter.NewWriter(cmd.OutOrStdout())
+		table.SetHeader([]string{"Name", "Container", "Type", "Default", "Secret", "Description"})
+		table.SetBorder(false)
+		for _, item := range payload.Parameters {
+			table.Append([]string{item.Name, item.Container, item.Type, item.Default, strconv.FormatBool(item.Secret), item.Description})
+		}
+		table.Render()
+		return nil
+	}
+}
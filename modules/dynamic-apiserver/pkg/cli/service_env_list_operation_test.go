@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+var testServiceEnv = models.ServiceEnv{
+	Variables: []*models.EnvVar{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "SMTP_PASSWORD", Container: "app", Secret: true},
+	},
+	Parameters: []*models.ParameterSchema{
+		{Name: "LOG_LEVEL", Type: "string", Default: "info", Description: "logging level"},
+		{Name: "SMTP_PASSWORD", Type: "string", Secret: true},
+	},
+}
+
+func TestServiceEnvListJson(t *testing.T) {
+	cmd, err := MakeRootCmd(makeTestClient(200, testServiceEnv), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "env", "--service_id", "test", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	result := models.ServiceEnv{}
+	if err := json.Unmarshal(b.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, testServiceEnv) {
+		t.Errorf("expected vs actual: %+v vs %s", testServiceEnv, b.String())
+	}
+}
+
+func TestServiceEnvList(t *testing.T) {
+	cmd, err := MakeRootCmd(makeTestClient(200, testServiceEnv), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "env", "--service_id", "test"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, expected := range []string{"LOG_LEVEL", "debug", "<secret>", "Supported parameters:", "logging level"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("%q is not found in the output:\n%s", expected, out)
+		}
+	}
+}
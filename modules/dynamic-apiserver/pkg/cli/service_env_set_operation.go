@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	envNameFlag      = "name"
+	envContainerFlag = "container"
+	envValueFlag     = "value"
+	envSecretFlag    = "secret"
+)
+
+// makeServiceEnvSetCmd returns a cmd to handle operation serviceEnvSet
+func makeServiceEnvSetCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceEnvSet",
+		Short:   `Sets an environment variable of a service, the service is restarted with the new value`,
+		Aliases: []string{"env-set"},
+		RunE:    runServiceEnvSet(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.PersistentFlags().String(envNameFlag, "", "Required. Variable name")
+	_ = cmd.PersistentFlags().String(envContainerFlag, "", "Set the variable only for the container")
+	_ = cmd.PersistentFlags().String(envValueFlag, "", "Variable value")
+	_ = cmd.PersistentFlags().Bool(envSecretFlag, false, "Store the value in a secret, the value is not shown afterwards")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	_ = cmd.MarkFlagRequired(envNameFlag)
+
+	return cmd
+}
+
+// runServiceEnvSet uses cmd flags to call endpoint api
+func runServiceEnvSet(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		// retrieve flag values from cmd and fill params
+		params := service.NewServiceEnvSetParams()
+		params.EnvValue = &models.EnvValue{}
+
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		}
+		if value, err := getString(cmd, envNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.EnvName = *value
+		}
+		if value, err := getString(cmd, envContainerFlag); err != nil {
+			return err
+		} else if value != nil && *value != "" {
+			params.Container = value
+		}
+		if value, err := getString(cmd, envValueFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.EnvValue.Value = *value
+		}
+		if value, err := getBool(cmd, envSecretFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.EnvValue.Secret = *value
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.ServiceID)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		_, err = apiClient.Service.ServiceEnvSet(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Variable '%s' of service '%s' is set\n", params.EnvName, params.ServiceID)
+		return err
+	}
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestServiceEnvSet(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     int
+		payload  interface{}
+		args     []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "ok",
+			code:     200,
+			payload:  map[string]interface{}{"name": "LOG_LEVEL", "value": "debug"},
+			args:     []string{"--value", "debug"},
+			expected: "Variable 'LOG_LEVEL' of service 'test' is set",
+		},
+		{
+			name:     "secret",
+			code:     200,
+			payload:  map[string]interface{}{"name": "LOG_LEVEL", "container": "app", "secret": true},
+			args:     []string{"--value", "debug", "--container", "app", "--secret"},
+			expected: "Variable 'LOG_LEVEL' of service 'test' is set",
+		},
+		{
+			name:    "invalid",
+			code:    400,
+			payload: map[string]interface{}{"message": "invalid environment variable"},
+			args:    []string{"--value", "debug"},
+			err:     "invalid environment variable",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := MakeRootCmd(makeTestClient(tc.code, tc.payload), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs(append([]string{"service", "env-set", "--service_id", "test", "--name", "LOG_LEVEL"}, tc.args...))
+			err = cmd.Execute()
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected vs actual: %v vs %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.TrimSpace(b.String()) != tc.expected {
+				t.Errorf("expected vs actual: %s vs %s", tc.expected, b.String())
+			}
+		})
+	}
+}
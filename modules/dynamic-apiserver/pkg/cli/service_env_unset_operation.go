@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+)
+
+// makeServiceEnvUnsetCmd returns a cmd to handle operation serviceEnvUnset
+func makeServiceEnvUnsetCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceEnvUnset",
+		Short:   `Removes an environment variable of a service`,
+		Aliases: []string{"env-unset"},
+		RunE:    runServiceEnvUnset(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.PersistentFlags().String(envNameFlag, "", "Required. Variable name")
+	_ = cmd.PersistentFlags().String(envContainerFlag, "", "Remove the variable set for the container")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	_ = cmd.MarkFlagRequired(envNameFlag)
+
+	return cmd
+}
+
+// runServiceEnvUnset uses cmd flags to call endpoint api
+func runServiceEnvUnset(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		// retrieve flag values from cmd and fill params
+		params := service.NewServiceEnvUnsetParams()
+
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		}
+		if value, err := getString(cmd, envNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.EnvName = *value
+		}
+		if value, err := getString(cmd, envContainerFlag); err != nil {
+			return err
+		} else if value != nil && *value != "" {
+			params.Container = value
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.ServiceID)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		_, err = apiClient.Service.ServiceEnvUnset(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Variable '%s' of service '%s' is removed\n", params.EnvName, params.ServiceID)
+		return err
+	}
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestServiceEnvUnset(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     int
+		payload  interface{}
+		expected string
+		err      string
+	}{
+		{
+			name:     "ok",
+			code:     200,
+			expected: "Variable 'LOG_LEVEL' of service 'test' is removed",
+		},
+		{
+			name:    "not-found",
+			code:    404,
+			payload: map[string]interface{}{"message": "environment variable not found: LOG_LEVEL"},
+			err:     "environment variable not found: LOG_LEVEL",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := MakeRootCmd(makeTestClient(tc.code, tc.payload), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs([]string{"service", "env-unset", "--service_id", "test", "--name", "LOG_LEVEL"})
+			err = cmd.Execute()
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected vs actual: %v vs %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.TrimSpace(b.String()) != tc.expected {
+				t.Errorf("expected vs actual: %s vs %s", tc.expected, b.String())
+			}
+		})
+	}
+}
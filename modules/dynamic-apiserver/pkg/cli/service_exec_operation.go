@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	stdinFlag = "stdin"
+	ttyFlag   = "tty"
+)
+
+// exec session channels, every WebSocket message starts with one of them
+const (
+	execStdinChannel byte = iota
+	execStdoutChannel
+	execStderrChannel
+	execStatusChannel
+	execResizeChannel
+)
+
+// execResizeInterval is how often the terminal is checked for size changes
+var execResizeInterval = 250 * time.Millisecond
+
+// makeServiceExecCmd returns a cmd to handle operation serviceExec
+func makeServiceExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceExec [service_id] -- COMMAND [args...]",
+		Short:   `Execute a command in a service container`,
+		Aliases: []string{"exec"},
+		RunE:    runServiceExec,
+	}
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Service id. Can be passed as the first argument instead")
+	_ = cmd.PersistentFlags().String(podNameFlag, "", "Pod name. The first running pod of the service is used if not set")
+	_ = cmd.PersistentFlags().String(containerNameFlag, "", "Container name. The first container of the pod is used if not set")
+	_ = cmd.PersistentFlags().BoolP(stdinFlag, "i", false, "Pass stdin to the command")
+	_ = cmd.PersistentFlags().BoolP(ttyFlag, "t", false, "Allocate a TTY for the command")
+	return cmd
+}
+
+// runServiceExec uses cmd flags and args to start an exec session
+func runServiceExec(cmd *cobra.Command, args []string) error {
+	dash := cmd.ArgsLenAtDash()
+	if dash < 0 || dash == len(args) {
+		return errors.New("command is required after --")
+	}
+	if dash > 1 {
+		return errors.New("only service id is accepted before --")
+	}
+
+	serviceID, err := getString(cmd, serviceIdFlag)
+	if err != nil {
+		return err
+	}
+	if dash == 1 {
+		serviceID = &args[0]
+	}
+	if serviceID == nil {
+		return errors.New("Service id is required")
+	}
+
+	query := url.Values{"Command": args[dash:]}
+	for param, flag := range map[string]string{"PodName": podNameFlag, "ContainerName": containerNameFlag} {
+		if value, err := getString(cmd, flag); err != nil {
+			return err
+		} else if value != nil {
+			query.Set(param, *value)
+		}
+	}
+	stdin, err := cmd.Flags().GetBool(stdinFlag)
+	if err != nil {
+		return err
+	}
+	tty, err := cmd.Flags().GetBool(ttyFlag)
+	if err != nil {
+		return err
+	}
+	query.Set("Stdin", fmt.Sprint(stdin))
+	query.Set("Tty", fmt.Sprint(tty))
+
+	scheme := "ws"
+	if viper.GetString(schemeFlag) == "https" {
+		scheme = "wss"
+	}
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     viper.GetString(apiHostFlag),
+		Path:     client.DefaultBasePath + "/services/" + *serviceID + "/exec",
+		RawQuery: query.Encode(),
+	}
+
+	if dryRun {
+		logDebugf("Url: %s", u.String())
+		logDebugf("dry-run flag specified. Skip sending request.")
+		return nil
+	}
+
+	header := http.Header{}
+	header.Set("X-Token", viper.GetString(tokenFlag))
+	conn, resp, err := websocket.DefaultDialer.DialContext(cmd.Context(), u.String(), header)
+	if err != nil {
+		return execDialError(resp, err)
+	}
+	defer conn.Close()
+
+	session := &execClientSession{conn: conn}
+	if stdin {
+		in := cmd.InOrStdin()
+		if f, ok := in.(*os.File); ok && tty && term.IsTerminal(int(f.Fd())) {
+			state, err := term.MakeRaw(int(f.Fd()))
+			if err != nil {
+				return errors.Wrap(err, "failed to switch terminal to raw mode")
+			}
+			defer func() {
+				_ = term.Restore(int(f.Fd()), state)
+			}()
+
+			done := make(chan struct{})
+			defer close(done)
+			go session.watchTerminalSize(int(f.Fd()), done)
+		}
+		go session.sendStdin(in)
+	}
+	return session.receive(cmd.OutOrStdout(), cmd.ErrOrStderr())
+}
+
+// execDialError returns the error reported by the server when the session can't be started
+func execDialError(resp *http.Response, err error) error {
+	if resp == nil {
+		return err
+	}
+	defer resp.Body.Close()
+	e := &models.Error{}
+	if decodeErr := json.NewDecoder(resp.Body).Decode(e); decodeErr != nil || e.Message == "" {
+		return errors.Wrapf(err, "failed to start exec session, status %d", resp.StatusCode)
+	}
+	return errors.New(e.Message)
+}
+
+// execClientSession exchanges channel messages with the server
+type execClientSession struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (s *execClientSession) send(channel byte, p []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteMessage(websocket.BinaryMessage, append([]byte{channel}, p...))
+}
+
+// sendStdin forwards in to the command, an empty message is sent when in is exhausted
+func (s *execClientSession) sendStdin(in io.Reader) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if sendErr := s.send(execStdinChannel, buf[:n]); sendErr != nil {
+				return
+			}
+		}
+		if err != nil {
+			_ = s.send(execStdinChannel, nil)
+			return
+		}
+	}
+}
+
+// watchTerminalSize sends terminal size to the server whenever it changes
+func (s *execClientSession) watchTerminalSize(fd int, done <-chan struct{}) {
+	ticker := time.NewTicker(execResizeInterval)
+	defer ticker.Stop()
+
+	var width, height int
+	for {
+		if w, h, err := term.GetSize(fd); err == nil && (w != width || h != height) {
+			width, height = w, h
+			data, _ := json.Marshal(map[string]int{"Width": w, "Height": h})
+			if err := s.send(execResizeChannel, data); err != nil {
+				return
+			}
+		}
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// receive writes the command output until the session is over and returns an error if the command failed
+func (s *execClientSession) receive(stdout, stderr io.Writer) error {
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return errors.Wrap(err, "exec session is interrupted")
+		}
+		if len(data) == 0 {
+			continue
+		}
+		switch data[0] {
+		case execStdoutChannel:
+			_, err = stdout.Write(data[1:])
+		case execStderrChannel:
+			_, err = stderr.Write(data[1:])
+		case execStatusChannel:
+			status := &models.ExecStatus{}
+			if err := json.Unmarshal(data[1:], status); err != nil {
+				return errors.Wrap(err, "failed to decode exec status")
+			}
+			if status.ExitCode != 0 || status.Message != "" {
+				return errors.New(status.Message)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// execTestServer starts an exec server echoing stdin to stdout and finishing with status
+func execTestServer(t *testing.T, status *models.ExecStatus, requests chan<- *http.Request) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests <- r
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if data[0] != execStdinChannel {
+				continue
+			}
+			if len(data) == 1 {
+				break
+			}
+			_ = conn.WriteMessage(websocket.BinaryMessage, append([]byte{execStdoutChannel}, data[1:]...))
+		}
+		_ = conn.WriteMessage(websocket.BinaryMessage, append([]byte{execStderrChannel}, "done"...))
+		payload, _ := json.Marshal(status)
+		_ = conn.WriteMessage(websocket.BinaryMessage, append([]byte{execStatusChannel}, payload...))
+	}))
+	t.Cleanup(srv.Close)
+	useTestServer(srv)
+}
+
+// useTestServer points the cli to srv.
+// Values are set explicitly, because flags are overridden by values set by other commands (e.g. install).
+func useTestServer(srv *httptest.Server) {
+	viper.Set(apiHostFlag, strings.TrimPrefix(srv.URL, "http://"))
+	viper.Set(schemeFlag, "http")
+}
+
+func TestServiceExec(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	execTestServer(t, &models.ExecStatus{}, requests)
+	viper.Set(tokenFlag, "secret")
+
+	cmd, err := MakeRootCmd(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := bytes.NewBufferString(""), bytes.NewBufferString("")
+	cmd.SetIn(bytes.NewBufferString("hello"))
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"service", "exec", "demo", "--container", "app", "-i", "--", "cat", "-n"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if stdout.String() != "hello" {
+		t.Errorf("unexpected stdout: %q", stdout.String())
+	}
+	if stderr.String() != "done" {
+		t.Errorf("unexpected stderr: %q", stderr.String())
+	}
+
+	req := <-requests
+	if !strings.HasSuffix(req.URL.Path, "/services/demo/exec") {
+		t.Errorf("unexpected path: %s", req.URL.Path)
+	}
+	expected := url.Values{
+		"Command":       {"cat", "-n"},
+		"ContainerName": {"app"},
+		"Stdin":         {"true"},
+		"Tty":           {"false"},
+	}
+	if !reflect.DeepEqual(req.URL.Query(), expected) {
+		t.Errorf("query does not equal: actual vs expected\n%v\n%v", req.URL.Query(), expected)
+	}
+	if token := req.Header.Get("X-Token"); token != "secret" {
+		t.Errorf("unexpected token: %s", token)
+	}
+}
+
+func TestServiceExecFailed(t *testing.T) {
+	execTestServer(t, &models.ExecStatus{ExitCode: 2, Message: "command terminated with exit code 2"}, make(chan *http.Request, 1))
+
+	cmd, err := MakeRootCmd(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetIn(bytes.NewBufferString(""))
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"service", "exec", "--service_id", "demo", "-i", "--", "false"})
+	err = cmd.Execute()
+	if err == nil || err.Error() != "command terminated with exit code 2" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceExecRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(rw).Encode(&models.Error{Message: "service does not exist"})
+	}))
+	defer srv.Close()
+	useTestServer(srv)
+
+	cmd, err := MakeRootCmd(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"service", "exec", "demo", "--", "sh"})
+	err = cmd.Execute()
+	if err == nil || err.Error() != "service does not exist" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceExecArgs(t *testing.T) {
+	for _, args := range [][]string{
+		{"service", "exec", "demo"},
+		{"service", "exec", "demo", "--"},
+		{"service", "exec", "demo", "other", "--", "sh"},
+		{"service", "exec", "--", "sh"},
+	} {
+		cmd, err := MakeRootCmd(nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd.SetOut(bytes.NewBufferString(""))
+		cmd.SetErr(bytes.NewBufferString(""))
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("%v must fail", args)
+		}
+	}
+}
@@ -12,7 +12,7 @@ import (
 	"strconv"
 )
 
-// makeServiceExplainCmd returns a cmd to handle operation serviceLogs
+// makeServiceExplainCmd returns a cmd to handle operation serviceExplain
 func makeServiceExplainCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "serviceExplain",
@@ -69,68 +69,114 @@ func runServiceExplain(apiClientFunc func() (*client.ServiceAPI, error)) func(cm
 
 		payload := response.GetPayload()
 
-		if payload.Ingress.Error != "" {
-			cmd.Printf("Ingress error: %s", payload.Ingress.Error)
-		} else {
-			printIngress(cmd, payload.Ingress)
+		cmd.Printf("Namespace: %s\n", valueOrDash(payload.Namespace))
+		cmd.Printf("Phase: %s\n", valueOrDash(payload.Phase))
+		if payload.LastError != nil {
+			cmd.Printf("Last error: %s (%s)\n", payload.LastError.Message, payload.LastError.Time)
 		}
+		cmd.Println()
 
-		if payload.Pod.Error != "" {
-			cmd.Printf("Container errors: %s\n", payload.Pod.Error)
-		} else {
-			printContainers(cmd, payload.Pod)
+		if len(payload.Conditions) > 0 {
+			printConditions(cmd, payload.Conditions)
 		}
-
-		if payload.Pvc.Error != "" {
-			cmd.Printf("PVC error: %s\n", payload.Pvc.Error)
-		} else {
-			printPvc(cmd, payload.Pvc)
+		if len(payload.Components) > 0 {
+			printComponents(cmd, payload.Components)
+		}
+		if len(payload.Objects) > 0 {
+			printObjects(cmd, payload.Objects)
+		}
+		if len(payload.Pods) > 0 {
+			printContainers(cmd, payload.Pods)
+		}
+		if len(payload.Events) > 0 {
+			printEvents(cmd, payload.Events)
+		}
+		for _, w := range payload.Warnings {
+			cmd.Printf("Warning: %s\n", w)
 		}
 		return nil
 	}
 }
 
-func printContainers(cmd *cobra.Command, data *models.ExplainPod) {
+func printConditions(cmd *cobra.Command, conditions []*models.ExplainCondition) {
 	table := tablewriter.NewWriter(cmd.OutOrStdout())
-
-	table.SetHeader([]string{"№", "NAME", "STATUS", "RESTART COUNT"})
-
+	table.SetHeader([]string{"TYPE", "STATUS", "REASON", "MESSAGE", "LAST TRANSITION"})
 	table.SetBorder(false)
-	for i, item := range data.Containers {
-		table.Append([]string{
-			strconv.Itoa(i + 1), item.Name, item.Status, strconv.FormatInt(*item.RestartCount, 10),
-		})
+	table.SetAutoWrapText(false)
+	for _, c := range conditions {
+		table.Append([]string{c.Type, c.Status, c.Reason, c.Message, c.LastTransitionTime.String()})
 	}
-	cmd.Println("Containers:")
+	cmd.Println("Conditions:")
 	table.Render()
 	cmd.Println()
 }
 
-func printPvc(cmd *cobra.Command, data *models.ExplainPvc) {
+func printComponents(cmd *cobra.Command, components []*models.ExplainComponent) {
 	table := tablewriter.NewWriter(cmd.OutOrStdout())
-
-	table.SetHeader([]string{"PHASE", "SIZE", "STORAGE CLASS"})
+	table.SetHeader([]string{"KIND", "NAME", "READY", "MESSAGE"})
 	table.SetBorder(false)
-	table.Append([]string{
-		data.Phase, data.Size, data.StorageClass,
-	})
+	for _, c := range components {
+		table.Append([]string{c.Kind, c.Name, strconv.FormatBool(c.Ready), c.Message})
+	}
+	cmd.Println("Components:")
+	table.Render()
+	cmd.Println()
+}
 
-	cmd.Println("Storage:")
+func printObjects(cmd *cobra.Command, objects []*models.ExplainObject) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"KIND", "NAME", "STATUS"})
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	for _, o := range objects {
+		status := o.Status
+		if o.Error != "" {
+			status = "error: " + o.Error
+		}
+		table.Append([]string{o.Kind, o.Name, valueOrDash(status)})
+	}
+	cmd.Println("Objects:")
 	table.Render()
 	cmd.Println()
 }
 
-func printIngress(cmd *cobra.Command, data *models.ExplainIngress) {
+func printContainers(cmd *cobra.Command, pods []*models.ExplainPod) {
 	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"POD", "PHASE", "NODE", "CONTAINER", "STATUS", "REASON", "RESTART COUNT"})
+	table.SetBorder(false)
+	for _, pod := range pods {
+		if len(pod.Containers) == 0 {
+			table.Append([]string{pod.Name, pod.Phase, valueOrDash(pod.Node), "-", "-", "-", "-"})
+		}
+		for _, c := range pod.Containers {
+			restarts := "-"
+			if c.RestartCount != nil {
+				restarts = strconv.FormatInt(*c.RestartCount, 10)
+			}
+			table.Append([]string{pod.Name, pod.Phase, valueOrDash(pod.Node), c.Name, c.Status, valueOrDash(c.Reason), restarts})
+		}
+	}
+	cmd.Println("Pods:")
+	table.Render()
+	cmd.Println()
+}
 
-	table.SetHeader([]string{"№", "HOST", "INGRESS CLASS"})
+func printEvents(cmd *cobra.Command, events []*models.ExplainEvent) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"TIME", "TYPE", "REASON", "OBJECT", "MESSAGE"})
 	table.SetBorder(false)
-	for i, item := range data.Hosts {
-		table.Append([]string{
-			strconv.Itoa(i + 1), item, data.IngressClass,
-		})
+	table.SetAutoWrapText(false)
+	for _, e := range events {
+		table.Append([]string{e.Time.String(), e.Type, e.Reason, e.Object, e.Message})
 	}
-	cmd.Println("Ingress:")
+	cmd.Println("Events:")
 	table.Render()
 	cmd.Println()
 }
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -5,41 +5,54 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
 )
 
-func TestExplainJson(t *testing.T) {
-	input := models.Explain{
-		Ingress: &models.ExplainIngress{
-			Hosts:        []string{"example.com", "example.org"},
-			IngressClass: "kong",
-		},
-		Pod: &models.ExplainPod{
-			Containers: []*models.ExplainPodContainersItems0{
+var testExplain = models.Explain{
+	Namespace: "test",
+	Phase:     "NotReady",
+	Conditions: []*models.ExplainCondition{
+		{Type: "Ready", Status: "False", Reason: "ReadyConditionNotMet"},
+	},
+	Components: []*models.ExplainComponent{
+		{Kind: "Deployment", Name: "test", Message: "0/1 replicas are ready"},
+	},
+	Objects: []*models.ExplainObject{
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "test", Status: "0/1 replicas are ready"},
+		{APIVersion: "v1", Kind: "Secret", Name: "test", Error: "object does not exist"},
+	},
+	Pods: []*models.ExplainPod{
+		{
+			Name:  "test-0",
+			Phase: "Pending",
+			Containers: []*models.ExplainContainer{
 				{
 					Name:         "foo",
 					RestartCount: util.Int64AsPointer(5),
-					Status:       "running",
+					Status:       "waiting",
+					Reason:       "CrashLoopBackOff",
 				},
 				{
 					Name:         "bar",
-					RestartCount: util.Int64AsPointer(30),
-					Status:       "failing",
+					RestartCount: util.Int64AsPointer(0),
+					Status:       "running",
 				},
 			},
 		},
-		Pvc: &models.ExplainPvc{
-			Phase:        "Bound",
-			Size:         "10Gi",
-			StorageClass: "standard",
-		},
-	}
+	},
+	Events: []*models.ExplainEvent{
+		{Type: "Warning", Reason: "BackOff", Object: "Pod/test-0", Message: "Back-off restarting failed container", Count: 3},
+	},
+	LastError: &models.ExplainError{Message: "failed to syc Deployment test/test"},
+	Warnings:  []string{"failed to list events"},
+}
 
-	expectedJson, _ := json.Marshal(input)
-	client := makeTestClient(200, input)
+func TestExplainJson(t *testing.T) {
+	client := makeTestClient(200, testExplain)
 	cmd, err := MakeRootCmd(client, nil)
 	if err != nil {
 		t.Fatal(err)
@@ -63,7 +76,37 @@ func TestExplainJson(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if reflect.DeepEqual(result, input) {
-		t.Errorf("Expected %+v, got %+v", expectedJson, result)
+	if !reflect.DeepEqual(*result, testExplain) {
+		expectedJson, _ := json.Marshal(testExplain)
+		t.Errorf("Expected %s, got %s", expectedJson, actual)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	client := makeTestClient(200, testExplain)
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "explain", "--service_id", "test"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, expected := range []string{
+		"Namespace: test", "Phase: NotReady", "Last error: failed to syc Deployment test/test",
+		"Conditions:", "ReadyConditionNotMet",
+		"Components:", "0/1 replicas are ready",
+		"Objects:", "error: object does not exist",
+		"Pods:", "CrashLoopBackOff",
+		"Events:", "Back-off restarting failed container",
+		"Warning: failed to list events",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("%q is not found in the output:\n%s", expected, out)
+		}
 	}
 }
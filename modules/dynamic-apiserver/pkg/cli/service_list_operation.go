@@ -23,6 +23,10 @@ func makeServiceListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra
 	}
 
 	_ = cmd.PersistentFlags().String(subscriptionId, "", "Subscription id to filter by")
+	_ = cmd.PersistentFlags().String(listTypeFlag, "", "Service type to filter by")
+	_ = cmd.PersistentFlags().String(listStatusFlag, "", "Service status to filter by")
+	_ = cmd.PersistentFlags().String(listDomainFlag, "", "Service domain to filter by")
+	addListFlags(cmd, "type", "domain", "status")
 
 	return cmd
 }
@@ -38,6 +42,23 @@ func runServiceList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 		}
 
 		params := service.NewServiceListParams()
+		for flag, param := range map[string]**string{
+			subscriptionId:        &params.SubscriptionID,
+			listTypeFlag:          &params.Type,
+			listStatusFlag:        &params.Status,
+			listDomainFlag:        &params.Domain,
+			listContinueFlag:      &params.Continue,
+			listSortFlag:          &params.Sort,
+			listCreatedAfterFlag:  &params.CreatedAfter,
+			listCreatedBeforeFlag: &params.CreatedBefore,
+		} {
+			if *param, err = getString(cmd, flag); err != nil {
+				return err
+			}
+		}
+		if params.Limit, err = setInt64(cmd, listLimitFlag); err != nil {
+			return err
+		}
 
 		var formatResponse format
 		if value, err := getString(cmd, "format"); err != nil {
@@ -58,6 +79,7 @@ func runServiceList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 		}
 
 		payload := response.GetPayload()
+		printNextPage(cmd, response.XContinue)
 		if isDefaultPrintFormat(formatResponse) {
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
 			table.SetHeader([]string{"№", "ID", "Subscription ID", "Type", "Replica", "Version", "Backup Schedule", "Status", "Endpoint"})
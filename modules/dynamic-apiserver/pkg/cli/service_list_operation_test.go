@@ -11,6 +11,7 @@ import (
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 	"github.com/olekukonko/tablewriter"
 	"io/ioutil"
+	"net/http"
 	"reflect"
 	"strconv"
 	"strings"
@@ -251,3 +252,50 @@ func TestListFormatStr(t *testing.T) {
 		t.Fatalf("expected vs actual: %s vs %s", buff.String(), out)
 	}
 }
+
+func TestListPagination(t *testing.T) {
+	var query map[string][]string
+	data, _ := json.Marshal([]map[string]interface{}{
+		{"id": "test-1", "type": "postgresql", "replicas": 1},
+	})
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		query = req.URL.Query()
+		header := make(http.Header)
+		header.Set("X-Continue", "next-page-token")
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     header,
+		}
+	})
+	cmd, err := MakeRootCmd(httpClient, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, stderr := bytes.NewBufferString(""), bytes.NewBufferString("")
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"service", "list", "--limit", "1", "--sort", "-created_at", "--type", "postgresql",
+		"--created-after", "2022-05-10T16:00:53Z", "--continue", "token"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		"limit":         {"1"},
+		"sort":          {"-created_at"},
+		"type":          {"postgresql"},
+		"created_after": {"2022-05-10T16:00:53Z"},
+		"continue":      {"token"},
+	}
+	if !reflect.DeepEqual(query, expected) {
+		t.Errorf("query does not equal: actual vs expected\n%v\n%v", query, expected)
+	}
+	if !strings.Contains(stdout.String(), "test-1") {
+		t.Errorf("service is expected in the output:\n%s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "--continue next-page-token") {
+		t.Errorf("next page hint is expected in stderr:\n%s", stderr.String())
+	}
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 
 	client2 "github.com/go-openapi/runtime/client"
@@ -10,15 +12,21 @@ import (
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
 
 	"github.com/spf13/cobra"
 )
 
 const (
 	containerNameFlag = "container"
+	podNameFlag       = "pod"
+	followFlag        = "follow"
+	tailFlag          = "tail"
+	sinceFlag         = "since"
+	previousFlag      = "previous"
 )
 
-// makeServiceLogsCmd returns a cmd to handle operation serviceLogs
+// makeServiceLogsCmd returns a cmd to handle operations serviceLogs and serviceLogsFollow
 func makeServiceLogsCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "serviceLogs",
@@ -28,7 +36,12 @@ func makeServiceLogsCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra
 	}
 	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
 	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	_ = cmd.PersistentFlags().String(podNameFlag, "", "List logs only for specified pod")
 	_ = cmd.PersistentFlags().String(containerNameFlag, "", "List logs only for specified container")
+	_ = cmd.PersistentFlags().BoolP(followFlag, "f", false, "Stream new logs as they are written")
+	_ = cmd.PersistentFlags().Int64(tailFlag, -1, "Number of recent lines to show. All lines are shown if negative")
+	_ = cmd.PersistentFlags().Duration(sinceFlag, 0, "Show only logs newer than a relative duration like 5s, 2m or 3h")
+	_ = cmd.PersistentFlags().Bool(previousFlag, false, "Show logs of the previous terminated container instance")
 	return cmd
 }
 
@@ -42,7 +55,7 @@ func runServiceLogs(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 			return err
 		}
 
-		params := service.NewServiceLogsParams()
+		params := service.NewServiceLogsParamsWithContext(cmd.Context())
 
 		if value, err := getString(cmd, serviceIdFlag); err != nil {
 			return err
@@ -52,31 +65,131 @@ func runServiceLogs(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *
 			return errors.New("Service id is required")
 		}
 
+		if value, err := getString(cmd, podNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.PodName = value
+		}
+
 		if value, err := getString(cmd, containerNameFlag); err != nil {
 			return err
 		} else if value != nil {
 			params.ContainerName = value
 		}
 
+		if value, err := cmd.Flags().GetInt64(tailFlag); err != nil {
+			return err
+		} else if value >= 0 {
+			params.TailLines = &value
+		}
+
+		if value, err := cmd.Flags().GetDuration(sinceFlag); err != nil {
+			return err
+		} else if value < 0 {
+			return errors.Errorf("invalid %s value: %s", sinceFlag, value)
+		} else if value > 0 {
+			seconds := int64(math.Ceil(value.Seconds()))
+			params.SinceSeconds = &seconds
+		}
+
+		if value, err := cmd.Flags().GetBool(previousFlag); err != nil {
+			return err
+		} else if value {
+			params.Previous = &value
+		}
+
+		follow, err := cmd.Flags().GetBool(followFlag)
+		if err != nil {
+			return err
+		}
+		if follow && params.Previous != nil {
+			return errors.Errorf("%s and %s flags can not be used together", followFlag, previousFlag)
+		}
+
 		if dryRun {
-			logDebugf("Params: %+v", params.ContainerName)
+			logDebugf("Params: %+v", params)
 			logDebugf("dry-run flag specified. Skip sending request.")
 			return nil
 		}
 
+		if follow {
+			return followServiceLogs(cmd, apiClient, params)
+		}
+
 		// make request and then print result
 		response, err := apiClient.Service.ServiceLogs(params,
 			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
 		if err != nil {
 			return humanizeError(err)
 		}
+
+		pods := make(map[string]bool)
+		for _, log := range response.GetPayload() {
+			pods[log.PodName] = true
+		}
 		output := ""
-		for _, container := range response.GetPayload() {
-			for _, line := range strings.Split(container.Logs, "\n") {
-				output += fmt.Sprintf("%s:\t%s\n", container.ContainerName, line)
+		for _, log := range response.GetPayload() {
+			prefix := logPrefix(log, len(pods) > 1)
+			for _, line := range strings.Split(log.Logs, "\n") {
+				output += fmt.Sprintf("%s:\t%s\n", prefix, line)
 			}
 		}
 		_, err = fmt.Fprint(cmd.OutOrStdout(), output)
 		return err
 	}
 }
+
+// followServiceLogs prints log lines as they come until all service containers exit
+func followServiceLogs(cmd *cobra.Command, apiClient *client.ServiceAPI, logsParams *service.ServiceLogsParams) error {
+	var eof bool
+	stream := &eventStreamWriter{
+		onEvent: func(event string, data []byte) error {
+			switch event {
+			case "error":
+				e := &models.Error{}
+				if err := json.Unmarshal(data, e); err != nil {
+					return err
+				}
+				return errors.New(e.Message)
+			case "eof":
+				eof = true
+				return nil
+			}
+
+			log := &models.Log{}
+			if err := json.Unmarshal(data, log); err != nil {
+				return errors.Wrap(err, "error decoding log event")
+			}
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "%s:\t%s\n", logPrefix(log, logsParams.PodName == nil), log.Logs)
+			return err
+		},
+	}
+
+	auth := client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag))
+	// the server closes the stream periodically, keep following from the last received line
+	for !eof {
+		params := service.NewServiceLogsFollowParamsWithContext(cmd.Context())
+		params.ServiceID = logsParams.ServiceID
+		params.PodName = logsParams.PodName
+		params.ContainerName = logsParams.ContainerName
+		params.TailLines = logsParams.TailLines
+		params.SinceSeconds = logsParams.SinceSeconds
+		if stream.lastID != "" {
+			lastID := stream.lastID
+			params.LastEventID = &lastID
+		}
+
+		if _, err := apiClient.Service.ServiceLogsFollow(params, auth, stream); err != nil {
+			return humanizeError(err)
+		}
+	}
+	return nil
+}
+
+// logPrefix returns a prefix for service log lines
+func logPrefix(log *models.Log, withPod bool) string {
+	if withPod {
+		return log.PodName + "/" + log.ContainerName
+	}
+	return log.ContainerName
+}
@@ -2,8 +2,11 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -40,3 +43,84 @@ func TestLogs(t *testing.T) {
 		t.Fatalf("expected vs actual: %s vs %s", expected, actual)
 	}
 }
+
+func TestLogsMultiplePods(t *testing.T) {
+	input := models.Logs{
+		{PodName: "first", ContainerName: "test", Logs: "line 1"},
+		{PodName: "second", ContainerName: "test", Logs: "line 2"},
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var query url.Values
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		query = req.URL.Query()
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     make(http.Header),
+		}
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "logs", "--service_id", "test", "--tail", "10", "--since", "90s", "--previous"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "first/test:\tline 1\nsecond/test:\tline 2\n"
+	if b.String() != expected {
+		t.Fatalf("expected vs actual: %q vs %q", expected, b.String())
+	}
+	for k, v := range map[string]string{"TailLines": "10", "SinceSeconds": "90", "Previous": "true"} {
+		if query.Get(k) != v {
+			t.Errorf("unexpected %s query value: %s", k, query.Get(k))
+		}
+	}
+}
+
+func TestLogsFollow(t *testing.T) {
+	var requests []*http.Request
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req)
+		if len(requests) == 1 {
+			return makeEventStreamResponse(t, "2022-07-01T10:00:00.5Z", "log", &models.Log{PodName: "first", ContainerName: "test", Logs: "line 1"})
+		}
+		// stream is closed by server, next one must resume from the last received line
+		return makeEventStreamResponse(t, "", "eof", struct{}{})
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "logs", "--service_id", "test", "-f", "--tail", "5"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "first/test:\tline 1\n"
+	if b.String() != expected {
+		t.Fatalf("expected vs actual: %q vs %q", expected, b.String())
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if p := requests[0].URL.Path; !strings.HasSuffix(p, "/services/test/logs/follow") {
+		t.Errorf("unexpected path: %s", p)
+	}
+	if tail := requests[0].URL.Query().Get("TailLines"); tail != "5" {
+		t.Errorf("unexpected tail lines: %s", tail)
+	}
+	if id := requests[1].Header.Get("Last-Event-ID"); id != "2022-07-01T10:00:00.5Z" {
+		t.Errorf("logs are not resumed from the last line: %s", id)
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"strconv"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// makeServiceMetricsCmd returns a cmd to handle operation serviceMetrics
+func makeServiceMetricsCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceMetrics",
+		Short:   `Display resource usage of service`,
+		Aliases: []string{"top", "metrics"},
+		RunE:    runServiceMetrics(apiClientFunc),
+	}
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	return cmd
+}
+
+// runServiceMetrics uses cmd flags to call endpoint api
+func runServiceMetrics(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := service.NewServiceMetricsParams()
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		} else {
+			return errors.New("Service id is required")
+		}
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.ServiceID)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Service.ServiceMetrics(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		payload := response.GetPayload()
+		if !isDefaultPrintFormat(formatResponse) {
+			return printResult(cmd, formatResponse, payload)
+		}
+
+		printUsage(cmd, payload)
+		printContainerUsage(cmd, payload.Containers)
+		if len(payload.Volumes) > 0 {
+			printVolumeUsage(cmd, payload.Volumes)
+		}
+		for _, w := range payload.Warnings {
+			cmd.Printf("Warning: %s\n", w)
+		}
+		return nil
+	}
+}
+
+func printUsage(cmd *cobra.Command, metrics *models.ServiceMetrics) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"RESOURCE", "USED", "LIMIT", "USED %"})
+	table.SetBorder(false)
+	for _, r := range []struct {
+		name  string
+		usage *models.ResourceUsage
+	}{
+		{"cpu", metrics.CPU},
+		{"memory", metrics.Memory},
+		{"storage", metrics.Storage},
+	} {
+		if r.usage == nil {
+			continue
+		}
+		limit, percent := "-", "-"
+		if r.usage.Limit != "" {
+			limit = r.usage.Limit
+		}
+		if r.usage.Percent != nil {
+			percent = strconv.FormatFloat(*r.usage.Percent, 'f', 1, 64)
+		}
+		table.Append([]string{r.name, r.usage.Used, limit, percent})
+	}
+	cmd.Println("Usage:")
+	table.Render()
+	cmd.Println()
+}
+
+func printContainerUsage(cmd *cobra.Command, containers []*models.ContainerUsage) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"POD", "CONTAINER", "CPU", "MEMORY"})
+	table.SetBorder(false)
+	for _, c := range containers {
+		table.Append([]string{c.Pod, c.Name, c.CPU, c.Memory})
+	}
+	cmd.Println("Containers:")
+	table.Render()
+	cmd.Println()
+}
+
+func printVolumeUsage(cmd *cobra.Command, volumes []*models.VolumeUsage) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"POD", "VOLUME", "CLAIM", "USED", "CAPACITY", "AVAILABLE"})
+	table.SetBorder(false)
+	for _, v := range volumes {
+		table.Append([]string{v.Pod, v.Name, v.Claim, v.Used, v.Capacity, v.Available})
+	}
+	cmd.Println("Volumes:")
+	table.Render()
+	cmd.Println()
+}
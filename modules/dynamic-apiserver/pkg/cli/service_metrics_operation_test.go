@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+func TestServiceTop(t *testing.T) {
+	percent := 40.0
+	client := makeTestClient(200, &models.ServiceMetrics{
+		CPU:     &models.ResourceUsage{Used: "400m", Limit: "1", Percent: &percent},
+		Memory:  &models.ResourceUsage{Used: "512Mi"},
+		Storage: &models.ResourceUsage{Used: "1Gi"},
+		Containers: []*models.ContainerUsage{
+			{Pod: "demo-0", Name: "app", CPU: "400m", Memory: "512Mi"},
+		},
+		Volumes: []*models.VolumeUsage{
+			{Pod: "demo-0", Name: "data", Claim: "data-demo", Used: "1Gi", Capacity: "10Gi", Available: "9Gi"},
+		},
+		Warnings: []string{"volume usage of node node-2 is not available"},
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "top", "--service_id", "demo"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, expected := range []string{
+		"400m", "1", "40.0",
+		"memory", "512Mi", "-",
+		"demo-0", "data-demo", "10Gi",
+		"Warning: volume usage of node node-2 is not available",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("%q is not found in the output:\n%s", expected, out)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+)
+
+const (
+	secretIdFlag    = "secret_id"
+	secretValueFlag = "value"
+)
+
+// makeServiceSecretGetCmd returns a cmd to handle operation serviceSecretGet
+func makeServiceSecretGetCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceSecretGet",
+		Short:   `Reveals a value of a service secret`,
+		Aliases: []string{"secret-get"},
+		RunE:    runServiceSecretGet(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.PersistentFlags().String(secretIdFlag, "", "Required. Secret id")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	_ = cmd.MarkFlagRequired(secretIdFlag)
+
+	return cmd
+}
+
+// runServiceSecretGet uses cmd flags to call endpoint api
+func runServiceSecretGet(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		// retrieve flag values from cmd and fill params
+		params := service.NewServiceSecretGetParams()
+
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		}
+		if value, err := getString(cmd, secretIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.SecretID = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		// make request and then print result
+		response, err := apiClient.Service.ServiceSecretGet(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), payload.Value)
+			return err
+		}
+		return printResult(cmd, formatResponse, payload)
+	}
+}
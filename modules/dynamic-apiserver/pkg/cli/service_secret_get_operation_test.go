@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestServiceSecretGet(t *testing.T) {
+	client := makeTestClient(200, map[string]interface{}{
+		"id": "token", "value": "secret-value", "visible": true,
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "secret-get", "--service_id", "test", "--secret_id", "token"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(b.String()) != "secret-value" {
+		t.Errorf("expected vs actual: %s vs %s", "secret-value", b.String())
+	}
+}
+
+func TestServiceSecretGetNotFound(t *testing.T) {
+	client := makeTestClient(404, map[string]interface{}{
+		"message": "secret not found: token",
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"service", "secret-get", "--service_id", "test", "--secret_id", "token"})
+	err = cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "secret not found: token") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+)
+
+// makeServiceSecretRotateCmd returns a cmd to handle operation serviceSecretRotate
+func makeServiceSecretRotateCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceSecretRotate",
+		Short:   `Regenerates a service secret, the service is restarted with the new value`,
+		Aliases: []string{"secret-rotate"},
+		RunE:    runServiceSecretRotate(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.PersistentFlags().String(secretIdFlag, "", "Required. Secret id")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	_ = cmd.MarkFlagRequired(secretIdFlag)
+
+	return cmd
+}
+
+// runServiceSecretRotate uses cmd flags to call endpoint api
+func runServiceSecretRotate(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		// retrieve flag values from cmd and fill params
+		params := service.NewServiceSecretRotateParams()
+
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		}
+		if value, err := getString(cmd, secretIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.SecretID = *value
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		_, err = apiClient.Service.ServiceSecretRotate(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Secret '%s' of service '%s' is being rotated\n", params.SecretID, params.ServiceID)
+		return err
+	}
+}
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestServiceSecretRotate(t *testing.T) {
+	client := makeTestClient(202, nil)
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "secret-rotate", "--service_id", "test", "--secret_id", "token"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Secret 'token' of service 'test' is being rotated"
+	if strings.TrimSpace(b.String()) != expected {
+		t.Errorf("expected vs actual: %s vs %s", expected, b.String())
+	}
+}
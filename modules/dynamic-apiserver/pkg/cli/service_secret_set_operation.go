@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// makeServiceSecretSetCmd returns a cmd to handle operation serviceSecretSet
+func makeServiceSecretSetCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceSecretSet",
+		Short:   `Sets a value of a service secret, the service is restarted with the new value`,
+		Aliases: []string{"secret-set"},
+		RunE:    runServiceSecretSet(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Required. Service id")
+	_ = cmd.PersistentFlags().String(secretIdFlag, "", "Required. Secret id")
+	_ = cmd.PersistentFlags().String(secretValueFlag, "", "Required. Secret value")
+	_ = cmd.MarkFlagRequired(serviceIdFlag)
+	_ = cmd.MarkFlagRequired(secretIdFlag)
+	_ = cmd.MarkFlagRequired(secretValueFlag)
+
+	return cmd
+}
+
+// runServiceSecretSet uses cmd flags to call endpoint api
+func runServiceSecretSet(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		// retrieve flag values from cmd and fill params
+		params := service.NewServiceSecretSetParams()
+
+		if value, err := getString(cmd, serviceIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.ServiceID = *value
+		}
+		if value, err := getString(cmd, secretIdFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.SecretID = *value
+		}
+		if value, err := getString(cmd, secretValueFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.SecretValue = &models.SecretValue{Value: value}
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.ServiceID)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		_, err = apiClient.Service.ServiceSecretSet(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Secret '%s' of service '%s' is updated\n", params.SecretID, params.ServiceID)
+		return err
+	}
+}
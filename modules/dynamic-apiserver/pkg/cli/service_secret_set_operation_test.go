@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestServiceSecretSet(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     int
+		payload  interface{}
+		expected string
+		err      string
+	}{
+		{
+			name:     "ok",
+			code:     200,
+			expected: "Secret 'token' of service 'test' is updated",
+		},
+		{
+			name:    "not-rotatable",
+			code:    422,
+			payload: map[string]interface{}{"message": "secret token can't be changed"},
+			err:     "secret token can't be changed",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := MakeRootCmd(makeTestClient(tc.code, tc.payload), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs([]string{"service", "secret-set", "--service_id", "test", "--secret_id", "token", "--value", "new"})
+			err = cmd.Execute()
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected vs actual: %v vs %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.TrimSpace(b.String()) != tc.expected {
+				t.Errorf("expected vs actual: %s vs %s", tc.expected, b.String())
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	openapiClient "github.com/go-openapi/runtime/client"
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/viper"
@@ -11,11 +13,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// makeServiceAddCmd returns a cmd to handle operation serviceAdd
+// makeServiceSecretsListCmd returns a cmd to handle operation serviceSecretsList
 func makeServiceSecretsListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "serviceSecretsList",
-		Short:   `Retrieves service secrets`,
+		Short:   `Lists secrets declared by a service`,
 		Aliases: []string{"secrets"},
 		RunE:    runServiceSecretsList(apiClientFunc),
 	}
@@ -26,7 +28,7 @@ func makeServiceSecretsListCmd(apiClientFunc func() (*client.ServiceAPI, error))
 	return cmd
 }
 
-// runServiceAdd uses cmd flags to call endpoint api
+// runServiceSecretsList uses cmd flags to call endpoint api
 func runServiceSecretsList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		var err error
@@ -68,10 +70,10 @@ func runServiceSecretsList(apiClientFunc func() (*client.ServiceAPI, error)) fun
 		payload := response.GetPayload()
 		if isDefaultPrintFormat(formatResponse) {
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
-			table.SetHeader([]string{"ID", "Value"})
+			table.SetHeader([]string{"ID", "Visible", "Rotatable"})
 			table.SetBorder(false)
 			for _, item := range payload {
-				table.Append([]string{item.ID, item.Value})
+				table.Append([]string{item.ID, strconv.FormatBool(item.Visible), strconv.FormatBool(item.Rotatable)})
 			}
 			table.Render()
 		} else {
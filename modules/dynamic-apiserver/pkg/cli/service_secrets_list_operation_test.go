@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -22,12 +23,12 @@ func TestServiceSecretsListFormatJson(t *testing.T) {
 	// make own http client
 	expected := []map[string]interface{}{
 		{
-			"id":    "a",
-			"value": "b",
+			"id":      "a",
+			"visible": true,
 		},
 		{
-			"id":    "c",
-			"value": "d",
+			"id":        "c",
+			"rotatable": true,
 		},
 	}
 	client := makeTestClient(200, expected)
@@ -64,12 +65,12 @@ func TestServiceSecretsListFormatYaml(t *testing.T) {
 	// make own http client
 	expected := []map[string]interface{}{
 		{
-			"id":    "a",
-			"value": "b",
+			"id":      "a",
+			"visible": true,
 		},
 		{
-			"id":    "c",
-			"value": "d",
+			"id":        "c",
+			"rotatable": true,
 		},
 	}
 	client := makeTestClient(200, expected)
@@ -106,12 +107,12 @@ func TestServiceSecretsListFormatStr(t *testing.T) {
 	// make own http client
 	expected := []map[string]interface{}{
 		{
-			"id":    "a",
-			"value": "b",
+			"id":      "a",
+			"visible": true,
 		},
 		{
-			"id":    "c",
-			"value": "d",
+			"id":        "c",
+			"rotatable": true,
 		},
 	}
 	client := makeTestClient(200, expected)
@@ -133,12 +134,15 @@ func TestServiceSecretsListFormatStr(t *testing.T) {
 	}
 	buff := bytes.NewBufferString("")
 	table := tablewriter.NewWriter(buff)
-	table.SetHeader([]string{"ID", "Value"})
+	table.SetHeader([]string{"ID", "Visible", "Rotatable"})
 	table.SetBorder(false)
 	for _, item := range expected {
+		visible, _ := item["visible"].(bool)
+		rotatable, _ := item["rotatable"].(bool)
 		table.Append([]string{
 			item["id"].(string),
-			item["value"].(string),
+			strconv.FormatBool(visible),
+			strconv.FormatBool(rotatable),
 		})
 	}
 	table.Render()
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"strings"
+
+	openapiClient "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+)
+
+// makeServiceTypeListCmd returns a cmd to handle operation serviceTypeList
+func makeServiceTypeListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceTypeList",
+		Short:   `Lists service types and their supported versions`,
+		Aliases: []string{"types"},
+		RunE:    runServiceTypeList(apiClientFunc),
+	}
+	return cmd
+}
+
+// runServiceTypeList uses cmd flags to call endpoint api
+func runServiceTypeList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := service.NewServiceTypeListParams()
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		// make request and then print result
+		response, err := apiClient.Service.ServiceTypeList(params,
+			openapiClient.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{"Type", "Default", "Versions"})
+			table.SetBorder(false)
+			for _, item := range payload {
+				// any version is supported when the list is empty
+				versions := strings.Join(item.Versions, ", ")
+				if versions == "" {
+					versions = "any"
+				}
+				table.Append([]string{item.Type, item.Default, versions})
+			}
+			table.Render()
+		} else {
+			return printResult(cmd, formatResponse, payload)
+		}
+		return nil
+	}
+}
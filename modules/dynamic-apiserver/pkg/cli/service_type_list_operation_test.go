@@ -0,0 +1,97 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ */
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+func TestServiceTypeListFormatJson(t *testing.T) {
+	// make own http client
+	expected := []map[string]interface{}{
+		{
+			"type":     "postgres",
+			"default":  "13",
+			"versions": []interface{}{"12", "13"},
+		},
+	}
+	client := makeTestClient(200, expected)
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "types",
+		"--format", "json",
+	})
+	err = cmd.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual []map[string]interface{}
+	err = json.Unmarshal(out, &actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected vs actual: %+v vs %+v", expected, actual)
+	}
+}
+
+func TestServiceTypeListFormatStr(t *testing.T) {
+	// make own http client
+	expected := []map[string]interface{}{
+		{
+			"type":     "postgres",
+			"default":  "13",
+			"versions": []interface{}{"12", "13"},
+		},
+		{
+			"type": "redis",
+		},
+	}
+	client := makeTestClient(200, expected)
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "types"})
+	err = cmd.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buff := bytes.NewBufferString("")
+	table := tablewriter.NewWriter(buff)
+	table.SetHeader([]string{"Type", "Default", "Versions"})
+	table.SetBorder(false)
+	table.Append([]string{"postgres", "13", "12, 13"})
+	table.Append([]string{"redis", "", "any"})
+	table.Render()
+
+	if strings.TrimSpace(string(out)) != strings.TrimSpace(buff.String()) {
+		t.Fatalf("expected vs actual: %s vs %s", buff.String(), out)
+	}
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	resourceVersionFlag = "resource_version"
+)
+
+// makeServiceWatchCmd returns a cmd to handle operations serviceWatch and serviceListWatch
+func makeServiceWatchCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceWatch",
+		Short:   `Watch service changes`,
+		Aliases: []string{"watch"},
+		RunE:    runServiceWatch(apiClientFunc),
+	}
+	_ = cmd.PersistentFlags().String(serviceIdFlag, "", "Service id to watch. All services are watched if not set")
+	_ = cmd.PersistentFlags().String(subscriptionId, "", "Subscription id to filter by")
+	_ = cmd.PersistentFlags().String(resourceVersionFlag, "", "Resource version to start watching from")
+	return cmd
+}
+
+// serviceEvent is a single service change received from the watch stream
+type serviceEvent struct {
+	Type    string          `json:"type"`
+	Service *models.Service `json:"service"`
+}
+
+// runServiceWatch uses cmd flags to call endpoint api
+func runServiceWatch(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, "format"); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		serviceID, err := getString(cmd, serviceIdFlag)
+		if err != nil {
+			return err
+		}
+		subscription, err := getString(cmd, subscriptionId)
+		if err != nil {
+			return err
+		}
+
+		var deleted bool
+		stream := &eventStreamWriter{
+			onEvent: func(event string, data []byte) error {
+				if event == "error" {
+					e := &models.Error{}
+					if err := json.Unmarshal(data, e); err != nil {
+						return err
+					}
+					return errors.New(e.Message)
+				}
+
+				item := &models.Service{}
+				if err := json.Unmarshal(data, item); err != nil {
+					return errors.Wrap(err, "error decoding service event")
+				}
+				deleted = serviceID != nil && event == "deleted"
+				if isDefaultPrintFormat(formatResponse) {
+					_, err := fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\t%s\n", event, *item.ID, item.Status, item.Endpoint)
+					return err
+				}
+				return printResult(cmd, formatResponse, &serviceEvent{Type: event, Service: item})
+			},
+		}
+		if value, err := getString(cmd, resourceVersionFlag); err != nil {
+			return err
+		} else if value != nil {
+			stream.lastID = *value
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		auth := client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag))
+		// the server closes the stream periodically, keep watching from the last received event
+		for !deleted {
+			var resourceVersion *string
+			if stream.lastID != "" {
+				lastID := stream.lastID
+				resourceVersion = &lastID
+			}
+
+			if serviceID != nil {
+				params := service.NewServiceWatchParamsWithContext(cmd.Context())
+				params.ServiceID = *serviceID
+				params.ResourceVersion = resourceVersion
+				_, err = apiClient.Service.ServiceWatch(params, auth, stream)
+			} else {
+				params := service.NewServiceListWatchParamsWithContext(cmd.Context())
+				params.SubscriptionID = subscription
+				params.ResourceVersion = resourceVersion
+				_, err = apiClient.Service.ServiceListWatch(params, auth, stream)
+			}
+			if err != nil {
+				return humanizeError(err)
+			}
+		}
+		return nil
+	}
+}
+
+// eventStreamWriter splits a server-sent events stream into separate events
+type eventStreamWriter struct {
+	buf     []byte
+	lastID  string
+	onEvent func(event string, data []byte) error
+}
+
+func (w *eventStreamWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		end := bytes.Index(w.buf, []byte("\n\n"))
+		if end < 0 {
+			return len(p), nil
+		}
+		block := w.buf[:end]
+		w.buf = w.buf[end+2:]
+
+		event := "message"
+		var data [][]byte
+		for _, line := range bytes.Split(block, []byte("\n")) {
+			parts := bytes.SplitN(line, []byte(":"), 2)
+			if len(parts) != 2 {
+				continue
+			}
+			value := bytes.TrimPrefix(parts[1], []byte(" "))
+			switch string(parts[0]) {
+			case "id":
+				w.lastID = string(value)
+			case "event":
+				event = string(value)
+			case "data":
+				data = append(data, value)
+			}
+		}
+		// events without data only move the resume point
+		if len(data) == 0 {
+			continue
+		}
+		if err := w.onEvent(event, bytes.Join(data, []byte("\n"))); err != nil {
+			return 0, err
+		}
+	}
+}
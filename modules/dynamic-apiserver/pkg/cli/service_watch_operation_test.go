@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/util"
+)
+
+func makeEventStreamResponse(t *testing.T, id, event string, payload interface{}) *http.Response {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := make(http.Header)
+	header.Set("Content-Type", "text/event-stream")
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", id, event, data))),
+		Header:     header,
+	}
+}
+
+func TestServiceWatch(t *testing.T) {
+	svc := &models.Service{
+		ID:     util.StrAsPointer("demo"),
+		Type:   util.StrAsPointer("docker-compose"),
+		Status: "Ready",
+	}
+
+	var requests []*http.Request
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req)
+		if len(requests) == 1 {
+			return makeEventStreamResponse(t, "5", "modified", svc)
+		}
+		// stream is closed by server, next one must resume from the last received event
+		return makeEventStreamResponse(t, "6", "deleted", svc)
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"service", "watch", "--service_id", "demo"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "modified\tdemo\tReady\t\ndeleted\tdemo\tReady\t\n"
+	if b.String() != expected {
+		t.Fatalf("expected vs actual: %q vs %q", expected, b.String())
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if p := requests[0].URL.Path; !strings.HasSuffix(p, "/services/demo/watch") {
+		t.Errorf("unexpected path: %s", p)
+	}
+	if rv := requests[1].URL.Query().Get("ResourceVersion"); rv != "5" {
+		t.Errorf("watch is not resumed from the last event: %s", rv)
+	}
+}
+
+func TestServiceWatchError(t *testing.T) {
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		return makeEventStreamResponse(t, "", "error", &models.Error{Message: "too old resource version"})
+	})
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"service", "watch", "--resource_version", "1"})
+	err = cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "too old resource version") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
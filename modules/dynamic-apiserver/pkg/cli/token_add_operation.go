@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	tokenNameFlag      = "name"
+	tokenScopesFlag    = "scopes"
+	tokenExpiresInFlag = "expires_in"
+)
+
+// makeTokenAddCmd returns a cmd to handle operation tokenAdd
+func makeTokenAddCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tokenAdd",
+		Short:   `Create an API token`,
+		Aliases: []string{"add"},
+		RunE:    runTokenAdd(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(tokenNameFlag, "", "Required. Token name")
+	_ = cmd.MarkFlagRequired(tokenNameFlag)
+	_ = cmd.PersistentFlags().StringSlice(tokenScopesFlag, []string{"read-only"},
+		"Token scopes: read-only, admin or <resource>:<action> like services:write or backups:*")
+	_ = cmd.PersistentFlags().Duration(tokenExpiresInFlag, 0, "Token lifetime like 24h. The token does not expire if not set")
+	_ = cmd.PersistentFlags().String(tenantFlag, "", "Tenant of the token. Tokens without a tenant access services of all tenants")
+	return cmd
+}
+
+// runTokenAdd uses cmd flags to call endpoint api
+func runTokenAdd(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := token.NewTokenAddParams()
+		params.TokenItem = new(models.Token)
+
+		if value, err := getString(cmd, tokenNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.TokenItem.Name = value
+		} else {
+			return errors.New("Token name is required")
+		}
+
+		if params.TokenItem.Scopes, err = cmd.Flags().GetStringSlice(tokenScopesFlag); err != nil {
+			return err
+		}
+
+		if value, err := cmd.Flags().GetDuration(tokenExpiresInFlag); err != nil {
+			return err
+		} else if value < 0 {
+			return errors.Errorf("invalid %s value: %s", tokenExpiresInFlag, value)
+		} else if value > 0 {
+			expiresAt := strfmt.DateTime(time.Now().Add(value))
+			params.TokenItem.ExpiresAt = &expiresAt
+		}
+
+		if value, err := getString(cmd, tenantFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.TokenItem.Tenant = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.TokenItem)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Token.TokenAdd(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(),
+				"Token '%s' successfully created: %s\nStore it now, the token can not be shown again\n", *payload.Name, payload.Token)
+			return err
+		}
+		return printResult(cmd, formatResponse, payload)
+	}
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+func TestTokenAdd(t *testing.T) {
+	var requested *models.Token
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		requested = new(models.Token)
+		_ = json.NewDecoder(req.Body).Decode(requested)
+		data, _ := json.Marshal(map[string]interface{}{
+			"name":   "ci",
+			"scopes": []string{"services:write", "backups:*"},
+			"token":  "ci.secret",
+		})
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"token", "add",
+		"--name", "ci",
+		"--scopes", "services:write,backups:*",
+		"--expires_in", "24h",
+		"--tenant", "acme",
+	})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if *requested.Name != "ci" || !reflect.DeepEqual(requested.Scopes, []string{"services:write", "backups:*"}) || requested.Tenant != "acme" {
+		t.Errorf("unexpected token request: %+v", requested)
+	}
+	if requested.ExpiresAt == nil || time.Until(time.Time(*requested.ExpiresAt)) < 23*time.Hour {
+		t.Errorf("unexpected token expiration time: %v", requested.ExpiresAt)
+	}
+	expected := "Token 'ci' successfully created: ci.secret"
+	if !strings.HasPrefix(b.String(), expected) {
+		t.Errorf("expected vs actual: %s vs %s", expected, b.String())
+	}
+}
+
+func TestTokenAddConflict(t *testing.T) {
+	expected := "token already exists: ci"
+	client := makeTestClient(409, map[string]string{
+		"message": expected,
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"token", "add", "--name", "ci"})
+	err = cmd.Execute()
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected vs actual: %v vs %v", expected, err)
+	}
+}
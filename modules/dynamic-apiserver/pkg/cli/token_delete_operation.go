@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/token"
+)
+
+// makeTokenDeleteCmd returns a cmd to handle operation tokenDelete
+func makeTokenDeleteCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tokenDelete",
+		Short:   `Revokes an API token by name`,
+		Aliases: []string{"delete", "revoke"},
+		RunE:    runTokenDelete(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(tokenNameFlag, "", "Required. Token name")
+	_ = cmd.MarkFlagRequired(tokenNameFlag)
+	return cmd
+}
+
+// runTokenDelete uses cmd flags to call endpoint api
+func runTokenDelete(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := token.NewTokenDeleteParams()
+
+		if value, err := getString(cmd, tokenNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.TokenName = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.TokenName)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Token.TokenDelete(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Token '%s' successfully revoked\n", params.TokenName)
+			return err
+		}
+		return printResult(cmd, formatResponse, response)
+	}
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTokenDeleteNotFound(t *testing.T) {
+	expected := "token not found: ci"
+	cmd, err := MakeRootCmd(makeTestClient(404, map[string]string{
+		"message": expected,
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"token", "revoke", "--name", "ci"})
+	err = cmd.Execute()
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected vs actual: %v vs %v", expected, err)
+	}
+}
+
+func TestTokenDeleteSuccessFormatStr(t *testing.T) {
+	cmd, err := MakeRootCmd(makeTestClient(200, map[string]interface{}{}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"token", "delete", "--name", "ci"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Token 'ci' successfully revoked"
+	if strings.TrimSpace(b.String()) != expected {
+		t.Fatalf("expected vs actual: %s vs %s", expected, b.String())
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/token"
+)
+
+// makeTokenListCmd returns a cmd to handle operation tokenList
+func makeTokenListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tokenList",
+		Short:   `List API tokens`,
+		Aliases: []string{"list"},
+		RunE:    runTokenList(apiClientFunc),
+	}
+	return cmd
+}
+
+// runTokenList uses cmd flags to call endpoint api
+func runTokenList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := token.NewTokenListParams()
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Token.TokenList(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{"№", "Name", "Scopes", "Created", "Expires"})
+			table.SetBorder(false)
+			for i, item := range payload {
+				expires := "never"
+				if item.ExpiresAt != nil {
+					expires = item.ExpiresAt.String()
+					if time.Time(*item.ExpiresAt).Before(time.Now()) {
+						expires += " (expired)"
+					}
+				}
+				table.Append([]string{
+					strconv.Itoa(i), *item.Name, strings.Join(item.Scopes, ","), item.CreatedAt.String(), expires})
+			}
+			table.Render()
+		} else {
+			return printResult(cmd, formatResponse, payload)
+		}
+		return nil
+	}
+}
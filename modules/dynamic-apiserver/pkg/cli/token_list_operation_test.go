@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenListFormatJson(t *testing.T) {
+	expected := []map[string]interface{}{
+		{
+			"created_at": "2022-05-10T16:00:53.000Z",
+			"name":       "ci",
+			"scopes":     []interface{}{"services:write"},
+		},
+		{
+			"created_at": "2022-05-10T16:00:53.000Z",
+			"expires_at": "2022-06-10T16:00:53.000Z",
+			"name":       "monitoring",
+			"scopes":     []interface{}{"read-only"},
+		},
+	}
+	cmd, err := MakeRootCmd(makeTestClient(200, expected), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"token", "list", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	var actual []map[string]interface{}
+	if err := json.Unmarshal(b.Bytes(), &actual); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected vs actual: %s vs %s", expected, actual)
+	}
+}
+
+func TestTokenListFormatStr(t *testing.T) {
+	cmd, err := MakeRootCmd(makeTestClient(200, []map[string]interface{}{
+		{
+			"name":   "ci",
+			"scopes": []string{"services:write", "backups:*"},
+		},
+		{
+			"expires_at": "2022-06-10T16:00:53.000Z",
+			"name":       "monitoring",
+			"scopes":     []string{"read-only"},
+		},
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"token", "list"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"services:write,backups:*", "never", "(expired)"} {
+		if !strings.Contains(b.String(), expected) {
+			t.Errorf("%s is expected in the output:\n%s", expected, b.String())
+		}
+	}
+}
@@ -240,3 +240,19 @@ func readAnswer(p survey.Prompt, dst interface{}, opts ...survey.AskOpt) error {
 	}
 	return nil
 }
+
+// addListFlags adds pagination, sorting and creation time flags of list commands
+func addListFlags(cmd *cobra.Command, sortKeys ...string) {
+	_ = cmd.PersistentFlags().Int64(listLimitFlag, 0, "Maximum number of items to show. All items are shown if not set")
+	_ = cmd.PersistentFlags().String(listContinueFlag, "", "Token of the page to show, it is printed after the previous page")
+	_ = cmd.PersistentFlags().String(listSortFlag, "", "Sort key prefixed with - for the descending order: "+strings.Join(append([]string{"id", "created_at"}, sortKeys...), ", "))
+	_ = cmd.PersistentFlags().String(listCreatedAfterFlag, "", "Show only items created after the time like 2021-01-02T15:04:05Z")
+	_ = cmd.PersistentFlags().String(listCreatedBeforeFlag, "", "Show only items created before the time like 2021-01-02T15:04:05Z")
+}
+
+// printNextPage prints how to get the next page of a list to stderr so the list output stays parsable
+func printNextPage(cmd *cobra.Command, token string) {
+	if token != "" {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "more items are available, use --%s %s to list them\n", listContinueFlag, token)
+	}
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/webhook"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	webhookNameFlag   = "name"
+	webhookURLFlag    = "url"
+	webhookEventsFlag = "events"
+	webhookSecretFlag = "secret"
+)
+
+// makeWebhookAddCmd returns a cmd to handle operation webhookAdd
+func makeWebhookAddCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhookAdd",
+		Short:   `Register a webhook receiving service lifecycle events`,
+		Aliases: []string{"add"},
+		RunE:    runWebhookAdd(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(webhookNameFlag, "", "Required. Webhook name")
+	_ = cmd.MarkFlagRequired(webhookNameFlag)
+	_ = cmd.PersistentFlags().String(webhookURLFlag, "", "Required. URL receiving events")
+	_ = cmd.MarkFlagRequired(webhookURLFlag)
+	_ = cmd.PersistentFlags().StringSlice(webhookEventsFlag, nil,
+		"Delivered events like service.ready or backup.*. All events are delivered if not set")
+	_ = cmd.PersistentFlags().String(webhookSecretFlag, "", "HMAC signing secret. It is generated if not set")
+	_ = cmd.PersistentFlags().String(tenantFlag, "", "Tenant of the webhook. Webhooks without a tenant receive events of all tenants")
+	return cmd
+}
+
+// runWebhookAdd uses cmd flags to call endpoint api
+func runWebhookAdd(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := webhook.NewWebhookAddParams()
+		params.WebhookItem = new(models.Webhook)
+
+		if value, err := getString(cmd, webhookNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.WebhookItem.Name = value
+		} else {
+			return errors.New("Webhook name is required")
+		}
+
+		if value, err := getString(cmd, webhookURLFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.WebhookItem.URL = value
+		} else {
+			return errors.New("Webhook url is required")
+		}
+
+		if params.WebhookItem.Events, err = cmd.Flags().GetStringSlice(webhookEventsFlag); err != nil {
+			return err
+		}
+
+		if value, err := getString(cmd, webhookSecretFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.WebhookItem.Secret = *value
+		}
+
+		if value, err := getString(cmd, tenantFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.WebhookItem.Tenant = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.WebhookItem)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Webhook.WebhookAdd(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(),
+				"Webhook '%s' successfully registered, signing secret: %s\nStore it now, the secret can not be shown again\n", *payload.Name, payload.Secret)
+			return err
+		}
+		return printResult(cmd, formatResponse, payload)
+	}
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+func TestWebhookAdd(t *testing.T) {
+	var requested *models.Webhook
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		requested = new(models.Webhook)
+		_ = json.NewDecoder(req.Body).Decode(requested)
+		data, _ := json.Marshal(map[string]interface{}{
+			"name":   "billing",
+			"url":    "https://billing.example.com/hooks",
+			"events": []string{"service.ready", "backup.*"},
+			"secret": "generated",
+		})
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	})
+
+	cmd, err := MakeRootCmd(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"webhook", "add",
+		"--name", "billing",
+		"--url", "https://billing.example.com/hooks",
+		"--events", "service.ready,backup.*",
+	})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if *requested.Name != "billing" || *requested.URL != "https://billing.example.com/hooks" ||
+		!reflect.DeepEqual(requested.Events, []string{"service.ready", "backup.*"}) || requested.Secret != "" {
+		t.Errorf("unexpected webhook request: %+v", requested)
+	}
+	expected := "Webhook 'billing' successfully registered, signing secret: generated"
+	if !strings.HasPrefix(b.String(), expected) {
+		t.Errorf("expected vs actual: %s vs %s", expected, b.String())
+	}
+}
+
+func TestWebhookAddInvalid(t *testing.T) {
+	expected := "invalid webhook url 'billing', http or https url is expected"
+	cmd, err := MakeRootCmd(makeTestClient(400, map[string]string{
+		"message": expected,
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"webhook", "add", "--name", "billing", "--url", "billing"})
+	err = cmd.Execute()
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected vs actual: %v vs %v", expected, err)
+	}
+}
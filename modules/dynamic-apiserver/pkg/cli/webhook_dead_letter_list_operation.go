@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"strconv"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/webhook"
+)
+
+// makeWebhookDeadLetterListCmd returns a cmd to handle operation webhookDeadLetterList
+func makeWebhookDeadLetterListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhookDeadLetterList",
+		Short:   `List events that were not delivered after all retries`,
+		Aliases: []string{"deadletters"},
+		RunE:    runWebhookDeadLetterList(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(webhookNameFlag, "", "Webhook name. Undelivered events of all webhooks are listed if not set")
+	return cmd
+}
+
+// runWebhookDeadLetterList uses cmd flags to call endpoint api
+func runWebhookDeadLetterList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := webhook.NewWebhookDeadLetterListParams()
+
+		if params.Webhook, err = getString(cmd, webhookNameFlag); err != nil {
+			return err
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Webhook.WebhookDeadLetterList(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{"№", "Webhook", "Event", "Service", "Attempts", "Error", "Time"})
+			table.SetBorder(false)
+			for i, item := range payload {
+				event, service := "", ""
+				if item.Event != nil {
+					event, service = item.Event.Type, item.Event.Service
+				}
+				table.Append([]string{
+					strconv.Itoa(i), item.Webhook, event, service, strconv.FormatInt(item.Attempts, 10), item.Error, item.Time.String()})
+			}
+			table.Render()
+		} else {
+			return printResult(cmd, formatResponse, payload)
+		}
+		return nil
+	}
+}
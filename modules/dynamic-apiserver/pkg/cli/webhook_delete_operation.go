@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/webhook"
+)
+
+// makeWebhookDeleteCmd returns a cmd to handle operation webhookDelete
+func makeWebhookDeleteCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhookDelete",
+		Short:   `Deletes a webhook by name`,
+		Aliases: []string{"delete"},
+		RunE:    runWebhookDelete(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(webhookNameFlag, "", "Required. Webhook name")
+	_ = cmd.MarkFlagRequired(webhookNameFlag)
+	return cmd
+}
+
+// runWebhookDelete uses cmd flags to call endpoint api
+func runWebhookDelete(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := webhook.NewWebhookDeleteParams()
+
+		if value, err := getString(cmd, webhookNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.WebhookName = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.WebhookName)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Webhook.WebhookDelete(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+		if isDefaultPrintFormat(formatResponse) {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Webhook '%s' successfully deleted\n", params.WebhookName)
+			return err
+		}
+		return printResult(cmd, formatResponse, response)
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/webhook"
+)
+
+// makeWebhookListCmd returns a cmd to handle operation webhookList
+func makeWebhookListCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhookList",
+		Short:   `List registered webhooks`,
+		Aliases: []string{"list"},
+		RunE:    runWebhookList(apiClientFunc),
+	}
+	return cmd
+}
+
+// runWebhookList uses cmd flags to call endpoint api
+func runWebhookList(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := webhook.NewWebhookListParams()
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Webhook.WebhookList(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{"№", "Name", "URL", "Events", "Tenant", "Created"})
+			table.SetBorder(false)
+			for i, item := range payload {
+				events := "all"
+				if len(item.Events) > 0 {
+					events = strings.Join(item.Events, ",")
+				}
+				table.Append([]string{
+					strconv.Itoa(i), *item.Name, *item.URL, events, item.Tenant, item.CreatedAt.String()})
+			}
+			table.Render()
+		} else {
+			return printResult(cmd, formatResponse, payload)
+		}
+		return nil
+	}
+}
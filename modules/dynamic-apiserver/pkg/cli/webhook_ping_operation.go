@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+
+	client2 "github.com/go-openapi/runtime/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/webhook"
+)
+
+// makeWebhookPingCmd returns a cmd to handle operation webhookPing
+func makeWebhookPingCmd(apiClientFunc func() (*client.ServiceAPI, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhookPing",
+		Short:   `Sends a ping event to a webhook`,
+		Aliases: []string{"ping"},
+		RunE:    runWebhookPing(apiClientFunc),
+	}
+
+	_ = cmd.PersistentFlags().String(webhookNameFlag, "", "Required. Webhook name")
+	_ = cmd.MarkFlagRequired(webhookNameFlag)
+	return cmd
+}
+
+// runWebhookPing uses cmd flags to call endpoint api
+func runWebhookPing(apiClientFunc func() (*client.ServiceAPI, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		apiClient, err := apiClientFunc()
+		if err != nil {
+			return err
+		}
+
+		params := webhook.NewWebhookPingParams()
+
+		if value, err := getString(cmd, webhookNameFlag); err != nil {
+			return err
+		} else if value != nil {
+			params.WebhookName = *value
+		}
+
+		var formatResponse format
+		if value, err := getString(cmd, formatFlag); err != nil {
+			return err
+		} else if value != nil {
+			formatResponse = format(*value)
+		}
+
+		if dryRun {
+			logDebugf("Params: %+v", params.WebhookName)
+			logDebugf("dry-run flag specified. Skip sending request.")
+			return nil
+		}
+
+		response, err := apiClient.Webhook.WebhookPing(params,
+			client2.APIKeyAuth("X-Token", "header", viper.GetString(tokenFlag)))
+		if err != nil {
+			return humanizeError(err)
+		}
+
+		payload := response.GetPayload()
+		if isDefaultPrintFormat(formatResponse) {
+			if payload.Error != "" {
+				return fmt.Errorf("ping of webhook '%s' failed: %s", params.WebhookName, payload.Error)
+			}
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Webhook '%s' responded with status %d\n", params.WebhookName, payload.Status)
+			return err
+		}
+		return printResult(cmd, formatResponse, payload)
+	}
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWebhookPing(t *testing.T) {
+	cases := []struct {
+		name     string
+		payload  map[string]interface{}
+		expected string
+		err      string
+	}{
+		{
+			name: "ok",
+			payload: map[string]interface{}{
+				"webhook": "billing", "attempts": 1, "status": 204,
+			},
+			expected: "Webhook 'billing' responded with status 204",
+		},
+		{
+			name: "failed",
+			payload: map[string]interface{}{
+				"webhook": "billing", "attempts": 1, "status": 401, "error": "unexpected status 401",
+			},
+			err: "ping of webhook 'billing' failed: unexpected status 401",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := MakeRootCmd(makeTestClient(200, tc.payload), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs([]string{"webhook", "ping", "--name", "billing"})
+			err = cmd.Execute()
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected vs actual: %v vs %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.TrimSpace(b.String()) != tc.expected {
+				t.Errorf("expected vs actual: %s vs %s", tc.expected, b.String())
+			}
+		})
+	}
+}
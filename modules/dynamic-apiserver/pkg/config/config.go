@@ -17,9 +17,13 @@
 package config
 
 import (
+	"strings"
+	"time"
+
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Config struct
@@ -35,6 +39,76 @@ type Config struct {
 	SentryDsn    string `envconfig:"optional"`
 	DeploymentId string `envconfig:"optional"`
 	Domain       string
+
+	// ApiserverToken is a bootstrap token with admin access
+	ApiserverToken string `envconfig:"optional"`
+	// Namespace keeps objects owned by the apiserver like API tokens
+	Namespace string `envconfig:"default=kuberlogic"`
+
+	// Oidc configures authentication with bearer tokens issued by an OIDC provider
+	Oidc struct {
+		// Issuer of accepted tokens, bearer authentication is disabled if empty
+		Issuer string `envconfig:"optional"`
+		// JwksUrl overrides the signing keys location discovered from the issuer
+		JwksUrl string `envconfig:"optional"`
+		// Audience is checked against the "aud" claim if set
+		Audience string `envconfig:"optional"`
+		// UsernameClaim names the authenticated principal
+		UsernameClaim string `envconfig:"default=sub"`
+		// RolesClaim holds principal roles, nested claims are separated by dots e.g. realm_access.roles
+		RolesClaim string `envconfig:"default=roles"`
+		// Roles maps roles to API scopes in the <role>=<scope> form, a role can be listed multiple times
+		Roles []string `envconfig:"optional"`
+		// TenantClaim holds the principal tenant, tokens without it are rejected if set
+		TenantClaim string `envconfig:"optional"`
+	}
+
+	// TenantQuota limits services of every tenant, zero values mean no limit.
+	// Quotas of individual tenants can be overridden in the tenant quotas configmap of the apiserver namespace.
+	TenantQuota struct {
+		// Services is the maximum number of services
+		Services int `envconfig:"default=0"`
+		// Cpu, Memory and Storage cap the sum of service limits
+		Cpu     string `envconfig:"optional"`
+		Memory  string `envconfig:"optional"`
+		Storage string `envconfig:"optional"`
+	}
+
+	// Audit configures records of mutating and sensitive API calls
+	Audit struct {
+		// Sink is one of stdout, file, webhook or none to disable auditing
+		Sink string `envconfig:"default=stdout"`
+		// File keeps records as JSON lines when the file sink is used
+		File string `envconfig:"optional"`
+		// WebhookUrl receives records as JSON when the webhook sink is used
+		WebhookUrl string `envconfig:"optional"`
+		// BufferSize is the number of last records kept in memory for queries when the sink can't be queried
+		BufferSize int `envconfig:"default=1000"`
+	}
+
+	// Notifications configures webhook notifications of service lifecycle events
+	Notifications struct {
+		// Enabled watches services, backups and restores for events, webhooks can still be pinged if disabled
+		Enabled bool `envconfig:"default=true"`
+		// Attempts is the number of delivery attempts before an event is kept as a dead letter
+		Attempts int `envconfig:"default=5"`
+		// Backoff is the delay before the second attempt, it doubles with each next attempt
+		Backoff time.Duration `envconfig:"default=2s"`
+		// Timeout limits a single delivery attempt
+		Timeout time.Duration `envconfig:"default=10s"`
+		// DeadLetters is the number of last undelivered events kept in memory
+		DeadLetters int `envconfig:"default=1000"`
+		// AllowedHosts are webhook hosts that may resolve to cluster, private or loopback addresses
+		AllowedHosts []string `envconfig:"optional"`
+	}
+
+	// Idempotency configures replays of POST requests retried with the same Idempotency-Key
+	Idempotency struct {
+		// Ttl is how long responses are kept for replays
+		Ttl time.Duration `envconfig:"default=24h"`
+		// MaxKeys is the number of kept responses, the oldest are dropped first
+		MaxKeys int `envconfig:"default=10000"`
+	}
 }
 
 // InitConfig func
@@ -43,6 +117,29 @@ func InitConfig(prefix string, log logging.Logger) (*Config, error) {
 	if err := envconfig.InitWithPrefix(config, prefix); err != nil {
 		return nil, errors.Wrap(err, "init config failed")
 	}
+	for _, role := range config.Oidc.Roles {
+		if parts := strings.SplitN(role, "=", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid oidc role mapping: %s", role)
+		}
+	}
+	for _, quantity := range []string{config.TenantQuota.Cpu, config.TenantQuota.Memory, config.TenantQuota.Storage} {
+		if _, err := resource.ParseQuantity(quantity); quantity != "" && err != nil {
+			return nil, errors.Wrapf(err, "invalid tenant quota: %s", quantity)
+		}
+	}
+	switch config.Audit.Sink {
+	case "stdout", "none":
+	case "file":
+		if config.Audit.File == "" {
+			return nil, errors.New("audit file is required for the file audit sink")
+		}
+	case "webhook":
+		if config.Audit.WebhookUrl == "" {
+			return nil, errors.New("audit webhook url is required for the webhook audit sink")
+		}
+	default:
+		return nil, errors.Errorf("unknown audit sink: %s", config.Audit.Sink)
+	}
 
 	log.Debugw("config is", "config", config)
 	return config, nil
@@ -67,6 +67,12 @@ type BackupAddParams struct {
 	*/
 	BackupItem *models.Backup
 
+	/* IdempotencyKey.
+
+	   unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	*/
+	IdempotencyKey *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -131,6 +137,17 @@ func (o *BackupAddParams) SetBackupItem(backupItem *models.Backup) {
 	o.BackupItem = backupItem
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the backup add params
+func (o *BackupAddParams) WithIdempotencyKey(idempotencyKey *string) *BackupAddParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the backup add params
+func (o *BackupAddParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BackupAddParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -144,6 +161,14 @@ func (o *BackupAddParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
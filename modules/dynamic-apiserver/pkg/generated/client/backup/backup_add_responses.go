@@ -156,7 +156,7 @@ func NewBackupAddConflict() *BackupAddConflict {
 
 /* BackupAddConflict describes a response with status code 409, with default header values.
 
-item already exists
+item already exists or a request with the same Idempotency-Key is in progress
 */
 type BackupAddConflict struct {
 }
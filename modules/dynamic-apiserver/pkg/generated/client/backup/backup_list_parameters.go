@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewBackupListParams creates a new BackupListParams object,
@@ -59,12 +60,50 @@ func NewBackupListParamsWithHTTPClient(client *http.Client) *BackupListParams {
 */
 type BackupListParams struct {
 
+	/* Continue.
+
+	   token of the page to return, it is taken from the X-Continue header of the previous page
+	*/
+	Continue *string
+
+	/* CreatedAfter.
+
+	   return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z
+	*/
+	CreatedAfter *string
+
+	/* CreatedBefore.
+
+	   return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z
+	*/
+	CreatedBefore *string
+
+	/* Limit.
+
+	   maximum number of items in the page, all items are returned if not set
+	*/
+	Limit *int64
+
 	/* ServiceID.
 
 	   service Resource ID to query backups/restores by
 	*/
 	ServiceID *string
 
+	/* Sort.
+
+	   sort key prefixed with "-" for the descending order.
+Items are sorted by id if not set.
+Keys are id and created_at, services can also be sorted by type, domain and status.
+	*/
+	Sort *string
+
+	/* Status.
+
+	   return only items in the status
+	*/
+	Status *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -118,6 +157,50 @@ func (o *BackupListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithContinue adds the continueVar to the backup list params
+func (o *BackupListParams) WithContinue(continueVar *string) *BackupListParams {
+	o.SetContinue(continueVar)
+	return o
+}
+
+// SetContinue adds the continue to the backup list params
+func (o *BackupListParams) SetContinue(continueVar *string) {
+	o.Continue = continueVar
+}
+
+// WithCreatedAfter adds the createdAfter to the backup list params
+func (o *BackupListParams) WithCreatedAfter(createdAfter *string) *BackupListParams {
+	o.SetCreatedAfter(createdAfter)
+	return o
+}
+
+// SetCreatedAfter adds the createdAfter to the backup list params
+func (o *BackupListParams) SetCreatedAfter(createdAfter *string) {
+	o.CreatedAfter = createdAfter
+}
+
+// WithCreatedBefore adds the createdBefore to the backup list params
+func (o *BackupListParams) WithCreatedBefore(createdBefore *string) *BackupListParams {
+	o.SetCreatedBefore(createdBefore)
+	return o
+}
+
+// SetCreatedBefore adds the createdBefore to the backup list params
+func (o *BackupListParams) SetCreatedBefore(createdBefore *string) {
+	o.CreatedBefore = createdBefore
+}
+
+// WithLimit adds the limit to the backup list params
+func (o *BackupListParams) WithLimit(limit *int64) *BackupListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the backup list params
+func (o *BackupListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
 // WithServiceID adds the serviceID to the backup list params
 func (o *BackupListParams) WithServiceID(serviceID *string) *BackupListParams {
 	o.SetServiceID(serviceID)
@@ -129,6 +212,28 @@ func (o *BackupListParams) SetServiceID(serviceID *string) {
 	o.ServiceID = serviceID
 }
 
+// WithSort adds the sort to the backup list params
+func (o *BackupListParams) WithSort(sort *string) *BackupListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the backup list params
+func (o *BackupListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithStatus adds the status to the backup list params
+func (o *BackupListParams) WithStatus(status *string) *BackupListParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the backup list params
+func (o *BackupListParams) SetStatus(status *string) {
+	o.Status = status
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BackupListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -137,6 +242,74 @@ func (o *BackupListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.Continue != nil {
+
+		// query param continue
+		var qrContinue string
+
+		if o.Continue != nil {
+			qrContinue = *o.Continue
+		}
+		qContinue := qrContinue
+		if qContinue != "" {
+
+			if err := r.SetQueryParam("continue", qContinue); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CreatedAfter != nil {
+
+		// query param created_after
+		var qrCreatedAfter string
+
+		if o.CreatedAfter != nil {
+			qrCreatedAfter = *o.CreatedAfter
+		}
+		qCreatedAfter := qrCreatedAfter
+		if qCreatedAfter != "" {
+
+			if err := r.SetQueryParam("created_after", qCreatedAfter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CreatedBefore != nil {
+
+		// query param created_before
+		var qrCreatedBefore string
+
+		if o.CreatedBefore != nil {
+			qrCreatedBefore = *o.CreatedBefore
+		}
+		qCreatedBefore := qrCreatedBefore
+		if qCreatedBefore != "" {
+
+			if err := r.SetQueryParam("created_before", qCreatedBefore); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.ServiceID != nil {
 
 		// query param ServiceID
@@ -154,6 +327,40 @@ func (o *BackupListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		}
 	}
 
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -74,6 +74,11 @@ func NewBackupListOK() *BackupListOK {
 search results matching criteria
 */
 type BackupListOK struct {
+
+	/* token of the next page, it is empty on the last page
+	 */
+	XContinue string
+
 	Payload models.Backups
 }
 
@@ -86,6 +91,13 @@ func (o *BackupListOK) GetPayload() models.Backups {
 
 func (o *BackupListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Continue
+	hdrXContinue := response.GetHeader("X-Continue")
+
+	if hdrXContinue != "" {
+		o.XContinue = hdrXContinue
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
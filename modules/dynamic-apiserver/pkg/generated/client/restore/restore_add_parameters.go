@@ -61,6 +61,12 @@ func NewRestoreAddParamsWithHTTPClient(client *http.Client) *RestoreAddParams {
 */
 type RestoreAddParams struct {
 
+	/* IdempotencyKey.
+
+	   unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	*/
+	IdempotencyKey *string
+
 	/* RestoreItem.
 
 	   restore item
@@ -120,6 +126,17 @@ func (o *RestoreAddParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the restore add params
+func (o *RestoreAddParams) WithIdempotencyKey(idempotencyKey *string) *RestoreAddParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the restore add params
+func (o *RestoreAddParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithRestoreItem adds the restoreItem to the restore add params
 func (o *RestoreAddParams) WithRestoreItem(restoreItem *models.Restore) *RestoreAddParams {
 	o.SetRestoreItem(restoreItem)
@@ -138,6 +155,14 @@ func (o *RestoreAddParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		return err
 	}
 	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
 	if o.RestoreItem != nil {
 		if err := r.SetBodyParam(o.RestoreItem); err != nil {
 			return err
@@ -61,6 +61,12 @@ func NewServiceAddParamsWithHTTPClient(client *http.Client) *ServiceAddParams {
 */
 type ServiceAddParams struct {
 
+	/* IdempotencyKey.
+
+	   unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	*/
+	IdempotencyKey *string
+
 	/* ServiceItem.
 
 	   service item
@@ -120,6 +126,17 @@ func (o *ServiceAddParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the service add params
+func (o *ServiceAddParams) WithIdempotencyKey(idempotencyKey *string) *ServiceAddParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the service add params
+func (o *ServiceAddParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithServiceItem adds the serviceItem to the service add params
 func (o *ServiceAddParams) WithServiceItem(serviceItem *models.Service) *ServiceAddParams {
 	o.SetServiceItem(serviceItem)
@@ -138,6 +155,14 @@ func (o *ServiceAddParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		return err
 	}
 	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
 	if o.ServiceItem != nil {
 		if err := r.SetBodyParam(o.ServiceItem); err != nil {
 			return err
@@ -162,17 +162,28 @@ func NewServiceAddForbidden() *ServiceAddForbidden {
 
 /* ServiceAddForbidden describes a response with status code 403, with default header values.
 
-bad permissions
+bad permissions or tenant quota exceeded
 */
 type ServiceAddForbidden struct {
+	Payload *models.Error
 }
 
 func (o *ServiceAddForbidden) Error() string {
-	return fmt.Sprintf("[POST /services/][%d] serviceAddForbidden ", 403)
+	return fmt.Sprintf("[POST /services/][%d] serviceAddForbidden  %+v", 403, o.Payload)
+}
+func (o *ServiceAddForbidden) GetPayload() *models.Error {
+	return o.Payload
 }
 
 func (o *ServiceAddForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -183,7 +194,7 @@ func NewServiceAddConflict() *ServiceAddConflict {
 
 /* ServiceAddConflict describes a response with status code 409, with default header values.
 
-item already exists
+item already exists or a request with the same Idempotency-Key is in progress
 */
 type ServiceAddConflict struct {
 }
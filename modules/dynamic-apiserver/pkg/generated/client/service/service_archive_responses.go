@@ -53,6 +53,12 @@ func (o *ServiceArchiveReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewServiceArchiveConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewServiceArchiveUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -197,6 +203,38 @@ func (o *ServiceArchiveNotFound) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewServiceArchiveConflict creates a ServiceArchiveConflict with default headers values
+func NewServiceArchiveConflict() *ServiceArchiveConflict {
+	return &ServiceArchiveConflict{}
+}
+
+/* ServiceArchiveConflict describes a response with status code 409, with default header values.
+
+request with the same Idempotency-Key is in progress
+*/
+type ServiceArchiveConflict struct {
+	Payload *models.Error
+}
+
+func (o *ServiceArchiveConflict) Error() string {
+	return fmt.Sprintf("[POST /services/{ServiceID}/archive][%d] serviceArchiveConflict  %+v", 409, o.Payload)
+}
+func (o *ServiceArchiveConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ServiceArchiveConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewServiceArchiveUnprocessableEntity creates a ServiceArchiveUnprocessableEntity with default headers values
 func NewServiceArchiveUnprocessableEntity() *ServiceArchiveUnprocessableEntity {
 	return &ServiceArchiveUnprocessableEntity{}
@@ -7,6 +7,7 @@ package service
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -40,18 +41,42 @@ type ClientService interface {
 
 	ServiceEdit(params *ServiceEditParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEditOK, error)
 
+	ServiceEnvList(params *ServiceEnvListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEnvListOK, error)
+
+	ServiceEnvSet(params *ServiceEnvSetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEnvSetOK, error)
+
+	ServiceEnvUnset(params *ServiceEnvUnsetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEnvUnsetOK, error)
+
+	ServiceExec(params *ServiceExecParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceExecSwitchingProtocols, error)
+
 	ServiceExplain(params *ServiceExplainParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceExplainOK, error)
 
 	ServiceGet(params *ServiceGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceGetOK, error)
 
 	ServiceList(params *ServiceListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceListOK, error)
 
+	ServiceListWatch(params *ServiceListWatchParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ServiceListWatchOK, error)
+
 	ServiceLogs(params *ServiceLogsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceLogsOK, error)
 
+	ServiceLogsFollow(params *ServiceLogsFollowParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ServiceLogsFollowOK, error)
+
+	ServiceMetrics(params *ServiceMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceMetricsOK, error)
+
+	ServiceSecretGet(params *ServiceSecretGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretGetOK, error)
+
+	ServiceSecretRotate(params *ServiceSecretRotateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretRotateAccepted, error)
+
+	ServiceSecretSet(params *ServiceSecretSetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretSetOK, error)
+
 	ServiceSecretsList(params *ServiceSecretsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretsListOK, error)
 
+	ServiceTypeList(params *ServiceTypeListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceTypeListOK, error)
+
 	ServiceUnarchive(params *ServiceUnarchiveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceUnarchiveOK, error)
 
+	ServiceWatch(params *ServiceWatchParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ServiceWatchOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -224,7 +249,8 @@ func (a *Client) ServiceDelete(params *ServiceDeleteParams, authInfo runtime.Cli
 /*
   ServiceEdit edits a service item
 
-  Edit service object
+  Edit service object.
+Pass the ETag of the service in the If-Match header to reject the edit if the service was changed since it was read.
 
 */
 func (a *Client) ServiceEdit(params *ServiceEditParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEditOK, error) {
@@ -263,6 +289,174 @@ func (a *Client) ServiceEdit(params *ServiceEditParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+  ServiceEnvList lists service environment variables
+
+  Returns environment variables of the service and parameters supported by its type. Values of secret variables are not returned
+*/
+func (a *Client) ServiceEnvList(params *ServiceEnvListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEnvListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceEnvListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceEnvList",
+		Method:             "GET",
+		PathPattern:        "/services/{ServiceID}/env",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceEnvListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceEnvListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceEnvList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceEnvSet sets a service environment variable
+
+  Sets an environment variable of service containers, service pods are restarted to pick it up
+*/
+func (a *Client) ServiceEnvSet(params *ServiceEnvSetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEnvSetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceEnvSetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceEnvSet",
+		Method:             "PUT",
+		PathPattern:        "/services/{ServiceID}/env/{EnvName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceEnvSetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceEnvSetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceEnvSet: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceEnvUnset unsets a service environment variable
+
+  Removes an environment variable from service containers, service pods are restarted
+*/
+func (a *Client) ServiceEnvUnset(params *ServiceEnvUnsetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceEnvUnsetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceEnvUnsetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceEnvUnset",
+		Method:             "DELETE",
+		PathPattern:        "/services/{ServiceID}/env/{EnvName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceEnvUnsetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceEnvUnsetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceEnvUnset: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceExec executes a command in a service container
+
+  Upgrades the connection to a WebSocket and attaches it to a command executed in a service container.
+Every binary message starts with a channel byte followed by the payload:
+0 - stdin (an empty payload closes stdin), 1 - stdout, 2 - stderr,
+3 - ExecStatus sent once the command exits, 4 - terminal size as {"Width": 80, "Height": 24}.
+
+*/
+func (a *Client) ServiceExec(params *ServiceExecParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceExecSwitchingProtocols, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceExecParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceExec",
+		Method:             "GET",
+		PathPattern:        "/services/{ServiceID}/exec",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceExecReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceExecSwitchingProtocols)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceExec: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ServiceExplain explains status of service
 
@@ -349,7 +543,8 @@ func (a *Client) ServiceGet(params *ServiceGetParams, authInfo runtime.ClientAut
 /*
   ServiceList lists all services
 
-  List of service objects
+  List of service objects.
+Pass the X-Continue header value of the response as the continue parameter to get the next page.
 
 */
 func (a *Client) ServiceList(params *ServiceListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceListOK, error) {
@@ -388,6 +583,49 @@ func (a *Client) ServiceList(params *ServiceListParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+  ServiceListWatch watches services
+
+  Streams service changes as server-sent events.
+Every event carries the service resource version as its id, pass it back to resume the stream.
+
+*/
+func (a *Client) ServiceListWatch(params *ServiceListWatchParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ServiceListWatchOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceListWatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceListWatch",
+		Method:             "GET",
+		PathPattern:        "/services/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceListWatchReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceListWatchOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceListWatch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ServiceLogs lists service logs
 
@@ -430,9 +668,220 @@ func (a *Client) ServiceLogs(params *ServiceLogsParams, authInfo runtime.ClientA
 }
 
 /*
-  ServiceSecretsList retrieves service secrets
+  ServiceLogsFollow follows service logs
+
+  Streams service container logs as server-sent events.
+Every event carries a single log line along with its pod and container names and uses the line timestamp as its id,
+pass it back to resume the stream.
+An "eof" event is sent when all followed containers have exited.
+
+*/
+func (a *Client) ServiceLogsFollow(params *ServiceLogsFollowParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ServiceLogsFollowOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceLogsFollowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceLogsFollow",
+		Method:             "GET",
+		PathPattern:        "/services/{ServiceID}/logs/follow",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceLogsFollowReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceLogsFollowOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceLogsFollow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceMetrics resources usage of service
+
+  Current CPU and memory usage of service containers reported by the metrics API
+and usage of service volumes reported by kubelets, compared with service limits.
+
+*/
+func (a *Client) ServiceMetrics(params *ServiceMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceMetricsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceMetricsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceMetrics",
+		Method:             "GET",
+		PathPattern:        "/services/{ServiceID}/metrics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceMetricsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceMetricsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceMetrics: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceSecretGet reveals a service secret
+
+  Returns a value of a visible service secret. Calls are audited
+*/
+func (a *Client) ServiceSecretGet(params *ServiceSecretGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceSecretGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceSecretGet",
+		Method:             "GET",
+		PathPattern:        "/services/{ServiceID}/secrets/{SecretID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceSecretGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceSecretGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceSecretGet: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceSecretRotate rotates a service secret
+
+  Regenerates a rotatable service secret by the service plugin, service pods are restarted to pick it up
+*/
+func (a *Client) ServiceSecretRotate(params *ServiceSecretRotateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretRotateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceSecretRotateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceSecretRotate",
+		Method:             "POST",
+		PathPattern:        "/services/{ServiceID}/secrets/{SecretID}/rotate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceSecretRotateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceSecretRotateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceSecretRotate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceSecretSet sets a service secret
+
+  Sets a rotatable service secret to the provided value, service pods are restarted to pick it up
+*/
+func (a *Client) ServiceSecretSet(params *ServiceSecretSetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretSetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceSecretSetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceSecretSet",
+		Method:             "PUT",
+		PathPattern:        "/services/{ServiceID}/secrets/{SecretID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceSecretSetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceSecretSetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceSecretSet: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ServiceSecretsList lists service secrets
 
-  retrieves service secrets
+  Lists secrets declared by the service plugin. Secret values are not returned, use serviceSecretGet to reveal a secret
 */
 func (a *Client) ServiceSecretsList(params *ServiceSecretsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceSecretsListOK, error) {
 	// TODO: Validate the params before sending
@@ -470,6 +919,47 @@ func (a *Client) ServiceSecretsList(params *ServiceSecretsListParams, authInfo r
 	panic(msg)
 }
 
+/*
+  ServiceTypeList lists service types
+
+  list service types and their supported versions
+*/
+func (a *Client) ServiceTypeList(params *ServiceTypeListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ServiceTypeListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceTypeListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceTypeList",
+		Method:             "GET",
+		PathPattern:        "/service-types/",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceTypeListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceTypeListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceTypeList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ServiceUnarchive unarchives service
 
@@ -511,6 +1001,49 @@ func (a *Client) ServiceUnarchive(params *ServiceUnarchiveParams, authInfo runti
 	panic(msg)
 }
 
+/*
+  ServiceWatch watches a service item
+
+  Streams service item changes as server-sent events.
+Every event carries the service resource version as its id, pass it back to resume the stream.
+
+*/
+func (a *Client) ServiceWatch(params *ServiceWatchParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ServiceWatchOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewServiceWatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "serviceWatch",
+		Method:             "GET",
+		PathPattern:        "/services/{ServiceID}/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ServiceWatchReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ServiceWatchOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for serviceWatch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -61,6 +61,12 @@ func NewServiceCredentialsUpdateParamsWithHTTPClient(client *http.Client) *Servi
 */
 type ServiceCredentialsUpdateParams struct {
 
+	/* IdempotencyKey.
+
+	   unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	*/
+	IdempotencyKey *string
+
 	/* ServiceCredentials.
 
 	   service credentials
@@ -126,6 +132,17 @@ func (o *ServiceCredentialsUpdateParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the service credentials update params
+func (o *ServiceCredentialsUpdateParams) WithIdempotencyKey(idempotencyKey *string) *ServiceCredentialsUpdateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the service credentials update params
+func (o *ServiceCredentialsUpdateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithServiceCredentials adds the serviceCredentials to the service credentials update params
 func (o *ServiceCredentialsUpdateParams) WithServiceCredentials(serviceCredentials models.ServiceCredentials) *ServiceCredentialsUpdateParams {
 	o.SetServiceCredentials(serviceCredentials)
@@ -155,6 +172,14 @@ func (o *ServiceCredentialsUpdateParams) WriteToRequest(r runtime.ClientRequest,
 		return err
 	}
 	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
 	if o.ServiceCredentials != nil {
 		if err := r.SetBodyParam(o.ServiceCredentials); err != nil {
 			return err
@@ -47,6 +47,12 @@ func (o *ServiceCredentialsUpdateReader) ReadResponse(response runtime.ClientRes
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewServiceCredentialsUpdateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewServiceCredentialsUpdateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -159,6 +165,38 @@ func (o *ServiceCredentialsUpdateForbidden) readResponse(response runtime.Client
 	return nil
 }
 
+// NewServiceCredentialsUpdateConflict creates a ServiceCredentialsUpdateConflict with default headers values
+func NewServiceCredentialsUpdateConflict() *ServiceCredentialsUpdateConflict {
+	return &ServiceCredentialsUpdateConflict{}
+}
+
+/* ServiceCredentialsUpdateConflict describes a response with status code 409, with default header values.
+
+request with the same Idempotency-Key is in progress
+*/
+type ServiceCredentialsUpdateConflict struct {
+	Payload *models.Error
+}
+
+func (o *ServiceCredentialsUpdateConflict) Error() string {
+	return fmt.Sprintf("[POST /services/{ServiceID}/credentials][%d] serviceCredentialsUpdateConflict  %+v", 409, o.Payload)
+}
+func (o *ServiceCredentialsUpdateConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ServiceCredentialsUpdateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewServiceCredentialsUpdateUnprocessableEntity creates a ServiceCredentialsUpdateUnprocessableEntity with default headers values
 func NewServiceCredentialsUpdateUnprocessableEntity() *ServiceCredentialsUpdateUnprocessableEntity {
 	return &ServiceCredentialsUpdateUnprocessableEntity{}
@@ -61,6 +61,12 @@ func NewServiceEditParamsWithHTTPClient(client *http.Client) *ServiceEditParams
 */
 type ServiceEditParams struct {
 
+	/* IfMatch.
+
+	   ETag of the service the request is based on
+	*/
+	IfMatch *string
+
 	/* ServiceID.
 
 	   service Resource ID
@@ -126,6 +132,17 @@ func (o *ServiceEditParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the service edit params
+func (o *ServiceEditParams) WithIfMatch(ifMatch *string) *ServiceEditParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the service edit params
+func (o *ServiceEditParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithServiceID adds the serviceID to the service edit params
 func (o *ServiceEditParams) WithServiceID(serviceID string) *ServiceEditParams {
 	o.SetServiceID(serviceID)
@@ -156,6 +173,14 @@ func (o *ServiceEditParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param ServiceID
 	if err := r.SetPathParam("ServiceID", o.ServiceID); err != nil {
 		return err
@@ -53,6 +53,18 @@ func (o *ServiceEditReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewServiceEditConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewServiceEditPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewServiceEditUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -80,6 +92,11 @@ func NewServiceEditOK() *ServiceEditOK {
 item edited
 */
 type ServiceEditOK struct {
+
+	/* resource version of the service, pass it in the If-Match header to edit this version only
+	 */
+	ETag string
+
 	Payload *models.Service
 }
 
@@ -92,6 +109,13 @@ func (o *ServiceEditOK) GetPayload() *models.Service {
 
 func (o *ServiceEditOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Service)
 
 	// response payload
@@ -162,17 +186,28 @@ func NewServiceEditForbidden() *ServiceEditForbidden {
 
 /* ServiceEditForbidden describes a response with status code 403, with default header values.
 
-bad permissions
+bad permissions or tenant quota exceeded
 */
 type ServiceEditForbidden struct {
+	Payload *models.Error
 }
 
 func (o *ServiceEditForbidden) Error() string {
-	return fmt.Sprintf("[PATCH /services/{ServiceID}/][%d] serviceEditForbidden ", 403)
+	return fmt.Sprintf("[PATCH /services/{ServiceID}/][%d] serviceEditForbidden  %+v", 403, o.Payload)
+}
+func (o *ServiceEditForbidden) GetPayload() *models.Error {
+	return o.Payload
 }
 
 func (o *ServiceEditForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -208,6 +243,70 @@ func (o *ServiceEditNotFound) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewServiceEditConflict creates a ServiceEditConflict with default headers values
+func NewServiceEditConflict() *ServiceEditConflict {
+	return &ServiceEditConflict{}
+}
+
+/* ServiceEditConflict describes a response with status code 409, with default header values.
+
+service was changed by a concurrent request, read it again and retry
+*/
+type ServiceEditConflict struct {
+	Payload *models.Error
+}
+
+func (o *ServiceEditConflict) Error() string {
+	return fmt.Sprintf("[PATCH /services/{ServiceID}/][%d] serviceEditConflict  %+v", 409, o.Payload)
+}
+func (o *ServiceEditConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ServiceEditConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewServiceEditPreconditionFailed creates a ServiceEditPreconditionFailed with default headers values
+func NewServiceEditPreconditionFailed() *ServiceEditPreconditionFailed {
+	return &ServiceEditPreconditionFailed{}
+}
+
+/* ServiceEditPreconditionFailed describes a response with status code 412, with default header values.
+
+service does not match the If-Match header
+*/
+type ServiceEditPreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *ServiceEditPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /services/{ServiceID}/][%d] serviceEditPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *ServiceEditPreconditionFailed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ServiceEditPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewServiceEditUnprocessableEntity creates a ServiceEditUnprocessableEntity with default headers values
 func NewServiceEditUnprocessableEntity() *ServiceEditUnprocessableEntity {
 	return &ServiceEditUnprocessableEntity{}
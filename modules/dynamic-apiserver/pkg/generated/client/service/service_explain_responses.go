@@ -47,6 +47,12 @@ func (o *ServiceExplainReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 404:
+		result := NewServiceExplainNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewServiceExplainUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -170,6 +176,38 @@ func (o *ServiceExplainForbidden) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewServiceExplainNotFound creates a ServiceExplainNotFound with default headers values
+func NewServiceExplainNotFound() *ServiceExplainNotFound {
+	return &ServiceExplainNotFound{}
+}
+
+/* ServiceExplainNotFound describes a response with status code 404, with default header values.
+
+item not found
+*/
+type ServiceExplainNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ServiceExplainNotFound) Error() string {
+	return fmt.Sprintf("[GET /services/{ServiceID}/explain][%d] serviceExplainNotFound  %+v", 404, o.Payload)
+}
+func (o *ServiceExplainNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ServiceExplainNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewServiceExplainUnprocessableEntity creates a ServiceExplainUnprocessableEntity with default headers values
 func NewServiceExplainUnprocessableEntity() *ServiceExplainUnprocessableEntity {
 	return &ServiceExplainUnprocessableEntity{}
@@ -80,6 +80,11 @@ func NewServiceGetOK() *ServiceGetOK {
 item edited
 */
 type ServiceGetOK struct {
+
+	/* resource version of the service, pass it in the If-Match header to edit this version only
+	 */
+	ETag string
+
 	Payload *models.Service
 }
 
@@ -92,6 +97,13 @@ func (o *ServiceGetOK) GetPayload() *models.Service {
 
 func (o *ServiceGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Service)
 
 	// response payload
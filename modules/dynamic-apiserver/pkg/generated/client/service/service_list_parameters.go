@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewServiceListParams creates a new ServiceListParams object,
@@ -59,12 +60,62 @@ func NewServiceListParamsWithHTTPClient(client *http.Client) *ServiceListParams
 */
 type ServiceListParams struct {
 
+	/* Continue.
+
+	   token of the page to return, it is taken from the X-Continue header of the previous page
+	*/
+	Continue *string
+
+	/* CreatedAfter.
+
+	   return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z
+	*/
+	CreatedAfter *string
+
+	/* CreatedBefore.
+
+	   return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z
+	*/
+	CreatedBefore *string
+
+	/* Domain.
+
+	   return only services with the domain
+	*/
+	Domain *string
+
+	/* Limit.
+
+	   maximum number of items in the page, all items are returned if not set
+	*/
+	Limit *int64
+
+	/* Sort.
+
+	   sort key prefixed with "-" for the descending order.
+Items are sorted by id if not set.
+Keys are id and created_at, services can also be sorted by type, domain and status.
+	*/
+	Sort *string
+
+	/* Status.
+
+	   return only items in the status
+	*/
+	Status *string
+
 	/* SubscriptionID.
 
 	   subscription ID
 	*/
 	SubscriptionID *string
 
+	/* Type.
+
+	   return only services of the type
+	*/
+	Type *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -118,6 +169,83 @@ func (o *ServiceListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithContinue adds the continueVar to the service list params
+func (o *ServiceListParams) WithContinue(continueVar *string) *ServiceListParams {
+	o.SetContinue(continueVar)
+	return o
+}
+
+// SetContinue adds the continue to the service list params
+func (o *ServiceListParams) SetContinue(continueVar *string) {
+	o.Continue = continueVar
+}
+
+// WithCreatedAfter adds the createdAfter to the service list params
+func (o *ServiceListParams) WithCreatedAfter(createdAfter *string) *ServiceListParams {
+	o.SetCreatedAfter(createdAfter)
+	return o
+}
+
+// SetCreatedAfter adds the createdAfter to the service list params
+func (o *ServiceListParams) SetCreatedAfter(createdAfter *string) {
+	o.CreatedAfter = createdAfter
+}
+
+// WithCreatedBefore adds the createdBefore to the service list params
+func (o *ServiceListParams) WithCreatedBefore(createdBefore *string) *ServiceListParams {
+	o.SetCreatedBefore(createdBefore)
+	return o
+}
+
+// SetCreatedBefore adds the createdBefore to the service list params
+func (o *ServiceListParams) SetCreatedBefore(createdBefore *string) {
+	o.CreatedBefore = createdBefore
+}
+
+// WithDomain adds the domain to the service list params
+func (o *ServiceListParams) WithDomain(domain *string) *ServiceListParams {
+	o.SetDomain(domain)
+	return o
+}
+
+// SetDomain adds the domain to the service list params
+func (o *ServiceListParams) SetDomain(domain *string) {
+	o.Domain = domain
+}
+
+// WithLimit adds the limit to the service list params
+func (o *ServiceListParams) WithLimit(limit *int64) *ServiceListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the service list params
+func (o *ServiceListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithSort adds the sort to the service list params
+func (o *ServiceListParams) WithSort(sort *string) *ServiceListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the service list params
+func (o *ServiceListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithStatus adds the status to the service list params
+func (o *ServiceListParams) WithStatus(status *string) *ServiceListParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the service list params
+func (o *ServiceListParams) SetStatus(status *string) {
+	o.Status = status
+}
+
 // WithSubscriptionID adds the subscriptionID to the service list params
 func (o *ServiceListParams) WithSubscriptionID(subscriptionID *string) *ServiceListParams {
 	o.SetSubscriptionID(subscriptionID)
@@ -129,6 +257,17 @@ func (o *ServiceListParams) SetSubscriptionID(subscriptionID *string) {
 	o.SubscriptionID = subscriptionID
 }
 
+// WithType adds the typeVar to the service list params
+func (o *ServiceListParams) WithType(typeVar *string) *ServiceListParams {
+	o.SetType(typeVar)
+	return o
+}
+
+// SetType adds the type to the service list params
+func (o *ServiceListParams) SetType(typeVar *string) {
+	o.Type = typeVar
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ServiceListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -137,6 +276,125 @@ func (o *ServiceListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.Continue != nil {
+
+		// query param continue
+		var qrContinue string
+
+		if o.Continue != nil {
+			qrContinue = *o.Continue
+		}
+		qContinue := qrContinue
+		if qContinue != "" {
+
+			if err := r.SetQueryParam("continue", qContinue); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CreatedAfter != nil {
+
+		// query param created_after
+		var qrCreatedAfter string
+
+		if o.CreatedAfter != nil {
+			qrCreatedAfter = *o.CreatedAfter
+		}
+		qCreatedAfter := qrCreatedAfter
+		if qCreatedAfter != "" {
+
+			if err := r.SetQueryParam("created_after", qCreatedAfter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CreatedBefore != nil {
+
+		// query param created_before
+		var qrCreatedBefore string
+
+		if o.CreatedBefore != nil {
+			qrCreatedBefore = *o.CreatedBefore
+		}
+		qCreatedBefore := qrCreatedBefore
+		if qCreatedBefore != "" {
+
+			if err := r.SetQueryParam("created_before", qCreatedBefore); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Domain != nil {
+
+		// query param domain
+		var qrDomain string
+
+		if o.Domain != nil {
+			qrDomain = *o.Domain
+		}
+		qDomain := qrDomain
+		if qDomain != "" {
+
+			if err := r.SetQueryParam("domain", qDomain); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.SubscriptionID != nil {
 
 		// query param SubscriptionID
@@ -154,6 +412,23 @@ func (o *ServiceListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Type != nil {
+
+		// query param type
+		var qrType string
+
+		if o.Type != nil {
+			qrType = *o.Type
+		}
+		qType := qrType
+		if qType != "" {
+
+			if err := r.SetQueryParam("type", qType); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewServiceLogsParams creates a new ServiceLogsParams object,
@@ -61,16 +62,40 @@ type ServiceLogsParams struct {
 
 	/* ContainerName.
 
-	   service pod container name to query logs by
+	   service pod container name
 	*/
 	ContainerName *string
 
+	/* PodName.
+
+	   service pod name
+	*/
+	PodName *string
+
+	/* Previous.
+
+	   return logs of the previous terminated container instance
+	*/
+	Previous *bool
+
 	/* ServiceID.
 
 	   service Resource ID
 	*/
 	ServiceID string
 
+	/* SinceSeconds.
+
+	   show logs newer than a relative duration in seconds
+	*/
+	SinceSeconds *int64
+
+	/* TailLines.
+
+	   number of lines from the end of the logs to show
+	*/
+	TailLines *int64
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -135,6 +160,28 @@ func (o *ServiceLogsParams) SetContainerName(containerName *string) {
 	o.ContainerName = containerName
 }
 
+// WithPodName adds the podName to the service logs params
+func (o *ServiceLogsParams) WithPodName(podName *string) *ServiceLogsParams {
+	o.SetPodName(podName)
+	return o
+}
+
+// SetPodName adds the podName to the service logs params
+func (o *ServiceLogsParams) SetPodName(podName *string) {
+	o.PodName = podName
+}
+
+// WithPrevious adds the previous to the service logs params
+func (o *ServiceLogsParams) WithPrevious(previous *bool) *ServiceLogsParams {
+	o.SetPrevious(previous)
+	return o
+}
+
+// SetPrevious adds the previous to the service logs params
+func (o *ServiceLogsParams) SetPrevious(previous *bool) {
+	o.Previous = previous
+}
+
 // WithServiceID adds the serviceID to the service logs params
 func (o *ServiceLogsParams) WithServiceID(serviceID string) *ServiceLogsParams {
 	o.SetServiceID(serviceID)
@@ -146,6 +193,28 @@ func (o *ServiceLogsParams) SetServiceID(serviceID string) {
 	o.ServiceID = serviceID
 }
 
+// WithSinceSeconds adds the sinceSeconds to the service logs params
+func (o *ServiceLogsParams) WithSinceSeconds(sinceSeconds *int64) *ServiceLogsParams {
+	o.SetSinceSeconds(sinceSeconds)
+	return o
+}
+
+// SetSinceSeconds adds the sinceSeconds to the service logs params
+func (o *ServiceLogsParams) SetSinceSeconds(sinceSeconds *int64) {
+	o.SinceSeconds = sinceSeconds
+}
+
+// WithTailLines adds the tailLines to the service logs params
+func (o *ServiceLogsParams) WithTailLines(tailLines *int64) *ServiceLogsParams {
+	o.SetTailLines(tailLines)
+	return o
+}
+
+// SetTailLines adds the tailLines to the service logs params
+func (o *ServiceLogsParams) SetTailLines(tailLines *int64) {
+	o.TailLines = tailLines
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ServiceLogsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -171,11 +240,79 @@ func (o *ServiceLogsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.PodName != nil {
+
+		// query param PodName
+		var qrPodName string
+
+		if o.PodName != nil {
+			qrPodName = *o.PodName
+		}
+		qPodName := qrPodName
+		if qPodName != "" {
+
+			if err := r.SetQueryParam("PodName", qPodName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Previous != nil {
+
+		// query param Previous
+		var qrPrevious bool
+
+		if o.Previous != nil {
+			qrPrevious = *o.Previous
+		}
+		qPrevious := swag.FormatBool(qrPrevious)
+		if qPrevious != "" {
+
+			if err := r.SetQueryParam("Previous", qPrevious); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param ServiceID
 	if err := r.SetPathParam("ServiceID", o.ServiceID); err != nil {
 		return err
 	}
 
+	if o.SinceSeconds != nil {
+
+		// query param SinceSeconds
+		var qrSinceSeconds int64
+
+		if o.SinceSeconds != nil {
+			qrSinceSeconds = *o.SinceSeconds
+		}
+		qSinceSeconds := swag.FormatInt64(qrSinceSeconds)
+		if qSinceSeconds != "" {
+
+			if err := r.SetQueryParam("SinceSeconds", qSinceSeconds); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TailLines != nil {
+
+		// query param TailLines
+		var qrTailLines int64
+
+		if o.TailLines != nil {
+			qrTailLines = *o.TailLines
+		}
+		qTailLines := swag.FormatInt64(qrTailLines)
+		if qTailLines != "" {
+
+			if err := r.SetQueryParam("TailLines", qTailLines); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -59,6 +59,12 @@ func NewServiceUnarchiveParamsWithHTTPClient(client *http.Client) *ServiceUnarch
 */
 type ServiceUnarchiveParams struct {
 
+	/* IdempotencyKey.
+
+	   unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	*/
+	IdempotencyKey *string
+
 	/* ServiceID.
 
 	   service Resource ID
@@ -118,6 +124,17 @@ func (o *ServiceUnarchiveParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the service unarchive params
+func (o *ServiceUnarchiveParams) WithIdempotencyKey(idempotencyKey *string) *ServiceUnarchiveParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the service unarchive params
+func (o *ServiceUnarchiveParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithServiceID adds the serviceID to the service unarchive params
 func (o *ServiceUnarchiveParams) WithServiceID(serviceID string) *ServiceUnarchiveParams {
 	o.SetServiceID(serviceID)
@@ -137,6 +154,14 @@ func (o *ServiceUnarchiveParams) WriteToRequest(r runtime.ClientRequest, reg str
 	}
 	var res []error
 
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+
 	// path param ServiceID
 	if err := r.SetPathParam("ServiceID", o.ServiceID); err != nil {
 		return err
@@ -53,6 +53,12 @@ func (o *ServiceUnarchiveReader) ReadResponse(response runtime.ClientResponse, c
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewServiceUnarchiveConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewServiceUnarchiveUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -197,6 +203,38 @@ func (o *ServiceUnarchiveNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewServiceUnarchiveConflict creates a ServiceUnarchiveConflict with default headers values
+func NewServiceUnarchiveConflict() *ServiceUnarchiveConflict {
+	return &ServiceUnarchiveConflict{}
+}
+
+/* ServiceUnarchiveConflict describes a response with status code 409, with default header values.
+
+request with the same Idempotency-Key is in progress
+*/
+type ServiceUnarchiveConflict struct {
+	Payload *models.Error
+}
+
+func (o *ServiceUnarchiveConflict) Error() string {
+	return fmt.Sprintf("[POST /services/{ServiceID}/unarchive][%d] serviceUnarchiveConflict  %+v", 409, o.Payload)
+}
+func (o *ServiceUnarchiveConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ServiceUnarchiveConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewServiceUnarchiveUnprocessableEntity creates a ServiceUnarchiveUnprocessableEntity with default headers values
 func NewServiceUnarchiveUnprocessableEntity() *ServiceUnarchiveUnprocessableEntity {
 	return &ServiceUnarchiveUnprocessableEntity{}
@@ -10,9 +10,13 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/backup"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/plan"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/restore"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/client/webhook"
 )
 
 // Default service API HTTP client.
@@ -57,9 +61,13 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ServiceAPI
 
 	cli := new(ServiceAPI)
 	cli.Transport = transport
+	cli.Audit = audit.New(transport, formats)
 	cli.Backup = backup.New(transport, formats)
+	cli.Plan = plan.New(transport, formats)
 	cli.Restore = restore.New(transport, formats)
 	cli.Service = service.New(transport, formats)
+	cli.Token = token.New(transport, formats)
+	cli.Webhook = webhook.New(transport, formats)
 	return cli
 }
 
@@ -104,19 +112,31 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // ServiceAPI is a client for service API
 type ServiceAPI struct {
+	Audit audit.ClientService
+
 	Backup backup.ClientService
 
+	Plan plan.ClientService
+
 	Restore restore.ClientService
 
 	Service service.ClientService
 
+	Token token.ClientService
+
+	Webhook webhook.ClientService
+
 	Transport runtime.ClientTransport
 }
 
 // SetTransport changes the transport on the client and all its subresources
 func (c *ServiceAPI) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Audit.SetTransport(transport)
 	c.Backup.SetTransport(transport)
+	c.Plan.SetTransport(transport)
 	c.Restore.SetTransport(transport)
 	c.Service.SetTransport(transport)
+	c.Token.SetTransport(transport)
+	c.Webhook.SetTransport(transport)
 }
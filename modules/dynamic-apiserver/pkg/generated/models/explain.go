@@ -19,141 +19,157 @@ import (
 // swagger:model Explain
 type Explain struct {
 
-	// ingress
-	Ingress *ExplainIngress `json:"ingress,omitempty"`
+	// readiness of service components reported by the service plugin
+	Components []*ExplainComponent `json:"components"`
 
-	// pod
-	Pod *ExplainPod `json:"pod,omitempty"`
+	// conditions
+	Conditions []*ExplainCondition `json:"conditions"`
 
-	// pvc
-	Pvc *ExplainPvc `json:"pvc,omitempty"`
+	// recent events of the service namespace, newest first
+	Events []*ExplainEvent `json:"events"`
+
+	// last error
+	LastError *ExplainError `json:"lastError,omitempty"`
+
+	// namespace that contains service objects
+	Namespace string `json:"namespace,omitempty"`
+
+	// objects managed by the service plugin
+	Objects []*ExplainObject `json:"objects"`
+
+	// phase
+	Phase string `json:"phase,omitempty"`
+
+	// pods
+	Pods []*ExplainPod `json:"pods"`
+
+	// parts of the report that could not be collected
+	Warnings []string `json:"warnings"`
 }
 
 // Validate validates this explain
 func (m *Explain) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateIngress(formats); err != nil {
+	if err := m.validateComponents(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := m.validatePod(formats); err != nil {
+	if err := m.validateConditions(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := m.validatePvc(formats); err != nil {
+	if err := m.validateEvents(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
+	if err := m.validateLastError(formats); err != nil {
+		res = append(res, err)
 	}
-	return nil
-}
 
-func (m *Explain) validateIngress(formats strfmt.Registry) error {
-	if swag.IsZero(m.Ingress) { // not required
-		return nil
+	if err := m.validateObjects(formats); err != nil {
+		res = append(res, err)
 	}
 
-	if m.Ingress != nil {
-		if err := m.Ingress.Validate(formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("ingress")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("ingress")
-			}
-			return err
-		}
+	if err := m.validatePods(formats); err != nil {
+		res = append(res, err)
 	}
 
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-func (m *Explain) validatePod(formats strfmt.Registry) error {
-	if swag.IsZero(m.Pod) { // not required
+func (m *Explain) validateComponents(formats strfmt.Registry) error {
+	if swag.IsZero(m.Components) { // not required
 		return nil
 	}
 
-	if m.Pod != nil {
-		if err := m.Pod.Validate(formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("pod")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("pod")
+	for i := 0; i < len(m.Components); i++ {
+		if swag.IsZero(m.Components[i]) { // not required
+			continue
+		}
+
+		if m.Components[i] != nil {
+			if err := m.Components[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("components" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("components" + "." + strconv.Itoa(i))
+				}
+				return err
 			}
-			return err
 		}
+
 	}
 
 	return nil
 }
 
-func (m *Explain) validatePvc(formats strfmt.Registry) error {
-	if swag.IsZero(m.Pvc) { // not required
+func (m *Explain) validateConditions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Conditions) { // not required
 		return nil
 	}
 
-	if m.Pvc != nil {
-		if err := m.Pvc.Validate(formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("pvc")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("pvc")
+	for i := 0; i < len(m.Conditions); i++ {
+		if swag.IsZero(m.Conditions[i]) { // not required
+			continue
+		}
+
+		if m.Conditions[i] != nil {
+			if err := m.Conditions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("conditions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("conditions" + "." + strconv.Itoa(i))
+				}
+				return err
 			}
-			return err
 		}
+
 	}
 
 	return nil
 }
 
-// ContextValidate validate this explain based on the context it is used
-func (m *Explain) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	var res []error
-
-	if err := m.contextValidateIngress(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.contextValidatePod(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.contextValidatePvc(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
+func (m *Explain) validateEvents(formats strfmt.Registry) error {
+	if swag.IsZero(m.Events) { // not required
+		return nil
 	}
-	return nil
-}
 
-func (m *Explain) contextValidateIngress(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(m.Events); i++ {
+		if swag.IsZero(m.Events[i]) { // not required
+			continue
+		}
 
-	if m.Ingress != nil {
-		if err := m.Ingress.ContextValidate(ctx, formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("ingress")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("ingress")
+		if m.Events[i] != nil {
+			if err := m.Events[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("events" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("events" + "." + strconv.Itoa(i))
+				}
+				return err
 			}
-			return err
 		}
+
 	}
 
 	return nil
 }
 
-func (m *Explain) contextValidatePod(ctx context.Context, formats strfmt.Registry) error {
+func (m *Explain) validateLastError(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastError) { // not required
+		return nil
+	}
 
-	if m.Pod != nil {
-		if err := m.Pod.ContextValidate(ctx, formats); err != nil {
+	if m.LastError != nil {
+		if err := m.LastError.Validate(formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("pod")
+				return ve.ValidateName("lastError")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("pod")
+				return ce.ValidateName("lastError")
 			}
 			return err
 		}
@@ -162,100 +178,83 @@ func (m *Explain) contextValidatePod(ctx context.Context, formats strfmt.Registr
 	return nil
 }
 
-func (m *Explain) contextValidatePvc(ctx context.Context, formats strfmt.Registry) error {
+func (m *Explain) validateObjects(formats strfmt.Registry) error {
+	if swag.IsZero(m.Objects) { // not required
+		return nil
+	}
 
-	if m.Pvc != nil {
-		if err := m.Pvc.ContextValidate(ctx, formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("pvc")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("pvc")
-			}
-			return err
+	for i := 0; i < len(m.Objects); i++ {
+		if swag.IsZero(m.Objects[i]) { // not required
+			continue
 		}
-	}
 
-	return nil
-}
+		if m.Objects[i] != nil {
+			if err := m.Objects[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("objects" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("objects" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
 
-// MarshalBinary interface implementation
-func (m *Explain) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
 	}
-	return swag.WriteJSON(m)
-}
 
-// UnmarshalBinary interface implementation
-func (m *Explain) UnmarshalBinary(b []byte) error {
-	var res Explain
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
 	return nil
 }
 
-// ExplainIngress explain ingress
-//
-// swagger:model ExplainIngress
-type ExplainIngress struct {
+func (m *Explain) validatePods(formats strfmt.Registry) error {
+	if swag.IsZero(m.Pods) { // not required
+		return nil
+	}
 
-	// error
-	Error string `json:"error,omitempty"`
+	for i := 0; i < len(m.Pods); i++ {
+		if swag.IsZero(m.Pods[i]) { // not required
+			continue
+		}
 
-	// hosts
-	Hosts []string `json:"hosts"`
+		if m.Pods[i] != nil {
+			if err := m.Pods[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pods" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
 
-	// ingress class
-	IngressClass string `json:"ingressClass,omitempty"`
-}
+	}
 
-// Validate validates this explain ingress
-func (m *ExplainIngress) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this explain ingress based on context it is used
-func (m *ExplainIngress) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
+// ContextValidate validate this explain based on the context it is used
+func (m *Explain) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
 
-// MarshalBinary interface implementation
-func (m *ExplainIngress) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
+	if err := m.contextValidateComponents(ctx, formats); err != nil {
+		res = append(res, err)
 	}
-	return swag.WriteJSON(m)
-}
 
-// UnmarshalBinary interface implementation
-func (m *ExplainIngress) UnmarshalBinary(b []byte) error {
-	var res ExplainIngress
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
+	if err := m.contextValidateConditions(ctx, formats); err != nil {
+		res = append(res, err)
 	}
-	*m = res
-	return nil
-}
 
-// ExplainPod explain pod
-//
-// swagger:model ExplainPod
-type ExplainPod struct {
-
-	// containers
-	Containers []*ExplainPodContainersItems0 `json:"containers"`
+	if err := m.contextValidateEvents(ctx, formats); err != nil {
+		res = append(res, err)
+	}
 
-	// error
-	Error string `json:"error,omitempty"`
-}
+	if err := m.contextValidateLastError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
 
-// Validate validates this explain pod
-func (m *ExplainPod) Validate(formats strfmt.Registry) error {
-	var res []error
+	if err := m.contextValidateObjects(ctx, formats); err != nil {
+		res = append(res, err)
+	}
 
-	if err := m.validateContainers(formats); err != nil {
+	if err := m.contextValidatePods(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -265,22 +264,16 @@ func (m *ExplainPod) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *ExplainPod) validateContainers(formats strfmt.Registry) error {
-	if swag.IsZero(m.Containers) { // not required
-		return nil
-	}
+func (m *Explain) contextValidateComponents(ctx context.Context, formats strfmt.Registry) error {
 
-	for i := 0; i < len(m.Containers); i++ {
-		if swag.IsZero(m.Containers[i]) { // not required
-			continue
-		}
+	for i := 0; i < len(m.Components); i++ {
 
-		if m.Containers[i] != nil {
-			if err := m.Containers[i].Validate(formats); err != nil {
+		if m.Components[i] != nil {
+			if err := m.Components[i].ContextValidate(ctx, formats); err != nil {
 				if ve, ok := err.(*errors.Validation); ok {
-					return ve.ValidateName("pod" + "." + "containers" + "." + strconv.Itoa(i))
+					return ve.ValidateName("components" + "." + strconv.Itoa(i))
 				} else if ce, ok := err.(*errors.CompositeError); ok {
-					return ce.ValidateName("pod" + "." + "containers" + "." + strconv.Itoa(i))
+					return ce.ValidateName("components" + "." + strconv.Itoa(i))
 				}
 				return err
 			}
@@ -291,30 +284,36 @@ func (m *ExplainPod) validateContainers(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validate this explain pod based on the context it is used
-func (m *ExplainPod) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	var res []error
+func (m *Explain) contextValidateConditions(ctx context.Context, formats strfmt.Registry) error {
 
-	if err := m.contextValidateContainers(ctx, formats); err != nil {
-		res = append(res, err)
-	}
+	for i := 0; i < len(m.Conditions); i++ {
+
+		if m.Conditions[i] != nil {
+			if err := m.Conditions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("conditions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("conditions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
 
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
 	}
+
 	return nil
 }
 
-func (m *ExplainPod) contextValidateContainers(ctx context.Context, formats strfmt.Registry) error {
+func (m *Explain) contextValidateEvents(ctx context.Context, formats strfmt.Registry) error {
 
-	for i := 0; i < len(m.Containers); i++ {
+	for i := 0; i < len(m.Events); i++ {
 
-		if m.Containers[i] != nil {
-			if err := m.Containers[i].ContextValidate(ctx, formats); err != nil {
+		if m.Events[i] != nil {
+			if err := m.Events[i].ContextValidate(ctx, formats); err != nil {
 				if ve, ok := err.(*errors.Validation); ok {
-					return ve.ValidateName("pod" + "." + "containers" + "." + strconv.Itoa(i))
+					return ve.ValidateName("events" + "." + strconv.Itoa(i))
 				} else if ce, ok := err.(*errors.CompositeError); ok {
-					return ce.ValidateName("pod" + "." + "containers" + "." + strconv.Itoa(i))
+					return ce.ValidateName("events" + "." + strconv.Itoa(i))
 				}
 				return err
 			}
@@ -325,97 +324,64 @@ func (m *ExplainPod) contextValidateContainers(ctx context.Context, formats strf
 	return nil
 }
 
-// MarshalBinary interface implementation
-func (m *ExplainPod) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
+func (m *Explain) contextValidateLastError(ctx context.Context, formats strfmt.Registry) error {
 
-// UnmarshalBinary interface implementation
-func (m *ExplainPod) UnmarshalBinary(b []byte) error {
-	var res ExplainPod
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
+	if m.LastError != nil {
+		if err := m.LastError.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("lastError")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("lastError")
+			}
+			return err
+		}
 	}
-	*m = res
+
 	return nil
 }
 
-// ExplainPodContainersItems0 explain pod containers items0
-//
-// swagger:model ExplainPodContainersItems0
-type ExplainPodContainersItems0 struct {
-
-	// name
-	Name string `json:"name,omitempty"`
+func (m *Explain) contextValidateObjects(ctx context.Context, formats strfmt.Registry) error {
 
-	// restart count
-	RestartCount *int64 `json:"restartCount,omitempty"`
+	for i := 0; i < len(m.Objects); i++ {
 
-	// status
-	Status string `json:"status,omitempty"`
-}
-
-// Validate validates this explain pod containers items0
-func (m *ExplainPodContainersItems0) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this explain pod containers items0 based on context it is used
-func (m *ExplainPodContainersItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
+		if m.Objects[i] != nil {
+			if err := m.Objects[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("objects" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("objects" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
 
-// MarshalBinary interface implementation
-func (m *ExplainPodContainersItems0) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
 	}
-	return swag.WriteJSON(m)
-}
 
-// UnmarshalBinary interface implementation
-func (m *ExplainPodContainersItems0) UnmarshalBinary(b []byte) error {
-	var res ExplainPodContainersItems0
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
 	return nil
 }
 
-// ExplainPvc explain pvc
-//
-// swagger:model ExplainPvc
-type ExplainPvc struct {
-
-	// error
-	Error string `json:"error,omitempty"`
-
-	// phase
-	Phase string `json:"phase,omitempty"`
+func (m *Explain) contextValidatePods(ctx context.Context, formats strfmt.Registry) error {
 
-	// size
-	Size string `json:"size,omitempty"`
+	for i := 0; i < len(m.Pods); i++ {
 
-	// storage class
-	StorageClass string `json:"storageClass,omitempty"`
-}
+		if m.Pods[i] != nil {
+			if err := m.Pods[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pods" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
 
-// Validate validates this explain pvc
-func (m *ExplainPvc) Validate(formats strfmt.Registry) error {
-	return nil
-}
+	}
 
-// ContextValidate validates this explain pvc based on context it is used
-func (m *ExplainPvc) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (m *ExplainPvc) MarshalBinary() ([]byte, error) {
+func (m *Explain) MarshalBinary() ([]byte, error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -423,8 +389,8 @@ func (m *ExplainPvc) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (m *ExplainPvc) UnmarshalBinary(b []byte) error {
-	var res ExplainPvc
+func (m *Explain) UnmarshalBinary(b []byte) error {
+	var res Explain
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -26,6 +26,10 @@ type Log struct {
 	// logs
 	// Read Only: true
 	Logs string `json:"logs,omitempty"`
+
+	// pod name
+	// Read Only: true
+	PodName string `json:"podName,omitempty"`
 }
 
 // Validate validates this log
@@ -45,6 +49,10 @@ func (m *Log) ContextValidate(ctx context.Context, formats strfmt.Registry) erro
 		res = append(res, err)
 	}
 
+	if err := m.contextValidatePodName(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -69,6 +77,15 @@ func (m *Log) contextValidateLogs(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Log) contextValidatePodName(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "podName", "body", string(m.PodName)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Log) MarshalBinary() ([]byte, error) {
 	if m == nil {
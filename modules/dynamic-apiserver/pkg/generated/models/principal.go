@@ -9,19 +9,48 @@ import (
 	"context"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
-// Principal principal
+// Principal authenticated API client
 //
 // swagger:model principal
-type Principal string
+type Principal struct {
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// scopes
+	Scopes []string `json:"scopes"`
+
+	// tenant of the principal, empty for principals with access to all tenants
+	Tenant string `json:"tenant,omitempty"`
+}
 
 // Validate validates this principal
-func (m Principal) Validate(formats strfmt.Registry) error {
+func (m *Principal) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
 // ContextValidate validates this principal based on context it is used
-func (m Principal) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+func (m *Principal) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Principal) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Principal) UnmarshalBinary(b []byte) error {
+	var res Principal
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
 	return nil
 }
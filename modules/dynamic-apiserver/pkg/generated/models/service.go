@@ -50,6 +50,9 @@ type Service struct {
 	// limits
 	Limits *Limits `json:"limits,omitempty"`
 
+	// name of the service plan, plan parameters are used unless they are set in the service
+	Plan string `json:"plan,omitempty"`
+
 	// replicas
 	Replicas *int64 `json:"replicas,omitempty"`
 
@@ -60,6 +63,10 @@ type Service struct {
 	// subscription
 	Subscription string `json:"subscription,omitempty"`
 
+	// tenant owning the service, it is set from the authenticated principal
+	// if the principal belongs to a tenant
+	Tenant string `json:"tenant,omitempty"`
+
 	// type
 	// Required: true
 	Type *string `json:"type"`
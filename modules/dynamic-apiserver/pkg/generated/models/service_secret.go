@@ -25,10 +25,18 @@ type ServiceSecret struct {
 	// Pattern: [a-z0-9]([-a-z0-9]*[a-z0-9])?
 	ID string `json:"id,omitempty"`
 
-	// value
+	// secret can be rotated or set
+	// Read Only: true
+	Rotatable bool `json:"rotatable,omitempty"`
+
+	// secret value, it is returned only when a single secret is requested
 	// Read Only: true
 	// Min Length: 1
 	Value string `json:"value,omitempty"`
+
+	// secret can be revealed
+	// Read Only: true
+	Visible bool `json:"visible,omitempty"`
 }
 
 // Validate validates this service secret
@@ -85,10 +93,18 @@ func (m *ServiceSecret) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRotatable(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateValue(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVisible(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -104,6 +120,15 @@ func (m *ServiceSecret) contextValidateID(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *ServiceSecret) contextValidateRotatable(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "rotatable", "body", bool(m.Rotatable)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceSecret) contextValidateValue(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "value", "body", string(m.Value)); err != nil {
@@ -113,6 +138,15 @@ func (m *ServiceSecret) contextValidateValue(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *ServiceSecret) contextValidateVisible(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "visible", "body", bool(m.Visible)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceSecret) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -39,6 +39,7 @@ func configureAPI(api *operations.KuberlogicAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
+	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
 
 	// Applies when the "x-token" header is set
@@ -39,73 +39,40 @@ func init() {
   "host": "localhost:8001",
   "basePath": "/api/v1/",
   "paths": {
-    "/backups/": {
+    "/audit/": {
       "get": {
-        "description": "List backup objects",
+        "description": "List audit records of mutating and sensitive API calls, newest first.\nPrincipals of a tenant see only records of their tenant.\n",
         "tags": [
-          "backup"
+          "audit"
         ],
-        "summary": "list backups",
-        "operationId": "backupList",
+        "summary": "query audit records",
+        "operationId": "auditList",
         "parameters": [
           {
-            "$ref": "#/parameters/BackupRestoreServiceID"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "search results matching criteria",
-            "schema": {
-              "$ref": "#/definitions/Backups"
-            }
-          },
-          "400": {
-            "description": "bad input parameter",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+            "$ref": "#/parameters/AuditPrincipal"
           },
-          "401": {
-            "description": "bad authentication"
+          {
+            "$ref": "#/parameters/AuditOperation"
           },
-          "403": {
-            "description": "bad permissions"
+          {
+            "$ref": "#/parameters/AuditTarget"
           },
-          "422": {
-            "description": "bad validation",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+          {
+            "$ref": "#/parameters/AuditSince"
           },
-          "503": {
-            "description": "internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      },
-      "post": {
-        "description": "Create backup object",
-        "tags": [
-          "backup"
-        ],
-        "summary": "create backup object",
-        "operationId": "backupAdd",
-        "parameters": [
           {
-            "$ref": "#/parameters/BackupItem"
+            "$ref": "#/parameters/AuditLimit"
           }
         ],
         "responses": {
-          "201": {
-            "description": "item created",
+          "200": {
+            "description": "search results matching criteria",
             "schema": {
-              "$ref": "#/definitions/Backup"
+              "$ref": "#/definitions/AuditRecords"
             }
           },
           "400": {
-            "description": "invalid input, object invalid",
+            "description": "bad input parameter",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -113,8 +80,8 @@ func init() {
           "401": {
             "description": "bad authentication"
           },
-          "409": {
-            "description": "item already exists"
+          "403": {
+            "description": "bad permissions"
           },
           "422": {
             "description": "bad validation",
@@ -131,71 +98,48 @@ func init() {
         }
       }
     },
-    "/backups/{BackupID}/": {
-      "delete": {
-        "description": "Deletes a backup object\n",
+    "/backups/": {
+      "get": {
+        "description": "List backup objects",
         "tags": [
           "backup"
         ],
-        "summary": "deletes a backup item",
-        "operationId": "backupDelete",
+        "summary": "list backups",
+        "operationId": "backupList",
         "parameters": [
           {
-            "$ref": "#/parameters/BackupID"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "item deleted"
+            "$ref": "#/parameters/BackupRestoreServiceID"
           },
-          "400": {
-            "description": "invalid input, object invalid",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+          {
+            "$ref": "#/parameters/ListLimit"
           },
-          "401": {
-            "description": "bad authentication"
+          {
+            "$ref": "#/parameters/ListContinue"
           },
-          "403": {
-            "description": "bad permissions"
+          {
+            "$ref": "#/parameters/ListSort"
           },
-          "404": {
-            "description": "item not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+          {
+            "$ref": "#/parameters/ListStatus"
           },
-          "422": {
-            "description": "bad validation"
+          {
+            "$ref": "#/parameters/ListCreatedAfter"
           },
-          "503": {
-            "description": "internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/restores/": {
-      "get": {
-        "description": "List restore objects",
-        "tags": [
-          "restore"
-        ],
-        "summary": "list restores",
-        "operationId": "restoreList",
-        "parameters": [
           {
-            "$ref": "#/parameters/BackupRestoreServiceID"
+            "$ref": "#/parameters/ListCreatedBefore"
           }
         ],
         "responses": {
           "200": {
             "description": "search results matching criteria",
             "schema": {
-              "$ref": "#/definitions/Restores"
+              "$ref": "#/definitions/Backups"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "token of the next page, it is empty on the last page"
+              }
             }
           },
           "400": {
@@ -225,22 +169,25 @@ func init() {
         }
       },
       "post": {
-        "description": "Create restore object",
+        "description": "Create backup object",
         "tags": [
-          "restore"
+          "backup"
         ],
-        "summary": "create restore object",
-        "operationId": "restoreAdd",
+        "summary": "create backup object",
+        "operationId": "backupAdd",
         "parameters": [
           {
-            "$ref": "#/parameters/RestoreItem"
+            "$ref": "#/parameters/BackupItem"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
           }
         ],
         "responses": {
           "201": {
             "description": "item created",
             "schema": {
-              "$ref": "#/definitions/Restore"
+              "$ref": "#/definitions/Backup"
             }
           },
           "400": {
@@ -253,7 +200,7 @@ func init() {
             "description": "bad authentication"
           },
           "409": {
-            "description": "item already exists"
+            "description": "item already exists or a request with the same Idempotency-Key is in progress"
           },
           "422": {
             "description": "bad validation",
@@ -270,17 +217,17 @@ func init() {
         }
       }
     },
-    "/restores/{RestoreID}/": {
+    "/backups/{BackupID}/": {
       "delete": {
-        "description": "Deletes a restore object\n",
+        "description": "Deletes a backup object\n",
         "tags": [
-          "restore"
+          "backup"
         ],
-        "summary": "deletes a restore item",
-        "operationId": "restoreDelete",
+        "summary": "deletes a backup item",
+        "operationId": "backupDelete",
         "parameters": [
           {
-            "$ref": "#/parameters/RestoreID"
+            "$ref": "#/parameters/BackupID"
           }
         ],
         "responses": {
@@ -317,30 +264,18 @@ func init() {
         }
       }
     },
-    "/services/": {
+    "/plans/": {
       "get": {
-        "description": "List of service objects\n",
         "tags": [
-          "service"
-        ],
-        "summary": "lists all services",
-        "operationId": "serviceList",
-        "parameters": [
-          {
-            "$ref": "#/parameters/SubscriptionID"
-          }
+          "plan"
         ],
+        "summary": "list service plans",
+        "operationId": "planList",
         "responses": {
           "200": {
             "description": "search results matching criteria",
             "schema": {
-              "$ref": "#/definitions/Services"
-            }
-          },
-          "400": {
-            "description": "bad input parameter",
-            "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/ServicePlans"
             }
           },
           "401": {
@@ -349,12 +284,6 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
-          "422": {
-            "description": "bad validation",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "503": {
             "description": "internal server error",
             "schema": {
@@ -364,22 +293,25 @@ func init() {
         }
       },
       "post": {
-        "description": "Adds service object\n",
+        "description": "Create a service plan. Services created with the plan get its parameters\nunless they are overridden by the service request\n",
         "tags": [
-          "service"
+          "plan"
         ],
-        "summary": "adds a service item",
-        "operationId": "serviceAdd",
+        "summary": "create service plan",
+        "operationId": "planAdd",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceItem"
+            "$ref": "#/parameters/PlanItem"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
           }
         ],
         "responses": {
           "201": {
             "description": "item created",
             "schema": {
-              "$ref": "#/definitions/Service"
+              "$ref": "#/definitions/ServicePlan"
             }
           },
           "400": {
@@ -395,7 +327,10 @@ func init() {
             "description": "bad permissions"
           },
           "409": {
-            "description": "item already exists"
+            "description": "item already exists or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
           "422": {
             "description": "bad validation",
@@ -412,24 +347,23 @@ func init() {
         }
       }
     },
-    "/services/{ServiceID}/": {
+    "/plans/{PlanName}/": {
       "get": {
-        "description": "Get service object\n",
         "tags": [
-          "service"
+          "plan"
         ],
-        "summary": "get a service item",
-        "operationId": "serviceGet",
+        "summary": "get service plan",
+        "operationId": "planGet",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/PlanName"
           }
         ],
         "responses": {
           "200": {
-            "description": "item edited",
+            "description": "item found",
             "schema": {
-              "$ref": "#/definitions/Service"
+              "$ref": "#/definitions/ServicePlan"
             }
           },
           "400": {
@@ -465,15 +399,15 @@ func init() {
         }
       },
       "delete": {
-        "description": "Deletes a service object\n",
+        "description": "Delete a service plan. Plans used by services can't be deleted\n",
         "tags": [
-          "service"
+          "plan"
         ],
-        "summary": "deletes a service item",
-        "operationId": "serviceDelete",
+        "summary": "delete service plan",
+        "operationId": "planDelete",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/PlanName"
           }
         ],
         "responses": {
@@ -498,6 +432,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "409": {
+            "description": "plan is used by services",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "422": {
             "description": "bad validation"
           },
@@ -510,25 +450,28 @@ func init() {
         }
       },
       "patch": {
-        "description": "Edit service object\n",
+        "description": "Edit a service plan. When rollout is set the plan changes are applied to all services\ncreated with the plan, service overrides are kept\n",
         "tags": [
-          "service"
+          "plan"
         ],
-        "summary": "edit a service item",
-        "operationId": "serviceEdit",
+        "summary": "edit service plan",
+        "operationId": "planEdit",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/PlanName"
           },
           {
-            "$ref": "#/parameters/ServiceItem"
+            "$ref": "#/parameters/PlanItem"
+          },
+          {
+            "$ref": "#/parameters/PlanRollout"
           }
         ],
         "responses": {
           "200": {
             "description": "item edited",
             "schema": {
-              "$ref": "#/definitions/Service"
+              "$ref": "#/definitions/ServicePlan"
             }
           },
           "400": {
@@ -564,25 +507,52 @@ func init() {
         }
       }
     },
-    "/services/{ServiceID}/archive": {
-      "post": {
-        "description": "archive service (for example, if user subscription got cancelled)",
+    "/restores/": {
+      "get": {
+        "description": "List restore objects",
         "tags": [
-          "service"
+          "restore"
         ],
-        "summary": "archive service",
-        "operationId": "serviceArchive",
+        "summary": "list restores",
+        "operationId": "restoreList",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/BackupRestoreServiceID"
+          },
+          {
+            "$ref": "#/parameters/ListLimit"
+          },
+          {
+            "$ref": "#/parameters/ListContinue"
+          },
+          {
+            "$ref": "#/parameters/ListSort"
+          },
+          {
+            "$ref": "#/parameters/ListStatus"
+          },
+          {
+            "$ref": "#/parameters/ListCreatedAfter"
+          },
+          {
+            "$ref": "#/parameters/ListCreatedBefore"
           }
         ],
         "responses": {
           "200": {
-            "description": "service request to archive is sent"
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Restores"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "token of the next page, it is empty on the last page"
+              }
+            }
           },
           "400": {
-            "description": "invalid input",
+            "description": "bad input parameter",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -593,46 +563,88 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
-          "404": {
-            "description": "service not found",
+          "422": {
+            "description": "bad validation",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "422": {
-            "description": "bad validation"
-          },
           "503": {
-            "description": "internal service error",
+            "description": "internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      }
-    },
-    "/services/{ServiceID}/credentials": {
+      },
       "post": {
-        "description": "updates service credentials with passed data",
+        "description": "Create restore object",
         "tags": [
-          "service"
+          "restore"
         ],
-        "summary": "updates service credentials",
-        "operationId": "serviceCredentialsUpdate",
+        "summary": "create restore object",
+        "operationId": "restoreAdd",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/RestoreItem"
           },
           {
-            "$ref": "#/parameters/ServiceCredentials"
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Restore"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/restores/{RestoreID}/": {
+      "delete": {
+        "description": "Deletes a restore object\n",
+        "tags": [
+          "restore"
+        ],
+        "summary": "deletes a restore item",
+        "operationId": "restoreDelete",
+        "parameters": [
+          {
+            "$ref": "#/parameters/RestoreID"
           }
         ],
         "responses": {
           "200": {
-            "description": "credentials are updated"
+            "description": "item deleted"
           },
           "400": {
-            "description": "invalid input",
+            "description": "invalid input, object invalid",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -643,11 +655,17 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "422": {
             "description": "bad validation"
           },
           "503": {
-            "description": "internal service error",
+            "description": "internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -655,24 +673,84 @@ func init() {
         }
       }
     },
-    "/services/{ServiceID}/explain": {
+    "/service-types/": {
       "get": {
-        "description": "Explain status of service",
+        "description": "list service types and their supported versions",
         "tags": [
           "service"
         ],
-        "summary": "explain status of service",
-        "operationId": "serviceExplain",
+        "summary": "list service types",
+        "operationId": "serviceTypeList",
+        "responses": {
+          "200": {
+            "description": "service types and their versions",
+            "schema": {
+              "$ref": "#/definitions/ServiceTypes"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/": {
+      "get": {
+        "description": "List of service objects.\nPass the X-Continue header value of the response as the continue parameter to get the next page.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "lists all services",
+        "operationId": "serviceList",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/SubscriptionID"
+          },
+          {
+            "$ref": "#/parameters/ListLimit"
+          },
+          {
+            "$ref": "#/parameters/ListContinue"
+          },
+          {
+            "$ref": "#/parameters/ListSort"
+          },
+          {
+            "$ref": "#/parameters/ListStatus"
+          },
+          {
+            "$ref": "#/parameters/ListCreatedAfter"
+          },
+          {
+            "$ref": "#/parameters/ListCreatedBefore"
+          },
+          {
+            "$ref": "#/parameters/ServiceTypeFilter"
+          },
+          {
+            "$ref": "#/parameters/ServiceDomainFilter"
           }
         ],
         "responses": {
           "200": {
-            "description": "kuberlogic service explain",
+            "description": "search results matching criteria",
             "schema": {
-              "$ref": "#/definitions/Explain"
+              "$ref": "#/definitions/Services"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "token of the next page, it is empty on the last page"
+              }
             }
           },
           "400": {
@@ -700,29 +778,89 @@ func init() {
             }
           }
         }
+      },
+      "post": {
+        "description": "Adds service object\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "adds a service item",
+        "operationId": "serviceAdd",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceItem"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Service"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions or tenant quota exceeded",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "/services/{ServiceID}/logs": {
+    "/services/watch": {
       "get": {
-        "description": "List service pod logs",
+        "description": "Streams service changes as server-sent events.\nEvery event carries the service resource version as its id, pass it back to resume the stream.\n",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
           "service"
         ],
-        "summary": "list service logs",
-        "operationId": "serviceLogs",
+        "summary": "watch services",
+        "operationId": "serviceListWatch",
         "parameters": [
           {
-            "$ref": "#/parameters/ServiceID"
+            "$ref": "#/parameters/SubscriptionID"
           },
           {
-            "$ref": "#/parameters/ContainerName"
+            "$ref": "#/parameters/ResourceVersion"
+          },
+          {
+            "$ref": "#/parameters/LastEventID"
           }
         ],
         "responses": {
           "200": {
-            "description": "kuberlogic service logs",
+            "description": "stream of service events",
             "schema": {
-              "$ref": "#/definitions/Logs"
+              "type": "file"
             }
           },
           "400": {
@@ -752,14 +890,14 @@ func init() {
         }
       }
     },
-    "/services/{ServiceID}/secrets": {
+    "/services/{ServiceID}/": {
       "get": {
-        "description": "retrieves service secrets",
+        "description": "Get service object\n",
         "tags": [
           "service"
         ],
-        "summary": "retrieves service secrets",
-        "operationId": "serviceSecretsList",
+        "summary": "get a service item",
+        "operationId": "serviceGet",
         "parameters": [
           {
             "$ref": "#/parameters/ServiceID"
@@ -767,9 +905,15 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "service secrets",
+            "description": "item edited",
             "schema": {
-              "$ref": "#/definitions/ServiceSecrets"
+              "$ref": "#/definitions/Service"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "resource version of the service, pass it in the If-Match header to edit this version only"
+              }
             }
           },
           "400": {
@@ -784,23 +928,33 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "503": {
-            "description": "internal service error",
+            "description": "internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      }
-    },
-    "/services/{ServiceID}/unarchive": {
-      "post": {
-        "description": "unarchive service (for example, if user subscription resumed from canceled state)",
+      },
+      "delete": {
+        "description": "Deletes a service object\n",
         "tags": [
           "service"
         ],
-        "summary": "unarchive service",
-        "operationId": "serviceUnarchive",
+        "summary": "deletes a service item",
+        "operationId": "serviceDelete",
         "parameters": [
           {
             "$ref": "#/parameters/ServiceID"
@@ -808,10 +962,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "service request to unarchive is sent"
+            "description": "item deleted"
           },
           "400": {
-            "description": "invalid input",
+            "description": "invalid input, object invalid",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -823,7 +977,7 @@ func init() {
             "description": "bad permissions"
           },
           "404": {
-            "description": "service not found",
+            "description": "item not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -832,449 +986,4858 @@ func init() {
             "description": "bad validation"
           },
           "503": {
-            "description": "internal service error",
+            "description": "internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "Advanced": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "Backup": {
-      "type": "object",
-      "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "readOnly": true
-        },
-        "id": {
-          "type": "string",
-          "maxLength": 63,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-          "readOnly": true
-        },
-        "service_id": {
-          "type": "string",
-          "maxLength": 20,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
-        },
-        "status": {
-          "type": "string"
+      },
+      "patch": {
+        "description": "Edit service object.\nPass the ETag of the service in the If-Match header to reject the edit if the service was changed since it was read.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "edit a service item",
+        "operationId": "serviceEdit",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/ServiceItem"
+          },
+          {
+            "$ref": "#/parameters/IfMatch"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item edited",
+            "schema": {
+              "$ref": "#/definitions/Service"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "resource version of the service, pass it in the If-Match header to edit this version only"
+              }
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions or tenant quota exceeded",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "service was changed by a concurrent request, read it again and retry",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "412": {
+            "description": "service does not match the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Backups": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Backup"
-      }
-    },
-    "Error": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string"
+    "/services/{ServiceID}/archive": {
+      "post": {
+        "description": "archive service (for example, if user subscription got cancelled)",
+        "tags": [
+          "service"
+        ],
+        "summary": "archive service",
+        "operationId": "serviceArchive",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service request to archive is sent"
+          },
+          "400": {
+            "description": "invalid input",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "service not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Explain": {
-      "type": "object",
-      "properties": {
-        "ingress": {
-          "type": "object",
-          "properties": {
-            "error": {
-              "type": "string"
-            },
-            "hosts": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            },
-            "ingressClass": {
-              "type": "string"
-            }
+    "/services/{ServiceID}/credentials": {
+      "post": {
+        "description": "updates service credentials with passed data",
+        "tags": [
+          "service"
+        ],
+        "summary": "updates service credentials",
+        "operationId": "serviceCredentialsUpdate",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/ServiceCredentials"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
           }
-        },
-        "pod": {
-          "type": "object",
-          "properties": {
-            "containers": {
-              "type": "array",
-              "items": {
-                "type": "object",
-                "properties": {
-                  "name": {
-                    "type": "string"
-                  },
-                  "restartCount": {
-                    "type": "integer",
-                    "x-nullable": true
-                  },
-                  "status": {
-                    "type": "string"
-                  }
-                }
-              }
-            },
-            "error": {
-              "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "credentials are updated"
+          },
+          "400": {
+            "description": "invalid input",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
-          }
-        },
-        "pvc": {
-          "type": "object",
-          "properties": {
-            "error": {
-              "type": "string"
-            },
-            "phase": {
-              "type": "string"
-            },
-            "size": {
-              "type": "string"
-            },
-            "storageClass": {
-              "type": "string"
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
         }
       }
     },
-    "Limits": {
-      "type": "object",
-      "properties": {
-        "cpu": {
-          "type": "string"
-        },
-        "memory": {
-          "type": "string"
-        },
-        "storage": {
-          "type": "string"
+    "/services/{ServiceID}/env": {
+      "get": {
+        "description": "Returns environment variables of the service and parameters supported by its type. Values of secret variables are not returned",
+        "tags": [
+          "service"
+        ],
+        "summary": "lists service environment variables",
+        "operationId": "serviceEnvList",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service environment variables",
+            "schema": {
+              "$ref": "#/definitions/ServiceEnv"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Log": {
-      "type": "object",
-      "properties": {
-        "containerName": {
-          "type": "string",
-          "readOnly": true
-        },
-        "logs": {
-          "type": "string",
-          "readOnly": true
+    "/services/{ServiceID}/env/{EnvName}": {
+      "put": {
+        "description": "Sets an environment variable of service containers, service pods are restarted to pick it up",
+        "tags": [
+          "service"
+        ],
+        "summary": "sets a service environment variable",
+        "operationId": "serviceEnvSet",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/EnvName"
+          },
+          {
+            "$ref": "#/parameters/EnvContainer"
+          },
+          {
+            "$ref": "#/parameters/EnvValue"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "environment variable is set",
+            "schema": {
+              "$ref": "#/definitions/EnvVar"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "service is changed by a concurrent request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Removes an environment variable from service containers, service pods are restarted",
+        "tags": [
+          "service"
+        ],
+        "summary": "unsets a service environment variable",
+        "operationId": "serviceEnvUnset",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/EnvName"
+          },
+          {
+            "$ref": "#/parameters/EnvContainer"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "environment variable is removed"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "service is changed by a concurrent request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Logs": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Log"
-      }
-    },
-    "Restore": {
-      "type": "object",
-      "properties": {
-        "backup_id": {
-          "type": "string",
-          "maxLength": 63,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "readOnly": true
-        },
-        "id": {
-          "type": "string",
-          "maxLength": 63,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-          "readOnly": true
-        },
-        "status": {
-          "type": "string"
+    "/services/{ServiceID}/exec": {
+      "get": {
+        "description": "Upgrades the connection to a WebSocket and attaches it to a command executed in a service container.\nEvery binary message starts with a channel byte followed by the payload:\n0 - stdin (an empty payload closes stdin), 1 - stdout, 2 - stderr,\n3 - ExecStatus sent once the command exits, 4 - terminal size as {\"Width\": 80, \"Height\": 24}.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "execute a command in a service container",
+        "operationId": "serviceExec",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/PodName"
+          },
+          {
+            "$ref": "#/parameters/ContainerName"
+          },
+          {
+            "$ref": "#/parameters/Command"
+          },
+          {
+            "$ref": "#/parameters/Stdin"
+          },
+          {
+            "$ref": "#/parameters/Tty"
+          }
+        ],
+        "responses": {
+          "101": {
+            "description": "switching to the WebSocket protocol"
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Restores": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Restore"
+    "/services/{ServiceID}/explain": {
+      "get": {
+        "description": "Explain status of service",
+        "tags": [
+          "service"
+        ],
+        "summary": "explain status of service",
+        "operationId": "serviceExplain",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "kuberlogic service explain",
+            "schema": {
+              "$ref": "#/definitions/Explain"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "Service": {
-      "type": "object",
-      "required": [
-        "id",
-        "type"
-      ],
-      "properties": {
-        "advanced": {
-          "$ref": "#/definitions/Advanced"
-        },
-        "backupSchedule": {
-          "type": "string"
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "readOnly": true
-        },
-        "domain": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string",
-          "readOnly": true
-        },
-        "id": {
-          "type": "string",
-          "maxLength": 20,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
-        },
-        "insecure": {
-          "type": "boolean"
-        },
-        "limits": {
-          "$ref": "#/definitions/Limits"
-        },
-        "replicas": {
-          "type": "integer",
-          "x-nullable": true
-        },
-        "status": {
-          "type": "string",
-          "readOnly": true
-        },
-        "subscription": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
-        },
-        "use_letsencrypt": {
-          "type": "boolean"
-        },
-        "version": {
-          "type": "string"
+    "/services/{ServiceID}/logs": {
+      "get": {
+        "description": "List service pod logs",
+        "tags": [
+          "service"
+        ],
+        "summary": "list service logs",
+        "operationId": "serviceLogs",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/PodName"
+          },
+          {
+            "$ref": "#/parameters/ContainerName"
+          },
+          {
+            "$ref": "#/parameters/TailLines"
+          },
+          {
+            "$ref": "#/parameters/SinceSeconds"
+          },
+          {
+            "$ref": "#/parameters/Previous"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "kuberlogic service logs",
+            "schema": {
+              "$ref": "#/definitions/Logs"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "ServiceCredentials": {
-      "description": "service credentials",
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    },
-    "ServiceSecret": {
-      "description": "service secret",
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "string",
-          "minLength": 1,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-          "readOnly": true
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1,
-          "readOnly": true
+    "/services/{ServiceID}/logs/follow": {
+      "get": {
+        "description": "Streams service container logs as server-sent events.\nEvery event carries a single log line along with its pod and container names and uses the line timestamp as its id,\npass it back to resume the stream.\nAn \"eof\" event is sent when all followed containers have exited.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "service"
+        ],
+        "summary": "follow service logs",
+        "operationId": "serviceLogsFollow",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/PodName"
+          },
+          {
+            "$ref": "#/parameters/ContainerName"
+          },
+          {
+            "$ref": "#/parameters/TailLines"
+          },
+          {
+            "$ref": "#/parameters/SinceSeconds"
+          },
+          {
+            "$ref": "#/parameters/LastEventID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "stream of service log lines",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "ServiceSecrets": {
-      "description": "service secrets",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ServiceSecret"
+    "/services/{ServiceID}/metrics": {
+      "get": {
+        "description": "Current CPU and memory usage of service containers reported by the metrics API\nand usage of service volumes reported by kubelets, compared with service limits.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "resource usage of service",
+        "operationId": "serviceMetrics",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service resource usage",
+            "schema": {
+              "$ref": "#/definitions/ServiceMetrics"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "metrics are not available",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "Services": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Service"
+    "/services/{ServiceID}/secrets": {
+      "get": {
+        "description": "Lists secrets declared by the service plugin. Secret values are not returned, use serviceSecretGet to reveal a secret",
+        "tags": [
+          "service"
+        ],
+        "summary": "lists service secrets",
+        "operationId": "serviceSecretsList",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service secrets",
+            "schema": {
+              "$ref": "#/definitions/ServiceSecrets"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "principal": {
-      "type": "string"
-    }
-  },
-  "parameters": {
-    "BackupID": {
-      "maxLength": 63,
-      "minLength": 3,
-      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-      "type": "string",
+    "/services/{ServiceID}/secrets/{SecretID}": {
+      "get": {
+        "description": "Returns a value of a visible service secret. Calls are audited",
+        "tags": [
+          "service"
+        ],
+        "summary": "reveals a service secret",
+        "operationId": "serviceSecretGet",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/SecretID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service secret",
+            "schema": {
+              "$ref": "#/definitions/ServiceSecret"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Sets a rotatable service secret to the provided value, service pods are restarted to pick it up",
+        "tags": [
+          "service"
+        ],
+        "summary": "sets a service secret",
+        "operationId": "serviceSecretSet",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/SecretID"
+          },
+          {
+            "$ref": "#/parameters/SecretValue"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "secret is set"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "secret is changed by a concurrent request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/secrets/{SecretID}/rotate": {
+      "post": {
+        "description": "Regenerates a rotatable service secret by the service plugin, service pods are restarted to pick it up",
+        "tags": [
+          "service"
+        ],
+        "summary": "rotates a service secret",
+        "operationId": "serviceSecretRotate",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/SecretID"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "secret rotation is requested"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "secret is changed by a concurrent request or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/unarchive": {
+      "post": {
+        "description": "unarchive service (for example, if user subscription resumed from canceled state)",
+        "tags": [
+          "service"
+        ],
+        "summary": "unarchive service",
+        "operationId": "serviceUnarchive",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service request to unarchive is sent"
+          },
+          "400": {
+            "description": "invalid input",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "service not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/watch": {
+      "get": {
+        "description": "Streams service item changes as server-sent events.\nEvery event carries the service resource version as its id, pass it back to resume the stream.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "service"
+        ],
+        "summary": "watch a service item",
+        "operationId": "serviceWatch",
+        "parameters": [
+          {
+            "$ref": "#/parameters/ServiceID"
+          },
+          {
+            "$ref": "#/parameters/ResourceVersion"
+          },
+          {
+            "$ref": "#/parameters/LastEventID"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "stream of service events",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/tokens/": {
+      "get": {
+        "description": "List API tokens. Token values are never returned",
+        "tags": [
+          "token"
+        ],
+        "summary": "list api tokens",
+        "operationId": "tokenList",
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Tokens"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a named API token limited to the scopes.\nOnly scopes covered by the caller scopes can be granted, the admin scope is granted only by admins.\nThe token value is returned only once in the response.\n",
+        "tags": [
+          "token"
+        ],
+        "summary": "create api token",
+        "operationId": "tokenAdd",
+        "parameters": [
+          {
+            "$ref": "#/parameters/TokenItem"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/tokens/{TokenName}/": {
+      "delete": {
+        "description": "Revokes an API token\n",
+        "tags": [
+          "token"
+        ],
+        "summary": "revoke api token",
+        "operationId": "tokenDelete",
+        "parameters": [
+          {
+            "$ref": "#/parameters/TokenName"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item deleted"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/webhook-deadletters/": {
+      "get": {
+        "description": "List events that were not delivered after all retries, newest first.\nDead letters are kept in memory of the apiserver.\n",
+        "tags": [
+          "webhook"
+        ],
+        "summary": "list undelivered events",
+        "operationId": "webhookDeadLetterList",
+        "parameters": [
+          {
+            "$ref": "#/parameters/WebhookNameFilter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/WebhookDeliveries"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/": {
+      "get": {
+        "description": "List registered webhooks. Signing secrets are never returned",
+        "tags": [
+          "webhook"
+        ],
+        "summary": "list webhooks",
+        "operationId": "webhookList",
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Webhooks"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Register a webhook receiving signed notifications of service lifecycle events.\nThe signing secret is generated unless it is set, it is returned only once in the response.\n",
+        "tags": [
+          "webhook"
+        ],
+        "summary": "register webhook",
+        "operationId": "webhookAdd",
+        "parameters": [
+          {
+            "$ref": "#/parameters/WebhookItem"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Webhook"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{WebhookName}/": {
+      "delete": {
+        "tags": [
+          "webhook"
+        ],
+        "summary": "delete webhook",
+        "operationId": "webhookDelete",
+        "parameters": [
+          {
+            "$ref": "#/parameters/WebhookName"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item deleted"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{WebhookName}/ping": {
+      "post": {
+        "description": "Send a signed ping event to the webhook once and return the delivery result",
+        "tags": [
+          "webhook"
+        ],
+        "summary": "send ping event",
+        "operationId": "webhookPing",
+        "parameters": [
+          {
+            "$ref": "#/parameters/WebhookName"
+          },
+          {
+            "$ref": "#/parameters/IdempotencyKey"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "delivery result",
+            "schema": {
+              "$ref": "#/definitions/WebhookDelivery"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "Advanced": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "AuditChange": {
+      "description": "changed field of the audit target, values are JSON encoded",
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "new": {
+          "type": "string"
+        },
+        "old": {
+          "type": "string"
+        }
+      }
+    },
+    "AuditRecord": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AuditChange"
+          }
+        },
+        "error": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "operation": {
+          "description": "API operation id",
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "denied"
+          ]
+        },
+        "status": {
+          "description": "HTTP status of the response",
+          "type": "integer"
+        },
+        "target": {
+          "description": "object the operation was applied to like services/demo",
+          "type": "string"
+        },
+        "tenant": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "AuditRecords": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/AuditRecord"
+      }
+    },
+    "Backup": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+          "readOnly": true
+        },
+        "service_id": {
+          "type": "string",
+          "maxLength": 20,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "Backups": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Backup"
+      }
+    },
+    "ContainerUsage": {
+      "type": "object",
+      "properties": {
+        "cpu": {
+          "type": "string"
+        },
+        "memory": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "pod": {
+          "type": "string"
+        }
+      }
+    },
+    "EnvValue": {
+      "description": "new value of environment variable",
+      "type": "object",
+      "properties": {
+        "secret": {
+          "description": "keep the value in a secret",
+          "type": "boolean"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "EnvVar": {
+      "description": "environment variable of service containers",
+      "type": "object",
+      "properties": {
+        "container": {
+          "description": "container of the variable, all containers get variables without a container",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "secret": {
+          "description": "variable value is kept in a secret",
+          "type": "boolean"
+        },
+        "value": {
+          "description": "variable value, it is not returned for secret variables",
+          "type": "string"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ExecStatus": {
+      "type": "object",
+      "properties": {
+        "exitCode": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "message": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "Explain": {
+      "type": "object",
+      "properties": {
+        "components": {
+          "description": "readiness of service components reported by the service plugin",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainComponent"
+          }
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainCondition"
+          }
+        },
+        "events": {
+          "description": "recent events of the service namespace, newest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainEvent"
+          }
+        },
+        "lastError": {
+          "$ref": "#/definitions/ExplainError"
+        },
+        "namespace": {
+          "description": "namespace that contains service objects",
+          "type": "string"
+        },
+        "objects": {
+          "description": "objects managed by the service plugin",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainObject"
+          }
+        },
+        "phase": {
+          "type": "string"
+        },
+        "pods": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainPod"
+          }
+        },
+        "warnings": {
+          "description": "parts of the report that could not be collected",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ExplainComponent": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "ready": {
+          "type": "boolean"
+        }
+      }
+    },
+    "ExplainCondition": {
+      "type": "object",
+      "properties": {
+        "lastTransitionTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "ExplainContainer": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "reason of the waiting or terminated state",
+          "type": "string"
+        },
+        "restartCount": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "ExplainError": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "ExplainEvent": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer"
+        },
+        "message": {
+          "type": "string"
+        },
+        "object": {
+          "description": "kind and name of the object the event is about",
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "ExplainObject": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "description": "short summary of the object state",
+          "type": "string"
+        }
+      }
+    },
+    "ExplainPod": {
+      "type": "object",
+      "properties": {
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainContainer"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "phase": {
+          "type": "string"
+        }
+      }
+    },
+    "Limits": {
+      "type": "object",
+      "properties": {
+        "cpu": {
+          "type": "string"
+        },
+        "memory": {
+          "type": "string"
+        },
+        "storage": {
+          "type": "string"
+        }
+      }
+    },
+    "Log": {
+      "type": "object",
+      "properties": {
+        "containerName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "logs": {
+          "type": "string",
+          "readOnly": true
+        },
+        "podName": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "Logs": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Log"
+      }
+    },
+    "ParameterSchema": {
+      "description": "environment variable supported by the service type",
+      "type": "object",
+      "properties": {
+        "container": {
+          "type": "string"
+        },
+        "default": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "secret": {
+          "description": "variable must be a secret",
+          "type": "boolean"
+        },
+        "type": {
+          "description": "one of string, integer or boolean",
+          "type": "string"
+        }
+      }
+    },
+    "ResourceUsage": {
+      "type": "object",
+      "properties": {
+        "limit": {
+          "description": "service limit, it is empty if the service is not limited",
+          "type": "string"
+        },
+        "percent": {
+          "description": "usage in percents of the limit, it is not set if the service is not limited",
+          "type": "number",
+          "x-nullable": true
+        },
+        "used": {
+          "description": "current usage, e.g. 250m or 512Mi",
+          "type": "string"
+        }
+      }
+    },
+    "Restore": {
+      "type": "object",
+      "properties": {
+        "backup_id": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "Restores": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Restore"
+      }
+    },
+    "SecretValue": {
+      "description": "new value of service secret",
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "Service": {
+      "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
+      "properties": {
+        "advanced": {
+          "$ref": "#/definitions/Advanced"
+        },
+        "backupSchedule": {
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "domain": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 20,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "insecure": {
+          "type": "boolean"
+        },
+        "limits": {
+          "$ref": "#/definitions/Limits"
+        },
+        "plan": {
+          "description": "name of the service plan, plan parameters are used unless they are set in the service\n",
+          "type": "string"
+        },
+        "replicas": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "status": {
+          "type": "string",
+          "readOnly": true
+        },
+        "subscription": {
+          "type": "string"
+        },
+        "tenant": {
+          "description": "tenant owning the service, it is set from the authenticated principal\nif the principal belongs to a tenant\n",
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "use_letsencrypt": {
+          "type": "boolean"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "ServiceCredentials": {
+      "description": "service credentials",
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "ServiceEnv": {
+      "description": "service environment variables",
+      "type": "object",
+      "properties": {
+        "parameters": {
+          "description": "environment variables supported by the service type",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ParameterSchema"
+          }
+        },
+        "variables": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EnvVar"
+          }
+        }
+      }
+    },
+    "ServiceMetrics": {
+      "type": "object",
+      "properties": {
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ContainerUsage"
+          }
+        },
+        "cpu": {
+          "$ref": "#/definitions/ResourceUsage"
+        },
+        "memory": {
+          "$ref": "#/definitions/ResourceUsage"
+        },
+        "storage": {
+          "$ref": "#/definitions/ResourceUsage"
+        },
+        "time": {
+          "description": "time the container usage was collected at",
+          "type": "string",
+          "format": "date-time"
+        },
+        "volumes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VolumeUsage"
+          }
+        },
+        "warnings": {
+          "description": "parts of the usage that could not be collected",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ServicePlan": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "advanced": {
+          "$ref": "#/definitions/Advanced"
+        },
+        "backupSchedule": {
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "description": {
+          "type": "string"
+        },
+        "limits": {
+          "$ref": "#/definitions/Limits"
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "replicas": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "type": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "ServicePlans": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ServicePlan"
+      }
+    },
+    "ServiceSecret": {
+      "description": "service secret",
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "minLength": 1,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+          "readOnly": true
+        },
+        "rotatable": {
+          "description": "secret can be rotated or set",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "value": {
+          "description": "secret value, it is returned only when a single secret is requested",
+          "type": "string",
+          "minLength": 1,
+          "readOnly": true
+        },
+        "visible": {
+          "description": "secret can be revealed",
+          "type": "boolean",
+          "readOnly": true
+        }
+      }
+    },
+    "ServiceSecrets": {
+      "description": "service secrets",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ServiceSecret"
+      }
+    },
+    "ServiceType": {
+      "type": "object",
+      "properties": {
+        "default": {
+          "description": "version of services created without a version",
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "versions": {
+          "description": "supported versions ordered from the oldest, any version is supported when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ServiceTypes": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ServiceType"
+      }
+    },
+    "Services": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Service"
+      }
+    },
+    "Token": {
+      "type": "object",
+      "required": [
+        "name",
+        "scopes"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 3,
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        },
+        "scopes": {
+          "description": "scopes granted to the token: read-only, admin or \u003cresource\u003e:\u003caction\u003e\nlike services:write or backups:*\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "tenant the token principal belongs to, tenant principals access only the tenant services",
+          "type": "string"
+        },
+        "token": {
+          "description": "token value, returned only when the token is created",
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "Tokens": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Token"
+      }
+    },
+    "VolumeUsage": {
+      "type": "object",
+      "properties": {
+        "available": {
+          "type": "string"
+        },
+        "capacity": {
+          "type": "string"
+        },
+        "claim": {
+          "description": "persistent volume claim of the volume",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "pod": {
+          "type": "string"
+        },
+        "used": {
+          "type": "string"
+        }
+      }
+    },
+    "Webhook": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "events": {
+          "description": "event types delivered to the webhook like service.ready, all events are delivered if empty.\nEvent groups can be matched with a wildcard like service.*\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 3,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "secret": {
+          "description": "HMAC signing secret, it is generated if not set and returned only when the webhook is created\n",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "tenant the webhook belongs to, tenant webhooks receive events of the tenant services only",
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "WebhookDeliveries": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/WebhookDelivery"
+      }
+    },
+    "WebhookDelivery": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "type": "integer"
+        },
+        "error": {
+          "type": "string"
+        },
+        "event": {
+          "$ref": "#/definitions/WebhookEvent"
+        },
+        "status": {
+          "description": "HTTP status of the last attempt",
+          "type": "integer"
+        },
+        "time": {
+          "description": "time of the last attempt",
+          "type": "string",
+          "format": "date-time"
+        },
+        "webhook": {
+          "type": "string"
+        }
+      }
+    },
+    "WebhookEvent": {
+      "description": "notification delivered to webhooks",
+      "type": "object",
+      "properties": {
+        "backup": {
+          "type": "string"
+        },
+        "id": {
+          "description": "unique event id, it is the same for all delivery attempts",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "restore": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "status": {
+          "description": "service, backup or restore status",
+          "type": "string"
+        },
+        "tenant": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "ping",
+            "service.created",
+            "service.ready",
+            "service.failed",
+            "service.paused",
+            "service.archived",
+            "backup.completed",
+            "backup.failed",
+            "restore.completed",
+            "restore.failed"
+          ]
+        }
+      }
+    },
+    "Webhooks": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Webhook"
+      }
+    },
+    "principal": {
+      "description": "authenticated API client",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "tenant of the principal, empty for principals with access to all tenants",
+          "type": "string"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "AuditLimit": {
+      "maximum": 1000,
+      "minimum": 1,
+      "type": "integer",
+      "description": "maximum number of records, 100 if not set",
+      "name": "limit",
+      "in": "query"
+    },
+    "AuditOperation": {
+      "type": "string",
+      "description": "API operation id like serviceAdd",
+      "name": "operation",
+      "in": "query"
+    },
+    "AuditPrincipal": {
+      "type": "string",
+      "description": "name of the principal",
+      "name": "principal",
+      "in": "query"
+    },
+    "AuditSince": {
+      "type": "string",
+      "description": "show records newer than the time in RFC3339 format like 2021-01-02T15:04:05Z",
+      "name": "since",
+      "in": "query"
+    },
+    "AuditTarget": {
+      "type": "string",
+      "description": "object the operation was applied to like services/demo",
+      "name": "target",
+      "in": "query"
+    },
+    "BackupID": {
+      "maxLength": 63,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
       "description": "backup Resource ID",
       "name": "BackupID",
       "in": "path",
       "required": true
     },
-    "BackupItem": {
-      "description": "backup item",
-      "name": "backupItem",
-      "in": "body",
-      "required": true,
-      "schema": {
-        "$ref": "#/definitions/Backup"
+    "BackupItem": {
+      "description": "backup item",
+      "name": "backupItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Backup"
+      }
+    },
+    "BackupRestoreServiceID": {
+      "maxLength": 20,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "service Resource ID to query backups/restores by",
+      "name": "ServiceID",
+      "in": "query"
+    },
+    "Command": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "command to execute along with its arguments",
+      "name": "Command",
+      "in": "query",
+      "required": true
+    },
+    "ContainerName": {
+      "type": "string",
+      "description": "service pod container name",
+      "name": "ContainerName",
+      "in": "query"
+    },
+    "EnvContainer": {
+      "type": "string",
+      "description": "container of the environment variable, the variable is set for all containers when it is empty",
+      "name": "container",
+      "in": "query"
+    },
+    "EnvName": {
+      "maxLength": 253,
+      "pattern": "^[-._a-zA-Z][-._a-zA-Z0-9]*$",
+      "type": "string",
+      "description": "environment variable name",
+      "name": "EnvName",
+      "in": "path",
+      "required": true
+    },
+    "EnvValue": {
+      "name": "EnvValue",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/EnvValue"
+      }
+    },
+    "IdempotencyKey": {
+      "maxLength": 255,
+      "type": "string",
+      "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+      "name": "Idempotency-Key",
+      "in": "header"
+    },
+    "IfMatch": {
+      "type": "string",
+      "description": "ETag of the service the request is based on",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "LastEventID": {
+      "type": "string",
+      "description": "id of the last received event, sent by event stream clients on reconnect",
+      "name": "Last-Event-ID",
+      "in": "header"
+    },
+    "ListContinue": {
+      "type": "string",
+      "description": "token of the page to return, it is taken from the X-Continue header of the previous page",
+      "name": "continue",
+      "in": "query"
+    },
+    "ListCreatedAfter": {
+      "type": "string",
+      "description": "return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z",
+      "name": "created_after",
+      "in": "query"
+    },
+    "ListCreatedBefore": {
+      "type": "string",
+      "description": "return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z",
+      "name": "created_before",
+      "in": "query"
+    },
+    "ListLimit": {
+      "maximum": 1000,
+      "minimum": 1,
+      "type": "integer",
+      "description": "maximum number of items in the page, all items are returned if not set",
+      "name": "limit",
+      "in": "query"
+    },
+    "ListSort": {
+      "type": "string",
+      "description": "sort key prefixed with \"-\" for the descending order.\nItems are sorted by id if not set.\nKeys are id and created_at, services can also be sorted by type, domain and status.\n",
+      "name": "sort",
+      "in": "query"
+    },
+    "ListStatus": {
+      "type": "string",
+      "description": "return only items in the status",
+      "name": "status",
+      "in": "query"
+    },
+    "PlanItem": {
+      "description": "service plan item",
+      "name": "planItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/ServicePlan"
+      }
+    },
+    "PlanName": {
+      "maxLength": 63,
+      "minLength": 2,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "service plan name",
+      "name": "PlanName",
+      "in": "path",
+      "required": true
+    },
+    "PlanRollout": {
+      "type": "boolean",
+      "description": "apply plan changes to all services created with the plan",
+      "name": "rollout",
+      "in": "query"
+    },
+    "PodName": {
+      "type": "string",
+      "description": "service pod name",
+      "name": "PodName",
+      "in": "query"
+    },
+    "Previous": {
+      "type": "boolean",
+      "description": "return logs of the previous terminated container instance",
+      "name": "Previous",
+      "in": "query"
+    },
+    "ResourceVersion": {
+      "type": "string",
+      "description": "resource version to start watching from",
+      "name": "ResourceVersion",
+      "in": "query"
+    },
+    "RestoreID": {
+      "maxLength": 63,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "restore Resource ID",
+      "name": "RestoreID",
+      "in": "path",
+      "required": true
+    },
+    "RestoreItem": {
+      "description": "restore item",
+      "name": "restoreItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Restore"
+      }
+    },
+    "SecretID": {
+      "maxLength": 253,
+      "pattern": "^[-._a-zA-Z0-9]+$",
+      "type": "string",
+      "description": "service secret ID",
+      "name": "SecretID",
+      "in": "path",
+      "required": true
+    },
+    "SecretValue": {
+      "description": "secret value",
+      "name": "SecretValue",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/SecretValue"
+      }
+    },
+    "ServiceCredentials": {
+      "description": "service credentials",
+      "name": "ServiceCredentials",
+      "in": "body",
+      "schema": {
+        "$ref": "#/definitions/ServiceCredentials"
+      }
+    },
+    "ServiceDomainFilter": {
+      "type": "string",
+      "description": "return only services with the domain",
+      "name": "domain",
+      "in": "query"
+    },
+    "ServiceID": {
+      "maxLength": 20,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "service Resource ID",
+      "name": "ServiceID",
+      "in": "path",
+      "required": true
+    },
+    "ServiceItem": {
+      "description": "service item",
+      "name": "serviceItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Service"
+      }
+    },
+    "ServiceTypeFilter": {
+      "type": "string",
+      "description": "return only services of the type",
+      "name": "type",
+      "in": "query"
+    },
+    "SinceSeconds": {
+      "minimum": 1,
+      "type": "integer",
+      "description": "show logs newer than a relative duration in seconds",
+      "name": "SinceSeconds",
+      "in": "query"
+    },
+    "Stdin": {
+      "type": "boolean",
+      "description": "pass stdin to the command",
+      "name": "Stdin",
+      "in": "query"
+    },
+    "SubscriptionID": {
+      "type": "string",
+      "description": "subscription ID",
+      "name": "SubscriptionID",
+      "in": "query"
+    },
+    "TailLines": {
+      "minimum": 0,
+      "type": "integer",
+      "description": "number of lines from the end of the logs to show",
+      "name": "TailLines",
+      "in": "query"
+    },
+    "TokenItem": {
+      "description": "api token item",
+      "name": "tokenItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Token"
+      }
+    },
+    "TokenName": {
+      "maxLength": 63,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "api token name",
+      "name": "TokenName",
+      "in": "path",
+      "required": true
+    },
+    "Tty": {
+      "type": "boolean",
+      "description": "allocate a TTY for the command",
+      "name": "Tty",
+      "in": "query"
+    },
+    "WebhookItem": {
+      "description": "webhook item",
+      "name": "webhookItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Webhook"
+      }
+    },
+    "WebhookName": {
+      "maxLength": 63,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "webhook name",
+      "name": "WebhookName",
+      "in": "path",
+      "required": true
+    },
+    "WebhookNameFilter": {
+      "type": "string",
+      "description": "name of the webhook",
+      "name": "webhook",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "bearer": {
+      "description": "OIDC token passed in the \"Authorization: Bearer\" header.\nTokens are validated against the issuer configured in the apiserver, the URL below is a placeholder.\n",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "https://oidc.example.com/authorize"
+    },
+    "key": {
+      "type": "apiKey",
+      "name": "x-token",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    },
+    {
+      "bearer": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Everything about service resource",
+      "name": "service"
+    },
+    {
+      "description": "API tokens management",
+      "name": "token"
+    },
+    {
+      "description": "Audit log of mutating and sensitive API calls",
+      "name": "audit"
+    },
+    {
+      "description": "Service plans bundling service parameters",
+      "name": "plan"
+    },
+    {
+      "description": "Webhook notifications of service lifecycle events",
+      "name": "webhook"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "This is a service API",
+    "title": "service API",
+    "contact": {
+      "email": "info@kuberlogic.com"
+    },
+    "version": "0.0.1"
+  },
+  "host": "localhost:8001",
+  "basePath": "/api/v1/",
+  "paths": {
+    "/audit/": {
+      "get": {
+        "description": "List audit records of mutating and sensitive API calls, newest first.\nPrincipals of a tenant see only records of their tenant.\n",
+        "tags": [
+          "audit"
+        ],
+        "summary": "query audit records",
+        "operationId": "auditList",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of the principal",
+            "name": "principal",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "API operation id like serviceAdd",
+            "name": "operation",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "object the operation was applied to like services/demo",
+            "name": "target",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "show records newer than the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "maximum number of records, 100 if not set",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/AuditRecords"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/backups/": {
+      "get": {
+        "description": "List backup objects",
+        "tags": [
+          "backup"
+        ],
+        "summary": "list backups",
+        "operationId": "backupList",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID to query backups/restores by",
+            "name": "ServiceID",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "maximum number of items in the page, all items are returned if not set",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "token of the page to return, it is taken from the X-Continue header of the previous page",
+            "name": "continue",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "sort key prefixed with \"-\" for the descending order.\nItems are sorted by id if not set.\nKeys are id and created_at, services can also be sorted by type, domain and status.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items in the status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "created_before",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Backups"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "token of the next page, it is empty on the last page"
+              }
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create backup object",
+        "tags": [
+          "backup"
+        ],
+        "summary": "create backup object",
+        "operationId": "backupAdd",
+        "parameters": [
+          {
+            "description": "backup item",
+            "name": "backupItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/backups/{BackupID}/": {
+      "delete": {
+        "description": "Deletes a backup object\n",
+        "tags": [
+          "backup"
+        ],
+        "summary": "deletes a backup item",
+        "operationId": "backupDelete",
+        "parameters": [
+          {
+            "maxLength": 63,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "backup Resource ID",
+            "name": "BackupID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item deleted"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/plans/": {
+      "get": {
+        "tags": [
+          "plan"
+        ],
+        "summary": "list service plans",
+        "operationId": "planList",
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/ServicePlans"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a service plan. Services created with the plan get its parameters\nunless they are overridden by the service request\n",
+        "tags": [
+          "plan"
+        ],
+        "summary": "create service plan",
+        "operationId": "planAdd",
+        "parameters": [
+          {
+            "description": "service plan item",
+            "name": "planItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ServicePlan"
+            }
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/ServicePlan"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/plans/{PlanName}/": {
+      "get": {
+        "tags": [
+          "plan"
+        ],
+        "summary": "get service plan",
+        "operationId": "planGet",
+        "parameters": [
+          {
+            "maxLength": 63,
+            "minLength": 2,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service plan name",
+            "name": "PlanName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item found",
+            "schema": {
+              "$ref": "#/definitions/ServicePlan"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a service plan. Plans used by services can't be deleted\n",
+        "tags": [
+          "plan"
+        ],
+        "summary": "delete service plan",
+        "operationId": "planDelete",
+        "parameters": [
+          {
+            "maxLength": 63,
+            "minLength": 2,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service plan name",
+            "name": "PlanName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item deleted"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "plan is used by services",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Edit a service plan. When rollout is set the plan changes are applied to all services\ncreated with the plan, service overrides are kept\n",
+        "tags": [
+          "plan"
+        ],
+        "summary": "edit service plan",
+        "operationId": "planEdit",
+        "parameters": [
+          {
+            "maxLength": 63,
+            "minLength": 2,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service plan name",
+            "name": "PlanName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "service plan item",
+            "name": "planItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ServicePlan"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "apply plan changes to all services created with the plan",
+            "name": "rollout",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item edited",
+            "schema": {
+              "$ref": "#/definitions/ServicePlan"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/restores/": {
+      "get": {
+        "description": "List restore objects",
+        "tags": [
+          "restore"
+        ],
+        "summary": "list restores",
+        "operationId": "restoreList",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID to query backups/restores by",
+            "name": "ServiceID",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "maximum number of items in the page, all items are returned if not set",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "token of the page to return, it is taken from the X-Continue header of the previous page",
+            "name": "continue",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "sort key prefixed with \"-\" for the descending order.\nItems are sorted by id if not set.\nKeys are id and created_at, services can also be sorted by type, domain and status.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items in the status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "created_before",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Restores"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "token of the next page, it is empty on the last page"
+              }
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create restore object",
+        "tags": [
+          "restore"
+        ],
+        "summary": "create restore object",
+        "operationId": "restoreAdd",
+        "parameters": [
+          {
+            "description": "restore item",
+            "name": "restoreItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Restore"
+            }
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Restore"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/restores/{RestoreID}/": {
+      "delete": {
+        "description": "Deletes a restore object\n",
+        "tags": [
+          "restore"
+        ],
+        "summary": "deletes a restore item",
+        "operationId": "restoreDelete",
+        "parameters": [
+          {
+            "maxLength": 63,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "restore Resource ID",
+            "name": "RestoreID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item deleted"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/service-types/": {
+      "get": {
+        "description": "list service types and their supported versions",
+        "tags": [
+          "service"
+        ],
+        "summary": "list service types",
+        "operationId": "serviceTypeList",
+        "responses": {
+          "200": {
+            "description": "service types and their versions",
+            "schema": {
+              "$ref": "#/definitions/ServiceTypes"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/": {
+      "get": {
+        "description": "List of service objects.\nPass the X-Continue header value of the response as the continue parameter to get the next page.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "lists all services",
+        "operationId": "serviceList",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "subscription ID",
+            "name": "SubscriptionID",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "maximum number of items in the page, all items are returned if not set",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "token of the page to return, it is taken from the X-Continue header of the previous page",
+            "name": "continue",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "sort key prefixed with \"-\" for the descending order.\nItems are sorted by id if not set.\nKeys are id and created_at, services can also be sorted by type, domain and status.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items in the status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z",
+            "name": "created_before",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only services of the type",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return only services with the domain",
+            "name": "domain",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Services"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "token of the next page, it is empty on the last page"
+              }
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Adds service object\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "adds a service item",
+        "operationId": "serviceAdd",
+        "parameters": [
+          {
+            "description": "service item",
+            "name": "serviceItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Service"
+            }
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "item created",
+            "schema": {
+              "$ref": "#/definitions/Service"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions or tenant quota exceeded",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/watch": {
+      "get": {
+        "description": "Streams service changes as server-sent events.\nEvery event carries the service resource version as its id, pass it back to resume the stream.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "service"
+        ],
+        "summary": "watch services",
+        "operationId": "serviceListWatch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "subscription ID",
+            "name": "SubscriptionID",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "resource version to start watching from",
+            "name": "ResourceVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "id of the last received event, sent by event stream clients on reconnect",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "stream of service events",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/": {
+      "get": {
+        "description": "Get service object\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "get a service item",
+        "operationId": "serviceGet",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item edited",
+            "schema": {
+              "$ref": "#/definitions/Service"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "resource version of the service, pass it in the If-Match header to edit this version only"
+              }
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a service object\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "deletes a service item",
+        "operationId": "serviceDelete",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item deleted"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Edit service object.\nPass the ETag of the service in the If-Match header to reject the edit if the service was changed since it was read.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "edit a service item",
+        "operationId": "serviceEdit",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "service item",
+            "name": "serviceItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Service"
+            }
+          },
+          {
+            "type": "string",
+            "description": "ETag of the service the request is based on",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "item edited",
+            "schema": {
+              "$ref": "#/definitions/Service"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "resource version of the service, pass it in the If-Match header to edit this version only"
+              }
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions or tenant quota exceeded",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "service was changed by a concurrent request, read it again and retry",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "412": {
+            "description": "service does not match the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/archive": {
+      "post": {
+        "description": "archive service (for example, if user subscription got cancelled)",
+        "tags": [
+          "service"
+        ],
+        "summary": "archive service",
+        "operationId": "serviceArchive",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service request to archive is sent"
+          },
+          "400": {
+            "description": "invalid input",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "service not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/credentials": {
+      "post": {
+        "description": "updates service credentials with passed data",
+        "tags": [
+          "service"
+        ],
+        "summary": "updates service credentials",
+        "operationId": "serviceCredentialsUpdate",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "service credentials",
+            "name": "ServiceCredentials",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/ServiceCredentials"
+            }
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "credentials are updated"
+          },
+          "400": {
+            "description": "invalid input",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/env": {
+      "get": {
+        "description": "Returns environment variables of the service and parameters supported by its type. Values of secret variables are not returned",
+        "tags": [
+          "service"
+        ],
+        "summary": "lists service environment variables",
+        "operationId": "serviceEnvList",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service environment variables",
+            "schema": {
+              "$ref": "#/definitions/ServiceEnv"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/services/{ServiceID}/env/{EnvName}": {
+      "put": {
+        "description": "Sets an environment variable of service containers, service pods are restarted to pick it up",
+        "tags": [
+          "service"
+        ],
+        "summary": "sets a service environment variable",
+        "operationId": "serviceEnvSet",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 253,
+            "pattern": "^[-._a-zA-Z][-._a-zA-Z0-9]*$",
+            "type": "string",
+            "description": "environment variable name",
+            "name": "EnvName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "container of the environment variable, the variable is set for all containers when it is empty",
+            "name": "container",
+            "in": "query"
+          },
+          {
+            "name": "EnvValue",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/EnvValue"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "environment variable is set",
+            "schema": {
+              "$ref": "#/definitions/EnvVar"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "service is changed by a concurrent request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Removes an environment variable from service containers, service pods are restarted",
+        "tags": [
+          "service"
+        ],
+        "summary": "unsets a service environment variable",
+        "operationId": "serviceEnvUnset",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 253,
+            "pattern": "^[-._a-zA-Z][-._a-zA-Z0-9]*$",
+            "type": "string",
+            "description": "environment variable name",
+            "name": "EnvName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "container of the environment variable, the variable is set for all containers when it is empty",
+            "name": "container",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "environment variable is removed"
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "service is changed by a concurrent request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "BackupRestoreServiceID": {
-      "maxLength": 20,
-      "minLength": 3,
-      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-      "type": "string",
-      "description": "service Resource ID to query backups/restores by",
-      "name": "ServiceID",
-      "in": "query"
+    "/services/{ServiceID}/exec": {
+      "get": {
+        "description": "Upgrades the connection to a WebSocket and attaches it to a command executed in a service container.\nEvery binary message starts with a channel byte followed by the payload:\n0 - stdin (an empty payload closes stdin), 1 - stdout, 2 - stderr,\n3 - ExecStatus sent once the command exits, 4 - terminal size as {\"Width\": 80, \"Height\": 24}.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "execute a command in a service container",
+        "operationId": "serviceExec",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "service pod name",
+            "name": "PodName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "service pod container name",
+            "name": "ContainerName",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "command to execute along with its arguments",
+            "name": "Command",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "pass stdin to the command",
+            "name": "Stdin",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "allocate a TTY for the command",
+            "name": "Tty",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "101": {
+            "description": "switching to the WebSocket protocol"
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
     },
-    "ContainerName": {
-      "type": "string",
-      "description": "service pod container name to query logs by",
-      "name": "ContainerName",
-      "in": "query"
+    "/services/{ServiceID}/explain": {
+      "get": {
+        "description": "Explain status of service",
+        "tags": [
+          "service"
+        ],
+        "summary": "explain status of service",
+        "operationId": "serviceExplain",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "kuberlogic service explain",
+            "schema": {
+              "$ref": "#/definitions/Explain"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
     },
-    "RestoreID": {
-      "maxLength": 63,
-      "minLength": 3,
-      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-      "type": "string",
-      "description": "restore Resource ID",
-      "name": "RestoreID",
-      "in": "path",
-      "required": true
+    "/services/{ServiceID}/logs": {
+      "get": {
+        "description": "List service pod logs",
+        "tags": [
+          "service"
+        ],
+        "summary": "list service logs",
+        "operationId": "serviceLogs",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "service pod name",
+            "name": "PodName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "service pod container name",
+            "name": "ContainerName",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "number of lines from the end of the logs to show",
+            "name": "TailLines",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "show logs newer than a relative duration in seconds",
+            "name": "SinceSeconds",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return logs of the previous terminated container instance",
+            "name": "Previous",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "kuberlogic service logs",
+            "schema": {
+              "$ref": "#/definitions/Logs"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
     },
-    "RestoreItem": {
-      "description": "restore item",
-      "name": "restoreItem",
-      "in": "body",
-      "required": true,
-      "schema": {
-        "$ref": "#/definitions/Restore"
+    "/services/{ServiceID}/logs/follow": {
+      "get": {
+        "description": "Streams service container logs as server-sent events.\nEvery event carries a single log line along with its pod and container names and uses the line timestamp as its id,\npass it back to resume the stream.\nAn \"eof\" event is sent when all followed containers have exited.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "service"
+        ],
+        "summary": "follow service logs",
+        "operationId": "serviceLogsFollow",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "service pod name",
+            "name": "PodName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "service pod container name",
+            "name": "ContainerName",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "number of lines from the end of the logs to show",
+            "name": "TailLines",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "show logs newer than a relative duration in seconds",
+            "name": "SinceSeconds",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "id of the last received event, sent by event stream clients on reconnect",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "stream of service log lines",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "ServiceCredentials": {
-      "description": "service credentials",
-      "name": "ServiceCredentials",
-      "in": "body",
-      "schema": {
-        "$ref": "#/definitions/ServiceCredentials"
+    "/services/{ServiceID}/metrics": {
+      "get": {
+        "description": "Current CPU and memory usage of service containers reported by the metrics API\nand usage of service volumes reported by kubelets, compared with service limits.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "resource usage of service",
+        "operationId": "serviceMetrics",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service resource usage",
+            "schema": {
+              "$ref": "#/definitions/ServiceMetrics"
+            }
+          },
+          "400": {
+            "description": "bad input parameter",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "422": {
+            "description": "bad validation",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "metrics are not available",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "ServiceID": {
-      "maxLength": 20,
-      "minLength": 3,
-      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-      "type": "string",
-      "description": "service Resource ID",
-      "name": "ServiceID",
-      "in": "path",
-      "required": true
-    },
-    "ServiceItem": {
-      "description": "service item",
-      "name": "serviceItem",
-      "in": "body",
-      "required": true,
-      "schema": {
-        "$ref": "#/definitions/Service"
+    "/services/{ServiceID}/secrets": {
+      "get": {
+        "description": "Lists secrets declared by the service plugin. Secret values are not returned, use serviceSecretGet to reveal a secret",
+        "tags": [
+          "service"
+        ],
+        "summary": "lists service secrets",
+        "operationId": "serviceSecretsList",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service secrets",
+            "schema": {
+              "$ref": "#/definitions/ServiceSecrets"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
-    "SubscriptionID": {
-      "type": "string",
-      "description": "subscription ID",
-      "name": "SubscriptionID",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "apiKey",
-      "name": "x-token",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ],
-  "tags": [
-    {
-      "description": "Everything about service resource",
-      "name": "service"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "This is a service API",
-    "title": "service API",
-    "contact": {
-      "email": "info@kuberlogic.com"
-    },
-    "version": "0.0.1"
-  },
-  "host": "localhost:8001",
-  "basePath": "/api/v1/",
-  "paths": {
-    "/backups/": {
+    "/services/{ServiceID}/secrets/{SecretID}": {
       "get": {
-        "description": "List backup objects",
+        "description": "Returns a value of a visible service secret. Calls are audited",
+        "tags": [
+          "service"
+        ],
+        "summary": "reveals a service secret",
+        "operationId": "serviceSecretGet",
+        "parameters": [
+          {
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 253,
+            "pattern": "^[-._a-zA-Z0-9]+$",
+            "type": "string",
+            "description": "service secret ID",
+            "name": "SecretID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "service secret",
+            "schema": {
+              "$ref": "#/definitions/ServiceSecret"
+            }
+          },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Sets a rotatable service secret to the provided value, service pods are restarted to pick it up",
         "tags": [
-          "backup"
+          "service"
         ],
-        "summary": "list backups",
-        "operationId": "backupList",
+        "summary": "sets a service secret",
+        "operationId": "serviceSecretSet",
         "parameters": [
           {
             "maxLength": 20,
             "minLength": 3,
             "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
             "type": "string",
-            "description": "service Resource ID to query backups/restores by",
+            "description": "service Resource ID",
             "name": "ServiceID",
-            "in": "query"
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 253,
+            "pattern": "^[-._a-zA-Z0-9]+$",
+            "type": "string",
+            "description": "service secret ID",
+            "name": "SecretID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "secret value",
+            "name": "SecretValue",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SecretValue"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "search results matching criteria",
-            "schema": {
-              "$ref": "#/definitions/Backups"
-            }
+            "description": "secret is set"
           },
           "400": {
-            "description": "bad input parameter",
+            "description": "invalid input, object invalid",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1285,6 +5848,18 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "secret is changed by a concurrent request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "422": {
             "description": "bad validation",
             "schema": {
@@ -1292,37 +5867,53 @@ func init() {
             }
           },
           "503": {
-            "description": "internal server error",
+            "description": "internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      },
+      }
+    },
+    "/services/{ServiceID}/secrets/{SecretID}/rotate": {
       "post": {
-        "description": "Create backup object",
+        "description": "Regenerates a rotatable service secret by the service plugin, service pods are restarted to pick it up",
         "tags": [
-          "backup"
+          "service"
         ],
-        "summary": "create backup object",
-        "operationId": "backupAdd",
+        "summary": "rotates a service secret",
+        "operationId": "serviceSecretRotate",
         "parameters": [
           {
-            "description": "backup item",
-            "name": "backupItem",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Backup"
-            }
+            "maxLength": 20,
+            "minLength": 3,
+            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "type": "string",
+            "description": "service Resource ID",
+            "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 253,
+            "pattern": "^[-._a-zA-Z0-9]+$",
+            "type": "string",
+            "description": "service secret ID",
+            "name": "SecretID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "item created",
-            "schema": {
-              "$ref": "#/definitions/Backup"
-            }
+          "202": {
+            "description": "secret rotation is requested"
           },
           "400": {
             "description": "invalid input, object invalid",
@@ -1333,8 +5924,20 @@ func init() {
           "401": {
             "description": "bad authentication"
           },
+          "403": {
+            "description": "bad permissions"
+          },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "409": {
-            "description": "item already exists"
+            "description": "secret is changed by a concurrent request or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
           "422": {
             "description": "bad validation",
@@ -1343,7 +5946,7 @@ func init() {
             }
           },
           "503": {
-            "description": "internal server error",
+            "description": "internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1351,32 +5954,39 @@ func init() {
         }
       }
     },
-    "/backups/{BackupID}/": {
-      "delete": {
-        "description": "Deletes a backup object\n",
+    "/services/{ServiceID}/unarchive": {
+      "post": {
+        "description": "unarchive service (for example, if user subscription resumed from canceled state)",
         "tags": [
-          "backup"
+          "service"
         ],
-        "summary": "deletes a backup item",
-        "operationId": "backupDelete",
+        "summary": "unarchive service",
+        "operationId": "serviceUnarchive",
         "parameters": [
           {
-            "maxLength": 63,
+            "maxLength": 20,
             "minLength": 3,
             "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
             "type": "string",
-            "description": "backup Resource ID",
-            "name": "BackupID",
+            "description": "service Resource ID",
+            "name": "ServiceID",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "item deleted"
+            "description": "service request to unarchive is sent"
           },
           "400": {
-            "description": "invalid input, object invalid",
+            "description": "invalid input",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1388,7 +5998,13 @@ func init() {
             "description": "bad permissions"
           },
           "404": {
-            "description": "item not found",
+            "description": "service not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1397,7 +6013,7 @@ func init() {
             "description": "bad validation"
           },
           "503": {
-            "description": "internal server error",
+            "description": "internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1405,30 +6021,46 @@ func init() {
         }
       }
     },
-    "/restores/": {
+    "/services/{ServiceID}/watch": {
       "get": {
-        "description": "List restore objects",
+        "description": "Streams service item changes as server-sent events.\nEvery event carries the service resource version as its id, pass it back to resume the stream.\n",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "restore"
+          "service"
         ],
-        "summary": "list restores",
-        "operationId": "restoreList",
+        "summary": "watch a service item",
+        "operationId": "serviceWatch",
         "parameters": [
           {
             "maxLength": 20,
             "minLength": 3,
             "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
             "type": "string",
-            "description": "service Resource ID to query backups/restores by",
+            "description": "service Resource ID",
             "name": "ServiceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "resource version to start watching from",
+            "name": "ResourceVersion",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "id of the last received event, sent by event stream clients on reconnect",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "search results matching criteria",
+            "description": "stream of service events",
             "schema": {
-              "$ref": "#/definitions/Restores"
+              "type": "file"
             }
           },
           "400": {
@@ -1443,6 +6075,12 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
+          "404": {
+            "description": "item not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "422": {
             "description": "bad validation",
             "schema": {
@@ -1456,30 +6094,67 @@ func init() {
             }
           }
         }
+      }
+    },
+    "/tokens/": {
+      "get": {
+        "description": "List API tokens. Token values are never returned",
+        "tags": [
+          "token"
+        ],
+        "summary": "list api tokens",
+        "operationId": "tokenList",
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Tokens"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
+          },
+          "503": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       },
       "post": {
-        "description": "Create restore object",
+        "description": "Create a named API token limited to the scopes.\nOnly scopes covered by the caller scopes can be granted, the admin scope is granted only by admins.\nThe token value is returned only once in the response.\n",
         "tags": [
-          "restore"
+          "token"
         ],
-        "summary": "create restore object",
-        "operationId": "restoreAdd",
+        "summary": "create api token",
+        "operationId": "tokenAdd",
         "parameters": [
           {
-            "description": "restore item",
-            "name": "restoreItem",
+            "description": "api token item",
+            "name": "tokenItem",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Restore"
+              "$ref": "#/definitions/Token"
             }
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "item created",
             "schema": {
-              "$ref": "#/definitions/Restore"
+              "$ref": "#/definitions/Token"
             }
           },
           "400": {
@@ -1491,8 +6166,14 @@ func init() {
           "401": {
             "description": "bad authentication"
           },
+          "403": {
+            "description": "bad permissions"
+          },
           "409": {
-            "description": "item already exists"
+            "description": "item already exists or a request with the same Idempotency-Key is in progress",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
           "422": {
             "description": "bad validation",
@@ -1509,22 +6190,22 @@ func init() {
         }
       }
     },
-    "/restores/{RestoreID}/": {
+    "/tokens/{TokenName}/": {
       "delete": {
-        "description": "Deletes a restore object\n",
+        "description": "Revokes an API token\n",
         "tags": [
-          "restore"
+          "token"
         ],
-        "summary": "deletes a restore item",
-        "operationId": "restoreDelete",
+        "summary": "revoke api token",
+        "operationId": "tokenDelete",
         "parameters": [
           {
             "maxLength": 63,
             "minLength": 3,
             "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
             "type": "string",
-            "description": "restore Resource ID",
-            "name": "RestoreID",
+            "description": "api token name",
+            "name": "TokenName",
             "in": "path",
             "required": true
           }
@@ -1563,78 +6244,27 @@ func init() {
         }
       }
     },
-    "/services/": {
+    "/webhook-deadletters/": {
       "get": {
-        "description": "List of service objects\n",
+        "description": "List events that were not delivered after all retries, newest first.\nDead letters are kept in memory of the apiserver.\n",
         "tags": [
-          "service"
+          "webhook"
         ],
-        "summary": "lists all services",
-        "operationId": "serviceList",
+        "summary": "list undelivered events",
+        "operationId": "webhookDeadLetterList",
         "parameters": [
           {
             "type": "string",
-            "description": "subscription ID",
-            "name": "SubscriptionID",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "search results matching criteria",
-            "schema": {
-              "$ref": "#/definitions/Services"
-            }
-          },
-          "400": {
-            "description": "bad input parameter",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "422": {
-            "description": "bad validation",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      },
-      "post": {
-        "description": "Adds service object\n",
-        "tags": [
-          "service"
-        ],
-        "summary": "adds a service item",
-        "operationId": "serviceAdd",
-        "parameters": [
-          {
-            "description": "service item",
-            "name": "serviceItem",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Service"
-            }
+            "description": "name of the webhook",
+            "name": "webhook",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "item created",
+          "200": {
+            "description": "search results matching criteria",
             "schema": {
-              "$ref": "#/definitions/Service"
+              "$ref": "#/definitions/WebhookDeliveries"
             }
           },
           "400": {
@@ -1649,14 +6279,35 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
-          "409": {
-            "description": "item already exists"
-          },
-          "422": {
-            "description": "bad validation",
+          "503": {
+            "description": "internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          }
+        }
+      }
+    },
+    "/webhooks/": {
+      "get": {
+        "description": "List registered webhooks. Signing secrets are never returned",
+        "tags": [
+          "webhook"
+        ],
+        "summary": "list webhooks",
+        "operationId": "webhookList",
+        "responses": {
+          "200": {
+            "description": "search results matching criteria",
+            "schema": {
+              "$ref": "#/definitions/Webhooks"
+            }
+          },
+          "401": {
+            "description": "bad authentication"
+          },
+          "403": {
+            "description": "bad permissions"
           },
           "503": {
             "description": "internal server error",
@@ -1665,33 +6316,37 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/services/{ServiceID}/": {
-      "get": {
-        "description": "Get service object\n",
+      },
+      "post": {
+        "description": "Register a webhook receiving signed notifications of service lifecycle events.\nThe signing secret is generated unless it is set, it is returned only once in the response.\n",
         "tags": [
-          "service"
+          "webhook"
         ],
-        "summary": "get a service item",
-        "operationId": "serviceGet",
+        "summary": "register webhook",
+        "operationId": "webhookAdd",
         "parameters": [
           {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+            "description": "webhook item",
+            "name": "webhookItem",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Webhook"
+            }
+          },
+          {
+            "maxLength": 255,
             "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "item edited",
+          "201": {
+            "description": "item created",
             "schema": {
-              "$ref": "#/definitions/Service"
+              "$ref": "#/definitions/Webhook"
             }
           },
           "400": {
@@ -1706,8 +6361,8 @@ func init() {
           "403": {
             "description": "bad permissions"
           },
-          "404": {
-            "description": "item not found",
+          "409": {
+            "description": "item already exists or a request with the same Idempotency-Key is in progress",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1725,22 +6380,23 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/webhooks/{WebhookName}/": {
       "delete": {
-        "description": "Deletes a service object\n",
         "tags": [
-          "service"
+          "webhook"
         ],
-        "summary": "deletes a service item",
-        "operationId": "serviceDelete",
+        "summary": "delete webhook",
+        "operationId": "webhookDelete",
         "parameters": [
           {
-            "maxLength": 20,
+            "maxLength": 63,
             "minLength": 3,
             "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
             "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
+            "description": "webhook name",
+            "name": "WebhookName",
             "in": "path",
             "required": true
           }
@@ -1777,40 +6433,40 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Edit service object\n",
+      }
+    },
+    "/webhooks/{WebhookName}/ping": {
+      "post": {
+        "description": "Send a signed ping event to the webhook once and return the delivery result",
         "tags": [
-          "service"
+          "webhook"
         ],
-        "summary": "edit a service item",
-        "operationId": "serviceEdit",
+        "summary": "send ping event",
+        "operationId": "webhookPing",
         "parameters": [
           {
-            "maxLength": 20,
+            "maxLength": 63,
             "minLength": 3,
             "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
             "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
+            "description": "webhook name",
+            "name": "WebhookName",
             "in": "path",
             "required": true
           },
           {
-            "description": "service item",
-            "name": "serviceItem",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Service"
-            }
+            "maxLength": 255,
+            "type": "string",
+            "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+            "name": "Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "item edited",
+            "description": "delivery result",
             "schema": {
-              "$ref": "#/definitions/Service"
+              "$ref": "#/definitions/WebhookDelivery"
             }
           },
           "400": {
@@ -1831,12 +6487,15 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "422": {
-            "description": "bad validation",
+          "409": {
+            "description": "request with the same Idempotency-Key is in progress",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
+          "422": {
+            "description": "bad validation"
+          },
           "503": {
             "description": "internal server error",
             "schema": {
@@ -1845,663 +6504,999 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "Advanced": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "AuditChange": {
+      "description": "changed field of the audit target, values are JSON encoded",
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "new": {
+          "type": "string"
+        },
+        "old": {
+          "type": "string"
+        }
+      }
+    },
+    "AuditRecord": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AuditChange"
+          }
+        },
+        "error": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "operation": {
+          "description": "API operation id",
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "denied"
+          ]
+        },
+        "status": {
+          "description": "HTTP status of the response",
+          "type": "integer"
+        },
+        "target": {
+          "description": "object the operation was applied to like services/demo",
+          "type": "string"
+        },
+        "tenant": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "AuditRecords": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/AuditRecord"
+      }
+    },
+    "Backup": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+          "readOnly": true
+        },
+        "service_id": {
+          "type": "string",
+          "maxLength": 20,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "Backups": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Backup"
+      }
+    },
+    "ContainerUsage": {
+      "type": "object",
+      "properties": {
+        "cpu": {
+          "type": "string"
+        },
+        "memory": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "pod": {
+          "type": "string"
+        }
+      }
+    },
+    "EnvValue": {
+      "description": "new value of environment variable",
+      "type": "object",
+      "properties": {
+        "secret": {
+          "description": "keep the value in a secret",
+          "type": "boolean"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "EnvVar": {
+      "description": "environment variable of service containers",
+      "type": "object",
+      "properties": {
+        "container": {
+          "description": "container of the variable, all containers get variables without a container",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "secret": {
+          "description": "variable value is kept in a secret",
+          "type": "boolean"
+        },
+        "value": {
+          "description": "variable value, it is not returned for secret variables",
+          "type": "string"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ExecStatus": {
+      "type": "object",
+      "properties": {
+        "exitCode": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "message": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "Explain": {
+      "type": "object",
+      "properties": {
+        "components": {
+          "description": "readiness of service components reported by the service plugin",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainComponent"
+          }
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainCondition"
+          }
+        },
+        "events": {
+          "description": "recent events of the service namespace, newest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainEvent"
+          }
+        },
+        "lastError": {
+          "$ref": "#/definitions/ExplainError"
+        },
+        "namespace": {
+          "description": "namespace that contains service objects",
+          "type": "string"
+        },
+        "objects": {
+          "description": "objects managed by the service plugin",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainObject"
+          }
+        },
+        "phase": {
+          "type": "string"
+        },
+        "pods": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainPod"
+          }
+        },
+        "warnings": {
+          "description": "parts of the report that could not be collected",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     },
-    "/services/{ServiceID}/archive": {
-      "post": {
-        "description": "archive service (for example, if user subscription got cancelled)",
-        "tags": [
-          "service"
-        ],
-        "summary": "archive service",
-        "operationId": "serviceArchive",
-        "parameters": [
-          {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-            "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "service request to archive is sent"
-          },
-          "400": {
-            "description": "invalid input",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "404": {
-            "description": "service not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "422": {
-            "description": "bad validation"
-          },
-          "503": {
-            "description": "internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+    "ExplainComponent": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "ready": {
+          "type": "boolean"
         }
       }
     },
-    "/services/{ServiceID}/credentials": {
-      "post": {
-        "description": "updates service credentials with passed data",
-        "tags": [
-          "service"
-        ],
-        "summary": "updates service credentials",
-        "operationId": "serviceCredentialsUpdate",
-        "parameters": [
-          {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-            "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "service credentials",
-            "name": "ServiceCredentials",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/ServiceCredentials"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "credentials are updated"
-          },
-          "400": {
-            "description": "invalid input",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "422": {
-            "description": "bad validation"
-          },
-          "503": {
-            "description": "internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+    "ExplainCondition": {
+      "type": "object",
+      "properties": {
+        "lastTransitionTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
         }
       }
     },
-    "/services/{ServiceID}/explain": {
-      "get": {
-        "description": "Explain status of service",
-        "tags": [
-          "service"
-        ],
-        "summary": "explain status of service",
-        "operationId": "serviceExplain",
-        "parameters": [
-          {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-            "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "kuberlogic service explain",
-            "schema": {
-              "$ref": "#/definitions/Explain"
-            }
-          },
-          "400": {
-            "description": "bad input parameter",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "422": {
-            "description": "bad validation",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+    "ExplainContainer": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "reason of the waiting or terminated state",
+          "type": "string"
+        },
+        "restartCount": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "ExplainError": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "ExplainEvent": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer"
+        },
+        "message": {
+          "type": "string"
+        },
+        "object": {
+          "description": "kind and name of the object the event is about",
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "ExplainObject": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "description": "short summary of the object state",
+          "type": "string"
         }
       }
     },
-    "/services/{ServiceID}/logs": {
-      "get": {
-        "description": "List service pod logs",
-        "tags": [
-          "service"
-        ],
-        "summary": "list service logs",
-        "operationId": "serviceLogs",
-        "parameters": [
-          {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-            "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "service pod container name to query logs by",
-            "name": "ContainerName",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "kuberlogic service logs",
-            "schema": {
-              "$ref": "#/definitions/Logs"
-            }
-          },
-          "400": {
-            "description": "bad input parameter",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "422": {
-            "description": "bad validation",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+    "ExplainPod": {
+      "type": "object",
+      "properties": {
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExplainContainer"
           }
+        },
+        "name": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "phase": {
+          "type": "string"
         }
       }
     },
-    "/services/{ServiceID}/secrets": {
-      "get": {
-        "description": "retrieves service secrets",
-        "tags": [
-          "service"
-        ],
-        "summary": "retrieves service secrets",
-        "operationId": "serviceSecretsList",
-        "parameters": [
-          {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-            "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "service secrets",
-            "schema": {
-              "$ref": "#/definitions/ServiceSecrets"
-            }
-          },
-          "400": {
-            "description": "invalid input, object invalid",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "503": {
-            "description": "internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+    "Limits": {
+      "type": "object",
+      "properties": {
+        "cpu": {
+          "type": "string"
+        },
+        "memory": {
+          "type": "string"
+        },
+        "storage": {
+          "type": "string"
         }
       }
     },
-    "/services/{ServiceID}/unarchive": {
-      "post": {
-        "description": "unarchive service (for example, if user subscription resumed from canceled state)",
-        "tags": [
-          "service"
-        ],
-        "summary": "unarchive service",
-        "operationId": "serviceUnarchive",
-        "parameters": [
-          {
-            "maxLength": 20,
-            "minLength": 3,
-            "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-            "type": "string",
-            "description": "service Resource ID",
-            "name": "ServiceID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "service request to unarchive is sent"
-          },
-          "400": {
-            "description": "invalid input",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "bad authentication"
-          },
-          "403": {
-            "description": "bad permissions"
-          },
-          "404": {
-            "description": "service not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "422": {
-            "description": "bad validation"
-          },
-          "503": {
-            "description": "internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+    "Log": {
+      "type": "object",
+      "properties": {
+        "containerName": {
+          "type": "string",
+          "readOnly": true
+        },
+        "logs": {
+          "type": "string",
+          "readOnly": true
+        },
+        "podName": {
+          "type": "string",
+          "readOnly": true
         }
       }
-    }
-  },
-  "definitions": {
-    "Advanced": {
+    },
+    "Logs": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Log"
+      }
+    },
+    "ParameterSchema": {
+      "description": "environment variable supported by the service type",
+      "type": "object",
+      "properties": {
+        "container": {
+          "type": "string"
+        },
+        "default": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "secret": {
+          "description": "variable must be a secret",
+          "type": "boolean"
+        },
+        "type": {
+          "description": "one of string, integer or boolean",
+          "type": "string"
+        }
+      }
+    },
+    "ResourceUsage": {
+      "type": "object",
+      "properties": {
+        "limit": {
+          "description": "service limit, it is empty if the service is not limited",
+          "type": "string"
+        },
+        "percent": {
+          "description": "usage in percents of the limit, it is not set if the service is not limited",
+          "type": "number",
+          "x-nullable": true
+        },
+        "used": {
+          "description": "current usage, e.g. 250m or 512Mi",
+          "type": "string"
+        }
+      }
+    },
+    "Restore": {
+      "type": "object",
+      "properties": {
+        "backup_id": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+          "readOnly": true
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "Restores": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Restore"
+      }
+    },
+    "SecretValue": {
+      "description": "new value of service secret",
       "type": "object",
-      "additionalProperties": {
-        "type": "object"
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
       }
     },
-    "Backup": {
+    "Service": {
       "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
       "properties": {
+        "advanced": {
+          "$ref": "#/definitions/Advanced"
+        },
+        "backupSchedule": {
+          "type": "string"
+        },
         "created_at": {
           "type": "string",
           "format": "date-time",
           "readOnly": true
         },
-        "id": {
+        "domain": {
+          "type": "string"
+        },
+        "endpoint": {
           "type": "string",
-          "maxLength": 63,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
           "readOnly": true
         },
-        "service_id": {
+        "id": {
           "type": "string",
           "maxLength": 20,
           "minLength": 2,
           "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
         },
+        "insecure": {
+          "type": "boolean"
+        },
+        "limits": {
+          "$ref": "#/definitions/Limits"
+        },
+        "plan": {
+          "description": "name of the service plan, plan parameters are used unless they are set in the service\n",
+          "type": "string"
+        },
+        "replicas": {
+          "type": "integer",
+          "x-nullable": true
+        },
         "status": {
+          "type": "string",
+          "readOnly": true
+        },
+        "subscription": {
+          "type": "string"
+        },
+        "tenant": {
+          "description": "tenant owning the service, it is set from the authenticated principal\nif the principal belongs to a tenant\n",
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "use_letsencrypt": {
+          "type": "boolean"
+        },
+        "version": {
           "type": "string"
         }
       }
     },
-    "Backups": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Backup"
-      }
-    },
-    "Error": {
+    "ServiceCredentials": {
+      "description": "service credentials",
       "type": "object",
-      "properties": {
-        "message": {
-          "type": "string"
-        }
+      "additionalProperties": {
+        "type": "string"
       }
     },
-    "Explain": {
+    "ServiceEnv": {
+      "description": "service environment variables",
       "type": "object",
       "properties": {
-        "ingress": {
-          "type": "object",
-          "properties": {
-            "error": {
-              "type": "string"
-            },
-            "hosts": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            },
-            "ingressClass": {
-              "type": "string"
-            }
-          }
-        },
-        "pod": {
-          "type": "object",
-          "properties": {
-            "containers": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ExplainPodContainersItems0"
-              }
-            },
-            "error": {
-              "type": "string"
-            }
+        "parameters": {
+          "description": "environment variables supported by the service type",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ParameterSchema"
           }
         },
-        "pvc": {
-          "type": "object",
-          "properties": {
-            "error": {
-              "type": "string"
-            },
-            "phase": {
-              "type": "string"
-            },
-            "size": {
-              "type": "string"
-            },
-            "storageClass": {
-              "type": "string"
-            }
+        "variables": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EnvVar"
           }
         }
       }
     },
-    "ExplainIngress": {
+    "ServiceMetrics": {
       "type": "object",
       "properties": {
-        "error": {
-          "type": "string"
-        },
-        "hosts": {
+        "containers": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/ContainerUsage"
           }
         },
-        "ingressClass": {
-          "type": "string"
-        }
-      }
-    },
-    "ExplainPod": {
-      "type": "object",
-      "properties": {
-        "containers": {
+        "cpu": {
+          "$ref": "#/definitions/ResourceUsage"
+        },
+        "memory": {
+          "$ref": "#/definitions/ResourceUsage"
+        },
+        "storage": {
+          "$ref": "#/definitions/ResourceUsage"
+        },
+        "time": {
+          "description": "time the container usage was collected at",
+          "type": "string",
+          "format": "date-time"
+        },
+        "volumes": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ExplainPodContainersItems0"
+            "$ref": "#/definitions/VolumeUsage"
           }
         },
-        "error": {
-          "type": "string"
+        "warnings": {
+          "description": "parts of the usage that could not be collected",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "ExplainPodContainersItems0": {
+    "ServicePlan": {
       "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
       "properties": {
-        "name": {
+        "advanced": {
+          "$ref": "#/definitions/Advanced"
+        },
+        "backupSchedule": {
           "type": "string"
         },
-        "restartCount": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "description": {
+          "type": "string"
+        },
+        "limits": {
+          "$ref": "#/definitions/Limits"
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 2,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        },
+        "replicas": {
           "type": "integer",
           "x-nullable": true
         },
-        "status": {
+        "type": {
+          "type": "string"
+        },
+        "version": {
           "type": "string"
         }
       }
     },
-    "ExplainPvc": {
+    "ServicePlans": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ServicePlan"
+      }
+    },
+    "ServiceSecret": {
+      "description": "service secret",
       "type": "object",
       "properties": {
-        "error": {
-          "type": "string"
+        "id": {
+          "type": "string",
+          "minLength": 1,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+          "readOnly": true
         },
-        "phase": {
-          "type": "string"
+        "rotatable": {
+          "description": "secret can be rotated or set",
+          "type": "boolean",
+          "readOnly": true
         },
-        "size": {
-          "type": "string"
+        "value": {
+          "description": "secret value, it is returned only when a single secret is requested",
+          "type": "string",
+          "minLength": 1,
+          "readOnly": true
         },
-        "storageClass": {
-          "type": "string"
+        "visible": {
+          "description": "secret can be revealed",
+          "type": "boolean",
+          "readOnly": true
         }
       }
     },
-    "Limits": {
+    "ServiceSecrets": {
+      "description": "service secrets",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ServiceSecret"
+      }
+    },
+    "ServiceType": {
       "type": "object",
       "properties": {
-        "cpu": {
+        "default": {
+          "description": "version of services created without a version",
           "type": "string"
         },
-        "memory": {
+        "type": {
           "type": "string"
         },
-        "storage": {
-          "type": "string"
+        "versions": {
+          "description": "supported versions ordered from the oldest, any version is supported when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "Log": {
+    "ServiceTypes": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ServiceType"
+      }
+    },
+    "Services": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Service"
+      }
+    },
+    "Token": {
       "type": "object",
+      "required": [
+        "name",
+        "scopes"
+      ],
       "properties": {
-        "containerName": {
+        "created_at": {
           "type": "string",
+          "format": "date-time",
           "readOnly": true
         },
-        "logs": {
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 63,
+          "minLength": 3,
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        },
+        "scopes": {
+          "description": "scopes granted to the token: read-only, admin or \u003cresource\u003e:\u003caction\u003e\nlike services:write or backups:*\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "tenant the token principal belongs to, tenant principals access only the tenant services",
+          "type": "string"
+        },
+        "token": {
+          "description": "token value, returned only when the token is created",
           "type": "string",
           "readOnly": true
         }
       }
     },
-    "Logs": {
+    "Tokens": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/Log"
+        "$ref": "#/definitions/Token"
       }
     },
-    "Restore": {
+    "VolumeUsage": {
       "type": "object",
       "properties": {
-        "backup_id": {
-          "type": "string",
-          "maxLength": 63,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+        "available": {
+          "type": "string"
         },
+        "capacity": {
+          "type": "string"
+        },
+        "claim": {
+          "description": "persistent volume claim of the volume",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "pod": {
+          "type": "string"
+        },
+        "used": {
+          "type": "string"
+        }
+      }
+    },
+    "Webhook": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
         "created_at": {
           "type": "string",
           "format": "date-time",
           "readOnly": true
         },
-        "id": {
+        "events": {
+          "description": "event types delivered to the webhook like service.ready, all events are delivered if empty.\nEvent groups can be matched with a wildcard like service.*\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
           "type": "string",
           "maxLength": 63,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-          "readOnly": true
+          "minLength": 3,
+          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
         },
-        "status": {
+        "secret": {
+          "description": "HMAC signing secret, it is generated if not set and returned only when the webhook is created\n",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "tenant the webhook belongs to, tenant webhooks receive events of the tenant services only",
+          "type": "string"
+        },
+        "url": {
           "type": "string"
         }
       }
     },
-    "Restores": {
+    "WebhookDeliveries": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/Restore"
+        "$ref": "#/definitions/WebhookDelivery"
       }
     },
-    "Service": {
+    "WebhookDelivery": {
       "type": "object",
-      "required": [
-        "id",
-        "type"
-      ],
       "properties": {
-        "advanced": {
-          "$ref": "#/definitions/Advanced"
+        "attempts": {
+          "type": "integer"
         },
-        "backupSchedule": {
+        "error": {
           "type": "string"
         },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "readOnly": true
+        "event": {
+          "$ref": "#/definitions/WebhookEvent"
         },
-        "domain": {
-          "type": "string"
+        "status": {
+          "description": "HTTP status of the last attempt",
+          "type": "integer"
         },
-        "endpoint": {
+        "time": {
+          "description": "time of the last attempt",
           "type": "string",
-          "readOnly": true
+          "format": "date-time"
+        },
+        "webhook": {
+          "type": "string"
+        }
+      }
+    },
+    "WebhookEvent": {
+      "description": "notification delivered to webhooks",
+      "type": "object",
+      "properties": {
+        "backup": {
+          "type": "string"
         },
         "id": {
-          "type": "string",
-          "maxLength": 20,
-          "minLength": 2,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+          "description": "unique event id, it is the same for all delivery attempts",
+          "type": "string"
         },
-        "insecure": {
-          "type": "boolean"
+        "message": {
+          "type": "string"
         },
-        "limits": {
-          "$ref": "#/definitions/Limits"
+        "restore": {
+          "type": "string"
         },
-        "replicas": {
-          "type": "integer",
-          "x-nullable": true
+        "service": {
+          "type": "string"
         },
         "status": {
-          "type": "string",
-          "readOnly": true
-        },
-        "subscription": {
+          "description": "service, backup or restore status",
           "type": "string"
         },
-        "type": {
+        "tenant": {
           "type": "string"
         },
-        "use_letsencrypt": {
-          "type": "boolean"
-        },
-        "version": {
-          "type": "string"
-        }
-      }
-    },
-    "ServiceCredentials": {
-      "description": "service credentials",
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    },
-    "ServiceSecret": {
-      "description": "service secret",
-      "type": "object",
-      "properties": {
-        "id": {
+        "time": {
           "type": "string",
-          "minLength": 1,
-          "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
-          "readOnly": true
+          "format": "date-time"
         },
-        "value": {
+        "type": {
           "type": "string",
-          "minLength": 1,
-          "readOnly": true
+          "enum": [
+            "ping",
+            "service.created",
+            "service.ready",
+            "service.failed",
+            "service.paused",
+            "service.archived",
+            "backup.completed",
+            "backup.failed",
+            "restore.completed",
+            "restore.failed"
+          ]
         }
       }
     },
-    "ServiceSecrets": {
-      "description": "service secrets",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ServiceSecret"
-      }
-    },
-    "Services": {
+    "Webhooks": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/Service"
+        "$ref": "#/definitions/Webhook"
       }
     },
     "principal": {
-      "type": "string"
+      "description": "authenticated API client",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "tenant of the principal, empty for principals with access to all tenants",
+          "type": "string"
+        }
+      }
     }
   },
   "parameters": {
+    "AuditLimit": {
+      "maximum": 1000,
+      "minimum": 1,
+      "type": "integer",
+      "description": "maximum number of records, 100 if not set",
+      "name": "limit",
+      "in": "query"
+    },
+    "AuditOperation": {
+      "type": "string",
+      "description": "API operation id like serviceAdd",
+      "name": "operation",
+      "in": "query"
+    },
+    "AuditPrincipal": {
+      "type": "string",
+      "description": "name of the principal",
+      "name": "principal",
+      "in": "query"
+    },
+    "AuditSince": {
+      "type": "string",
+      "description": "show records newer than the time in RFC3339 format like 2021-01-02T15:04:05Z",
+      "name": "since",
+      "in": "query"
+    },
+    "AuditTarget": {
+      "type": "string",
+      "description": "object the operation was applied to like services/demo",
+      "name": "target",
+      "in": "query"
+    },
     "BackupID": {
       "maxLength": 63,
       "minLength": 3,
@@ -2530,12 +7525,146 @@ func init() {
       "name": "ServiceID",
       "in": "query"
     },
+    "Command": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "command to execute along with its arguments",
+      "name": "Command",
+      "in": "query",
+      "required": true
+    },
     "ContainerName": {
       "type": "string",
-      "description": "service pod container name to query logs by",
+      "description": "service pod container name",
       "name": "ContainerName",
       "in": "query"
     },
+    "EnvContainer": {
+      "type": "string",
+      "description": "container of the environment variable, the variable is set for all containers when it is empty",
+      "name": "container",
+      "in": "query"
+    },
+    "EnvName": {
+      "maxLength": 253,
+      "pattern": "^[-._a-zA-Z][-._a-zA-Z0-9]*$",
+      "type": "string",
+      "description": "environment variable name",
+      "name": "EnvName",
+      "in": "path",
+      "required": true
+    },
+    "EnvValue": {
+      "name": "EnvValue",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/EnvValue"
+      }
+    },
+    "IdempotencyKey": {
+      "maxLength": 255,
+      "type": "string",
+      "description": "unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory",
+      "name": "Idempotency-Key",
+      "in": "header"
+    },
+    "IfMatch": {
+      "type": "string",
+      "description": "ETag of the service the request is based on",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "LastEventID": {
+      "type": "string",
+      "description": "id of the last received event, sent by event stream clients on reconnect",
+      "name": "Last-Event-ID",
+      "in": "header"
+    },
+    "ListContinue": {
+      "type": "string",
+      "description": "token of the page to return, it is taken from the X-Continue header of the previous page",
+      "name": "continue",
+      "in": "query"
+    },
+    "ListCreatedAfter": {
+      "type": "string",
+      "description": "return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z",
+      "name": "created_after",
+      "in": "query"
+    },
+    "ListCreatedBefore": {
+      "type": "string",
+      "description": "return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z",
+      "name": "created_before",
+      "in": "query"
+    },
+    "ListLimit": {
+      "maximum": 1000,
+      "minimum": 1,
+      "type": "integer",
+      "description": "maximum number of items in the page, all items are returned if not set",
+      "name": "limit",
+      "in": "query"
+    },
+    "ListSort": {
+      "type": "string",
+      "description": "sort key prefixed with \"-\" for the descending order.\nItems are sorted by id if not set.\nKeys are id and created_at, services can also be sorted by type, domain and status.\n",
+      "name": "sort",
+      "in": "query"
+    },
+    "ListStatus": {
+      "type": "string",
+      "description": "return only items in the status",
+      "name": "status",
+      "in": "query"
+    },
+    "PlanItem": {
+      "description": "service plan item",
+      "name": "planItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/ServicePlan"
+      }
+    },
+    "PlanName": {
+      "maxLength": 63,
+      "minLength": 2,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "service plan name",
+      "name": "PlanName",
+      "in": "path",
+      "required": true
+    },
+    "PlanRollout": {
+      "type": "boolean",
+      "description": "apply plan changes to all services created with the plan",
+      "name": "rollout",
+      "in": "query"
+    },
+    "PodName": {
+      "type": "string",
+      "description": "service pod name",
+      "name": "PodName",
+      "in": "query"
+    },
+    "Previous": {
+      "type": "boolean",
+      "description": "return logs of the previous terminated container instance",
+      "name": "Previous",
+      "in": "query"
+    },
+    "ResourceVersion": {
+      "type": "string",
+      "description": "resource version to start watching from",
+      "name": "ResourceVersion",
+      "in": "query"
+    },
     "RestoreID": {
       "maxLength": 63,
       "minLength": 3,
@@ -2555,6 +7684,24 @@ func init() {
         "$ref": "#/definitions/Restore"
       }
     },
+    "SecretID": {
+      "maxLength": 253,
+      "pattern": "^[-._a-zA-Z0-9]+$",
+      "type": "string",
+      "description": "service secret ID",
+      "name": "SecretID",
+      "in": "path",
+      "required": true
+    },
+    "SecretValue": {
+      "description": "secret value",
+      "name": "SecretValue",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/SecretValue"
+      }
+    },
     "ServiceCredentials": {
       "description": "service credentials",
       "name": "ServiceCredentials",
@@ -2563,6 +7710,12 @@ func init() {
         "$ref": "#/definitions/ServiceCredentials"
       }
     },
+    "ServiceDomainFilter": {
+      "type": "string",
+      "description": "return only services with the domain",
+      "name": "domain",
+      "in": "query"
+    },
     "ServiceID": {
       "maxLength": 20,
       "minLength": 3,
@@ -2582,14 +7735,96 @@ func init() {
         "$ref": "#/definitions/Service"
       }
     },
+    "ServiceTypeFilter": {
+      "type": "string",
+      "description": "return only services of the type",
+      "name": "type",
+      "in": "query"
+    },
+    "SinceSeconds": {
+      "minimum": 1,
+      "type": "integer",
+      "description": "show logs newer than a relative duration in seconds",
+      "name": "SinceSeconds",
+      "in": "query"
+    },
+    "Stdin": {
+      "type": "boolean",
+      "description": "pass stdin to the command",
+      "name": "Stdin",
+      "in": "query"
+    },
     "SubscriptionID": {
       "type": "string",
       "description": "subscription ID",
       "name": "SubscriptionID",
       "in": "query"
+    },
+    "TailLines": {
+      "minimum": 0,
+      "type": "integer",
+      "description": "number of lines from the end of the logs to show",
+      "name": "TailLines",
+      "in": "query"
+    },
+    "TokenItem": {
+      "description": "api token item",
+      "name": "tokenItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Token"
+      }
+    },
+    "TokenName": {
+      "maxLength": 63,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "api token name",
+      "name": "TokenName",
+      "in": "path",
+      "required": true
+    },
+    "Tty": {
+      "type": "boolean",
+      "description": "allocate a TTY for the command",
+      "name": "Tty",
+      "in": "query"
+    },
+    "WebhookItem": {
+      "description": "webhook item",
+      "name": "webhookItem",
+      "in": "body",
+      "required": true,
+      "schema": {
+        "$ref": "#/definitions/Webhook"
+      }
+    },
+    "WebhookName": {
+      "maxLength": 63,
+      "minLength": 3,
+      "pattern": "[a-z0-9]([-a-z0-9]*[a-z0-9])?",
+      "type": "string",
+      "description": "webhook name",
+      "name": "WebhookName",
+      "in": "path",
+      "required": true
+    },
+    "WebhookNameFilter": {
+      "type": "string",
+      "description": "name of the webhook",
+      "name": "webhook",
+      "in": "query"
     }
   },
   "securityDefinitions": {
+    "bearer": {
+      "description": "OIDC token passed in the \"Authorization: Bearer\" header.\nTokens are validated against the issuer configured in the apiserver, the URL below is a placeholder.\n",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "https://oidc.example.com/authorize"
+    },
     "key": {
       "type": "apiKey",
       "name": "x-token",
@@ -2599,12 +7834,31 @@ func init() {
   "security": [
     {
       "key": []
+    },
+    {
+      "bearer": []
     }
   ],
   "tags": [
     {
       "description": "Everything about service resource",
       "name": "service"
+    },
+    {
+      "description": "API tokens management",
+      "name": "token"
+    },
+    {
+      "description": "Audit log of mutating and sensitive API calls",
+      "name": "audit"
+    },
+    {
+      "description": "Service plans bundling service parameters",
+      "name": "plan"
+    },
+    {
+      "description": "Webhook notifications of service lifecycle events",
+      "name": "webhook"
     }
   ]
 }`))
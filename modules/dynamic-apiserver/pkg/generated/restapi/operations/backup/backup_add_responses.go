@@ -128,7 +128,7 @@ func (o *BackupAddUnauthorized) WriteResponse(rw http.ResponseWriter, producer r
 // BackupAddConflictCode is the HTTP code returned for type BackupAddConflict
 const BackupAddConflictCode int = 409
 
-/*BackupAddConflict item already exists
+/*BackupAddConflict item already exists or a request with the same Idempotency-Key is in progress
 
 swagger:response backupAddConflict
 */
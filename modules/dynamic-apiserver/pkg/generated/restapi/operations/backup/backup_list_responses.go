@@ -22,6 +22,11 @@ swagger:response backupListOK
 */
 type BackupListOK struct {
 
+	/*token of the next page, it is empty on the last page
+
+	 */
+	XContinue string `json:"X-Continue"`
+
 	/*
 	  In: Body
 	*/
@@ -34,6 +39,17 @@ func NewBackupListOK() *BackupListOK {
 	return &BackupListOK{}
 }
 
+// WithXContinue adds the xContinue to the backup list o k response
+func (o *BackupListOK) WithXContinue(xContinue string) *BackupListOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the backup list o k response
+func (o *BackupListOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
 // WithPayload adds the payload to the backup list o k response
 func (o *BackupListOK) WithPayload(payload models.Backups) *BackupListOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *BackupListOK) SetPayload(payload models.Backups) {
 // WriteResponse to the client
 func (o *BackupListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
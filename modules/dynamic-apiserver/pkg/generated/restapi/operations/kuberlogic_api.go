@@ -20,9 +20,13 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/audit"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/backup"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/plan"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/restore"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/service"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/token"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/restapi/operations/webhook"
 )
 
 // NewKuberlogicAPI creates a new Kuberlogic instance
@@ -45,8 +49,12 @@ func NewKuberlogicAPI(spec *loads.Document) *KuberlogicAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
+		AuditAuditListHandler: audit.AuditListHandlerFunc(func(params audit.AuditListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation audit.AuditList has not yet been implemented")
+		}),
 		BackupBackupAddHandler: backup.BackupAddHandlerFunc(func(params backup.BackupAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backup.BackupAdd has not yet been implemented")
 		}),
@@ -56,6 +64,21 @@ func NewKuberlogicAPI(spec *loads.Document) *KuberlogicAPI {
 		BackupBackupListHandler: backup.BackupListHandlerFunc(func(params backup.BackupListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backup.BackupList has not yet been implemented")
 		}),
+		PlanPlanAddHandler: plan.PlanAddHandlerFunc(func(params plan.PlanAddParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation plan.PlanAdd has not yet been implemented")
+		}),
+		PlanPlanDeleteHandler: plan.PlanDeleteHandlerFunc(func(params plan.PlanDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation plan.PlanDelete has not yet been implemented")
+		}),
+		PlanPlanEditHandler: plan.PlanEditHandlerFunc(func(params plan.PlanEditParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation plan.PlanEdit has not yet been implemented")
+		}),
+		PlanPlanGetHandler: plan.PlanGetHandlerFunc(func(params plan.PlanGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation plan.PlanGet has not yet been implemented")
+		}),
+		PlanPlanListHandler: plan.PlanListHandlerFunc(func(params plan.PlanListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation plan.PlanList has not yet been implemented")
+		}),
 		RestoreRestoreAddHandler: restore.RestoreAddHandlerFunc(func(params restore.RestoreAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation restore.RestoreAdd has not yet been implemented")
 		}),
@@ -80,6 +103,18 @@ func NewKuberlogicAPI(spec *loads.Document) *KuberlogicAPI {
 		ServiceServiceEditHandler: service.ServiceEditHandlerFunc(func(params service.ServiceEditParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.ServiceEdit has not yet been implemented")
 		}),
+		ServiceServiceEnvListHandler: service.ServiceEnvListHandlerFunc(func(params service.ServiceEnvListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceEnvList has not yet been implemented")
+		}),
+		ServiceServiceEnvSetHandler: service.ServiceEnvSetHandlerFunc(func(params service.ServiceEnvSetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceEnvSet has not yet been implemented")
+		}),
+		ServiceServiceEnvUnsetHandler: service.ServiceEnvUnsetHandlerFunc(func(params service.ServiceEnvUnsetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceEnvUnset has not yet been implemented")
+		}),
+		ServiceServiceExecHandler: service.ServiceExecHandlerFunc(func(params service.ServiceExecParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceExec has not yet been implemented")
+		}),
 		ServiceServiceExplainHandler: service.ServiceExplainHandlerFunc(func(params service.ServiceExplainParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.ServiceExplain has not yet been implemented")
 		}),
@@ -89,20 +124,71 @@ func NewKuberlogicAPI(spec *loads.Document) *KuberlogicAPI {
 		ServiceServiceListHandler: service.ServiceListHandlerFunc(func(params service.ServiceListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.ServiceList has not yet been implemented")
 		}),
+		ServiceServiceListWatchHandler: service.ServiceListWatchHandlerFunc(func(params service.ServiceListWatchParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceListWatch has not yet been implemented")
+		}),
 		ServiceServiceLogsHandler: service.ServiceLogsHandlerFunc(func(params service.ServiceLogsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.ServiceLogs has not yet been implemented")
 		}),
+		ServiceServiceLogsFollowHandler: service.ServiceLogsFollowHandlerFunc(func(params service.ServiceLogsFollowParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceLogsFollow has not yet been implemented")
+		}),
+		ServiceServiceMetricsHandler: service.ServiceMetricsHandlerFunc(func(params service.ServiceMetricsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceMetrics has not yet been implemented")
+		}),
+		ServiceServiceSecretGetHandler: service.ServiceSecretGetHandlerFunc(func(params service.ServiceSecretGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceSecretGet has not yet been implemented")
+		}),
+		ServiceServiceSecretRotateHandler: service.ServiceSecretRotateHandlerFunc(func(params service.ServiceSecretRotateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceSecretRotate has not yet been implemented")
+		}),
+		ServiceServiceSecretSetHandler: service.ServiceSecretSetHandlerFunc(func(params service.ServiceSecretSetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceSecretSet has not yet been implemented")
+		}),
 		ServiceServiceSecretsListHandler: service.ServiceSecretsListHandlerFunc(func(params service.ServiceSecretsListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.ServiceSecretsList has not yet been implemented")
 		}),
+		ServiceServiceTypeListHandler: service.ServiceTypeListHandlerFunc(func(params service.ServiceTypeListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceTypeList has not yet been implemented")
+		}),
 		ServiceServiceUnarchiveHandler: service.ServiceUnarchiveHandlerFunc(func(params service.ServiceUnarchiveParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.ServiceUnarchive has not yet been implemented")
 		}),
+		ServiceServiceWatchHandler: service.ServiceWatchHandlerFunc(func(params service.ServiceWatchParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.ServiceWatch has not yet been implemented")
+		}),
+		TokenTokenAddHandler: token.TokenAddHandlerFunc(func(params token.TokenAddParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation token.TokenAdd has not yet been implemented")
+		}),
+		TokenTokenDeleteHandler: token.TokenDeleteHandlerFunc(func(params token.TokenDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation token.TokenDelete has not yet been implemented")
+		}),
+		TokenTokenListHandler: token.TokenListHandlerFunc(func(params token.TokenListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation token.TokenList has not yet been implemented")
+		}),
+		WebhookWebhookAddHandler: webhook.WebhookAddHandlerFunc(func(params webhook.WebhookAddParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.WebhookAdd has not yet been implemented")
+		}),
+		WebhookWebhookDeadLetterListHandler: webhook.WebhookDeadLetterListHandlerFunc(func(params webhook.WebhookDeadLetterListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.WebhookDeadLetterList has not yet been implemented")
+		}),
+		WebhookWebhookDeleteHandler: webhook.WebhookDeleteHandlerFunc(func(params webhook.WebhookDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.WebhookDelete has not yet been implemented")
+		}),
+		WebhookWebhookListHandler: webhook.WebhookListHandlerFunc(func(params webhook.WebhookListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.WebhookList has not yet been implemented")
+		}),
+		WebhookWebhookPingHandler: webhook.WebhookPingHandlerFunc(func(params webhook.WebhookPingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.WebhookPing has not yet been implemented")
+		}),
 
 		// Applies when the "x-token" header is set
 		KeyAuth: func(token string) (*models.Principal, error) {
 			return nil, errors.NotImplemented("api key auth (key) x-token from header param [x-token] has not yet been implemented")
 		},
+		BearerAuth: func(token string, scopes []string) (*models.Principal, error) {
+			return nil, errors.NotImplemented("oauth2 bearer auth (bearer) has not yet been implemented")
+		},
 		// default authorizer is authorized meaning no requests are blocked
 		APIAuthorizer: security.Authorized(),
 	}
@@ -137,6 +223,9 @@ type KuberlogicAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	BinProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
@@ -145,15 +234,31 @@ type KuberlogicAPI struct {
 	// it performs authentication based on an api key x-token provided in the header
 	KeyAuth func(string) (*models.Principal, error)
 
+	// BearerAuth registers a function that takes an access token and a collection of required scopes and returns a principal
+	// it performs authentication based on an oauth2 bearer token provided in the request
+	BearerAuth func(string, []string) (*models.Principal, error)
+
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AuditAuditListHandler sets the operation handler for the audit list operation
+	AuditAuditListHandler audit.AuditListHandler
 	// BackupBackupAddHandler sets the operation handler for the backup add operation
 	BackupBackupAddHandler backup.BackupAddHandler
 	// BackupBackupDeleteHandler sets the operation handler for the backup delete operation
 	BackupBackupDeleteHandler backup.BackupDeleteHandler
 	// BackupBackupListHandler sets the operation handler for the backup list operation
 	BackupBackupListHandler backup.BackupListHandler
+	// PlanPlanAddHandler sets the operation handler for the plan add operation
+	PlanPlanAddHandler plan.PlanAddHandler
+	// PlanPlanDeleteHandler sets the operation handler for the plan delete operation
+	PlanPlanDeleteHandler plan.PlanDeleteHandler
+	// PlanPlanEditHandler sets the operation handler for the plan edit operation
+	PlanPlanEditHandler plan.PlanEditHandler
+	// PlanPlanGetHandler sets the operation handler for the plan get operation
+	PlanPlanGetHandler plan.PlanGetHandler
+	// PlanPlanListHandler sets the operation handler for the plan list operation
+	PlanPlanListHandler plan.PlanListHandler
 	// RestoreRestoreAddHandler sets the operation handler for the restore add operation
 	RestoreRestoreAddHandler restore.RestoreAddHandler
 	// RestoreRestoreDeleteHandler sets the operation handler for the restore delete operation
@@ -170,18 +275,58 @@ type KuberlogicAPI struct {
 	ServiceServiceDeleteHandler service.ServiceDeleteHandler
 	// ServiceServiceEditHandler sets the operation handler for the service edit operation
 	ServiceServiceEditHandler service.ServiceEditHandler
+	// ServiceServiceEnvListHandler sets the operation handler for the service env list operation
+	ServiceServiceEnvListHandler service.ServiceEnvListHandler
+	// ServiceServiceEnvSetHandler sets the operation handler for the service env set operation
+	ServiceServiceEnvSetHandler service.ServiceEnvSetHandler
+	// ServiceServiceEnvUnsetHandler sets the operation handler for the service env unset operation
+	ServiceServiceEnvUnsetHandler service.ServiceEnvUnsetHandler
+	// ServiceServiceExecHandler sets the operation handler for the service exec operation
+	ServiceServiceExecHandler service.ServiceExecHandler
 	// ServiceServiceExplainHandler sets the operation handler for the service explain operation
 	ServiceServiceExplainHandler service.ServiceExplainHandler
 	// ServiceServiceGetHandler sets the operation handler for the service get operation
 	ServiceServiceGetHandler service.ServiceGetHandler
 	// ServiceServiceListHandler sets the operation handler for the service list operation
 	ServiceServiceListHandler service.ServiceListHandler
+	// ServiceServiceListWatchHandler sets the operation handler for the service list watch operation
+	ServiceServiceListWatchHandler service.ServiceListWatchHandler
 	// ServiceServiceLogsHandler sets the operation handler for the service logs operation
 	ServiceServiceLogsHandler service.ServiceLogsHandler
+	// ServiceServiceLogsFollowHandler sets the operation handler for the service logs follow operation
+	ServiceServiceLogsFollowHandler service.ServiceLogsFollowHandler
+	// ServiceServiceMetricsHandler sets the operation handler for the service metrics operation
+	ServiceServiceMetricsHandler service.ServiceMetricsHandler
+	// ServiceServiceSecretGetHandler sets the operation handler for the service secret get operation
+	ServiceServiceSecretGetHandler service.ServiceSecretGetHandler
+	// ServiceServiceSecretRotateHandler sets the operation handler for the service secret rotate operation
+	ServiceServiceSecretRotateHandler service.ServiceSecretRotateHandler
+	// ServiceServiceSecretSetHandler sets the operation handler for the service secret set operation
+	ServiceServiceSecretSetHandler service.ServiceSecretSetHandler
 	// ServiceServiceSecretsListHandler sets the operation handler for the service secrets list operation
 	ServiceServiceSecretsListHandler service.ServiceSecretsListHandler
+	// ServiceServiceTypeListHandler sets the operation handler for the service type list operation
+	ServiceServiceTypeListHandler service.ServiceTypeListHandler
 	// ServiceServiceUnarchiveHandler sets the operation handler for the service unarchive operation
 	ServiceServiceUnarchiveHandler service.ServiceUnarchiveHandler
+	// ServiceServiceWatchHandler sets the operation handler for the service watch operation
+	ServiceServiceWatchHandler service.ServiceWatchHandler
+	// TokenTokenAddHandler sets the operation handler for the token add operation
+	TokenTokenAddHandler token.TokenAddHandler
+	// TokenTokenDeleteHandler sets the operation handler for the token delete operation
+	TokenTokenDeleteHandler token.TokenDeleteHandler
+	// TokenTokenListHandler sets the operation handler for the token list operation
+	TokenTokenListHandler token.TokenListHandler
+	// WebhookWebhookAddHandler sets the operation handler for the webhook add operation
+	WebhookWebhookAddHandler webhook.WebhookAddHandler
+	// WebhookWebhookDeadLetterListHandler sets the operation handler for the webhook dead letter list operation
+	WebhookWebhookDeadLetterListHandler webhook.WebhookDeadLetterListHandler
+	// WebhookWebhookDeleteHandler sets the operation handler for the webhook delete operation
+	WebhookWebhookDeleteHandler webhook.WebhookDeleteHandler
+	// WebhookWebhookListHandler sets the operation handler for the webhook list operation
+	WebhookWebhookListHandler webhook.WebhookListHandler
+	// WebhookWebhookPingHandler sets the operation handler for the webhook ping operation
+	WebhookWebhookPingHandler webhook.WebhookPingHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -255,6 +400,9 @@ func (o *KuberlogicAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -262,7 +410,13 @@ func (o *KuberlogicAPI) Validate() error {
 	if o.KeyAuth == nil {
 		unregistered = append(unregistered, "XTokenAuth")
 	}
+	if o.BearerAuth == nil {
+		unregistered = append(unregistered, "BearerAuth")
+	}
 
+	if o.AuditAuditListHandler == nil {
+		unregistered = append(unregistered, "audit.AuditListHandler")
+	}
 	if o.BackupBackupAddHandler == nil {
 		unregistered = append(unregistered, "backup.BackupAddHandler")
 	}
@@ -272,6 +426,21 @@ func (o *KuberlogicAPI) Validate() error {
 	if o.BackupBackupListHandler == nil {
 		unregistered = append(unregistered, "backup.BackupListHandler")
 	}
+	if o.PlanPlanAddHandler == nil {
+		unregistered = append(unregistered, "plan.PlanAddHandler")
+	}
+	if o.PlanPlanDeleteHandler == nil {
+		unregistered = append(unregistered, "plan.PlanDeleteHandler")
+	}
+	if o.PlanPlanEditHandler == nil {
+		unregistered = append(unregistered, "plan.PlanEditHandler")
+	}
+	if o.PlanPlanGetHandler == nil {
+		unregistered = append(unregistered, "plan.PlanGetHandler")
+	}
+	if o.PlanPlanListHandler == nil {
+		unregistered = append(unregistered, "plan.PlanListHandler")
+	}
 	if o.RestoreRestoreAddHandler == nil {
 		unregistered = append(unregistered, "restore.RestoreAddHandler")
 	}
@@ -296,6 +465,18 @@ func (o *KuberlogicAPI) Validate() error {
 	if o.ServiceServiceEditHandler == nil {
 		unregistered = append(unregistered, "service.ServiceEditHandler")
 	}
+	if o.ServiceServiceEnvListHandler == nil {
+		unregistered = append(unregistered, "service.ServiceEnvListHandler")
+	}
+	if o.ServiceServiceEnvSetHandler == nil {
+		unregistered = append(unregistered, "service.ServiceEnvSetHandler")
+	}
+	if o.ServiceServiceEnvUnsetHandler == nil {
+		unregistered = append(unregistered, "service.ServiceEnvUnsetHandler")
+	}
+	if o.ServiceServiceExecHandler == nil {
+		unregistered = append(unregistered, "service.ServiceExecHandler")
+	}
 	if o.ServiceServiceExplainHandler == nil {
 		unregistered = append(unregistered, "service.ServiceExplainHandler")
 	}
@@ -305,15 +486,63 @@ func (o *KuberlogicAPI) Validate() error {
 	if o.ServiceServiceListHandler == nil {
 		unregistered = append(unregistered, "service.ServiceListHandler")
 	}
+	if o.ServiceServiceListWatchHandler == nil {
+		unregistered = append(unregistered, "service.ServiceListWatchHandler")
+	}
 	if o.ServiceServiceLogsHandler == nil {
 		unregistered = append(unregistered, "service.ServiceLogsHandler")
 	}
+	if o.ServiceServiceLogsFollowHandler == nil {
+		unregistered = append(unregistered, "service.ServiceLogsFollowHandler")
+	}
+	if o.ServiceServiceMetricsHandler == nil {
+		unregistered = append(unregistered, "service.ServiceMetricsHandler")
+	}
+	if o.ServiceServiceSecretGetHandler == nil {
+		unregistered = append(unregistered, "service.ServiceSecretGetHandler")
+	}
+	if o.ServiceServiceSecretRotateHandler == nil {
+		unregistered = append(unregistered, "service.ServiceSecretRotateHandler")
+	}
+	if o.ServiceServiceSecretSetHandler == nil {
+		unregistered = append(unregistered, "service.ServiceSecretSetHandler")
+	}
 	if o.ServiceServiceSecretsListHandler == nil {
 		unregistered = append(unregistered, "service.ServiceSecretsListHandler")
 	}
+	if o.ServiceServiceTypeListHandler == nil {
+		unregistered = append(unregistered, "service.ServiceTypeListHandler")
+	}
 	if o.ServiceServiceUnarchiveHandler == nil {
 		unregistered = append(unregistered, "service.ServiceUnarchiveHandler")
 	}
+	if o.ServiceServiceWatchHandler == nil {
+		unregistered = append(unregistered, "service.ServiceWatchHandler")
+	}
+	if o.TokenTokenAddHandler == nil {
+		unregistered = append(unregistered, "token.TokenAddHandler")
+	}
+	if o.TokenTokenDeleteHandler == nil {
+		unregistered = append(unregistered, "token.TokenDeleteHandler")
+	}
+	if o.TokenTokenListHandler == nil {
+		unregistered = append(unregistered, "token.TokenListHandler")
+	}
+	if o.WebhookWebhookAddHandler == nil {
+		unregistered = append(unregistered, "webhook.WebhookAddHandler")
+	}
+	if o.WebhookWebhookDeadLetterListHandler == nil {
+		unregistered = append(unregistered, "webhook.WebhookDeadLetterListHandler")
+	}
+	if o.WebhookWebhookDeleteHandler == nil {
+		unregistered = append(unregistered, "webhook.WebhookDeleteHandler")
+	}
+	if o.WebhookWebhookListHandler == nil {
+		unregistered = append(unregistered, "webhook.WebhookListHandler")
+	}
+	if o.WebhookWebhookPingHandler == nil {
+		unregistered = append(unregistered, "webhook.WebhookPingHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -338,6 +567,11 @@ func (o *KuberlogicAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme
 				return o.KeyAuth(token)
 			})
 
+		case "bearer":
+			result[name] = o.BearerAuthenticator(name, func(token string, scopes []string) (interface{}, error) {
+				return o.BearerAuth(token, scopes)
+			})
+
 		}
 	}
 	return result
@@ -373,6 +607,8 @@ func (o *KuberlogicAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pro
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.BinProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -413,6 +649,10 @@ func (o *KuberlogicAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audit"] = audit.NewAuditList(o.context, o.AuditAuditListHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -428,6 +668,26 @@ func (o *KuberlogicAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/plans"] = plan.NewPlanAdd(o.context, o.PlanPlanAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/plans/{PlanName}"] = plan.NewPlanDelete(o.context, o.PlanPlanDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/plans/{PlanName}"] = plan.NewPlanEdit(o.context, o.PlanPlanEditHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/plans/{PlanName}"] = plan.NewPlanGet(o.context, o.PlanPlanGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/plans"] = plan.NewPlanList(o.context, o.PlanPlanListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/restores"] = restore.NewRestoreAdd(o.context, o.RestoreRestoreAddHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -460,6 +720,22 @@ func (o *KuberlogicAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/{ServiceID}/env"] = service.NewServiceEnvList(o.context, o.ServiceServiceEnvListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/{ServiceID}/env/{EnvName}"] = service.NewServiceEnvSet(o.context, o.ServiceServiceEnvSetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/services/{ServiceID}/env/{EnvName}"] = service.NewServiceEnvUnset(o.context, o.ServiceServiceEnvUnsetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{ServiceID}/exec"] = service.NewServiceExec(o.context, o.ServiceServiceExecHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/{ServiceID}/explain"] = service.NewServiceExplain(o.context, o.ServiceServiceExplainHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -472,15 +748,79 @@ func (o *KuberlogicAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/watch"] = service.NewServiceListWatch(o.context, o.ServiceServiceListWatchHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/{ServiceID}/logs"] = service.NewServiceLogs(o.context, o.ServiceServiceLogsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/{ServiceID}/logs/follow"] = service.NewServiceLogsFollow(o.context, o.ServiceServiceLogsFollowHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{ServiceID}/metrics"] = service.NewServiceMetrics(o.context, o.ServiceServiceMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{ServiceID}/secrets/{SecretID}"] = service.NewServiceSecretGet(o.context, o.ServiceServiceSecretGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/{ServiceID}/secrets/{SecretID}/rotate"] = service.NewServiceSecretRotate(o.context, o.ServiceServiceSecretRotateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/services/{ServiceID}/secrets/{SecretID}"] = service.NewServiceSecretSet(o.context, o.ServiceServiceSecretSetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/{ServiceID}/secrets"] = service.NewServiceSecretsList(o.context, o.ServiceServiceSecretsListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/service-types"] = service.NewServiceTypeList(o.context, o.ServiceServiceTypeListHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/services/{ServiceID}/unarchive"] = service.NewServiceUnarchive(o.context, o.ServiceServiceUnarchiveHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{ServiceID}/watch"] = service.NewServiceWatch(o.context, o.ServiceServiceWatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/tokens"] = token.NewTokenAdd(o.context, o.TokenTokenAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/tokens/{TokenName}"] = token.NewTokenDelete(o.context, o.TokenTokenDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/tokens"] = token.NewTokenList(o.context, o.TokenTokenListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = webhook.NewWebhookAdd(o.context, o.WebhookWebhookAddHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhook-deadletters"] = webhook.NewWebhookDeadLetterList(o.context, o.WebhookWebhookDeadLetterListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{WebhookName}"] = webhook.NewWebhookDelete(o.context, o.WebhookWebhookDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = webhook.NewWebhookList(o.context, o.WebhookWebhookListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/{WebhookName}/ping"] = webhook.NewWebhookPing(o.context, o.WebhookWebhookPingHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -128,7 +128,7 @@ func (o *RestoreAddUnauthorized) WriteResponse(rw http.ResponseWriter, producer
 // RestoreAddConflictCode is the HTTP code returned for type RestoreAddConflict
 const RestoreAddConflictCode int = 409
 
-/*RestoreAddConflict item already exists
+/*RestoreAddConflict item already exists or a request with the same Idempotency-Key is in progress
 
 swagger:response restoreAddConflict
 */
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -32,6 +33,24 @@ type RestoreListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*token of the page to return, it is taken from the X-Continue header of the previous page
+	  In: query
+	*/
+	Continue *string
+	/*return only items created after the time in RFC3339 format like 2021-01-02T15:04:05Z
+	  In: query
+	*/
+	CreatedAfter *string
+	/*return only items created before the time in RFC3339 format like 2021-01-02T15:04:05Z
+	  In: query
+	*/
+	CreatedBefore *string
+	/*maximum number of items in the page, all items are returned if not set
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
 	/*service Resource ID to query backups/restores by
 	  Max Length: 20
 	  Min Length: 3
@@ -39,6 +58,16 @@ type RestoreListParams struct {
 	  In: query
 	*/
 	ServiceID *string
+	/*sort key prefixed with "-" for the descending order.
+Items are sorted by id if not set.
+Keys are id and created_at, services can also be sorted by type, domain and status.
+	  In: query
+	*/
+	Sort *string
+	/*return only items in the status
+	  In: query
+	*/
+	Status *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -52,16 +81,140 @@ func (o *RestoreListParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qContinue, qhkContinue, _ := qs.GetOK("continue")
+	if err := o.bindContinue(qContinue, qhkContinue, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedAfter, qhkCreatedAfter, _ := qs.GetOK("created_after")
+	if err := o.bindCreatedAfter(qCreatedAfter, qhkCreatedAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedBefore, qhkCreatedBefore, _ := qs.GetOK("created_before")
+	if err := o.bindCreatedBefore(qCreatedBefore, qhkCreatedBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qServiceID, qhkServiceID, _ := qs.GetOK("ServiceID")
 	if err := o.bindServiceID(qServiceID, qhkServiceID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindContinue binds and validates parameter continue from query.
+func (o *RestoreListParams) bindContinue(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Continue = &raw
+
+	return nil
+}
+
+// bindCreatedAfter binds and validates parameter created_after from query.
+func (o *RestoreListParams) bindCreatedAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.CreatedAfter = &raw
+
+	return nil
+}
+
+// bindCreatedBefore binds and validates parameter created_before from query.
+func (o *RestoreListParams) bindCreatedBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.CreatedBefore = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter limit from query.
+func (o *RestoreListParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter limit
+func (o *RestoreListParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindServiceID binds and validates parameter ServiceID from query.
 func (o *RestoreListParams) bindServiceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -101,3 +254,39 @@ func (o *RestoreListParams) validateServiceID(formats strfmt.Registry) error {
 
 	return nil
 }
+
+// bindSort binds and validates parameter sort from query.
+func (o *RestoreListParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Sort = &raw
+
+	return nil
+}
+
+// bindStatus binds and validates parameter status from query.
+func (o *RestoreListParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Status = &raw
+
+	return nil
+}
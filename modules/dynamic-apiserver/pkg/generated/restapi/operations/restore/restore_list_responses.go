@@ -22,6 +22,11 @@ swagger:response restoreListOK
 */
 type RestoreListOK struct {
 
+	/*token of the next page, it is empty on the last page
+
+	 */
+	XContinue string `json:"X-Continue"`
+
 	/*
 	  In: Body
 	*/
@@ -34,6 +39,17 @@ func NewRestoreListOK() *RestoreListOK {
 	return &RestoreListOK{}
 }
 
+// WithXContinue adds the xContinue to the restore list o k response
+func (o *RestoreListOK) WithXContinue(xContinue string) *RestoreListOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the restore list o k response
+func (o *RestoreListOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
 // WithPayload adds the payload to the restore list o k response
 func (o *RestoreListOK) WithPayload(payload models.Restores) *RestoreListOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *RestoreListOK) SetPayload(payload models.Restores) {
 // WriteResponse to the client
 func (o *RestoreListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
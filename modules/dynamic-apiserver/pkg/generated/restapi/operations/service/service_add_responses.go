@@ -128,11 +128,16 @@ func (o *ServiceAddUnauthorized) WriteResponse(rw http.ResponseWriter, producer
 // ServiceAddForbiddenCode is the HTTP code returned for type ServiceAddForbidden
 const ServiceAddForbiddenCode int = 403
 
-/*ServiceAddForbidden bad permissions
+/*ServiceAddForbidden bad permissions or tenant quota exceeded
 
 swagger:response serviceAddForbidden
 */
 type ServiceAddForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
 }
 
 // NewServiceAddForbidden creates ServiceAddForbidden with default headers values
@@ -141,18 +146,33 @@ func NewServiceAddForbidden() *ServiceAddForbidden {
 	return &ServiceAddForbidden{}
 }
 
+// WithPayload adds the payload to the service add forbidden response
+func (o *ServiceAddForbidden) WithPayload(payload *models.Error) *ServiceAddForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service add forbidden response
+func (o *ServiceAddForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *ServiceAddForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
-
 	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // ServiceAddConflictCode is the HTTP code returned for type ServiceAddConflict
 const ServiceAddConflictCode int = 409
 
-/*ServiceAddConflict item already exists
+/*ServiceAddConflict item already exists or a request with the same Idempotency-Key is in progress
 
 swagger:response serviceAddConflict
 */
@@ -31,6 +31,11 @@ type ServiceArchiveParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	  Max Length: 255
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*service Resource ID
 	  Required: true
 	  Max Length: 20
@@ -50,6 +55,10 @@ func (o *ServiceArchiveParams) BindRequest(r *http.Request, route *middleware.Ma
 
 	o.HTTPRequest = r
 
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rServiceID, rhkServiceID, _ := route.Params.GetOK("ServiceID")
 	if err := o.bindServiceID(rServiceID, rhkServiceID, route.Formats); err != nil {
 		res = append(res, err)
@@ -60,6 +69,37 @@ func (o *ServiceArchiveParams) BindRequest(r *http.Request, route *middleware.Ma
 	return nil
 }
 
+// bindIdempotencyKey binds and validates parameter Idempotency-Key from header.
+func (o *ServiceArchiveParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IdempotencyKey = &raw
+
+	if err := o.validateIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIdempotencyKey carries on validations for parameter Idempotency-Key
+func (o *ServiceArchiveParams) validateIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("Idempotency-Key", "header", *o.IdempotencyKey, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindServiceID binds and validates parameter ServiceID from path.
 func (o *ServiceArchiveParams) bindServiceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
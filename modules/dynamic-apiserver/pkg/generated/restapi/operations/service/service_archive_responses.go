@@ -173,6 +173,50 @@ func (o *ServiceArchiveNotFound) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// ServiceArchiveConflictCode is the HTTP code returned for type ServiceArchiveConflict
+const ServiceArchiveConflictCode int = 409
+
+/*ServiceArchiveConflict request with the same Idempotency-Key is in progress
+
+swagger:response serviceArchiveConflict
+*/
+type ServiceArchiveConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewServiceArchiveConflict creates ServiceArchiveConflict with default headers values
+func NewServiceArchiveConflict() *ServiceArchiveConflict {
+
+	return &ServiceArchiveConflict{}
+}
+
+// WithPayload adds the payload to the service archive conflict response
+func (o *ServiceArchiveConflict) WithPayload(payload *models.Error) *ServiceArchiveConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service archive conflict response
+func (o *ServiceArchiveConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ServiceArchiveConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ServiceArchiveUnprocessableEntityCode is the HTTP code returned for type ServiceArchiveUnprocessableEntity
 const ServiceArchiveUnprocessableEntityCode int = 422
 
@@ -35,6 +35,11 @@ type ServiceCredentialsUpdateParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*unique key of the request, a retry with the same key returns the first response instead of repeating the request. Keys are kept in the apiserver memory
+	  Max Length: 255
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*service credentials
 	  In: body
 	*/
@@ -58,6 +63,10 @@ func (o *ServiceCredentialsUpdateParams) BindRequest(r *http.Request, route *mid
 
 	o.HTTPRequest = r
 
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.ServiceCredentials
@@ -90,6 +99,37 @@ func (o *ServiceCredentialsUpdateParams) BindRequest(r *http.Request, route *mid
 	return nil
 }
 
+// bindIdempotencyKey binds and validates parameter Idempotency-Key from header.
+func (o *ServiceCredentialsUpdateParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IdempotencyKey = &raw
+
+	if err := o.validateIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIdempotencyKey carries on validations for parameter Idempotency-Key
+func (o *ServiceCredentialsUpdateParams) validateIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("Idempotency-Key", "header", *o.IdempotencyKey, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindServiceID binds and validates parameter ServiceID from path.
 func (o *ServiceCredentialsUpdateParams) bindServiceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -129,6 +129,50 @@ func (o *ServiceCredentialsUpdateForbidden) WriteResponse(rw http.ResponseWriter
 	rw.WriteHeader(403)
 }
 
+// ServiceCredentialsUpdateConflictCode is the HTTP code returned for type ServiceCredentialsUpdateConflict
+const ServiceCredentialsUpdateConflictCode int = 409
+
+/*ServiceCredentialsUpdateConflict request with the same Idempotency-Key is in progress
+
+swagger:response serviceCredentialsUpdateConflict
+*/
+type ServiceCredentialsUpdateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewServiceCredentialsUpdateConflict creates ServiceCredentialsUpdateConflict with default headers values
+func NewServiceCredentialsUpdateConflict() *ServiceCredentialsUpdateConflict {
+
+	return &ServiceCredentialsUpdateConflict{}
+}
+
+// WithPayload adds the payload to the service credentials update conflict response
+func (o *ServiceCredentialsUpdateConflict) WithPayload(payload *models.Error) *ServiceCredentialsUpdateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service credentials update conflict response
+func (o *ServiceCredentialsUpdateConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ServiceCredentialsUpdateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ServiceCredentialsUpdateUnprocessableEntityCode is the HTTP code returned for type ServiceCredentialsUpdateUnprocessableEntity
 const ServiceCredentialsUpdateUnprocessableEntityCode int = 422
 
@@ -35,7 +35,8 @@ func NewServiceEdit(ctx *middleware.Context, handler ServiceEditHandler) *Servic
 
 edit a service item
 
-Edit service object
+Edit service object.
+Pass the ETag of the service in the If-Match header to reject the edit if the service was changed since it was read.
 
 
 */
@@ -36,6 +36,10 @@ type ServiceEditParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*ETag of the service the request is based on
+	  In: header
+	*/
+	IfMatch *string
 	/*service Resource ID
 	  Required: true
 	  Max Length: 20
@@ -60,6 +64,10 @@ func (o *ServiceEditParams) BindRequest(r *http.Request, route *middleware.Match
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rServiceID, rhkServiceID, _ := route.Params.GetOK("ServiceID")
 	if err := o.bindServiceID(rServiceID, rhkServiceID, route.Formats); err != nil {
 		res = append(res, err)
@@ -98,6 +106,23 @@ func (o *ServiceEditParams) BindRequest(r *http.Request, route *middleware.Match
 	return nil
 }
 
+// bindIfMatch binds and validates parameter If-Match from header.
+func (o *ServiceEditParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindServiceID binds and validates parameter ServiceID from path.
 func (o *ServiceEditParams) bindServiceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
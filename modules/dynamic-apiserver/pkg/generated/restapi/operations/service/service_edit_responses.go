@@ -22,6 +22,11 @@ swagger:response serviceEditOK
 */
 type ServiceEditOK struct {
 
+	/*resource version of the service, pass it in the If-Match header to edit this version only
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -34,6 +39,17 @@ func NewServiceEditOK() *ServiceEditOK {
 	return &ServiceEditOK{}
 }
 
+// WithETag adds the eTag to the service edit o k response
+func (o *ServiceEditOK) WithETag(eTag string) *ServiceEditOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the service edit o k response
+func (o *ServiceEditOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the service edit o k response
 func (o *ServiceEditOK) WithPayload(payload *models.Service) *ServiceEditOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *ServiceEditOK) SetPayload(payload *models.Service) {
 // WriteResponse to the client
 func (o *ServiceEditOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -128,11 +151,16 @@ func (o *ServiceEditUnauthorized) WriteResponse(rw http.ResponseWriter, producer
 // ServiceEditForbiddenCode is the HTTP code returned for type ServiceEditForbidden
 const ServiceEditForbiddenCode int = 403
 
-/*ServiceEditForbidden bad permissions
+/*ServiceEditForbidden bad permissions or tenant quota exceeded
 
 swagger:response serviceEditForbidden
 */
 type ServiceEditForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
 }
 
 // NewServiceEditForbidden creates ServiceEditForbidden with default headers values
@@ -141,12 +169,27 @@ func NewServiceEditForbidden() *ServiceEditForbidden {
 	return &ServiceEditForbidden{}
 }
 
+// WithPayload adds the payload to the service edit forbidden response
+func (o *ServiceEditForbidden) WithPayload(payload *models.Error) *ServiceEditForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service edit forbidden response
+func (o *ServiceEditForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *ServiceEditForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
-
 	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // ServiceEditNotFoundCode is the HTTP code returned for type ServiceEditNotFound
@@ -193,6 +236,94 @@ func (o *ServiceEditNotFound) WriteResponse(rw http.ResponseWriter, producer run
 	}
 }
 
+// ServiceEditConflictCode is the HTTP code returned for type ServiceEditConflict
+const ServiceEditConflictCode int = 409
+
+/*ServiceEditConflict service was changed by a concurrent request, read it again and retry
+
+swagger:response serviceEditConflict
+*/
+type ServiceEditConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewServiceEditConflict creates ServiceEditConflict with default headers values
+func NewServiceEditConflict() *ServiceEditConflict {
+
+	return &ServiceEditConflict{}
+}
+
+// WithPayload adds the payload to the service edit conflict response
+func (o *ServiceEditConflict) WithPayload(payload *models.Error) *ServiceEditConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service edit conflict response
+func (o *ServiceEditConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ServiceEditConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ServiceEditPreconditionFailedCode is the HTTP code returned for type ServiceEditPreconditionFailed
+const ServiceEditPreconditionFailedCode int = 412
+
+/*ServiceEditPreconditionFailed service does not match the If-Match header
+
+swagger:response serviceEditPreconditionFailed
+*/
+type ServiceEditPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewServiceEditPreconditionFailed creates ServiceEditPreconditionFailed with default headers values
+func NewServiceEditPreconditionFailed() *ServiceEditPreconditionFailed {
+
+	return &ServiceEditPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the service edit precondition failed response
+func (o *ServiceEditPreconditionFailed) WithPayload(payload *models.Error) *ServiceEditPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service edit precondition failed response
+func (o *ServiceEditPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ServiceEditPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ServiceEditUnprocessableEntityCode is the HTTP code returned for type ServiceEditUnprocessableEntity
 const ServiceEditUnprocessableEntityCode int = 422
 
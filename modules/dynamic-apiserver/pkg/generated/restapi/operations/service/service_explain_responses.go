@@ -149,6 +149,50 @@ func (o *ServiceExplainForbidden) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(403)
 }
 
+// ServiceExplainNotFoundCode is the HTTP code returned for type ServiceExplainNotFound
+const ServiceExplainNotFoundCode int = 404
+
+/*ServiceExplainNotFound item not found
+
+swagger:response serviceExplainNotFound
+*/
+type ServiceExplainNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewServiceExplainNotFound creates ServiceExplainNotFound with default headers values
+func NewServiceExplainNotFound() *ServiceExplainNotFound {
+
+	return &ServiceExplainNotFound{}
+}
+
+// WithPayload adds the payload to the service explain not found response
+func (o *ServiceExplainNotFound) WithPayload(payload *models.Error) *ServiceExplainNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service explain not found response
+func (o *ServiceExplainNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ServiceExplainNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ServiceExplainUnprocessableEntityCode is the HTTP code returned for type ServiceExplainUnprocessableEntity
 const ServiceExplainUnprocessableEntityCode int = 422
 
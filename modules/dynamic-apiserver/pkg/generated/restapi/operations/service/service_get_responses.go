@@ -22,6 +22,11 @@ swagger:response serviceGetOK
 */
 type ServiceGetOK struct {
 
+	/*resource version of the service, pass it in the If-Match header to edit this version only
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -34,6 +39,17 @@ func NewServiceGetOK() *ServiceGetOK {
 	return &ServiceGetOK{}
 }
 
+// WithETag adds the eTag to the service get o k response
+func (o *ServiceGetOK) WithETag(eTag string) *ServiceGetOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the service get o k response
+func (o *ServiceGetOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the service get o k response
 func (o *ServiceGetOK) WithPayload(payload *models.Service) *ServiceGetOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *ServiceGetOK) SetPayload(payload *models.Service) {
 // WriteResponse to the client
 func (o *ServiceGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
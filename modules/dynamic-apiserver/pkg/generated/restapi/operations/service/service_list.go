@@ -35,7 +35,8 @@ func NewServiceList(ctx *middleware.Context, handler ServiceListHandler) *Servic
 
 lists all services
 
-List of service objects
+List of service objects.
+Pass the X-Continue header value of the response as the continue parameter to get the next page.
 
 
 */
@@ -22,6 +22,11 @@ swagger:response serviceListOK
 */
 type ServiceListOK struct {
 
+	/*token of the next page, it is empty on the last page
+
+	 */
+	XContinue string `json:"X-Continue"`
+
 	/*
 	  In: Body
 	*/
@@ -34,6 +39,17 @@ func NewServiceListOK() *ServiceListOK {
 	return &ServiceListOK{}
 }
 
+// WithXContinue adds the xContinue to the service list o k response
+func (o *ServiceListOK) WithXContinue(xContinue string) *ServiceListOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the service list o k response
+func (o *ServiceListOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
 // WithPayload adds the payload to the service list o k response
 func (o *ServiceListOK) WithPayload(payload models.Services) *ServiceListOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *ServiceListOK) SetPayload(payload models.Services) {
 // WriteResponse to the client
 func (o *ServiceListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
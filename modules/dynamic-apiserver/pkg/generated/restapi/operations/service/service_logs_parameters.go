@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -32,10 +33,18 @@ type ServiceLogsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*service pod container name to query logs by
+	/*service pod container name
 	  In: query
 	*/
 	ContainerName *string
+	/*service pod name
+	  In: query
+	*/
+	PodName *string
+	/*return logs of the previous terminated container instance
+	  In: query
+	*/
+	Previous *bool
 	/*service Resource ID
 	  Required: true
 	  Max Length: 20
@@ -44,6 +53,16 @@ type ServiceLogsParams struct {
 	  In: path
 	*/
 	ServiceID string
+	/*show logs newer than a relative duration in seconds
+	  Minimum: 1
+	  In: query
+	*/
+	SinceSeconds *int64
+	/*number of lines from the end of the logs to show
+	  Minimum: 0
+	  In: query
+	*/
+	TailLines *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -62,10 +81,30 @@ func (o *ServiceLogsParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qPodName, qhkPodName, _ := qs.GetOK("PodName")
+	if err := o.bindPodName(qPodName, qhkPodName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPrevious, qhkPrevious, _ := qs.GetOK("Previous")
+	if err := o.bindPrevious(qPrevious, qhkPrevious, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rServiceID, rhkServiceID, _ := route.Params.GetOK("ServiceID")
 	if err := o.bindServiceID(rServiceID, rhkServiceID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSinceSeconds, qhkSinceSeconds, _ := qs.GetOK("SinceSeconds")
+	if err := o.bindSinceSeconds(qSinceSeconds, qhkSinceSeconds, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTailLines, qhkTailLines, _ := qs.GetOK("TailLines")
+	if err := o.bindTailLines(qTailLines, qhkTailLines, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -90,6 +129,46 @@ func (o *ServiceLogsParams) bindContainerName(rawData []string, hasKey bool, for
 	return nil
 }
 
+// bindPodName binds and validates parameter PodName from query.
+func (o *ServiceLogsParams) bindPodName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PodName = &raw
+
+	return nil
+}
+
+// bindPrevious binds and validates parameter Previous from query.
+func (o *ServiceLogsParams) bindPrevious(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("Previous", "query", "bool", raw)
+	}
+	o.Previous = &value
+
+	return nil
+}
+
 // bindServiceID binds and validates parameter ServiceID from path.
 func (o *ServiceLogsParams) bindServiceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -125,3 +204,75 @@ func (o *ServiceLogsParams) validateServiceID(formats strfmt.Registry) error {
 
 	return nil
 }
+
+// bindSinceSeconds binds and validates parameter SinceSeconds from query.
+func (o *ServiceLogsParams) bindSinceSeconds(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("SinceSeconds", "query", "int64", raw)
+	}
+	o.SinceSeconds = &value
+
+	if err := o.validateSinceSeconds(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSinceSeconds carries on validations for parameter SinceSeconds
+func (o *ServiceLogsParams) validateSinceSeconds(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("SinceSeconds", "query", *o.SinceSeconds, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindTailLines binds and validates parameter TailLines from query.
+func (o *ServiceLogsParams) bindTailLines(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("TailLines", "query", "int64", raw)
+	}
+	o.TailLines = &value
+
+	if err := o.validateTailLines(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTailLines carries on validations for parameter TailLines
+func (o *ServiceLogsParams) validateTailLines(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("TailLines", "query", *o.TailLines, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -33,9 +33,9 @@ func NewServiceSecretsList(ctx *middleware.Context, handler ServiceSecretsListHa
 
 /* ServiceSecretsList swagger:route GET /services/{ServiceID}/secrets service serviceSecretsList
 
-retrieves service secrets
+lists service secrets
 
-retrieves service secrets
+Lists secrets declared by the service plugin. Secret values are not returned, use serviceSecretGet to reveal a secret
 
 */
 type ServiceSecretsList struct {
@@ -173,6 +173,50 @@ func (o *ServiceUnarchiveNotFound) WriteResponse(rw http.ResponseWriter, produce
 	}
 }
 
+// ServiceUnarchiveConflictCode is the HTTP code returned for type ServiceUnarchiveConflict
+const ServiceUnarchiveConflictCode int = 409
+
+/*ServiceUnarchiveConflict request with the same Idempotency-Key is in progress
+
+swagger:response serviceUnarchiveConflict
+*/
+type ServiceUnarchiveConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewServiceUnarchiveConflict creates ServiceUnarchiveConflict with default headers values
+func NewServiceUnarchiveConflict() *ServiceUnarchiveConflict {
+
+	return &ServiceUnarchiveConflict{}
+}
+
+// WithPayload adds the payload to the service unarchive conflict response
+func (o *ServiceUnarchiveConflict) WithPayload(payload *models.Error) *ServiceUnarchiveConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the service unarchive conflict response
+func (o *ServiceUnarchiveConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ServiceUnarchiveConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ServiceUnarchiveUnprocessableEntityCode is the HTTP code returned for type ServiceUnarchiveUnprocessableEntity
 const ServiceUnarchiveUnprocessableEntityCode int = 422
 
@@ -0,0 +1,225 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package idempotency replays responses of POST requests retried with the same Idempotency-Key
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+const (
+	// KeyHeader is the request header with the idempotency key
+	KeyHeader = "Idempotency-Key"
+	// ReplayedHeader is set on responses replayed from the store
+	ReplayedHeader = "Idempotent-Replayed"
+
+	// maxKeyLength is the longest accepted key, longer keys are rejected by the operation validation
+	maxKeyLength = 255
+)
+
+// entry is a request seen with a key, its response is set when the request is completed
+type entry struct {
+	fingerprint string
+	expires     time.Time
+	done        bool
+
+	status int
+	header http.Header
+	body   []byte
+}
+
+// Authenticator authenticates the request before its response is replayed or stored and returns the principal keys are scoped by.
+// False is returned when the request is rejected, the error response is written then.
+type Authenticator func(rw http.ResponseWriter, r *http.Request) (string, bool)
+
+// Store keeps responses of requests sent with idempotency keys in memory
+type Store struct {
+	ttl     time.Duration
+	maxKeys int
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	// keys are ordered by the first request, the oldest are evicted first
+	keys []string
+}
+
+// New returns a store keeping responses for ttl, the oldest keys are dropped when there are more than maxKeys
+func New(ttl time.Duration, maxKeys int) *Store {
+	return &Store{
+		ttl:     ttl,
+		maxKeys: maxKeys,
+		now:     time.Now,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Middleware replays the stored response of a POST request retried with the same key.
+// A request with a key that is in progress gets 409, a different request with a used key gets 422.
+// Requests are authenticated before anything is replayed and keys are scoped by the principal,
+// so clients can't replay responses of each other.
+func (s *Store) Middleware(authenticate Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(KeyHeader)
+		if r.Method != http.MethodPost || key == "" || len(key) > maxKeyLength {
+			next.ServeHTTP(rw, r)
+			return
+		}
+		principal, ok := authenticate(rw, r)
+		if !ok {
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(rw, http.StatusBadRequest, "error reading request body")
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		scoped := scopedKey(principal, key)
+		previous, current := s.begin(scoped, fingerprint(r, body))
+		if previous != nil {
+			switch {
+			case previous.fingerprint != current.fingerprint:
+				writeError(rw, http.StatusUnprocessableEntity, "Idempotency-Key is already used for a different request")
+			case !previous.done:
+				writeError(rw, http.StatusConflict, "request with the same Idempotency-Key is in progress")
+			default:
+				replay(rw, previous)
+			}
+			return
+		}
+
+		ww := middleware.NewWrapResponseWriter(rw, r.ProtoMajor)
+		buf := &bytes.Buffer{}
+		ww.Tee(buf)
+		defer func() {
+			// the status is not set if the handler panics, the key is released then
+			s.finish(scoped, current, ww.Status(), rw.Header(), buf.Bytes())
+		}()
+		next.ServeHTTP(ww, r)
+	})
+}
+
+// begin returns a copy of the entry stored for the key or stores a new entry for the request
+func (s *Store) begin(key, fingerprint string) (*entry, *entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.evict(now)
+	if e, ok := s.entries[key]; ok {
+		previous := *e
+		return &previous, &entry{fingerprint: fingerprint}
+	}
+	e := &entry{fingerprint: fingerprint, expires: now.Add(s.ttl)}
+	s.entries[key] = e
+	s.keys = append(s.keys, key)
+	return nil, e
+}
+
+// finish stores the response of the request, responses that may change on retry are not stored and the key is released
+func (s *Store) finish(key string, e *entry, status int, header http.Header, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries[key] != e {
+		// evicted while in progress
+		return
+	}
+	if !storable(status) {
+		delete(s.entries, key)
+		return
+	}
+	e.done = true
+	e.status = status
+	e.header = header.Clone()
+	e.body = append([]byte(nil), body...)
+}
+
+// evict drops expired keys and the oldest keys above the limit
+func (s *Store) evict(now time.Time) {
+	drop := 0
+	for _, key := range s.keys {
+		e, ok := s.entries[key]
+		if ok && now.Before(e.expires) && len(s.entries) < s.maxKeys {
+			break
+		}
+		delete(s.entries, key)
+		drop++
+	}
+	s.keys = s.keys[drop:]
+}
+
+// storable checks if the response is final, failures that may succeed on retry are not replayed
+func storable(status int) bool {
+	switch {
+	case status == 0:
+		return false
+	case status >= http.StatusInternalServerError:
+		return false
+	case status == http.StatusUnauthorized, status == http.StatusForbidden, status == http.StatusTooManyRequests:
+		return false
+	}
+	return true
+}
+
+func replay(rw http.ResponseWriter, e *entry) {
+	for name, values := range e.header {
+		rw.Header()[name] = values
+	}
+	rw.Header().Set(ReplayedHeader, "true")
+	rw.WriteHeader(e.status)
+	_, _ = rw.Write(e.body)
+}
+
+// scopedKey binds the key to the authenticated principal
+func scopedKey(principal, key string) string {
+	sum := sha256.New()
+	for _, v := range []string{principal, key} {
+		sum.Write([]byte(v))
+		sum.Write([]byte{0})
+	}
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// fingerprint identifies the request the key is used for
+func fingerprint(r *http.Request, body []byte) string {
+	sum := sha256.New()
+	sum.Write([]byte(r.Method + " " + r.URL.Path))
+	sum.Write([]byte{0})
+	sum.Write(body)
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+func writeError(rw http.ResponseWriter, status int, msg string) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(&models.Error{Message: msg})
+}
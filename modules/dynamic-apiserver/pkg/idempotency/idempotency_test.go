@@ -0,0 +1,163 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+)
+
+// counter answers with the queued status and the number of calls
+type counter struct {
+	calls    int32
+	status   int
+	release  chan struct{}
+	received chan struct{}
+}
+
+func (c *counter) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt32(&c.calls, 1)
+	if c.release != nil {
+		c.received <- struct{}{}
+		<-c.release
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	rw.Header().Set("X-Call", strconv.Itoa(int(n)))
+	rw.WriteHeader(c.status)
+	_, _ = rw.Write(body)
+}
+
+// testAuthenticator authenticates requests with any token but "invalid", the token is the principal
+func testAuthenticator(rw http.ResponseWriter, r *http.Request) (string, bool) {
+	token := r.Header.Get("X-Token")
+	if token == "invalid" {
+		writeError(rw, http.StatusUnauthorized, "invalid credentials")
+		return "", false
+	}
+	return token, true
+}
+
+func post(h http.Handler, token, key, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/services/", strings.NewReader(body))
+	r.Header.Set("X-Token", token)
+	if key != "" {
+		r.Header.Set(KeyHeader, key)
+	}
+	rw := httptest.NewRecorder()
+	h.ServeHTTP(rw, r)
+	return rw
+}
+
+func errorMessage(t *testing.T, rw *httptest.ResponseRecorder) string {
+	e := &models.Error{}
+	if err := json.Unmarshal(rw.Body.Bytes(), e); err != nil {
+		t.Fatal(err)
+	}
+	return e.Message
+}
+
+func TestReplay(t *testing.T) {
+	c := &counter{status: http.StatusCreated}
+	h := New(time.Hour, 10).Middleware(testAuthenticator, c)
+
+	first := post(h, "token", "key", `{"id":"demo"}`)
+	second := post(h, "token", "key", `{"id":"demo"}`)
+	if c.calls != 1 {
+		t.Fatalf("expected 1 call, got %d", c.calls)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() || second.Header().Get("X-Call") != "1" {
+		t.Errorf("unexpected replayed response: %d %s %v", second.Code, second.Body.String(), second.Header())
+	}
+	if second.Header().Get(ReplayedHeader) != "true" || first.Header().Get(ReplayedHeader) != "" {
+		t.Errorf("only the second response is expected to be replayed")
+	}
+
+	// responses are not replayed to requests that fail authentication
+	if rw := post(h, "invalid", "key", `{"id":"demo"}`); rw.Code != http.StatusUnauthorized || rw.Header().Get(ReplayedHeader) != "" {
+		t.Errorf("expected 401 without a replay, got %d", rw.Code)
+	}
+
+	// keys are scoped by principals and requests without keys are not stored
+	post(h, "other", "key", `{"id":"demo"}`)
+	post(h, "token", "", `{"id":"demo"}`)
+	post(h, "token", "", `{"id":"demo"}`)
+	if c.calls != 4 {
+		t.Errorf("expected 4 calls, got %d", c.calls)
+	}
+}
+
+func TestDifferentRequest(t *testing.T) {
+	c := &counter{status: http.StatusCreated}
+	h := New(time.Hour, 10).Middleware(testAuthenticator, c)
+
+	post(h, "token", "key", `{"id":"demo"}`)
+	rw := post(h, "token", "key", `{"id":"other"}`)
+	if rw.Code != http.StatusUnprocessableEntity || c.calls != 1 {
+		t.Errorf("expected 422 without a call, got %d with %d calls", rw.Code, c.calls)
+	}
+	if msg := errorMessage(t, rw); msg != "Idempotency-Key is already used for a different request" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+}
+
+func TestInProgress(t *testing.T) {
+	c := &counter{status: http.StatusOK, release: make(chan struct{}), received: make(chan struct{}, 1)}
+	h := New(time.Hour, 10).Middleware(testAuthenticator, c)
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		done <- post(h, "token", "key", `{}`)
+	}()
+	<-c.received
+
+	rw := post(h, "token", "key", `{}`)
+	if rw.Code != http.StatusConflict {
+		t.Errorf("expected 409, got %d", rw.Code)
+	}
+	close(c.release)
+	if first := <-done; first.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", first.Code)
+	}
+}
+
+func TestNotStored(t *testing.T) {
+	for _, status := range []int{http.StatusServiceUnavailable, http.StatusForbidden, http.StatusTooManyRequests} {
+		c := &counter{status: status}
+		h := New(time.Hour, 10).Middleware(testAuthenticator, c)
+		post(h, "token", "key", `{}`)
+		post(h, "token", "key", `{}`)
+		if c.calls != 2 {
+			t.Errorf("%d: expected a retry to be executed, got %d calls", status, c.calls)
+		}
+	}
+}
+
+func TestEviction(t *testing.T) {
+	now := time.Now()
+	c := &counter{status: http.StatusCreated}
+	s := New(time.Minute, 2)
+	s.now = func() time.Time { return now }
+	h := s.Middleware(testAuthenticator, c)
+
+	post(h, "token", "first", `{}`)
+	post(h, "token", "second", `{}`)
+	post(h, "token", "third", `{}`)
+	// the oldest key is dropped above the limit
+	post(h, "token", "first", `{}`)
+	if c.calls != 4 {
+		t.Errorf("expected 4 calls, got %d", c.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	post(h, "token", "first", `{}`)
+	if c.calls != 5 {
+		t.Errorf("expected an expired key to be executed again, got %d calls", c.calls)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notify
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrForbiddenAddress is returned for webhook urls of the cluster network or of the apiserver host
+var ErrForbiddenAddress = errors.New("webhook address is not allowed")
+
+// forbiddenNetworks are loopback, link-local, private and shared networks, cluster pods and services use them
+var forbiddenNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// CheckAddress returns ErrForbiddenAddress if webhooks can't be delivered to the ip
+func CheckAddress(ip net.IP) error {
+	if ip == nil || ip.IsMulticast() || ip.IsLinkLocalMulticast() {
+		return errors.Wrapf(ErrForbiddenAddress, "%s", ip)
+	}
+	for _, network := range forbiddenNetworks {
+		if network.Contains(ip) {
+			return errors.Wrapf(ErrForbiddenAddress, "%s", ip)
+		}
+	}
+	return nil
+}
+
+// allowedHost returns true if the host is in the allowed list, checks of its addresses are skipped then
+func allowedHost(host string, allowed []string) bool {
+	for _, h := range allowed {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkURL resolves the host of the webhook url and returns ErrForbiddenAddress if any of its addresses is not allowed.
+// Hosts of the allowed list are not checked.
+// Hosts are resolved again when events are delivered, so the check is repeated by the dispatcher client.
+func checkURL(ctx context.Context, rawURL string, allowed []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if allowedHost(host, allowed) {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return CheckAddress(ip)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		// addresses of hosts that can't be resolved yet are checked when events are delivered
+		return nil
+	}
+	for _, addr := range addrs {
+		if err := CheckAddress(addr.IP); err != nil {
+			return errors.Wrapf(err, "%s resolves to", host)
+		}
+	}
+	return nil
+}
+
+// newClient returns a client delivering webhooks with the timeout.
+// Addresses are checked when connections are dialed, so hosts can't be resolved to other addresses after they are registered.
+// Proxies are not used, they would dial the checked addresses instead.
+func newClient(timeout time.Duration, allowed []string) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	guarded := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			return CheckAddress(net.ParseIP(host))
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		if allowedHost(host, allowed) {
+			return dialer.DialContext(ctx, network, addr)
+		}
+		return guarded.DialContext(ctx, network, addr)
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
@@ -0,0 +1,368 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package notify delivers signed notifications of service lifecycle events to registered webhooks
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+)
+
+// Event types
+const (
+	EventPing             = "ping"
+	EventServiceCreated   = "service.created"
+	EventServiceReady     = "service.ready"
+	EventServiceFailed    = "service.failed"
+	EventServicePaused    = "service.paused"
+	EventServiceArchived  = "service.archived"
+	EventBackupCompleted  = "backup.completed"
+	EventBackupFailed     = "backup.failed"
+	EventRestoreCompleted = "restore.completed"
+	EventRestoreFailed    = "restore.failed"
+)
+
+// EventTypes lists event types webhooks can subscribe to
+var EventTypes = []string{
+	EventServiceCreated,
+	EventServiceReady,
+	EventServiceFailed,
+	EventServicePaused,
+	EventServiceArchived,
+	EventBackupCompleted,
+	EventBackupFailed,
+	EventRestoreCompleted,
+	EventRestoreFailed,
+}
+
+// ValidSubscription checks that the subscription matches at least one event type
+func ValidSubscription(subscription string) bool {
+	for _, t := range EventTypes {
+		if subscriptionMatches(subscription, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// subscriptionMatches checks the event type against the subscription, "service.*" matches all service events
+func subscriptionMatches(subscription, eventType string) bool {
+	if strings.HasSuffix(subscription, ".*") {
+		return strings.HasPrefix(eventType, strings.TrimSuffix(subscription, "*"))
+	}
+	return subscription == eventType
+}
+
+// NewEvent returns an event of the type with a unique id
+func NewEvent(eventType string) *models.WebhookEvent {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return &models.WebhookEvent{
+		ID:   hex.EncodeToString(b),
+		Type: eventType,
+		Time: strfmt.DateTime(time.Now().UTC()),
+	}
+}
+
+// Endpoint is a registered webhook
+type Endpoint struct {
+	Name   string
+	URL    string
+	Secret string
+	// Events are subscribed event types, all events are delivered if empty
+	Events []string
+	// Tenant limits events to services of the tenant if set
+	Tenant string
+}
+
+// Subscribed checks if the event has to be delivered to the endpoint, ping events are always delivered
+func (e *Endpoint) Subscribed(event *models.WebhookEvent) bool {
+	if event.Type == EventPing {
+		return true
+	}
+	if e.Tenant != "" && e.Tenant != event.Tenant {
+		return false
+	}
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, s := range e.Events {
+		if subscriptionMatches(s, event.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// EndpointLister returns registered endpoints, it is called for every published event
+type EndpointLister func(ctx context.Context) ([]Endpoint, error)
+
+// Options configure deliveries
+type Options struct {
+	// Attempts is the number of delivery attempts before the event is moved to dead letters
+	Attempts int
+	// Backoff is the delay before the second attempt, it doubles with each next attempt
+	Backoff time.Duration
+	// Timeout limits a single attempt when the default http client is used,
+	// the default client doesn't connect to cluster, private and loopback addresses unless their hosts are allowed
+	Timeout time.Duration
+	// QueueSize is the number of events waiting for delivery, new events are dropped when the queue is full
+	QueueSize int
+	// DeadLetters is the number of last undelivered events kept in memory
+	DeadLetters int
+	// Concurrency is the maximum number of deliveries in progress
+	Concurrency int
+	// AllowedHosts are webhook hosts that may resolve to cluster, private or loopback addresses
+	AllowedHosts []string
+}
+
+// DefaultOptions are used for zero option values
+var DefaultOptions = Options{
+	Attempts:    5,
+	Backoff:     2 * time.Second,
+	Timeout:     10 * time.Second,
+	QueueSize:   1000,
+	DeadLetters: 1000,
+	Concurrency: 10,
+}
+
+func (o Options) withDefaults() Options {
+	if o.Attempts <= 0 {
+		o.Attempts = DefaultOptions.Attempts
+	}
+	if o.Backoff <= 0 {
+		o.Backoff = DefaultOptions.Backoff
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultOptions.Timeout
+	}
+	if o.QueueSize <= 0 {
+		o.QueueSize = DefaultOptions.QueueSize
+	}
+	if o.DeadLetters <= 0 {
+		o.DeadLetters = DefaultOptions.DeadLetters
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultOptions.Concurrency
+	}
+	return o
+}
+
+// Dispatcher delivers published events to subscribed endpoints in the background
+type Dispatcher struct {
+	endpoints EndpointLister
+	client    *http.Client
+	log       logging.Logger
+	opts      Options
+
+	queue chan *models.WebhookEvent
+	// slots bounds concurrent deliveries
+	slots chan struct{}
+
+	mu          sync.Mutex
+	deadLetters []deadLetter
+}
+
+// deadLetter is an undelivered event with the tenant of its endpoint
+type deadLetter struct {
+	tenant   string
+	delivery *models.WebhookDelivery
+}
+
+// New returns a dispatcher delivering events to endpoints returned by the lister, Run starts deliveries
+func New(endpoints EndpointLister, client *http.Client, log logging.Logger, opts Options) *Dispatcher {
+	opts = opts.withDefaults()
+	if client == nil {
+		client = newClient(opts.Timeout, opts.AllowedHosts)
+	}
+	return &Dispatcher{
+		endpoints: endpoints,
+		client:    client,
+		log:       log,
+		opts:      opts,
+		queue:     make(chan *models.WebhookEvent, opts.QueueSize),
+		slots:     make(chan struct{}, opts.Concurrency),
+	}
+}
+
+// CheckURL returns ErrForbiddenAddress if the webhook url resolves to addresses events can't be delivered to
+func (d *Dispatcher) CheckURL(ctx context.Context, rawURL string) error {
+	return checkURL(ctx, rawURL, d.opts.AllowedHosts)
+}
+
+// Publish queues the event for delivery without waiting for it
+func (d *Dispatcher) Publish(event *models.WebhookEvent) error {
+	select {
+	case d.queue <- event:
+		return nil
+	default:
+		return errors.Errorf("notification queue is full, event %s is dropped", event.Type)
+	}
+}
+
+// Run delivers queued events until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.queue:
+			endpoints, err := d.endpoints(ctx)
+			if err != nil {
+				d.log.Errorw("error listing webhooks, event is dropped", "error", err, "event", event.Type, "id", event.ID)
+				continue
+			}
+			for _, e := range endpoints {
+				if !e.Subscribed(event) {
+					continue
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case d.slots <- struct{}{}:
+				}
+				wg.Add(1)
+				go func(e Endpoint) {
+					defer func() {
+						<-d.slots
+						wg.Done()
+					}()
+					d.deliverWithRetries(ctx, e, event)
+				}(e)
+			}
+		}
+	}
+}
+
+// deliverWithRetries retries failed attempts with an exponential backoff and records a dead letter if all attempts fail
+func (d *Dispatcher) deliverWithRetries(ctx context.Context, e Endpoint, event *models.WebhookEvent) {
+	delivery := &models.WebhookDelivery{Webhook: e.Name, Event: event}
+	backoff := d.opts.Backoff
+	for {
+		retry := d.attempt(ctx, e, event, delivery)
+		if delivery.Error == "" {
+			return
+		}
+		if !retry || delivery.Attempts >= int64(d.opts.Attempts) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	d.log.Errorw("webhook delivery failed", "webhook", e.Name, "event", event.Type, "id", event.ID,
+		"attempts", delivery.Attempts, "error", delivery.Error)
+	d.addDeadLetter(e, delivery)
+}
+
+// Deliver makes a single delivery attempt and returns its result
+func (d *Dispatcher) Deliver(ctx context.Context, e Endpoint, event *models.WebhookEvent) *models.WebhookDelivery {
+	delivery := &models.WebhookDelivery{Webhook: e.Name, Event: event}
+	d.attempt(ctx, e, event, delivery)
+	return delivery
+}
+
+// attempt posts the event and updates the delivery, it returns true if a failed attempt can be retried
+func (d *Dispatcher) attempt(ctx context.Context, e Endpoint, event *models.WebhookEvent, delivery *models.WebhookDelivery) bool {
+	delivery.Attempts++
+	delivery.Time = strfmt.DateTime(time.Now().UTC())
+	delivery.Status, delivery.Error = 0, ""
+
+	status, err := d.post(ctx, e, event)
+	delivery.Status = int64(status)
+	if err != nil {
+		delivery.Error = err.Error()
+		return true
+	}
+	if status >= http.StatusOK && status < http.StatusMultipleChoices {
+		return false
+	}
+	delivery.Error = "unexpected status " + strconv.Itoa(status)
+	// the receiver rejected the event, it is not going to change its mind
+	return status >= http.StatusInternalServerError || status == http.StatusRequestTimeout || status == http.StatusTooManyRequests
+}
+
+func (d *Dispatcher) post(ctx context.Context, e Endpoint, event *models.WebhookEvent) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event.Type)
+	req.Header.Set(DeliveryHeader, event.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(e.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) addDeadLetter(e Endpoint, delivery *models.WebhookDelivery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deadLetters = append(d.deadLetters, deadLetter{tenant: e.Tenant, delivery: delivery})
+	if len(d.deadLetters) > d.opts.DeadLetters {
+		d.deadLetters = d.deadLetters[len(d.deadLetters)-d.opts.DeadLetters:]
+	}
+}
+
+// DeadLetters returns undelivered events newest first.
+// Empty webhook and tenant match events of all webhooks, otherwise events of the webhook or of webhooks of the tenant are returned.
+func (d *Dispatcher) DeadLetters(webhook, tenant string) []*models.WebhookDelivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := make([]*models.WebhookDelivery, 0)
+	for i := len(d.deadLetters) - 1; i >= 0; i-- {
+		l := d.deadLetters[i]
+		if (webhook == "" || l.delivery.Webhook == webhook) && (tenant == "" || l.tenant == tenant) {
+			result = append(result, l.delivery)
+		}
+	}
+	return result
+}
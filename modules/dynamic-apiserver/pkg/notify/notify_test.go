@@ -0,0 +1,303 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/generated/models"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/logging"
+)
+
+// receiver is a local webhook endpoint answering with queued statuses and recording verified events
+type receiver struct {
+	t      *testing.T
+	secret string
+
+	mu       sync.Mutex
+	statuses []int
+	requests int
+	events   []*models.WebhookEvent
+	received chan struct{}
+}
+
+func newReceiver(t *testing.T, secret string, statuses ...int) (*receiver, *httptest.Server) {
+	r := &receiver{t: t, secret: secret, statuses: statuses, received: make(chan struct{}, 100)}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return r, srv
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		r.t.Error(err)
+	}
+	if err := Verify(r.secret, req.Header, body, time.Minute); err != nil {
+		r.t.Errorf("invalid signature: %s", err)
+	}
+	event := &models.WebhookEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		r.t.Error(err)
+	}
+	if req.Header.Get(EventHeader) != event.Type || req.Header.Get(DeliveryHeader) != event.ID {
+		r.t.Errorf("event headers do not match event %s/%s", event.Type, event.ID)
+	}
+
+	r.mu.Lock()
+	r.requests++
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	if status < http.StatusMultipleChoices {
+		r.events = append(r.events, event)
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(status)
+	r.received <- struct{}{}
+}
+
+func (r *receiver) wait(requests int) {
+	for i := 0; i < requests; i++ {
+		select {
+		case <-r.received:
+		case <-time.After(5 * time.Second):
+			r.t.Fatalf("%d of %d requests are received", i, requests)
+		}
+	}
+}
+
+func newTestDispatcher(t *testing.T, endpoints ...Endpoint) *Dispatcher {
+	d := New(func(context.Context) ([]Endpoint, error) {
+		return endpoints, nil
+	}, nil, logging.WithComponentLogger("notify"), Options{Attempts: 3, Backoff: time.Millisecond, AllowedHosts: []string{"127.0.0.1"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go d.Run(ctx)
+	return d
+}
+
+func TestDispatcherDelivers(t *testing.T) {
+	r, srv := newReceiver(t, "secret")
+	d := newTestDispatcher(t, Endpoint{Name: "all", URL: srv.URL, Secret: "secret"})
+
+	event := NewEvent(EventServiceReady)
+	event.Service = "demo"
+	if err := d.Publish(event); err != nil {
+		t.Fatal(err)
+	}
+	r.wait(1)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.events) != 1 || r.events[0].ID != event.ID || r.events[0].Service != "demo" {
+		t.Errorf("unexpected events: %v", r.events)
+	}
+}
+
+func TestDispatcherRetries(t *testing.T) {
+	r, srv := newReceiver(t, "secret", http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	d := newTestDispatcher(t, Endpoint{Name: "flaky", URL: srv.URL, Secret: "secret"})
+
+	if err := d.Publish(NewEvent(EventBackupCompleted)); err != nil {
+		t.Fatal(err)
+	}
+	r.wait(3)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.requests != 3 || len(r.events) != 1 {
+		t.Errorf("expected 3 requests and 1 delivered event, got %d requests and %d events", r.requests, len(r.events))
+	}
+	if letters := d.DeadLetters("", ""); len(letters) != 0 {
+		t.Errorf("unexpected dead letters: %v", letters)
+	}
+}
+
+func TestDispatcherDeadLetters(t *testing.T) {
+	down, downSrv := newReceiver(t, "secret", 500, 500, 500)
+	rejecting, rejectingSrv := newReceiver(t, "other", http.StatusGone)
+	d := newTestDispatcher(t,
+		Endpoint{Name: "down", URL: downSrv.URL, Secret: "secret"},
+		Endpoint{Name: "rejecting", URL: rejectingSrv.URL, Secret: "other", Tenant: "acme"},
+	)
+
+	event := NewEvent(EventServiceFailed)
+	event.Tenant = "acme"
+	if err := d.Publish(event); err != nil {
+		t.Fatal(err)
+	}
+	down.wait(3)
+	rejecting.wait(1)
+
+	// dead letters are recorded after the last response is read
+	var letters []*models.WebhookDelivery
+	for i := 0; i < 100 && len(letters) < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+		letters = d.DeadLetters("", "")
+	}
+	if len(letters) != 2 {
+		t.Fatalf("expected 2 dead letters, got %d", len(letters))
+	}
+
+	byWebhook := map[string]*models.WebhookDelivery{}
+	for _, l := range letters {
+		byWebhook[l.Webhook] = l
+	}
+	for name, expected := range map[string]struct{ attempts, status int64 }{
+		"down":      {3, 500},
+		"rejecting": {1, http.StatusGone},
+	} {
+		l := byWebhook[name]
+		if l == nil || l.Attempts != expected.attempts || l.Status != expected.status || l.Event.ID != event.ID {
+			t.Errorf("unexpected dead letter of %s: %+v", name, l)
+		}
+		if l != nil && l.Error != "unexpected status "+strconv.Itoa(int(expected.status)) {
+			t.Errorf("unexpected dead letter error of %s: %s", name, l.Error)
+		}
+	}
+
+	if letters := d.DeadLetters("down", ""); len(letters) != 1 || letters[0].Webhook != "down" {
+		t.Errorf("unexpected dead letters of webhook: %v", letters)
+	}
+	if letters := d.DeadLetters("", "acme"); len(letters) != 1 || letters[0].Webhook != "rejecting" {
+		t.Errorf("unexpected dead letters of tenant: %v", letters)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	r, srv := newReceiver(t, "secret", http.StatusUnauthorized)
+	d := New(nil, nil, logging.WithComponentLogger("notify"), Options{AllowedHosts: []string{"127.0.0.1"}})
+
+	delivery := d.Deliver(context.Background(), Endpoint{Name: "ping", URL: srv.URL, Secret: "secret"}, NewEvent(EventPing))
+	r.wait(1)
+	if delivery.Attempts != 1 || delivery.Status != http.StatusUnauthorized || delivery.Error == "" {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	}
+
+	delivery = d.Deliver(context.Background(), Endpoint{Name: "ping", URL: srv.URL, Secret: "secret"}, NewEvent(EventPing))
+	r.wait(1)
+	if delivery.Status != http.StatusNoContent || delivery.Error != "" {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	}
+}
+
+func TestDeliverForbiddenAddress(t *testing.T) {
+	r, srv := newReceiver(t, "secret")
+	d := New(nil, nil, logging.WithComponentLogger("notify"), Options{})
+
+	// the address is checked when the connection is dialed
+	delivery := d.Deliver(context.Background(), Endpoint{Name: "local", URL: srv.URL, Secret: "secret"}, NewEvent(EventPing))
+	if delivery.Status != 0 || !strings.Contains(delivery.Error, ErrForbiddenAddress.Error()) {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.requests != 0 {
+		t.Errorf("forbidden address received %d requests", r.requests)
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	d := New(nil, nil, logging.WithComponentLogger("notify"), Options{AllowedHosts: []string{"hooks.internal"}})
+	for u, allowed := range map[string]bool{
+		"https://93.184.216.34/hooks":                  true,
+		"https://hooks.internal/events":                true,
+		"http://127.0.0.1:8080/metrics":                false,
+		"http://localhost/":                            false,
+		"http://169.254.169.254/latest/meta-data":      false,
+		"https://10.96.0.1/api":                        false,
+		"https://192.168.1.10/":                        false,
+		"http://[::1]:8443/":                           false,
+		"http://[fd00::1]/":                            false,
+		"http://0.0.0.0:9090/":                         false,
+		"http://100.64.0.10/":                          false,
+		"https://172.20.0.5/hooks":                     false,
+		"http://[::ffff:127.0.0.1]/":                   false,
+		"https://[2606:2800:220:1:248:1893:25c8:1946]": true,
+	} {
+		err := d.CheckURL(context.Background(), u)
+		if allowed && err != nil {
+			t.Errorf("%s is not allowed: %s", u, err)
+		} else if !allowed && !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("%s is allowed: %v", u, err)
+		}
+	}
+}
+
+func TestSubscribed(t *testing.T) {
+	cases := []struct {
+		name     string
+		endpoint Endpoint
+		event    *models.WebhookEvent
+		expected bool
+	}{
+		{"all-events", Endpoint{}, &models.WebhookEvent{Type: EventBackupFailed}, true},
+		{"listed", Endpoint{Events: []string{EventServiceReady}}, &models.WebhookEvent{Type: EventServiceReady}, true},
+		{"not-listed", Endpoint{Events: []string{EventServiceReady}}, &models.WebhookEvent{Type: EventServiceFailed}, false},
+		{"wildcard", Endpoint{Events: []string{"backup.*"}}, &models.WebhookEvent{Type: EventBackupCompleted}, true},
+		{"wildcard-other", Endpoint{Events: []string{"backup.*"}}, &models.WebhookEvent{Type: EventRestoreCompleted}, false},
+		{"tenant", Endpoint{Tenant: "acme"}, &models.WebhookEvent{Type: EventServiceReady, Tenant: "acme"}, true},
+		{"other-tenant", Endpoint{Tenant: "acme"}, &models.WebhookEvent{Type: EventServiceReady, Tenant: "other"}, false},
+		{"ping", Endpoint{Tenant: "acme", Events: []string{EventServiceReady}}, &models.WebhookEvent{Type: EventPing}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.endpoint.Subscribed(tc.event); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidSubscription(t *testing.T) {
+	for subscription, expected := range map[string]bool{
+		EventServiceArchived: true,
+		"restore.*":          true,
+		"restore.started":    false,
+		"cluster.*":          false,
+		EventPing:            false,
+	} {
+		if actual := ValidSubscription(subscription); actual != expected {
+			t.Errorf("%s: expected %v, got %v", subscription, expected, actual)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"type":"ping"}`)
+	now := time.Now().Unix()
+	header := func(secret string, ts int64) http.Header {
+		h := http.Header{}
+		h.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+		h.Set(SignatureHeader, Sign(secret, ts, body))
+		return h
+	}
+
+	if err := Verify("secret", header("secret", now), body, time.Minute); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	for name, h := range map[string]http.Header{
+		"wrong-secret": header("other", now),
+		"expired":      header("secret", now-3600),
+		"missing":      {},
+	} {
+		if err := Verify("secret", h, body, time.Minute); err == nil {
+			t.Errorf("%s: error is expected", name)
+		}
+	}
+	if err := Verify("secret", header("secret", now), []byte(`{"type":"service.ready"}`), 0); err == nil {
+		t.Error("error is expected for a changed body")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Headers of webhook requests
+const (
+	// SignatureHeader keeps the HMAC-SHA256 signature of the timestamp and the body in the sha256=<hex> form
+	SignatureHeader = "X-Kuberlogic-Signature"
+	// TimestampHeader keeps the unix time the request was signed at
+	TimestampHeader = "X-Kuberlogic-Timestamp"
+	// EventHeader keeps the event type
+	EventHeader = "X-Kuberlogic-Event"
+	// DeliveryHeader keeps the event id, it is the same for all delivery attempts
+	DeliveryHeader = "X-Kuberlogic-Delivery"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature of the body sent at the timestamp.
+// The timestamp is signed to prevent replaying old requests.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a received webhook request body,
+// requests signed earlier than tolerance ago are rejected if tolerance is not zero
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	signature := header.Get(SignatureHeader)
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return errors.New("signature is missing")
+	}
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return errors.New("invalid signature timestamp")
+	}
+	if tolerance > 0 && time.Since(time.Unix(timestamp, 0)) > tolerance {
+		return errors.New("signature is expired")
+	}
+	if !hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature)) {
+		return errors.New("signature does not match")
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package oidc validates JWT bearer tokens issued by an OpenID Connect provider
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// leeway allows small clock differences with the provider
+	leeway = time.Minute
+	// refreshInterval limits how often the keys are refetched because of unknown key ids
+	refreshInterval = time.Minute
+)
+
+// supportedAlgorithms are asymmetric algorithms the provider keys can sign tokens with
+var supportedAlgorithms = map[string]bool{
+	string(jose.RS256): true, string(jose.RS384): true, string(jose.RS512): true,
+	string(jose.PS256): true, string(jose.PS384): true, string(jose.PS512): true,
+	string(jose.ES256): true, string(jose.ES384): true, string(jose.ES512): true,
+}
+
+// Claims of a validated token
+type Claims map[string]interface{}
+
+// Strings returns string values of the claim, nested claims are separated by dots.
+// Both single string and string array claims are supported.
+func (c Claims) Strings(name string) []string {
+	var value interface{} = map[string]interface{}(c)
+	for _, part := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[part]
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var result []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// Verifier validates tokens signed by the issuer keys.
+// Keys are fetched lazily and refreshed when a token is signed by an unknown key.
+type Verifier struct {
+	issuer   string
+	audience string
+	jwksURL  string
+	client   *http.Client
+	now      func() time.Time
+
+	mu        sync.Mutex
+	keys      *jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+// NewVerifier returns a verifier of tokens issued by the issuer.
+// The keys location is discovered from the issuer configuration if jwksURL is empty.
+func NewVerifier(issuer, jwksURL, audience string, client *http.Client) *Verifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Verifier{
+		issuer:   issuer,
+		audience: audience,
+		jwksURL:  jwksURL,
+		client:   client,
+		now:      time.Now,
+	}
+}
+
+// Verify checks the token signature and standard claims and returns all token claims
+func (v *Verifier) Verify(ctx context.Context, token string) (Claims, error) {
+	tok, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed token")
+	}
+	if len(tok.Headers) != 1 {
+		return nil, errors.New("token must have a single signature")
+	}
+	header := tok.Headers[0]
+	if !supportedAlgorithms[header.Algorithm] {
+		return nil, errors.Errorf("unsupported token signing algorithm: %s", header.Algorithm)
+	}
+
+	key, err := v.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	standard := jwt.Claims{}
+	claims := Claims{}
+	if err := tok.Claims(key, &standard, &claims); err != nil {
+		return nil, errors.Wrap(err, "invalid token signature")
+	}
+	expected := jwt.Expected{
+		Issuer: v.issuer,
+		Time:   v.now(),
+	}
+	if v.audience != "" {
+		expected.Audience = jwt.Audience{v.audience}
+	}
+	if err := standard.ValidateWithLeeway(expected, leeway); err != nil {
+		return nil, errors.Wrap(err, "invalid token claims")
+	}
+	if standard.Expiry == nil {
+		return nil, errors.New("token without expiration time is not accepted")
+	}
+	return claims, nil
+}
+
+// key returns the signing key by its id, keys are refetched once if the key is unknown
+func (v *Verifier) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.keys != nil {
+		if key := findKey(v.keys, kid); key != nil {
+			return key, nil
+		}
+		if v.now().Sub(v.fetchedAt) < refreshInterval {
+			return nil, errors.Errorf("unknown token signing key: %s", kid)
+		}
+	}
+
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching token signing keys")
+	}
+	v.keys, v.fetchedAt = keys, v.now()
+
+	if key := findKey(v.keys, kid); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("unknown token signing key: %s", kid)
+}
+
+// findKey returns a public signing key by id, the only key is returned if the id is not set
+func findKey(keys *jose.JSONWebKeySet, kid string) *jose.JSONWebKey {
+	var candidates []jose.JSONWebKey
+	for _, key := range keys.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if !key.IsPublic() {
+			continue
+		}
+		if kid == "" || key.KeyID == kid {
+			candidates = append(candidates, key)
+		}
+	}
+	if len(candidates) != 1 {
+		return nil
+	}
+	return &candidates[0]
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	if v.jwksURL == "" {
+		discovery := struct {
+			Issuer  string `json:"issuer"`
+			JwksURI string `json:"jwks_uri"`
+		}{}
+		if err := v.get(ctx, strings.TrimSuffix(v.issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return nil, errors.Wrap(err, "error discovering issuer configuration")
+		}
+		if discovery.Issuer != v.issuer {
+			return nil, errors.Errorf("issuer does not match: %s", discovery.Issuer)
+		}
+		if discovery.JwksURI == "" {
+			return nil, errors.New("issuer configuration has no jwks_uri")
+		}
+		v.jwksURL = discovery.JwksURI
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	if err := v.get(ctx, v.jwksURL, keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (v *Verifier) get(ctx context.Context, url string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
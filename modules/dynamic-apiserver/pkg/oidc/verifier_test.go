@@ -0,0 +1,181 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// testProvider serves the issuer configuration and keys of a local signing key
+type testProvider struct {
+	*httptest.Server
+	keys      []jose.JSONWebKey
+	keyServed int32
+}
+
+func newTestProvider(t *testing.T, keys ...jose.JSONWebKey) *testProvider {
+	p := &testProvider{keys: keys}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(rw).Encode(map[string]string{
+			"issuer":   p.URL,
+			"jwks_uri": p.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&p.keyServed, 1)
+		set := jose.JSONWebKeySet{}
+		for _, key := range p.keys {
+			set.Keys = append(set.Keys, key.Public())
+		}
+		_ = json.NewEncoder(rw).Encode(set)
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func newTestKey(t *testing.T, kid string) jose.JSONWebKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return jose.JSONWebKey{Key: key, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"}
+}
+
+func signTestToken(t *testing.T, key jose.JSONWebKey, claims ...interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := jwt.Signed(signer)
+	for _, c := range claims {
+		builder = builder.Claims(c)
+	}
+	token, err := builder.CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func standardClaims(issuer string) jwt.Claims {
+	return jwt.Claims{
+		Issuer:   issuer,
+		Subject:  "user",
+		Audience: jwt.Audience{"kuberlogic"},
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestVerify(t *testing.T) {
+	key := newTestKey(t, "first")
+	provider := newTestProvider(t, key)
+	other := newTestKey(t, "first")
+
+	expired := standardClaims(provider.URL)
+	expired.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := standardClaims(provider.URL)
+	noExpiry.Expiry = nil
+
+	cases := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"valid", signTestToken(t, key, standardClaims(provider.URL), map[string]interface{}{"roles": []string{"admins"}}), true},
+		{"wrong-issuer", signTestToken(t, key, standardClaims("https://issuer.example.com")), false},
+		{"expired", signTestToken(t, key, expired), false},
+		{"no-expiry", signTestToken(t, key, noExpiry), false},
+		{"wrong-signature", signTestToken(t, other, standardClaims(provider.URL)), false},
+		{"malformed", "not a token", false},
+	}
+	verifier := NewVerifier(provider.URL, "", "kuberlogic", nil)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := verifier.Verify(context.Background(), tc.token)
+			if tc.valid && err != nil {
+				t.Fatal(err)
+			}
+			if !tc.valid {
+				if err == nil {
+					t.Fatalf("token is expected to be rejected, claims: %v", claims)
+				}
+				return
+			}
+			if !reflect.DeepEqual(claims.Strings("roles"), []string{"admins"}) {
+				t.Errorf("unexpected roles claim: %v", claims["roles"])
+			}
+		})
+	}
+}
+
+func TestVerifyAudience(t *testing.T) {
+	key := newTestKey(t, "first")
+	provider := newTestProvider(t, key)
+	token := signTestToken(t, key, standardClaims(provider.URL))
+
+	if _, err := NewVerifier(provider.URL, "", "dashboard", nil).Verify(context.Background(), token); err == nil {
+		t.Errorf("token for another audience is expected to be rejected")
+	}
+	if _, err := NewVerifier(provider.URL, "", "", nil).Verify(context.Background(), token); err != nil {
+		t.Errorf("audience is not expected to be checked: %v", err)
+	}
+}
+
+func TestVerifyKeyRotation(t *testing.T) {
+	first, second := newTestKey(t, "first"), newTestKey(t, "second")
+	provider := newTestProvider(t, first)
+	verifier := NewVerifier(provider.URL, provider.URL+"/keys", "", nil)
+	now := time.Now()
+	verifier.now = func() time.Time { return now }
+
+	if _, err := verifier.Verify(context.Background(), signTestToken(t, first, standardClaims(provider.URL))); err != nil {
+		t.Fatal(err)
+	}
+
+	provider.keys = append(provider.keys, second)
+	token := signTestToken(t, second, standardClaims(provider.URL))
+	if _, err := verifier.Verify(context.Background(), token); err == nil {
+		t.Fatalf("keys are not expected to be refetched that often")
+	}
+
+	now = now.Add(refreshInterval)
+	if _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatal(err)
+	}
+	if served := atomic.LoadInt32(&provider.keyServed); served != 2 {
+		t.Errorf("keys are expected to be fetched twice, fetched %d times", served)
+	}
+}
+
+func TestClaimsStrings(t *testing.T) {
+	claims := Claims{
+		"sub":          "user",
+		"groups":       []interface{}{"a", 1, "b"},
+		"realm_access": map[string]interface{}{"roles": []interface{}{"admins"}},
+	}
+	cases := map[string][]string{
+		"sub":                []string{"user"},
+		"groups":             []string{"a", "b"},
+		"realm_access.roles": []string{"admins"},
+		"missing":            nil,
+		"sub.nested":         nil,
+	}
+	for name, expected := range cases {
+		if actual := claims.Strings(name); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("claim %s: expected %v, got %v", name, expected, actual)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package util
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/kuberlogic/kuberlogic/modules/dynamic-apiserver/pkg/config"
@@ -16,11 +17,18 @@ import (
 	v12 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
 	SubscriptionField         = "subscription-id"
 	BackupRestoreServiceField = "kls-id"
+	// TenantField labels services owned by a tenant
+	TenantField = "kuberlogic.com/tenant"
+	// PlanField labels services created with a service plan
+	PlanField = "kuberlogic.com/plan"
+	// PlanOverridesAnnotation keeps service parameters overriding the plan ones
+	PlanOverridesAnnotation = "kuberlogic.com/plan-overrides"
 )
 
 func ServiceToKuberlogic(svc *models.Service, cfg *config.Config) (*kuberlogiccomv1alpha1.KuberLogicService, error) {
@@ -90,6 +98,23 @@ func ServiceToKuberlogic(svc *models.Service, cfg *config.Config) (*kuberlogicco
 		c.Labels[SubscriptionField] = svc.Subscription
 	}
 
+	if svc.Tenant != "" {
+		if errs := validation.IsValidLabelValue(svc.Tenant); len(errs) > 0 {
+			return nil, errors2.Errorf("invalid tenant '%s': %s", svc.Tenant, strings.Join(errs, ", "))
+		}
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[TenantField] = svc.Tenant
+	}
+
+	if svc.Plan != "" {
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[PlanField] = svc.Plan
+	}
+
 	return c, nil
 }
 
@@ -140,6 +165,8 @@ func KuberlogicToService(kls *kuberlogiccomv1alpha1.KuberLogicService) (*models.
 		if value, ok := kls.ObjectMeta.Labels["subscription-id"]; ok {
 			ret.Subscription = value
 		}
+		ret.Tenant = kls.ObjectMeta.Labels[TenantField]
+		ret.Plan = kls.ObjectMeta.Labels[PlanField]
 	}
 
 	return ret, nil
@@ -190,6 +217,85 @@ func KuberlogicToRestore(restore *kuberlogiccomv1alpha1.KuberlogicServiceRestore
 	}
 }
 
+func PlanToKuberlogic(plan *models.ServicePlan) (*kuberlogiccomv1alpha1.KuberLogicServicePlan, error) {
+	c := &kuberlogiccomv1alpha1.KuberLogicServicePlan{
+		ObjectMeta: v1.ObjectMeta{
+			Name: *plan.Name,
+		},
+		Spec: kuberlogiccomv1alpha1.KuberLogicServicePlanSpec{
+			Description:    plan.Description,
+			Type:           *plan.Type,
+			Version:        plan.Version,
+			BackupSchedule: plan.BackupSchedule,
+		},
+	}
+	if plan.Replicas != nil {
+		c.Spec.Replicas = int32(*plan.Replicas)
+	}
+
+	if plan.Limits != nil {
+		c.Spec.Limits = make(v12.ResourceList)
+		for name, value := range map[v12.ResourceName]string{
+			v12.ResourceCPU:     plan.Limits.CPU,
+			v12.ResourceMemory:  plan.Limits.Memory,
+			v12.ResourceStorage: plan.Limits.Storage,
+		} {
+			if value == "" {
+				continue
+			}
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, errors2.Wrapf(err, "invalid %s limit", name)
+			}
+			c.Spec.Limits[name] = quantity
+		}
+	}
+
+	if plan.Advanced != nil {
+		data, err := json.Marshal(plan.Advanced)
+		if err != nil {
+			return nil, errors2.Wrap(err, "cannot deserialize advanced parameter")
+		}
+		c.Spec.Advanced.Raw = data
+	}
+	return c, nil
+}
+
+func KuberlogicToPlan(plan *kuberlogiccomv1alpha1.KuberLogicServicePlan) (*models.ServicePlan, error) {
+	ret := &models.ServicePlan{
+		Name:           StrAsPointer(plan.GetName()),
+		Type:           StrAsPointer(plan.Spec.Type),
+		Description:    plan.Spec.Description,
+		Version:        plan.Spec.Version,
+		BackupSchedule: plan.Spec.BackupSchedule,
+		CreatedAt:      strfmt.DateTime(plan.GetCreationTimestamp().Time.UTC()),
+	}
+	if plan.Spec.Replicas != 0 {
+		ret.Replicas = Int64AsPointer(int64(plan.Spec.Replicas))
+	}
+
+	if plan.Spec.Limits != nil {
+		limits := new(models.Limits)
+		if !plan.Spec.Limits.Cpu().IsZero() {
+			limits.CPU = plan.Spec.Limits.Cpu().String()
+		}
+		if !plan.Spec.Limits.Memory().IsZero() {
+			limits.Memory = plan.Spec.Limits.Memory().String()
+		}
+		if !plan.Spec.Limits.Storage().IsZero() {
+			limits.Storage = plan.Spec.Limits.Storage().String()
+		}
+		ret.Limits = limits
+	}
+
+	if plan.Spec.Advanced.Raw != nil {
+		if err := json.Unmarshal(plan.Spec.Advanced.Raw, &ret.Advanced); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
 func Int64AsPointer(x int64) *int64 {
 	return &x
 }
@@ -197,3 +303,7 @@ func Int64AsPointer(x int64) *int64 {
 func StrAsPointer(x string) *string {
 	return &x
 }
+
+func BoolAsPointer(x bool) *bool {
+	return &x
+}
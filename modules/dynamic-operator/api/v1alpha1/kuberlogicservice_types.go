@@ -10,12 +10,18 @@ import (
 	v1 "k8s.io/api/core/v1"
 	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	// CredsUpdateSecretName is a corev1.Secret name that is created when a credentials update operation is requested via KL apiserver
 	CredsUpdateSecretName = "credential-request"
+	// EnvSecretName is a corev1.Secret name that keeps values of secret environment variables, it is managed by KL apiserver
+	EnvSecretName = "kuberlogic-service-env"
+	// ServiceVersionsConfigMap is a corev1.ConfigMap name that keeps versions supported by service types, it is published by the operator in its namespace.
+	// Keys are service types, values are JSON encoded ServiceTypeVersions.
+	ServiceVersionsConfigMap = "kuberlogic-service-versions"
 
 	configFailedCondType       = "ConfigurationError"
 	provisioningFailedCondType = "ProvisioningError"
@@ -27,6 +33,13 @@ const (
 	archivedCondType           = "Archived"
 )
 
+// ServiceTypeVersions describes versions supported by a service type, any version is supported when Versions is empty
+// +kubebuilder:object:generate=false
+type ServiceTypeVersions struct {
+	Default  string   `json:"default,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
 // KuberLogicServiceStatus defines the observed state of KuberLogicService
 type KuberLogicServiceStatus struct {
 	Phase      string             `json:"phase,omitempty"`
@@ -42,6 +55,69 @@ type KuberLogicServiceStatus struct {
 	RestoreRequested bool `json:"restoreRequested,omitempty"`
 	// a service is ready for restore process
 	ReadyForRestore bool `json:"readyForRestore,omitempty"`
+
+	// objects managed by the service plugin
+	Objects []ManagedObject `json:"objects,omitempty"`
+	// status of service components reported by the plugin
+	Components []ComponentStatus `json:"components,omitempty"`
+	// the last error that happened when reconciling the service
+	LastError *ReconcileError `json:"lastError,omitempty"`
+	// secrets of the service declared by the plugin
+	Secrets *ServiceSecrets `json:"secrets,omitempty"`
+	// environment variables supported by the service plugin
+	Parameters []ParameterSchema `json:"parameters,omitempty"`
+	// storage requested by volume claims of the service plugin, volumes can be sized apart from the storage limit
+	Storage *resource.Quantity `json:"storage,omitempty"`
+}
+
+// ManagedObject references an object created by the service plugin in the service namespace
+type ManagedObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+// ComponentStatus is a readiness of a single service component as reported by the plugin
+type ComponentStatus struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Ready   bool   `json:"ready"`
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceSecrets references a Secret in the service namespace with keys that users can see or change
+type ServiceSecrets struct {
+	// name of the Secret object
+	Name string             `json:"name"`
+	Keys []ServiceSecretKey `json:"keys,omitempty"`
+}
+
+type ServiceSecretKey struct {
+	ID string `json:"id"`
+	// a secret can be listed and revealed
+	Visible bool `json:"visible,omitempty"`
+	// a secret can be regenerated or set to a user provided value
+	Rotatable bool `json:"rotatable,omitempty"`
+}
+
+// ParameterSchema describes an environment variable supported by the service plugin
+type ParameterSchema struct {
+	Name string `json:"name"`
+	// container that gets the variable, all containers get it when empty
+	Container   string `json:"container,omitempty"`
+	Description string `json:"description,omitempty"`
+	// one of string, integer or boolean
+	Type    string `json:"type,omitempty"`
+	Default string `json:"default,omitempty"`
+	// a variable must be set when it has no default value
+	Required bool `json:"required,omitempty"`
+	// a variable value must be kept in a secret
+	Secret bool `json:"secret,omitempty"`
+}
+
+type ReconcileError struct {
+	Message string      `json:"message"`
+	Time    metav1.Time `json:"time"`
 }
 
 type KuberLogicServiceSpec struct {
@@ -65,6 +141,9 @@ type KuberLogicServiceSpec struct {
 	// any advanced configuration is supported
 	Advanced v11.JSON `json:"advanced,omitempty"`
 
+	// environment variables of service containers
+	Env []EnvVar `json:"env,omitempty"`
+
 	// Paused field allows to stop all service related containers
 	// +kubebuilder:default=false
 	Paused bool `json:"paused,omitempty"`
@@ -76,6 +155,25 @@ type KuberLogicServiceSpec struct {
 	BackupSchedule string `json:"backupSchedule,omitempty"`
 }
 
+// EnvVar is an environment variable of service containers
+type EnvVar struct {
+	// +kubebuilder:validation:Pattern=^[-._a-zA-Z][-._a-zA-Z0-9]*$
+	Name string `json:"name"`
+	// container that gets the variable, all containers get it when empty
+	Container string `json:"container,omitempty"`
+	Value     string `json:"value,omitempty"`
+	// the value is kept in the EnvSecretName secret of the service namespace instead of Value
+	Secret bool `json:"secret,omitempty"`
+}
+
+// SecretKey returns the EnvSecretName secret key that keeps the variable value
+func (e EnvVar) SecretKey() string {
+	if e.Container == "" {
+		return e.Name
+	}
+	return e.Container + "." + e.Name
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Service status"
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`,description="The cluster type"
@@ -113,6 +211,11 @@ func (in *KuberLogicService) Paused() bool {
 	return in.Spec.Paused
 }
 
+// PauseCompleted returns true when the service is paused by the operator, not just requested to pause
+func (in *KuberLogicService) PauseCompleted() bool {
+	return meta.IsStatusConditionTrue(in.Status.Conditions, pausedCondType)
+}
+
 func (in *KuberLogicService) Archived() bool {
 	c := meta.FindStatusCondition(in.Status.Conditions, archivedCondType)
 	if c == nil {
@@ -252,11 +355,21 @@ func (in *KuberLogicService) SetBackupStatus(klb *KuberlogicServiceBackup) {
 func (in *KuberLogicService) ConfigurationFailed(s string) {
 	in.Status.Phase = configFailedCondType
 	in.setConditionStatus(configFailedCondType, true, s, configFailedCondType)
+	in.setLastError(s)
 }
 
 func (in *KuberLogicService) ClusterSyncFailed(s string) {
 	in.Status.Phase = provisioningFailedCondType
 	in.setConditionStatus(provisioningFailedCondType, true, s, provisioningFailedCondType)
+	in.setLastError(s)
+}
+
+// setLastError records the reconcile error, it is kept when the service recovers to help troubleshooting
+func (in *KuberLogicService) setLastError(s string) {
+	in.Status.LastError = &ReconcileError{
+		Message: s,
+		Time:    metav1.Now(),
+	}
 }
 
 // KuberLogicServiceList contains a list of KuberLogicService
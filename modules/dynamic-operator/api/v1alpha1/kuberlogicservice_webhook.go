@@ -175,6 +175,12 @@ func (r *KuberLogicService) ValidateUpdate(old runtime.Object) error {
 	if err != nil {
 		return err
 	}
+	// the plugin checks if the version change is allowed
+	req.PreviousVersion = oldSpec.Spec.Version
+	// volume claims are passed to the plugin to check the downsize of every volume
+	if err = addVolumeClaims(r, req); err != nil {
+		return err
+	}
 
 	if err = plugin.ValidateUpdate(*req).Error(); err != nil {
 		return err
@@ -225,6 +231,7 @@ func makeRequest(kls *KuberLogicService) (*commons.PluginRequest, error) {
 		Replicas:   kls.Spec.Replicas,
 		Version:    kls.Spec.Version,
 		Parameters: spec,
+		Env:        PluginEnv(kls.Spec.Env),
 	}
 	err := req.SetLimits(&kls.Spec.Limits)
 	if err != nil {
@@ -234,6 +241,43 @@ func makeRequest(kls *KuberLogicService) (*commons.PluginRequest, error) {
 	return req, nil
 }
 
+// PluginEnv converts service environment variables to plugin request variables
+func PluginEnv(env []EnvVar) []commons.EnvVar {
+	var result []commons.EnvVar
+	for _, e := range env {
+		v := commons.EnvVar{
+			Name:      e.Name,
+			Container: e.Container,
+			Value:     e.Value,
+		}
+		if e.Secret {
+			v.Value = ""
+			v.SecretName, v.SecretKey = EnvSecretName, e.SecretKey()
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// addVolumeClaims adds volume claims found in the namespace of the service to the plugin request
+func addVolumeClaims(kls *KuberLogicService, req *commons.PluginRequest) error {
+	if kls.Status.Namespace == "" {
+		return nil
+	}
+	claims := &v1.PersistentVolumeClaimList{}
+	if err := k8sClient.List(context.TODO(), claims, client.InNamespace(kls.Status.Namespace)); err != nil {
+		return errors.Wrap(err, "failed to list volume claims")
+	}
+	for i := range claims.Items {
+		obj, err := commons.ToUnstructured(&claims.Items[i], v1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"))
+		if err != nil {
+			return err
+		}
+		req.AddObject(obj)
+	}
+	return nil
+}
+
 func validateScheduleFormat(schedule string) error {
 	_, err := cron.ParseStandard(schedule)
 	return err
@@ -0,0 +1,56 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ */
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KuberLogicServicePlanSpec defines service parameters shared by services created from the plan
+type KuberLogicServicePlanSpec struct {
+	// Description of the plan for humans
+	Description string `json:"description,omitempty"`
+	// Type of services created from the plan
+	Type string `json:"type"`
+	// Replicas of services, the service type default is used if empty
+	Replicas int32 `json:"replicas,omitempty"`
+	// Version of services, the service type default is used if empty
+	Version string `json:"version,omitempty"`
+	// Limits of services
+	Limits v1.ResourceList `json:"limits,omitempty"`
+	// BackupSchedule of services in the cron format
+	BackupSchedule string `json:"backupSchedule,omitempty"`
+
+	// Advanced parameters of services, they are merged with parameters of a service
+	Advanced v11.JSON `json:"advanced,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:shortName=klp,categories=kuberlogic,scope=Cluster
+//+kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`,description="The service type"
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.spec.version`,description="The service version"
+
+// KuberLogicServicePlan is the Schema for the kuberlogicserviceplans API
+type KuberLogicServicePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KuberLogicServicePlanSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// KuberLogicServicePlanList contains a list of KuberLogicServicePlan
+type KuberLogicServicePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KuberLogicServicePlan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KuberLogicServicePlan{}, &KuberLogicServicePlanList{})
+}
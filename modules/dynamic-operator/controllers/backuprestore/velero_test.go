@@ -32,7 +32,7 @@ var _ = Describe("Velero BackupRestore provider", func() {
 	var veleroBackupStorageLocation *velero.BackupStorageLocation
 
 	var ns *corev1.Namespace
-	var backupPVC *corev1.PersistentVolumeClaim
+	var backupPVC, volumePVC *corev1.PersistentVolumeClaim
 
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
@@ -123,12 +123,18 @@ var _ = Describe("Velero BackupRestore provider", func() {
 				Namespace: ns.GetName(),
 			},
 		}
+		volumePVC = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-data",
+				Namespace: ns.GetName(),
+			},
+		}
 
 		fakeClient = b.WithScheme(scheme).Build()
 		ctx = context.TODO()
 		backupRestore = NewVeleroBackupRestoreProvider(fakeClient, logger.FromContext(ctx).WithValues("test"), kls, false)
 
-		for _, o := range []client.Object{kls, klb, klr, veleroBackupStorageLocation, ns, backupPVC} {
+		for _, o := range []client.Object{kls, klb, klr, veleroBackupStorageLocation, ns, backupPVC, volumePVC} {
 			_ = fakeClient.Create(ctx, o)
 		}
 	})
@@ -174,6 +180,8 @@ var _ = Describe("Velero BackupRestore provider", func() {
 				By("Checking backup pod volume mounts")
 				_ = fakeClient.Get(ctx, client.ObjectKeyFromObject(backupPod), backupPod)
 				Expect(backupPod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).Should(Equal(backupPVC.GetName()))
+				Expect(backupPod.Spec.Volumes[1].PersistentVolumeClaim.ClaimName).Should(Equal(volumePVC.GetName()))
+				Expect(backupPod.GetAnnotations()[resticBackupVolumesAnnotation]).Should(Equal("demo,demo-data,"))
 
 				By("Checking velero backup existence")
 				veleroBackup = &velero.Backup{}
@@ -51,6 +51,11 @@ type EnvironmentManager struct {
 //+kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=pods;,verbs=deletecollection
 //+kubebuilder:rbac:groups="",resources=pods/log,verbs=get;list
+//+kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+//+kubebuilder:rbac:groups="",resources=nodes/proxy,verbs=get
+//+kubebuilder:rbac:groups="",resources=events,verbs=list
+//+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list
 //+kubebuilder:rbac:groups=kuberlogic.com,resources=kuberlogicservicebackupschedules,verbs=get;list;watch;create;update;patch;delete
 
 // SetupEnv checks if KLS environment is present and creates it if it is not
@@ -7,6 +7,8 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/getsentry/sentry-go"
@@ -19,6 +21,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -29,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	logger "sigs.k8s.io/controller-runtime/pkg/log"
+	"sort"
 	"sync"
 	"time"
 )
@@ -66,13 +72,16 @@ var (
 //+kubebuilder:rbac:groups=kuberlogic.com,resources=kuberlogicservices,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=kuberlogic.com,resources=kuberlogicservices/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=kuberlogic.com,resources=kuberlogicservices/finalizers,verbs=update
+//+kubebuilder:rbac:groups=kuberlogic.com,resources=kuberlogicserviceplans,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=pods/exec,verbs=get;list;watch;create;update;patch;delete
 
 // compose plugin roles:
 //+kubebuilder:rbac:groups="",resources=serviceaccounts;services;persistentvolumeclaims;secrets;configmaps;,verbs=get;list;watch;create;update;patch;delete
-//+kubebuilder:rbac:groups=apps,resources=deployments;,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=batch,resources=jobs;cronjobs,verbs=get;list;watch;create;update;patch;delete
 
 func (r *KuberLogicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logger.FromContext(ctx).WithValues("kuberlogicservicetype", req.String(), "run", time.Now().UnixNano())
@@ -197,11 +206,23 @@ func (r *KuberLogicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		TLSSecretName: r.Cfg.SvcOpts.TLSSecretName,
 		Host:          kls.GetHost(),
 		Parameters:    spec,
+		Env:           kuberlogiccomv1alpha1.PluginEnv(kls.Spec.Env),
 
 		IngressClass: r.Cfg.IngressClass,
 		StorageClass: r.Cfg.StorageClass,
 	}
 
+	// values of secret environment variables are not a part of the service spec
+	// the service is restarted when they are changed
+	envSecret := &v1.Secret{}
+	envSecret.SetName(kuberlogiccomv1alpha1.EnvSecretName)
+	envSecret.SetNamespace(ns)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(envSecret), envSecret); err != nil && !k8serrors.IsNotFound(err) {
+		log.Error(err, "error getting environment variables secret")
+		return ctrl.Result{}, err
+	}
+	pluginRequest.EnvChecksum = envChecksum(kls.Spec.Env, envSecret.Data)
+
 	if err := pluginRequest.SetLimits(&kls.Spec.Limits); err != nil {
 		kls.ConfigurationFailed("plugin error: " + err.Error())
 		_ = r.Status().Update(ctx, kls)
@@ -237,6 +258,28 @@ func (r *KuberLogicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}
 
+	// objects created for the previous service spec or plugin version are passed too, so plugins can migrate them
+	for _, m := range kls.Status.Objects {
+		if requestedObject(resp.Objects, m) {
+			continue
+		}
+		o := &unstructured.Unstructured{}
+		o.SetAPIVersion(m.APIVersion)
+		o.SetKind(m.Kind)
+		o.SetName(m.Name)
+		o.SetNamespace(env.NamespaceName)
+		if err := r.Get(ctx, client.ObjectKeyFromObject(o), o); k8serrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			kls.ClusterSyncFailed(fmt.Sprintf("failed to syc %s %s/%s", o.GetKind(), o.GetNamespace(), o.GetName()))
+			_ = r.Status().Update(ctx, kls)
+
+			log.Error(err, "error fetching object from cluster", "object", o)
+			return ctrl.Result{}, err
+		}
+		pluginRequest.AddObject(o)
+	}
+
 	// convert found objects
 	resp = plugin.Convert(pluginRequest)
 	if resp.Error() != nil {
@@ -266,6 +309,30 @@ func (r *KuberLogicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		log.Info("synced object", "op", op, "object", o)
 	}
 
+	// objects created for the previous service spec or plugin version that are not returned by the plugin anymore are deleted
+	for _, m := range kls.Status.Objects {
+		if requestedObject(resp.Objects, m) {
+			continue
+		}
+		o := &unstructured.Unstructured{}
+		o.SetAPIVersion(m.APIVersion)
+		o.SetKind(m.Kind)
+		o.SetName(m.Name)
+		o.SetNamespace(env.NamespaceName)
+		if err := r.Delete(ctx, o, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+			kls.ClusterSyncFailed(fmt.Sprintf("failed to delete %s %s/%s", o.GetKind(), o.GetNamespace(), o.GetName()))
+			_ = r.Status().Update(ctx, kls)
+
+			log.Error(err, "error deleting object", "object", o)
+			return ctrl.Result{}, err
+		}
+		log.Info("deleted object", "object", o)
+	}
+	kls.Status.Objects = managedObjects(resp.Objects)
+	kls.Status.Secrets = serviceSecrets(resp.Secrets)
+	kls.Status.Parameters = parameterSchema(resp.Parameters)
+	kls.Status.Storage = requestedStorage(resp.Objects)
+
 	// pause service when requested
 	if kls.PauseRequested() {
 		if err := env.PauseService(ctx); err != nil {
@@ -291,13 +358,14 @@ func (r *KuberLogicServiceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 	statusRequest.SetObjects(resp.Objects)
 	status := plugin.Status(*statusRequest)
-	if resp.Error() != nil {
-		kls.ConfigurationFailed("plugin error (Status): " + resp.Error().Error())
+	if status.Error() != nil {
+		kls.ConfigurationFailed("plugin error (Status): " + status.Error().Error())
 		_ = r.Status().Update(ctx, kls)
 
-		log.Error(resp.Error(), "error from rpc call 'ForUpdate'")
-		return ctrl.Result{}, resp.Error()
+		log.Error(status.Error(), "error from rpc call 'Status'")
+		return ctrl.Result{}, status.Error()
 	}
+	kls.Status.Components = componentsStatus(status.Components)
 
 	var requeueAfter time.Duration
 	if status.IsReady {
@@ -414,3 +482,120 @@ func (r *KuberLogicServiceReconciler) SetupWithManager(mgr ctrl.Manager, objects
 	builder.Owns(&kuberlogiccomv1alpha1.KuberlogicServiceBackupSchedule{})
 	return builder.Complete(r)
 }
+
+// managedObjects references plugin objects in the service status
+// requestedObject returns true when the managed object m is in objects
+func requestedObject(objects []*unstructured.Unstructured, m kuberlogiccomv1alpha1.ManagedObject) bool {
+	for _, o := range objects {
+		if o.GetAPIVersion() == m.APIVersion && o.GetKind() == m.Kind && o.GetName() == m.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func managedObjects(objects []*unstructured.Unstructured) []kuberlogiccomv1alpha1.ManagedObject {
+	result := make([]kuberlogiccomv1alpha1.ManagedObject, 0, len(objects))
+	for _, o := range objects {
+		result = append(result, kuberlogiccomv1alpha1.ManagedObject{
+			APIVersion: o.GetAPIVersion(),
+			Kind:       o.GetKind(),
+			Name:       o.GetName(),
+		})
+	}
+	return result
+}
+
+// componentsStatus converts components reported by the plugin into the service status
+func componentsStatus(components []commons.ComponentStatus) []kuberlogiccomv1alpha1.ComponentStatus {
+	var result []kuberlogiccomv1alpha1.ComponentStatus
+	for _, c := range components {
+		result = append(result, kuberlogiccomv1alpha1.ComponentStatus{
+			Kind:    c.Kind,
+			Name:    c.Name,
+			Ready:   c.Ready,
+			Message: c.Message,
+		})
+	}
+	return result
+}
+
+// serviceSecrets converts secrets declared by the plugin into the service status
+func serviceSecrets(secrets *commons.PluginSecrets) *kuberlogiccomv1alpha1.ServiceSecrets {
+	if secrets == nil || secrets.Name == "" {
+		return nil
+	}
+	result := &kuberlogiccomv1alpha1.ServiceSecrets{
+		Name: secrets.Name,
+	}
+	for _, k := range secrets.Keys {
+		result.Keys = append(result.Keys, kuberlogiccomv1alpha1.ServiceSecretKey{
+			ID:        k.ID,
+			Visible:   k.Visible,
+			Rotatable: k.Rotatable,
+		})
+	}
+	return result
+}
+
+func parameterSchema(parameters []commons.ParameterSchema) []kuberlogiccomv1alpha1.ParameterSchema {
+	var result []kuberlogiccomv1alpha1.ParameterSchema
+	for _, p := range parameters {
+		result = append(result, kuberlogiccomv1alpha1.ParameterSchema{
+			Name:        p.Name,
+			Container:   p.Container,
+			Description: p.Description,
+			Type:        p.Type,
+			Default:     p.Default,
+			Secret:      p.Secret,
+		})
+	}
+	return result
+}
+
+// requestedStorage returns the storage requested by volume claims returned by the plugin, it is nil when there are no claims.
+// Tenant quotas count it when it is above the storage limit of the service.
+func requestedStorage(objects []*unstructured.Unstructured) *resource.Quantity {
+	var total *resource.Quantity
+	for _, o := range objects {
+		if o.GetKind() != "PersistentVolumeClaim" {
+			continue
+		}
+		value, found, _ := unstructured.NestedString(o.Object, "spec", "resources", "requests", "storage")
+		if !found {
+			continue
+		}
+		size, err := resource.ParseQuantity(value)
+		if err != nil {
+			continue
+		}
+		if total == nil {
+			total = &resource.Quantity{}
+		}
+		total.Add(size)
+	}
+	return total
+}
+
+// envChecksum returns a checksum of secret environment variable values, it is empty when there are no secret variables
+func envChecksum(env []kuberlogiccomv1alpha1.EnvVar, data map[string][]byte) string {
+	var keys []string
+	for _, e := range env {
+		if e.Secret {
+			keys = append(keys, e.SecretKey())
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(data[k])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,49 @@
+/*
+ * CloudLinux Software Inc 2019-2021 All Rights Reserved
+ */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kuberlogiccomv1alpha1 "github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// PublishServiceVersions keeps versions supported by loaded plugins in the ServiceVersionsConfigMap of the namespace,
+// so they can be listed by the apiserver. Service types of plugins that are not loaded anymore are removed.
+func PublishServiceVersions(ctx context.Context, c client.Client, namespace string, plugins map[string]commons.PluginService) error {
+	data := make(map[string]string, len(plugins))
+	for pluginType, instance := range plugins {
+		resp := instance.Default()
+		if err := resp.Error(); err != nil {
+			return errors.Wrapf(err, "failed to get defaults of %s", pluginType)
+		}
+		versions, err := json.Marshal(&kuberlogiccomv1alpha1.ServiceTypeVersions{
+			Default:  resp.Version,
+			Versions: resp.Versions,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode versions of %s", pluginType)
+		}
+		data[pluginType] = string(versions)
+	}
+
+	cm := &v1.ConfigMap{}
+	cm.SetName(kuberlogiccomv1alpha1.ServiceVersionsConfigMap)
+	cm.SetNamespace(namespace)
+	if _, err := ctrl.CreateOrUpdate(ctx, c, cm, func() error {
+		cm.Data = data
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to publish service versions")
+	}
+	return nil
+}
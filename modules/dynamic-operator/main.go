@@ -5,6 +5,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"os/exec"
@@ -35,6 +36,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	certmanagerv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	kuberlogiccomv1alpha1 "github.com/kuberlogic/kuberlogic/modules/dynamic-operator/api/v1alpha1"
@@ -178,6 +180,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	// versions of service types are listed by the apiserver
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return controllers.PublishServiceVersions(ctx, mgr.GetClient(), cfg.Namespace, pluginInstances)
+	}))
+	if err != nil {
+		setupLog.Error(err, "unable to publish service versions")
+		os.Exit(1)
+	}
+
 	if err = (&kuberlogiccomv1alpha1.KuberLogicService{}).SetupWebhookWithManager(mgr, pluginInstances); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "KuberLogicService")
 		os.Exit(1)
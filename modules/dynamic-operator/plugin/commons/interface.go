@@ -63,6 +63,8 @@ type PluginRequest struct {
 
 	// Requested service Version
 	Version string
+	// PreviousVersion is the service Version before the update, it is empty when the service is created
+	PreviousVersion string
 
 	// If a service should be exposed via TLS
 	Insecure bool
@@ -80,6 +82,11 @@ type PluginRequest struct {
 	// Additional Parameters
 	Parameters map[string]interface{}
 
+	// Env contains environment variables set by users
+	Env []EnvVar
+	// EnvChecksum changes when values of secret environment variables are changed, the service must be restarted then
+	EnvChecksum string
+
 	// Credentials
 	Credentials map[string]string
 
@@ -87,6 +94,31 @@ type PluginRequest struct {
 	Objects []*unstructured.Unstructured
 }
 
+// EnvVar is an environment variable of service containers
+type EnvVar struct {
+	Name string
+	// Container that gets the variable, all containers get it when empty
+	Container string
+	Value     string
+	// SecretName is a Secret in the service namespace that keeps the value under the SecretKey key, Value is not set then
+	SecretName string
+	SecretKey  string
+}
+
+// ParameterSchema describes an environment variable supported by the service
+type ParameterSchema struct {
+	Name        string
+	Container   string
+	Description string
+	// Type is one of string, integer or boolean
+	Type    string
+	Default string
+	// Required variables must be set when there is no default value
+	Required bool
+	// Secret variables must be backed by a Secret
+	Secret bool
+}
+
 func (pl *PluginRequest) SetObjects(objs []*unstructured.Unstructured) {
 	pl.Objects = objs
 }
@@ -207,7 +239,26 @@ type PluginResponse struct {
 	Objects  []*unstructured.Unstructured
 	Protocol protocol
 	Service  string
-	Err      string
+	// Secrets declares secrets of the service that are managed by users, it is optional
+	Secrets *PluginSecrets
+	// Parameters is a schema of environment variables supported by the service, it is optional
+	Parameters []ParameterSchema
+	Err        string
+}
+
+// PluginSecrets declares keys of a Secret in the service namespace that users can see or change
+type PluginSecrets struct {
+	// Name of the Secret object
+	Name string
+	Keys []SecretKey
+}
+
+type SecretKey struct {
+	ID string
+	// Visible secrets can be listed and revealed by users
+	Visible bool
+	// Rotatable secrets can be regenerated or set to a user provided value, the service must pick up a changed value after restart
+	Rotatable bool
 }
 
 func (pl *PluginResponse) Error() error {
@@ -229,7 +280,17 @@ func (pl *PluginResponse) AddUnstructuredObject(object client.Object, gvk schema
 
 type PluginResponseStatus struct {
 	IsReady bool
-	Err     string
+	// Components explains readiness of separate service parts, it is optional
+	Components []ComponentStatus
+	Err        string
+}
+
+// ComponentStatus is a readiness of a single object managed by the plugin
+type ComponentStatus struct {
+	Kind    string
+	Name    string
+	Ready   bool
+	Message string
 }
 
 func (pl *PluginResponseStatus) Error() error {
@@ -242,6 +303,8 @@ func (pl *PluginResponseStatus) Error() error {
 type PluginResponseDefault struct {
 	Replicas int32
 	Version  string
+	// Versions lists supported versions of the service, any version is supported when it is empty
+	Versions []string
 	Host     string
 	// *v1.ResourceList
 	Limits     []byte
@@ -35,9 +35,10 @@ package main
 import "github.com/vrischmann/envconfig"
 
 type config struct {
-	ComposeFile  string `envconfig:"KL_PLUGIN_DOCKER_COMPOSE_FILE"`
-	SentryDsn    string `envconfig:"optional"`
-	DeploymentId string `envconfig:"optional"`
+	ComposeFile    string `envconfig:"KL_PLUGIN_DOCKER_COMPOSE_FILE"`
+	MigrationImage string `envconfig:"KL_PLUGIN_DOCKER_COMPOSE_MIGRATION_IMAGE,optional"`
+	SentryDsn      string `envconfig:"optional"`
+	DeploymentId   string `envconfig:"optional"`
 }
 
 func getConfig() (*config, error) {
@@ -58,6 +58,9 @@ func main() {
 	if err := compose.ValidateComposeProject(project); err != nil {
 		panic(err)
 	}
+	if cfg.MigrationImage != "" {
+		compose.MigrationImage = cfg.MigrationImage
+	}
 
 	rawLogger, err := zap.NewDevelopmentConfig().Build()
 	if err != nil {
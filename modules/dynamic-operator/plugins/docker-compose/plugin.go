@@ -71,8 +71,21 @@ func (d *dockerComposeService) Convert(req commons.PluginRequest) *commons.Plugi
 		}
 	}
 
+	if res.Secrets, err = dcModel.Secrets(); err != nil {
+		d.logger.Error(err, "error declaring secrets")
+		res.Err = err.Error()
+		return res
+	}
+	if res.Parameters, err = dcModel.Parameters(); err != nil {
+		d.logger.Error(err, "error declaring parameters")
+		res.Err = err.Error()
+		return res
+	}
 	res.Service = dcModel.AccessServiceName()
 	res.Protocol = commons.HTTPProto
+	if !dcModel.HTTPAccess() {
+		res.Protocol = commons.TCPProto
+	}
 	return res
 }
 
@@ -89,6 +102,13 @@ func (d *dockerComposeService) Status(req commons.PluginRequest) *commons.Plugin
 	}
 	status.IsReady = ready
 
+	components, err := dcModel.Components(&req)
+	if err != nil {
+		d.logger.Error(err.Error(), "error checking components")
+		status.Err = err.Error()
+	}
+	status.Components = components
+
 	return status
 }
 
@@ -118,18 +138,21 @@ func (d *dockerComposeService) Default() *commons.PluginResponseDefault {
 	if err != nil {
 		defaults.Err = err.Error()
 	}
+	if defaults.Version, defaults.Versions, err = pluginCompose.Versions(d.spec); err != nil {
+		defaults.Err = err.Error()
+	}
 	return defaults
 }
 
 func (d *dockerComposeService) ValidateCreate(req commons.PluginRequest) *commons.PluginResponseValidation {
 	return &commons.PluginResponseValidation{
-		Err: validateRequest(&req),
+		Err: validateRequest(d.spec, &req),
 	}
 }
 
 func (d *dockerComposeService) ValidateUpdate(req commons.PluginRequest) *commons.PluginResponseValidation {
 	return &commons.PluginResponseValidation{
-		Err: validateRequest(&req),
+		Err: validateRequest(d.spec, &req),
 	}
 }
 
@@ -144,10 +167,33 @@ func newDockerComposeServicePlugin(composeProject *compose.Project, logger *zap.
 	}
 }
 
-func validateRequest(req *commons.PluginRequest) string {
+func validateRequest(p *compose.Project, req *commons.PluginRequest) string {
 	var validateErrors []string
-	if req.Replicas != 1 {
-		validateErrors = append(validateErrors, "only 1 replica can be set")
+	if req.Replicas < 1 {
+		validateErrors = append(validateErrors, "at least 1 replica must be set")
+	}
+	if err := pluginCompose.ValidateReplicas(p, req.Replicas); err != nil {
+		validateErrors = append(validateErrors, err.Error())
+	}
+	if limits, err := req.GetLimits(); err != nil {
+		validateErrors = append(validateErrors, err.Error())
+	} else {
+		if err := pluginCompose.ValidateResources(p, req.Replicas, limits); err != nil {
+			validateErrors = append(validateErrors, err.Error())
+		}
+		// claims of volumes are found on updates only
+		if err := pluginCompose.ValidateVolumes(p, limits, req.GetObjects()); err != nil {
+			validateErrors = append(validateErrors, err.Error())
+		}
+	}
+	if err := pluginCompose.ValidateEnv(p, req.Env); err != nil {
+		validateErrors = append(validateErrors, err.Error())
+	}
+	if err := pluginCompose.ValidateAdvanced(p, req.Parameters); err != nil {
+		validateErrors = append(validateErrors, err.Error())
+	}
+	if err := pluginCompose.ValidateVersion(p, req.Version, req.PreviousVersion); err != nil {
+		validateErrors = append(validateErrors, err.Error())
 	}
 
 	return strings.Join(validateErrors, ", ")
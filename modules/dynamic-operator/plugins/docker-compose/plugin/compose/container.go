@@ -0,0 +1,346 @@
+package compose
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// defaultTerminationGracePeriod is used when compose services don't set stop_grace_period
+const defaultTerminationGracePeriod = int64(60)
+
+// reservedAnnotationPrefix can't be used by labels of compose services, annotations with the prefix are set by the plugin
+const reservedAnnotationPrefix = "kuberlogic.com/"
+
+// unsupportedField is a compose service field that can't be run by pods
+type unsupportedField struct {
+	name string
+	set  func(svc types.ServiceConfig) bool
+	hint string
+}
+
+var unsupportedFields = []unsupportedField{
+	{name: "build", set: func(s types.ServiceConfig) bool { return s.Build != nil }, hint: "use a prebuilt image"},
+	{name: "blkio_config", set: func(s types.ServiceConfig) bool { return s.BlkioConfig != nil }},
+	{name: "cgroup_parent", set: func(s types.ServiceConfig) bool { return s.CgroupParent != "" }},
+	{name: "configs", set: func(s types.ServiceConfig) bool { return len(s.Configs) != 0 }, hint: "use " + ConfigsExtension},
+	{name: "cpu_count", set: func(s types.ServiceConfig) bool { return s.CPUCount != 0 }, hint: "use deploy.resources"},
+	{name: "cpu_percent", set: func(s types.ServiceConfig) bool { return s.CPUPercent != 0 }, hint: "use deploy.resources"},
+	{name: "cpu_period", set: func(s types.ServiceConfig) bool { return s.CPUPeriod != 0 }, hint: "use deploy.resources"},
+	{name: "cpu_quota", set: func(s types.ServiceConfig) bool { return s.CPUQuota != 0 }, hint: "use deploy.resources"},
+	{name: "cpu_rt_period", set: func(s types.ServiceConfig) bool { return s.CPURTPeriod != 0 }},
+	{name: "cpu_rt_runtime", set: func(s types.ServiceConfig) bool { return s.CPURTRuntime != 0 }},
+	{name: "cpus", set: func(s types.ServiceConfig) bool { return s.CPUS != 0 }, hint: "use deploy.resources"},
+	{name: "cpuset", set: func(s types.ServiceConfig) bool { return s.CPUSet != "" }},
+	{name: "cpu_shares", set: func(s types.ServiceConfig) bool { return s.CPUShares != 0 }, hint: "use deploy.resources"},
+	{name: "credential_spec", set: func(s types.ServiceConfig) bool { return s.CredentialSpec != nil }},
+	{name: "device_cgroup_rules", set: func(s types.ServiceConfig) bool { return len(s.DeviceCgroupRules) != 0 }},
+	{name: "devices", set: func(s types.ServiceConfig) bool { return len(s.Devices) != 0 }},
+	{name: "dns", set: func(s types.ServiceConfig) bool { return len(s.DNS) != 0 }},
+	{name: "dns_opt", set: func(s types.ServiceConfig) bool { return len(s.DNSOpts) != 0 }},
+	{name: "dns_search", set: func(s types.ServiceConfig) bool { return len(s.DNSSearch) != 0 }},
+	{name: "domainname", set: func(s types.ServiceConfig) bool { return s.DomainName != "" }},
+	{name: "external_links", set: func(s types.ServiceConfig) bool { return len(s.ExternalLinks) != 0 }},
+	{name: "extra_hosts", set: func(s types.ServiceConfig) bool { return len(s.ExtraHosts) != 0 }},
+	{name: "group_add", set: func(s types.ServiceConfig) bool { return len(s.GroupAdd) != 0 }},
+	{name: "hostname", set: func(s types.ServiceConfig) bool { return s.Hostname != "" }},
+	{name: "init", set: func(s types.ServiceConfig) bool { return s.Init != nil && *s.Init }},
+	{name: "ipc", set: func(s types.ServiceConfig) bool { return s.Ipc != "" }},
+	{name: "isolation", set: func(s types.ServiceConfig) bool { return s.Isolation != "" }},
+	{name: "mac_address", set: func(s types.ServiceConfig) bool { return s.MacAddress != "" }},
+	{name: "mem_limit", set: func(s types.ServiceConfig) bool { return s.MemLimit != 0 }, hint: "use deploy.resources"},
+	{name: "mem_reservation", set: func(s types.ServiceConfig) bool { return s.MemReservation != 0 }, hint: "use deploy.resources"},
+	{name: "memswap_limit", set: func(s types.ServiceConfig) bool { return s.MemSwapLimit != 0 }},
+	{name: "mem_swappiness", set: func(s types.ServiceConfig) bool { return s.MemSwappiness != 0 }},
+	{name: "network_mode", set: func(s types.ServiceConfig) bool { return s.NetworkMode != "" || s.Net != "" }},
+	{name: "oom_kill_disable", set: func(s types.ServiceConfig) bool { return s.OomKillDisable }},
+	{name: "oom_score_adj", set: func(s types.ServiceConfig) bool { return s.OomScoreAdj != 0 }},
+	{name: "pid", set: func(s types.ServiceConfig) bool { return s.Pid != "" }},
+	{name: "pids_limit", set: func(s types.ServiceConfig) bool { return s.PidsLimit != 0 }},
+	{name: "platform", set: func(s types.ServiceConfig) bool { return s.Platform != "" }},
+	{name: "privileged", set: func(s types.ServiceConfig) bool { return s.Privileged }},
+	{name: "runtime", set: func(s types.ServiceConfig) bool { return s.Runtime != "" }},
+	{name: "secrets", set: func(s types.ServiceConfig) bool { return len(s.Secrets) != 0 }, hint: "use " + SecretsExtension},
+	{name: "security_opt", set: func(s types.ServiceConfig) bool { return len(s.SecurityOpt) != 0 }},
+	{name: "shm_size", set: func(s types.ServiceConfig) bool { return s.ShmSize != 0 }},
+	{name: "stop_signal", set: func(s types.ServiceConfig) bool { return s.StopSignal != "" }},
+	{name: "sysctls", set: func(s types.ServiceConfig) bool { return len(s.Sysctls) != 0 }},
+	{name: "ulimits", set: func(s types.ServiceConfig) bool { return len(s.Ulimits) != 0 }, hint: "limits of the container runtime are used"},
+	{name: "userns_mode", set: func(s types.ServiceConfig) bool { return s.UserNSMode != "" }},
+	{name: "uts", set: func(s types.ServiceConfig) bool { return s.Uts != "" }},
+	{name: "volume_driver", set: func(s types.ServiceConfig) bool { return s.VolumeDriver != "" }},
+	{name: "volumes_from", set: func(s types.ServiceConfig) bool { return len(s.VolumesFrom) != 0 }},
+	{name: "deploy.mode", set: func(s types.ServiceConfig) bool {
+		return s.Deploy != nil && s.Deploy.Mode != "" && s.Deploy.Mode != "replicated"
+	}},
+	{name: "deploy.replicas", set: func(s types.ServiceConfig) bool { return s.Deploy != nil && s.Deploy.Replicas != nil }, hint: "set replicas of the service"},
+	{name: "deploy.labels", set: func(s types.ServiceConfig) bool { return s.Deploy != nil && len(s.Deploy.Labels) != 0 }, hint: "use labels"},
+	{name: "deploy.update_config", set: func(s types.ServiceConfig) bool { return s.Deploy != nil && s.Deploy.UpdateConfig != nil }},
+	{name: "deploy.rollback_config", set: func(s types.ServiceConfig) bool { return s.Deploy != nil && s.Deploy.RollbackConfig != nil }},
+	{name: "deploy.restart_policy", set: func(s types.ServiceConfig) bool { return s.Deploy != nil && s.Deploy.RestartPolicy != nil }},
+	{name: "deploy.placement", set: func(s types.ServiceConfig) bool {
+		return s.Deploy != nil && (len(s.Deploy.Placement.Constraints) != 0 || len(s.Deploy.Placement.Preferences) != 0 || s.Deploy.Placement.MaxReplicas != 0)
+	}},
+	{name: "deploy.endpoint_mode", set: func(s types.ServiceConfig) bool { return s.Deploy != nil && s.Deploy.EndpointMode != "" }},
+	{name: "deploy.resources.devices", set: func(s types.ServiceConfig) bool {
+		return s.Deploy != nil && ((s.Deploy.Resources.Limits != nil && len(s.Deploy.Resources.Limits.Devices) != 0) ||
+			(s.Deploy.Resources.Reservations != nil && len(s.Deploy.Resources.Reservations.Devices) != 0))
+	}},
+	{name: "deploy.resources.generic_resources", set: func(s types.ServiceConfig) bool {
+		return s.Deploy != nil && ((s.Deploy.Resources.Limits != nil && len(s.Deploy.Resources.Limits.GenericResources) != 0) ||
+			(s.Deploy.Resources.Reservations != nil && len(s.Deploy.Resources.Reservations.GenericResources) != 0))
+	}},
+	{name: "deploy.resources.pids", set: func(s types.ServiceConfig) bool {
+		return s.Deploy != nil && ((s.Deploy.Resources.Limits != nil && s.Deploy.Resources.Limits.PIds != 0) ||
+			(s.Deploy.Resources.Reservations != nil && s.Deploy.Resources.Reservations.PIds != 0))
+	}},
+}
+
+// validateContainerFields checks that compose services don't set fields that can't be mapped to containers
+func validateContainerFields(p *types.Project) error {
+	annotations := make(map[string]string)
+	for _, svc := range p.Services {
+		for _, field := range unsupportedFields {
+			if !field.set(svc) {
+				continue
+			}
+			if field.hint != "" {
+				return errors.Wrapf(ErrUnsupportedField, "`%s` of service `%s`, %s", field.name, svc.Name, field.hint)
+			}
+			return errors.Wrapf(ErrUnsupportedField, "`%s` of service `%s`", field.name, svc.Name)
+		}
+
+		// restart policies of one-shot services are checked with the one-shot extension
+		switch svc.Restart {
+		case "", types.RestartPolicyAlways, types.RestartPolicyUnlessStopped:
+		default:
+			if !oneShotService(svc) {
+				return errors.Wrapf(ErrUnsupportedField, "restart policy `%s` of service `%s`, mark the service with %s", svc.Restart, svc.Name, OneShotExtension)
+			}
+		}
+		for name, dependency := range svc.DependsOn {
+			if dependency.Condition != types.ServiceConditionCompletedSuccessfully {
+				continue
+			}
+			if target, err := p.GetService(name); err != nil || !oneShotService(target) {
+				return errors.Wrapf(ErrUnsupportedField, "condition `%s` of service `%s` dependency `%s` that is not marked with %s",
+					dependency.Condition, svc.Name, name, OneShotExtension)
+			}
+		}
+		for name, network := range svc.Networks {
+			if network != nil && (len(network.Aliases) != 0 || network.Ipv4Address != "" || network.Ipv6Address != "") {
+				return errors.Wrapf(ErrUnsupportedField, "aliases and addresses of network `%s` of service `%s`", name, svc.Name)
+			}
+		}
+		for _, link := range svc.Links {
+			if parts := strings.SplitN(link, ":", 2); len(parts) == 2 && parts[0] != parts[1] {
+				return errors.Wrapf(ErrUnsupportedField, "alias of link `%s` of service `%s`", link, svc.Name)
+			}
+		}
+
+		if _, err := containerSecurityContext(svc); err != nil {
+			return err
+		}
+		if _, _, err := tmpfsVolumes(svc); err != nil {
+			return err
+		}
+		if _, err := imagePullPolicy(svc); err != nil {
+			return err
+		}
+		for key, value := range svc.Labels {
+			if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+				return errors.Wrapf(ErrUnsupportedField, "label `%s` of service `%s`: %s", key, svc.Name, strings.Join(errs, ", "))
+			}
+			if strings.HasPrefix(key, reservedAnnotationPrefix) {
+				return errors.Wrapf(ErrUnsupportedField, "label `%s` of service `%s` uses the reserved prefix %s", key, svc.Name, reservedAnnotationPrefix)
+			}
+			// services without the split mode share the pod annotations
+			if previous, found := annotations[key]; found && previous != value && !splitMode(p) {
+				return errors.Wrapf(ErrUnsupportedField, "label `%s` of service `%s` is set to a different value by another service", key, svc.Name)
+			}
+			annotations[key] = value
+		}
+	}
+	return nil
+}
+
+// containerCommand returns the command and arguments of the compose service svc container.
+// The command overrides the image entrypoint unless the entrypoint is set.
+func containerCommand(svc types.ServiceConfig) ([]string, []string) {
+	if len(svc.Entrypoint) == 0 {
+		return svc.Command, nil
+	}
+	return svc.Entrypoint, svc.Command
+}
+
+// parseUser parses the compose user in the uid[:gid] form
+func parseUser(user string) (*int64, *int64, error) {
+	if user == "" {
+		return nil, nil, nil
+	}
+	parts := strings.SplitN(user, ":", 2)
+	ids := make([]*int64, 2)
+	for i, part := range parts {
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || id < 0 {
+			return nil, nil, fmt.Errorf("user `%s` must be a numeric uid[:gid]", user)
+		}
+		ids[i] = &id
+	}
+	return ids[0], ids[1], nil
+}
+
+// capabilities returns capability names without the CAP_ prefix
+func capabilities(names []string) []corev1.Capability {
+	if len(names) == 0 {
+		return nil
+	}
+	caps := make([]corev1.Capability, 0, len(names))
+	for _, name := range names {
+		caps = append(caps, corev1.Capability(strings.TrimPrefix(strings.ToUpper(name), "CAP_")))
+	}
+	return caps
+}
+
+// containerSecurityContext returns a security context of the compose service svc container set with user, read_only, cap_add and cap_drop.
+// nil is returned when none of fields is set.
+func containerSecurityContext(svc types.ServiceConfig) (*corev1.SecurityContext, error) {
+	uid, gid, err := parseUser(svc.User)
+	if err != nil {
+		return nil, errors.Wrapf(ErrUnsupportedField, "service `%s`: %s", svc.Name, err)
+	}
+	if uid == nil && !svc.ReadOnly && len(svc.CapAdd) == 0 && len(svc.CapDrop) == 0 {
+		return nil, nil
+	}
+
+	sc := &corev1.SecurityContext{
+		RunAsUser:  uid,
+		RunAsGroup: gid,
+	}
+	if svc.ReadOnly {
+		readOnly := true
+		sc.ReadOnlyRootFilesystem = &readOnly
+	}
+	if len(svc.CapAdd) != 0 || len(svc.CapDrop) != 0 {
+		sc.Capabilities = &corev1.Capabilities{
+			Add:  capabilities(svc.CapAdd),
+			Drop: capabilities(svc.CapDrop),
+		}
+	}
+	return sc, nil
+}
+
+// tmpfsVolumes returns memory backed volumes and their mounts of the compose service svc tmpfs section.
+// Options follow the mount path, only the size option is used:
+//
+//	tmpfs:
+//	  - /run
+//	  - /tmp:size=64m
+func tmpfsVolumes(svc types.ServiceConfig) ([]corev1.Volume, []corev1.VolumeMount, error) {
+	volumes := make([]corev1.Volume, 0, len(svc.Tmpfs))
+	mounts := make([]corev1.VolumeMount, 0, len(svc.Tmpfs))
+	for i, tmpfs := range svc.Tmpfs {
+		parts := strings.SplitN(tmpfs, ":", 2)
+		if !strings.HasPrefix(parts[0], "/") {
+			return nil, nil, errors.Wrapf(ErrUnsupportedField, "tmpfs `%s` of service `%s` must be an absolute path", tmpfs, svc.Name)
+		}
+
+		emptyDir := &corev1.EmptyDirVolumeSource{
+			Medium: corev1.StorageMediumMemory,
+		}
+		if len(parts) == 2 {
+			for _, option := range strings.Split(parts[1], ",") {
+				if !strings.HasPrefix(option, "size=") {
+					continue
+				}
+				size, err := units.RAMInBytes(strings.TrimPrefix(option, "size="))
+				if err != nil || size <= 0 {
+					return nil, nil, errors.Wrapf(ErrUnsupportedField, "size of tmpfs `%s` of service `%s`", tmpfs, svc.Name)
+				}
+				emptyDir.SizeLimit = resource.NewQuantity(size, resource.BinarySI)
+			}
+		}
+
+		name := "tmpfs-" + svc.Name + "-" + strconv.Itoa(i)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: emptyDir,
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: parts[0],
+		})
+	}
+	return volumes, mounts, nil
+}
+
+// imagePullPolicy returns a pull policy of the compose service svc image
+func imagePullPolicy(svc types.ServiceConfig) (corev1.PullPolicy, error) {
+	switch svc.PullPolicy {
+	case "":
+		return "", nil
+	case types.PullPolicyAlways:
+		return corev1.PullAlways, nil
+	case types.PullPolicyNever:
+		return corev1.PullNever, nil
+	case types.PullPolicyIfNotPresent, types.PullPolicyMissing:
+		return corev1.PullIfNotPresent, nil
+	}
+	return "", errors.Wrapf(ErrUnsupportedField, "pull_policy `%s` of service `%s`", svc.PullPolicy, svc.Name)
+}
+
+// setContainerFields sets fields of the compose service svc to the container
+func setContainerFields(container *corev1.Container, svc types.ServiceConfig) error {
+	container.Command, container.Args = containerCommand(svc)
+	container.WorkingDir = svc.WorkingDir
+	container.TTY = svc.Tty
+	container.Stdin = svc.StdinOpen
+
+	var err error
+	if container.SecurityContext, err = containerSecurityContext(svc); err != nil {
+		return err
+	}
+	if container.ImagePullPolicy, err = imagePullPolicy(svc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// terminationGracePeriod returns the longest stop_grace_period of services
+func terminationGracePeriod(services types.Services) *int64 {
+	period := int64(-1)
+	for _, svc := range services {
+		if svc.StopGracePeriod == nil {
+			continue
+		}
+		if seconds := int64(math.Ceil(time.Duration(*svc.StopGracePeriod).Seconds())); seconds > period {
+			period = seconds
+		}
+	}
+	if period < 0 {
+		period = defaultTerminationGracePeriod
+	}
+	return &period
+}
+
+// serviceAnnotations returns labels of services, labels are set as pod annotations since their values are arbitrary
+func serviceAnnotations(services types.Services) map[string]string {
+	annotations := make(map[string]string)
+	for _, svc := range services {
+		for key, value := range svc.Labels {
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
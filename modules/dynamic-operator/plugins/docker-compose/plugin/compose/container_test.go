@@ -0,0 +1,262 @@
+package compose
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+func TestContainerFields(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+	duration := func(d time.Duration) *types.Duration {
+		v := types.Duration(d)
+		return &v
+	}
+
+	cases := []struct {
+		name    string
+		service types.ServiceConfig
+		check   func(t *testing.T, container corev1.Container, pod corev1.PodTemplateSpec)
+	}{
+		{
+			name:    "command",
+			service: types.ServiceConfig{Command: types.ShellCommand{"run", "--debug"}},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if diff := deep.Equal(container.Command, []string{"run", "--debug"}); diff != nil {
+					t.Error(diff)
+				}
+				if container.Args != nil {
+					t.Errorf("args are not expected: %v", container.Args)
+				}
+			},
+		},
+		{
+			name: "entrypoint",
+			service: types.ServiceConfig{
+				Entrypoint: types.ShellCommand{"/entrypoint.sh"},
+				Command:    types.ShellCommand{"run"},
+			},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if diff := deep.Equal(container.Command, []string{"/entrypoint.sh"}); diff != nil {
+					t.Error(diff)
+				}
+				if diff := deep.Equal(container.Args, []string{"run"}); diff != nil {
+					t.Error(diff)
+				}
+			},
+		},
+		{
+			name:    "working_dir",
+			service: types.ServiceConfig{WorkingDir: "/app"},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if container.WorkingDir != "/app" {
+					t.Errorf("unexpected working dir %s", container.WorkingDir)
+				}
+			},
+		},
+		{
+			name: "security context",
+			service: types.ServiceConfig{
+				User:     "1000:2000",
+				ReadOnly: true,
+				CapAdd:   []string{"CAP_NET_ADMIN"},
+				CapDrop:  []string{"all"},
+			},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if diff := deep.Equal(container.SecurityContext, &corev1.SecurityContext{
+					RunAsUser:              int64Ptr(1000),
+					RunAsGroup:             int64Ptr(2000),
+					ReadOnlyRootFilesystem: boolPtr(true),
+					Capabilities: &corev1.Capabilities{
+						Add:  []corev1.Capability{"NET_ADMIN"},
+						Drop: []corev1.Capability{"ALL"},
+					},
+				}); diff != nil {
+					t.Error(diff)
+				}
+			},
+		},
+		{
+			name:    "user without group",
+			service: types.ServiceConfig{User: "1000"},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if diff := deep.Equal(container.SecurityContext, &corev1.SecurityContext{RunAsUser: int64Ptr(1000)}); diff != nil {
+					t.Error(diff)
+				}
+			},
+		},
+		{
+			name:    "no security context",
+			service: types.ServiceConfig{},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if container.SecurityContext != nil {
+					t.Errorf("security context is not expected: %v", container.SecurityContext)
+				}
+			},
+		},
+		{
+			name:    "tmpfs",
+			service: types.ServiceConfig{Tmpfs: types.StringList{"/run", "/tmp:rw,size=64m"}},
+			check: func(t *testing.T, container corev1.Container, pod corev1.PodTemplateSpec) {
+				size := resource.MustParse("64Mi")
+				if diff := deep.Equal(pod.Spec.Volumes, []corev1.Volume{
+					{Name: "tmpfs-demo-0", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}}},
+					{Name: "tmpfs-demo-1", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory, SizeLimit: &size}}},
+				}); diff != nil {
+					t.Error(diff)
+				}
+				if diff := deep.Equal(container.VolumeMounts, []corev1.VolumeMount{
+					{Name: "tmpfs-demo-0", MountPath: "/run"},
+					{Name: "tmpfs-demo-1", MountPath: "/tmp"},
+				}); diff != nil {
+					t.Error(diff)
+				}
+			},
+		},
+		{
+			name:    "stop_grace_period",
+			service: types.ServiceConfig{StopGracePeriod: duration(90 * time.Second)},
+			check: func(t *testing.T, _ corev1.Container, pod corev1.PodTemplateSpec) {
+				if *pod.Spec.TerminationGracePeriodSeconds != 90 {
+					t.Errorf("unexpected grace period %d", *pod.Spec.TerminationGracePeriodSeconds)
+				}
+			},
+		},
+		{
+			name:    "default grace period",
+			service: types.ServiceConfig{},
+			check: func(t *testing.T, _ corev1.Container, pod corev1.PodTemplateSpec) {
+				if *pod.Spec.TerminationGracePeriodSeconds != defaultTerminationGracePeriod {
+					t.Errorf("unexpected grace period %d", *pod.Spec.TerminationGracePeriodSeconds)
+				}
+			},
+		},
+		{
+			name:    "labels",
+			service: types.ServiceConfig{Labels: types.Labels{"com.example.rule": "Host(`example.com`)"}},
+			check: func(t *testing.T, _ corev1.Container, pod corev1.PodTemplateSpec) {
+				if v := pod.GetAnnotations()["com.example.rule"]; v != "Host(`example.com`)" {
+					t.Errorf("unexpected label annotation %s", v)
+				}
+				if _, found := pod.GetAnnotations()[secretsChecksumAnnotation]; !found {
+					t.Error("secrets checksum annotation is not found")
+				}
+			},
+		},
+		{
+			name:    "tty and pull policy",
+			service: types.ServiceConfig{Tty: true, StdinOpen: true, PullPolicy: types.PullPolicyAlways},
+			check: func(t *testing.T, container corev1.Container, _ corev1.PodTemplateSpec) {
+				if !container.TTY || !container.Stdin || container.ImagePullPolicy != corev1.PullAlways {
+					t.Errorf("unexpected container %v", container)
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.service.Name, tc.service.Image = "demo", "demo"
+			p := &types.Project{
+				Name:     "test",
+				Services: types.Services{tc.service},
+			}
+			if err := ValidateComposeProject(p); err != nil {
+				t.Fatalf("project is not valid: %v", err)
+			}
+
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			if _, err := c.Reconcile(&commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1}); err != nil {
+				t.Fatalf("failed to reconcile: %v", err)
+			}
+			pod := c.deployment.Spec.Template
+			if len(pod.Spec.Containers) != 1 {
+				t.Fatalf("unexpected containers %v", pod.Spec.Containers)
+			}
+			tc.check(t, pod.Spec.Containers[0], pod)
+		})
+	}
+}
+
+func TestValidateContainerFields(t *testing.T) {
+	enabled := true
+	replicas := uint64(2)
+
+	cases := []struct {
+		name    string
+		service types.ServiceConfig
+		err     string
+	}{
+		{name: "build", service: types.ServiceConfig{Build: &types.BuildConfig{Context: "."}}, err: "`build` of service `demo`, use a prebuilt image"},
+		{name: "privileged", service: types.ServiceConfig{Privileged: true}, err: "`privileged` of service `demo`"},
+		{name: "ulimits", service: types.ServiceConfig{Ulimits: map[string]*types.UlimitsConfig{"nofile": {Single: 1024}}}, err: "`ulimits` of service `demo`"},
+		{name: "init", service: types.ServiceConfig{Init: &enabled}, err: "`init` of service `demo`"},
+		{name: "secrets", service: types.ServiceConfig{Secrets: []types.ServiceSecretConfig{{Source: "token"}}}, err: "use x-kuberlogic-secrets"},
+		{name: "mem_limit", service: types.ServiceConfig{MemLimit: 1024}, err: "use deploy.resources"},
+		{name: "stop_signal", service: types.ServiceConfig{StopSignal: "SIGINT"}, err: "`stop_signal` of service `demo`"},
+		{name: "deploy replicas", service: types.ServiceConfig{Deploy: &types.DeployConfig{Replicas: &replicas}}, err: "`deploy.replicas` of service `demo`"},
+		{name: "restart", service: types.ServiceConfig{Restart: types.RestartPolicyOnFailure}, err: "restart policy `on-failure`"},
+		{name: "user name", service: types.ServiceConfig{User: "www-data"}, err: "user `www-data` must be a numeric uid[:gid]"},
+		{name: "relative tmpfs", service: types.ServiceConfig{Tmpfs: types.StringList{"run"}}, err: "tmpfs `run` of service `demo` must be an absolute path"},
+		{name: "tmpfs size", service: types.ServiceConfig{Tmpfs: types.StringList{"/run:size=big"}}, err: "size of tmpfs `/run:size=big`"},
+		{name: "pull policy", service: types.ServiceConfig{PullPolicy: types.PullPolicyBuild}, err: "pull_policy `build`"},
+		{name: "label key", service: types.ServiceConfig{Labels: types.Labels{"invalid key": "value"}}, err: "label `invalid key`"},
+		{name: "reserved label", service: types.ServiceConfig{Labels: types.Labels{"kuberlogic.com/env-checksum": "value"}}, err: "reserved prefix"},
+		{name: "link alias", service: types.ServiceConfig{Links: []string{"db:database"}}, err: "alias of link `db:database`"},
+		{
+			name:    "network alias",
+			service: types.ServiceConfig{Networks: map[string]*types.ServiceNetworkConfig{"default": {Aliases: []string{"web"}}}},
+			err:     "aliases and addresses of network `default`",
+		},
+		{
+			name: "completed dependency",
+			service: types.ServiceConfig{DependsOn: types.DependsOnConfig{
+				"demo": types.ServiceDependency{Condition: types.ServiceConditionCompletedSuccessfully},
+			}},
+			err: "condition `service_completed_successfully`",
+		},
+		{name: "default network", service: types.ServiceConfig{Networks: map[string]*types.ServiceNetworkConfig{"default": nil}}},
+		{name: "link", service: types.ServiceConfig{Links: []string{"demo"}}},
+		{name: "restart unless-stopped", service: types.ServiceConfig{Restart: types.RestartPolicyUnlessStopped}},
+		{
+			name: "one-shot restart",
+			service: types.ServiceConfig{
+				Restart:    types.RestartPolicyOnFailure,
+				Extensions: map[string]interface{}{OneShotExtension: true},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.service.Name, tc.service.Image = "demo", "demo"
+			err := ValidateComposeProject(&types.Project{
+				Name:     "test",
+				Services: types.Services{tc.service},
+			})
+
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrUnsupportedField) {
+				t.Fatalf("expected unsupported field error, got: %v", err)
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("error `%s` does not contain `%s`", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package compose
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// cronJobLabel is set on cron jobs and their pods, the value is the cron job name of the x-kuberlogic-cronjobs extension
+const cronJobLabel = "docker-compose.service/cronjob"
+
+// cronJobDefinition is a key of the x-kuberlogic-cronjobs extension.
+// The compose service is run with the command on the schedule, the service command is run when the command is not set.
+// The command is run with a shell when it is a string:
+//
+//	x-kuberlogic-cronjobs:
+//	  cleanup:
+//	    schedule: "0 3 * * *"
+//	    service: web
+//	    command: ["app", "cleanup"]
+//	  digest:
+//	    schedule: "@weekly"
+//	    service: web
+//	    command: app send-digest --all
+type cronJobDefinition struct {
+	name     string
+	schedule string
+	service  string
+	command  types.ShellCommand
+}
+
+// cronJobDefinitions decodes the x-kuberlogic-cronjobs extension of the project p, definitions are sorted by name
+func cronJobDefinitions(p *types.Project) ([]cronJobDefinition, error) {
+	raw, set := p.Extensions[CronJobsExtension]
+	if !set {
+		return nil, nil
+	}
+	cronJobs, converted := raw.(map[string]interface{})
+	if !converted {
+		return nil, errors.Wrapf(ErrCronJobsDecodeFailed, "failed to decode parameter %s", CronJobsExtension)
+	}
+
+	definitions := make([]cronJobDefinition, 0, len(cronJobs))
+	for k, v := range cronJobs {
+		if errs := validation.IsDNS1123Label(k); len(errs) != 0 {
+			return nil, errors.Wrapf(ErrCronJobsDecodeFailed, "invalid name of cron job `%s`", k)
+		}
+		fields, converted := v.(map[string]interface{})
+		if !converted {
+			return nil, errors.Wrapf(ErrCronJobsDecodeFailed, "it is expected that cron job `%s` value is object", k)
+		}
+
+		def := cronJobDefinition{
+			name: k,
+		}
+		for field, fieldValue := range fields {
+			var ok bool
+			switch field {
+			case "schedule":
+				def.schedule, ok = fieldValue.(string)
+			case "service":
+				def.service, ok = fieldValue.(string)
+			case "command":
+				def.command, ok = cronJobCommand(fieldValue)
+			default:
+				return nil, errors.Wrapf(ErrCronJobsDecodeFailed, "unknown field `%s` of cron job `%s`", field, k)
+			}
+			if !ok {
+				return nil, errors.Wrapf(ErrCronJobsDecodeFailed, "invalid type of field `%s` of cron job `%s`", field, k)
+			}
+		}
+
+		if _, err := cron.ParseStandard(def.schedule); err != nil {
+			return nil, errors.Wrapf(ErrCronJobsDecodeFailed, "invalid schedule `%s` of cron job `%s`", def.schedule, k)
+		}
+		definitions = append(definitions, def)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].name < definitions[j].name
+	})
+	return definitions, nil
+}
+
+// cronJobCommand converts the command of a cron job, string commands are run with a shell
+func cronJobCommand(raw interface{}) (types.ShellCommand, bool) {
+	switch value := raw.(type) {
+	case string:
+		return types.ShellCommand{"sh", "-c", value}, value != ""
+	case []interface{}:
+		command := make(types.ShellCommand, 0, len(value))
+		for _, arg := range value {
+			s, converted := arg.(string)
+			if !converted {
+				return nil, false
+			}
+			command = append(command, s)
+		}
+		return command, len(command) != 0
+	}
+	return nil, false
+}
+
+// validateCronJobs checks the x-kuberlogic-cronjobs extension of the project p.
+// Claims of volumes of services run by statefulsets can't be mounted by cron jobs.
+func validateCronJobs(p *types.Project) error {
+	definitions, err := cronJobDefinitions(p)
+	if err != nil {
+		return err
+	}
+	volumes := statefulVolumes(p)
+	for _, def := range definitions {
+		svc, err := p.GetService(def.service)
+		if err != nil {
+			return errors.Wrapf(ErrCronJobsDecodeFailed, "cron job `%s` runs unknown service `%s`", def.name, def.service)
+		}
+		for _, v := range svc.Volumes {
+			if volumes[v.Source] {
+				return errors.Wrapf(ErrCronJobsDecodeFailed, "cron job `%s` can't mount volume `%s` of stateful services", def.name, v.Source)
+			}
+		}
+	}
+	return nil
+}
+
+// cronJobName returns a name of the cron job of the x-kuberlogic-cronjobs extension of the kuberlogic service name.
+// Cron job names are limited to 52 characters since names of their jobs get a suffix of 11 characters,
+// longer names are truncated and suffixed with a hash of the full name to keep them unique.
+func cronJobName(name, cronJob string) string {
+	full := name + "-" + cronJob
+	if len(full) <= 52 {
+		return full
+	}
+	hash := md5.Sum([]byte(full))
+	return strings.TrimRight(full[:43], "-") + "-" + hex.EncodeToString(hash[:])[:8]
+}
+
+// cronJob returns a cron job by the name, the cron job is created when it is not found
+func (c *ComposeModel) cronJob(name string) *batchv1.CronJob {
+	cronJob, found := c.cronJobs[name]
+	if !found {
+		cronJob = &batchv1.CronJob{}
+		c.cronJobs[name] = cronJob
+	}
+	return cronJob
+}
+
+// sortedCronJobs returns cron jobs ordered by name
+func (c *ComposeModel) sortedCronJobs() []*batchv1.CronJob {
+	cronJobs := make([]*batchv1.CronJob, 0, len(c.cronJobs))
+	for _, cronJob := range c.cronJobs {
+		cronJobs = append(cronJobs, cronJob)
+	}
+	sort.Slice(cronJobs, func(i, j int) bool {
+		return cronJobs[i].GetName() < cronJobs[j].GetName()
+	})
+	return cronJobs
+}
+
+// composeService returns the compose service by the name, one-shot services are included
+func (c *ComposeModel) composeService(name string) (types.ServiceConfig, bool) {
+	for _, svc := range append(c.sortedServices(), c.oneShotServices...) {
+		if svc.Name == name {
+			return svc, true
+		}
+	}
+	return types.ServiceConfig{}, false
+}
+
+// setCronJobs runs clones of compose services by cron jobs of the x-kuberlogic-cronjobs extension.
+// Clones get the same environment, secrets and volumes, but they don't expose ports and are not checked for health.
+// Runs of a cron job don't overlap, cron jobs removed from the extension are deleted.
+func (c *ComposeModel) setCronJobs(req *commons.PluginRequest) error {
+	definitions, err := cronJobDefinitions(c.composeProject)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]bool, len(definitions))
+	for _, def := range definitions {
+		svc, found := c.composeService(def.service)
+		if !found {
+			return errors.Wrapf(ErrCronJobsDecodeFailed, "cron job `%s` runs unknown service `%s`", def.name, def.service)
+		}
+		svc.Ports, svc.HealthCheck = nil, nil
+		if len(def.command) != 0 {
+			svc.Command = def.command
+		}
+
+		template := corev1.PodTemplateSpec{}
+		if err := c.setPodTemplate(&template, map[string]string{cronJobLabel: def.name}, types.Services{svc}, nil, req); err != nil {
+			return err
+		}
+		template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+
+		name := cronJobName(req.Name, def.name)
+		current[name] = true
+		cronJob := c.cronJob(name)
+		cronJob.SetName(name)
+		cronJob.SetNamespace(req.Namespace)
+		cronJobLabels := labels(req.Name)
+		cronJobLabels[cronJobLabel] = def.name
+		cronJob.SetLabels(cronJobLabels)
+
+		cronJob.Spec.Schedule = def.schedule
+		cronJob.Spec.ConcurrencyPolicy = batchv1.ForbidConcurrent
+		cronJob.Spec.JobTemplate.Spec.Template = template
+		c.logger.Debug("set cronjob", "object", cronJob)
+	}
+
+	for name := range c.cronJobs {
+		if !current[name] {
+			delete(c.cronJobs, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package compose
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// envChecksumAnnotation is set on the deployment pod template, pods are rolled when secret environment variables are changed
+const envChecksumAnnotation = "kuberlogic.com/env-checksum"
+
+const (
+	parameterTypeString  = "string"
+	parameterTypeInteger = "integer"
+	parameterTypeBoolean = "boolean"
+)
+
+// parameterSchema decodes the x-kuberlogic-parameters extension of the project p, parameters are sorted by name.
+// Each parameter is an environment variable that users can set:
+//
+//	x-kuberlogic-parameters:
+//	  MAX_UPLOAD_SIZE:
+//	    description: maximum size of uploaded files in megabytes
+//	    type: integer
+//	    default: "10"
+//	    container: app
+//	  SMTP_PASSWORD:
+//	    secret: true
+func parameterSchema(p *types.Project) ([]commons.ParameterSchema, error) {
+	raw, set := p.Extensions[ParametersExtension]
+	if !set {
+		return nil, nil
+	}
+	parameters, converted := raw.(map[string]interface{})
+	if !converted {
+		return nil, errors.Wrapf(ErrParametersDecodeFailed, "failed to decode parameter %s", ParametersExtension)
+	}
+
+	schema := make([]commons.ParameterSchema, 0, len(parameters))
+	for name, v := range parameters {
+		parameter := commons.ParameterSchema{
+			Name: name,
+			Type: parameterTypeString,
+		}
+		fields, converted := v.(map[string]interface{})
+		if !converted && v != nil {
+			return nil, errors.Wrapf(ErrParametersDecodeFailed, "it is expected that parameter `%s` value is object", name)
+		}
+		for field, fieldValue := range fields {
+			var ok bool
+			switch field {
+			case "description":
+				parameter.Description, ok = fieldValue.(string)
+			case "type":
+				parameter.Type, ok = fieldValue.(string)
+			case "default":
+				parameter.Default, ok = fieldValue.(string)
+			case "container":
+				parameter.Container, ok = fieldValue.(string)
+			case "secret":
+				parameter.Secret, ok = fieldValue.(bool)
+			default:
+				return nil, errors.Wrapf(ErrParametersDecodeFailed, "unknown field `%s` of parameter `%s`", field, name)
+			}
+			if !ok {
+				return nil, errors.Wrapf(ErrParametersDecodeFailed, "invalid type of field `%s` of parameter `%s`", field, name)
+			}
+		}
+
+		if parameter.Container != "" {
+			if _, err := p.GetService(parameter.Container); err != nil {
+				return nil, errors.Wrapf(ErrParametersDecodeFailed, "container `%s` of parameter `%s` is not found", parameter.Container, name)
+			}
+		}
+		switch parameter.Type {
+		case parameterTypeString, parameterTypeInteger, parameterTypeBoolean:
+		default:
+			return nil, errors.Wrapf(ErrParametersDecodeFailed, "unknown type `%s` of parameter `%s`", parameter.Type, name)
+		}
+		if parameter.Default != "" {
+			if err := checkParameterType(parameter.Type, parameter.Default); err != nil {
+				return nil, errors.Wrapf(ErrParametersDecodeFailed, "default value of parameter `%s`: %s", name, err)
+			}
+		}
+		schema = append(schema, parameter)
+	}
+	sort.Slice(schema, func(i, j int) bool {
+		return schema[i].Name < schema[j].Name
+	})
+	return schema, nil
+}
+
+// checkParameterType returns an error when value does not match the parameter type t
+func checkParameterType(t, value string) error {
+	switch t {
+	case parameterTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("`%s` is not an integer", value)
+		}
+	case parameterTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("`%s` is not a boolean", value)
+		}
+	}
+	return nil
+}
+
+// Parameters returns a schema of environment variables supported by the compose project
+func (c *ComposeModel) Parameters() ([]commons.ParameterSchema, error) {
+	return parameterSchema(c.composeProject)
+}
+
+// ValidateEnv checks environment variables set by users against the compose project p.
+// Any variable can be set when the project does not declare parameters.
+func ValidateEnv(p *types.Project, env []commons.EnvVar) error {
+	schema, err := parameterSchema(p)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(env))
+	for _, e := range env {
+		key := e.Container + "/" + e.Name
+		if seen[key] {
+			return errors.Wrapf(ErrInvalidEnvVar, "`%s` is set twice", e.Name)
+		}
+		seen[key] = true
+
+		if e.Container != "" {
+			if _, err := p.GetService(e.Container); err != nil {
+				return errors.Wrapf(ErrInvalidEnvVar, "container `%s` of `%s` is not found", e.Container, e.Name)
+			}
+		}
+		if len(schema) == 0 {
+			continue
+		}
+
+		parameter, found := findParameter(schema, e)
+		if !found {
+			return errors.Wrapf(ErrInvalidEnvVar, "`%s` is not supported", e.Name)
+		}
+		if parameter.Secret && e.SecretName == "" {
+			return errors.Wrapf(ErrInvalidEnvVar, "`%s` must be a secret", e.Name)
+		}
+		if e.SecretName == "" {
+			if err := checkParameterType(parameter.Type, e.Value); err != nil {
+				return errors.Wrapf(ErrInvalidEnvVar, "`%s`: %s", e.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// advancedEnvVars returns environment variables of the spec.advanced parameters sorted by name.
+// They are set like variables of spec.env without a container,
+// parameters of the x-kuberlogic-parameters extension scoped to a container are set for the container only.
+func advancedEnvVars(p *types.Project, parameters map[string]interface{}) ([]commons.EnvVar, error) {
+	schema, err := parameterSchema(p)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make([]commons.EnvVar, 0, len(parameters))
+	for name, raw := range parameters {
+		e := commons.EnvVar{Name: name}
+		switch value := raw.(type) {
+		case string:
+			e.Value = value
+		case bool:
+			e.Value = strconv.FormatBool(value)
+		case float64:
+			e.Value = strconv.FormatFloat(value, 'f', -1, 64)
+		case int64:
+			e.Value = strconv.FormatInt(value, 10)
+		default:
+			return nil, errors.Wrapf(ErrInvalidEnvVar, "advanced parameter `%s` must be a string, a number or a boolean", name)
+		}
+		for _, parameter := range schema {
+			if parameter.Name == name && parameter.Container != "" {
+				e.Container = parameter.Container
+			}
+		}
+		env = append(env, e)
+	}
+	sort.Slice(env, func(i, j int) bool {
+		return env[i].Name < env[j].Name
+	})
+	return env, nil
+}
+
+// ValidateAdvanced checks the spec.advanced parameters against the compose project p like environment variables set by users
+func ValidateAdvanced(p *types.Project, parameters map[string]interface{}) error {
+	env, err := advancedEnvVars(p, parameters)
+	if err != nil {
+		return err
+	}
+	if err := ValidateEnv(p, env); err != nil {
+		return errors.Wrap(err, "advanced parameters")
+	}
+	return nil
+}
+
+// findParameter returns a parameter that can be set by the variable e
+func findParameter(schema []commons.ParameterSchema, e commons.EnvVar) (commons.ParameterSchema, bool) {
+	for _, parameter := range schema {
+		if parameter.Name == e.Name && (parameter.Container == "" || parameter.Container == e.Container) {
+			return parameter, true
+		}
+	}
+	return commons.ParameterSchema{}, false
+}
+
+// userEnvVars returns environment variables set by users for container, container scoped variables take precedence
+func userEnvVars(req *commons.PluginRequest, container string) []corev1.EnvVar {
+	envs := make([]corev1.EnvVar, 0)
+	for _, scoped := range []bool{false, true} {
+		for _, e := range req.Env {
+			if (e.Container != "") != scoped || (scoped && e.Container != container) {
+				continue
+			}
+
+			v := corev1.EnvVar{
+				Name:  e.Name,
+				Value: e.Value,
+			}
+			if e.SecretName != "" {
+				v.Value = ""
+				v.ValueFrom = &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: e.SecretName,
+						},
+						Key: e.SecretKey,
+					},
+				}
+			}
+			envs = append(envs, v)
+		}
+	}
+	return envs
+}
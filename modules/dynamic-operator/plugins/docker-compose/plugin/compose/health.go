@@ -0,0 +1,254 @@
+package compose
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// dependencyWaitImage runs init containers waiting for dependencies of compose services in the split mode
+	dependencyWaitImage = "busybox:1.28"
+
+	// defaults of the docker healthcheck
+	healthCheckDefaultInterval = 30 * time.Second
+	healthCheckDefaultTimeout  = 30 * time.Second
+	healthCheckDefaultRetries  = 3
+)
+
+// healthCheckEnabled returns true when the compose service svc declares a healthcheck that is not disabled
+func healthCheckEnabled(svc types.ServiceConfig) bool {
+	hc := svc.HealthCheck
+	if hc == nil || hc.Disable {
+		return false
+	}
+	return len(hc.Test) == 0 || hc.Test[0] != "NONE"
+}
+
+// healthCheckCommand returns the command of the compose healthcheck test
+func healthCheckCommand(test types.HealthCheckTest) []string {
+	if len(test) == 0 {
+		return nil
+	}
+	switch test[0] {
+	case "CMD":
+		return test[1:]
+	case "CMD-SHELL":
+		return []string{"sh", "-c", strings.Join(test[1:], " ")}
+	}
+	return nil
+}
+
+// healthCheckHandler returns a probe handler of the compose service svc healthcheck.
+// The healthcheck test is run in the container unless the HTTP or TCP check is set with extensions:
+//
+//	services:
+//	  web:
+//	    image: nginx
+//	    ports:
+//	      - 8080:80
+//	    healthcheck:
+//	      x-kuberlogic-http-get: /healthz
+//	  db:
+//	    image: postgres
+//	    healthcheck:
+//	      x-kuberlogic-tcp-socket: 5432
+func healthCheckHandler(svc types.ServiceConfig) (corev1.Handler, error) {
+	hc := svc.HealthCheck
+	var handlers []corev1.Handler
+
+	if raw, set := hc.Extensions[HealthCheckHTTPGetExtension]; set {
+		path, converted := raw.(string)
+		if !converted || !strings.HasPrefix(path, "/") {
+			return corev1.Handler{}, errors.Wrapf(ErrHealthCheckDecodeFailed, "`%s` of service `%s` must be an HTTP path", HealthCheckHTTPGetExtension, svc.Name)
+		}
+		if len(svc.Ports) == 0 {
+			return corev1.Handler{}, errors.Wrapf(ErrHealthCheckDecodeFailed, "service `%s` does not expose ports for `%s`", svc.Name, HealthCheckHTTPGetExtension)
+		}
+		handlers = append(handlers, corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromInt(int(svc.Ports[0].Target)),
+			},
+		})
+	}
+	if raw, set := hc.Extensions[HealthCheckTCPSocketExtension]; set {
+		port, converted := raw.(int)
+		if !converted || port <= 0 || port > math.MaxUint16 {
+			return corev1.Handler{}, errors.Wrapf(ErrHealthCheckDecodeFailed, "`%s` of service `%s` must be a port number", HealthCheckTCPSocketExtension, svc.Name)
+		}
+		handlers = append(handlers, corev1.Handler{
+			TCPSocket: &corev1.TCPSocketAction{
+				Port: intstr.FromInt(port),
+			},
+		})
+	}
+	if len(hc.Test) != 0 {
+		command := healthCheckCommand(hc.Test)
+		if len(command) == 0 {
+			return corev1.Handler{}, errors.Wrapf(ErrHealthCheckDecodeFailed, "unsupported test `%s` of service `%s`", strings.Join(hc.Test, " "), svc.Name)
+		}
+		handlers = append(handlers, corev1.Handler{
+			Exec: &corev1.ExecAction{
+				Command: command,
+			},
+		})
+	}
+
+	if len(handlers) != 1 {
+		return corev1.Handler{}, errors.Wrapf(ErrHealthCheckDecodeFailed, "service `%s` must set exactly one of test, %s or %s",
+			svc.Name, HealthCheckHTTPGetExtension, HealthCheckTCPSocketExtension)
+	}
+	return handlers[0], nil
+}
+
+// probeSeconds returns the duration d in seconds rounded up, def is used when d is not set
+func probeSeconds(d *types.Duration, def time.Duration) int32 {
+	duration := def
+	if d != nil {
+		duration = time.Duration(*d)
+	}
+	seconds := int32(math.Ceil(duration.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+// healthCheckProbe returns a probe of the compose service svc healthcheck, nil is returned when the healthcheck is disabled
+func healthCheckProbe(svc types.ServiceConfig) (*corev1.Probe, error) {
+	if !healthCheckEnabled(svc) {
+		return nil, nil
+	}
+	handler, err := healthCheckHandler(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	hc := svc.HealthCheck
+	probe := &corev1.Probe{
+		Handler:          handler,
+		PeriodSeconds:    probeSeconds(hc.Interval, healthCheckDefaultInterval),
+		TimeoutSeconds:   probeSeconds(hc.Timeout, healthCheckDefaultTimeout),
+		FailureThreshold: healthCheckDefaultRetries,
+	}
+	if hc.Retries != nil && *hc.Retries > 0 {
+		probe.FailureThreshold = int32(*hc.Retries)
+	}
+	if hc.StartPeriod != nil {
+		probe.InitialDelaySeconds = probeSeconds(hc.StartPeriod, 0)
+	}
+	return probe, nil
+}
+
+// healthyDependencies returns sorted names of services the compose service svc waits to become healthy
+func healthyDependencies(svc types.ServiceConfig) []string {
+	var dependencies []string
+	for name, dependency := range svc.DependsOn {
+		if dependency.Condition == types.ServiceConditionHealthy {
+			dependencies = append(dependencies, name)
+		}
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// awaitedService returns true when any service of the project p waits the compose service name to become healthy
+func awaitedService(p *types.Project, name string) bool {
+	for _, svc := range p.Services {
+		for _, dependency := range healthyDependencies(svc) {
+			if dependency == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// startOrder returns the number of healthy dependencies that are started before the compose service name
+func startOrder(p *types.Project, name string, seen map[string]bool) int {
+	svc, err := p.GetService(name)
+	if err != nil || seen[name] {
+		return 0
+	}
+	seen[name] = true
+	defer delete(seen, name)
+
+	order := 0
+	for _, dependency := range healthyDependencies(svc) {
+		if o := startOrder(p, dependency, seen) + 1; o > order {
+			order = o
+		}
+	}
+	return order
+}
+
+// validateDependencies checks healthchecks of compose services and their healthy dependencies.
+// Containers of a pod wait for dependencies with the healthcheck test, so only tests are supported without the split mode.
+func validateDependencies(p *types.Project) error {
+	for _, svc := range p.Services {
+		if _, err := healthCheckProbe(svc); err != nil {
+			return err
+		}
+
+		for _, name := range healthyDependencies(svc) {
+			dependency, err := p.GetService(name)
+			if err != nil {
+				return errors.Wrapf(ErrDependencyNotSupported, "service `%s` depends on unknown service `%s`", svc.Name, name)
+			}
+			if !healthCheckEnabled(dependency) {
+				return errors.Wrapf(ErrDependencyNotSupported, "service `%s` depends on service `%s` that does not declare a healthcheck", svc.Name, name)
+			}
+			if !splitMode(p) && len(dependency.HealthCheck.Test) == 0 {
+				return errors.Wrapf(ErrDependencyNotSupported, "service `%s` can be awaited only with the healthcheck test unless %s is set", name, SplitExtension)
+			}
+		}
+	}
+	return nil
+}
+
+// dependencyHook returns a hook of the compose service svc container that waits until the healthcheck test succeeds.
+// Containers of the pod are started in order, the next container is not started until the hook completes.
+func (c *ComposeModel) dependencyHook(svc types.ServiceConfig) *corev1.Lifecycle {
+	if c.split || !healthCheckEnabled(svc) || !awaitedService(c.composeProject, svc.Name) {
+		return nil
+	}
+	command := healthCheckCommand(svc.HealthCheck.Test)
+	if len(command) == 0 {
+		return nil
+	}
+	interval := probeSeconds(svc.HealthCheck.Interval, time.Second)
+	return &corev1.Lifecycle{
+		PostStart: &corev1.Handler{
+			Exec: &corev1.ExecAction{
+				Command: append([]string{"sh", "-c", `until "$@"; do sleep ` + strconv.Itoa(int(interval)) + `; done`, "wait-healthy"}, command...),
+			},
+		},
+	}
+}
+
+// dependencyWaitContainers returns init containers waiting until healthy dependencies of services are resolved.
+// Headless services of compose services list ready pods only, so dependencies are resolved when they are healthy.
+func (c *ComposeModel) dependencyWaitContainers(services types.Services) []corev1.Container {
+	if !c.split {
+		return nil
+	}
+	var containers []corev1.Container
+	for _, svc := range services {
+		for _, dependency := range healthyDependencies(svc) {
+			containers = append(containers, corev1.Container{
+				Name:    "wait-" + dependency,
+				Image:   dependencyWaitImage,
+				Command: []string{"sh", "-c", "until nslookup " + dependency + "; do sleep 2; done"},
+			})
+		}
+	}
+	return containers
+}
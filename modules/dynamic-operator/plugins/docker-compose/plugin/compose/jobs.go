@@ -0,0 +1,266 @@
+package compose
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// jobLabel is set on jobs of one-shot compose services and their pods, the value is the compose service name
+const jobLabel = "docker-compose.service/job"
+
+// oneShotService returns true when the compose service svc sets the x-kuberlogic-oneshot extension.
+// One-shot services are run to completion by jobs on create and on every version change,
+// services that depend on them with the service_completed_successfully condition are not updated until the job succeeds:
+//
+//	services:
+//	  migrate:
+//	    image: app:{{ .Version }}
+//	    command: ["app", "migrate"]
+//	    restart: on-failure:3
+//	    x-kuberlogic-oneshot: true
+//	  web:
+//	    image: app:{{ .Version }}
+//	    depends_on:
+//	      migrate:
+//	        condition: service_completed_successfully
+func oneShotService(svc types.ServiceConfig) bool {
+	oneShot, _ := svc.Extensions[OneShotExtension].(bool)
+	return oneShot
+}
+
+// workloadServices returns compose services of the project p that are run by deployments or statefulsets
+func workloadServices(p *types.Project) types.Services {
+	services := make(types.Services, 0, len(p.Services))
+	for _, svc := range p.Services {
+		if !oneShotService(svc) {
+			services = append(services, svc)
+		}
+	}
+	return services
+}
+
+// oneShotDependencies returns sorted names of one-shot services the compose service svc waits to complete
+func oneShotDependencies(svc types.ServiceConfig) []string {
+	var dependencies []string
+	for name, dependency := range svc.DependsOn {
+		if dependency.Condition == types.ServiceConditionCompletedSuccessfully {
+			dependencies = append(dependencies, name)
+		}
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// oneShotRetries returns the number of retries of the one-shot compose service svc set with the restart policy.
+// Services are not restarted with the "no" policy, the default of the job is used when on-failure does not set the number.
+func oneShotRetries(svc types.ServiceConfig) (*int32, error) {
+	restart := svc.Restart
+	switch {
+	case restart == "" || restart == types.RestartPolicyNo:
+		retries := int32(0)
+		return &retries, nil
+	case restart == types.RestartPolicyOnFailure:
+		return nil, nil
+	case strings.HasPrefix(restart, types.RestartPolicyOnFailure+":"):
+		retries, err := strconv.ParseInt(strings.TrimPrefix(restart, types.RestartPolicyOnFailure+":"), 10, 32)
+		if err != nil || retries < 0 {
+			return nil, errors.Wrapf(ErrOneShotNotSupported, "invalid restart policy `%s` of service `%s`", restart, svc.Name)
+		}
+		r := int32(retries)
+		return &r, nil
+	}
+	return nil, errors.Wrapf(ErrOneShotNotSupported, "restart policy `%s` of service `%s`, use no or on-failure", restart, svc.Name)
+}
+
+// validateOneShot checks the x-kuberlogic-oneshot extension of compose services.
+// One-shot services can't be reached by other services, so they can't publish ports or be awaited to become healthy.
+// Pods of jobs can't resolve containers of other pods without the split mode, so only then one-shot services can depend on other services.
+func validateOneShot(p *types.Project) error {
+	volumes := statefulVolumes(p)
+	for _, svc := range p.Services {
+		raw, set := svc.Extensions[OneShotExtension]
+		if set {
+			if _, converted := raw.(bool); !converted {
+				return errors.Wrapf(ErrOneShotDecodeFailed, "failed to decode parameter `%s` in service `%s`", OneShotExtension, svc.Name)
+			}
+		}
+
+		for name, dependency := range svc.DependsOn {
+			target, err := p.GetService(name)
+			if err != nil || !oneShotService(target) {
+				continue
+			}
+			if oneShotService(svc) {
+				return errors.Wrapf(ErrOneShotNotSupported, "one-shot service `%s` can't depend on one-shot service `%s`", svc.Name, name)
+			}
+			if dependency.Condition == types.ServiceConditionHealthy {
+				return errors.Wrapf(ErrOneShotNotSupported, "service `%s` can't wait one-shot service `%s` to become healthy", svc.Name, name)
+			}
+		}
+
+		if !oneShotService(svc) {
+			continue
+		}
+		if _, err := oneShotRetries(svc); err != nil {
+			return err
+		}
+		if len(svc.Ports) != 0 {
+			return errors.Wrapf(ErrOneShotNotSupported, "one-shot service `%s` can't publish ports", svc.Name)
+		}
+		for _, extension := range []string{StatefulExtension, StatelessExtension, SetCredentialsCmdExtension} {
+			if _, set := svc.Extensions[extension]; set {
+				return errors.Wrapf(ErrOneShotNotSupported, "one-shot service `%s` can't set %s", svc.Name, extension)
+			}
+		}
+		if len(svc.DependsOn) != 0 && !splitMode(p) {
+			return errors.Wrapf(ErrOneShotNotSupported, "one-shot service `%s` can depend on services only when %s is set", svc.Name, SplitExtension)
+		}
+		for _, v := range svc.Volumes {
+			if volumes[v.Source] {
+				return errors.Wrapf(ErrOneShotNotSupported, "one-shot service `%s` can't mount volume `%s` of stateful services", svc.Name, v.Source)
+			}
+		}
+	}
+	return nil
+}
+
+// statefulVolumes returns compose volumes claimed by statefulsets, their claims can't be mounted by jobs
+func statefulVolumes(p *types.Project) map[string]bool {
+	volumes := make(map[string]bool)
+	for _, svc := range workloadServices(p) {
+		if !statefulService(svc) && (splitMode(p) || !statefulProject(p)) {
+			continue
+		}
+		for _, v := range svc.Volumes {
+			volumes[v.Source] = true
+		}
+	}
+	return volumes
+}
+
+// jobName returns a name of the job of the one-shot compose service of the kuberlogic service name for the version.
+// Job names are limited to 63 characters since they are set as labels of job pods.
+func jobName(name, service, version string) string {
+	hash := md5.Sum([]byte(version))
+	prefix := name + "-" + service
+	if len(prefix) > 54 {
+		prefix = strings.TrimRight(prefix[:54], "-")
+	}
+	return prefix + "-" + hex.EncodeToString(hash[:])[:8]
+}
+
+// job returns a job by the name, the job is created when it is not found
+func (c *ComposeModel) job(name string) *batchv1.Job {
+	job, found := c.jobs[name]
+	if !found {
+		job = &batchv1.Job{}
+		c.jobs[name] = job
+	}
+	return job
+}
+
+// sortedJobs returns jobs of one-shot services ordered by name
+func (c *ComposeModel) sortedJobs() []*batchv1.Job {
+	jobs := make([]*batchv1.Job, 0, len(c.jobs))
+	for _, job := range c.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetName() < jobs[j].GetName()
+	})
+	return jobs
+}
+
+// setJobs runs one-shot services by jobs of the requested version.
+// Jobs can't be changed once they are created, jobs of previous versions are deleted.
+func (c *ComposeModel) setJobs(req *commons.PluginRequest) error {
+	current := make(map[string]bool, len(c.oneShotServices))
+	for _, svc := range c.oneShotServices {
+		name := jobName(req.Name, svc.Name, req.Version)
+		current[name] = true
+
+		// volume claims of the service are set even when the job is found
+		template := corev1.PodTemplateSpec{}
+		if err := c.setPodTemplate(&template, map[string]string{jobLabel: svc.Name}, types.Services{svc}, nil, req); err != nil {
+			return err
+		}
+		retries, err := oneShotRetries(svc)
+		if err != nil {
+			return err
+		}
+		template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+		if retries != nil && *retries == 0 {
+			template.Spec.RestartPolicy = corev1.RestartPolicyNever
+		}
+
+		job := c.job(name)
+		if job.GetName() == "" {
+			job.SetName(name)
+			job.SetNamespace(req.Namespace)
+			job.Spec.BackoffLimit = retries
+			job.Spec.Template = template
+		}
+		jobLabels := labels(req.Name)
+		jobLabels[jobLabel] = svc.Name
+		job.SetLabels(jobLabels)
+		c.logger.Debug("set job", "object", job)
+	}
+
+	for name := range c.jobs {
+		if !current[name] {
+			delete(c.jobs, name)
+		}
+	}
+	return nil
+}
+
+// jobSucceeded returns true when the job of the one-shot compose service name has completed for the requested version
+func (c *ComposeModel) jobSucceeded(name string, req *commons.PluginRequest) bool {
+	job, found := c.jobs[jobName(req.Name, name, req.Version)]
+	return found && job.Status.Succeeded > 0
+}
+
+// holdWorkload keeps the previous pod template of the workload running services until jobs of one-shot services they depend on succeed.
+// Workloads that are not found in the cluster are not scaled up until then.
+func (c *ComposeModel) holdWorkload(template, previous *corev1.PodTemplateSpec, replicas *int32, services types.Services, req *commons.PluginRequest) {
+	for _, svc := range services {
+		for _, dependency := range oneShotDependencies(svc) {
+			if c.jobSucceeded(dependency, req) {
+				continue
+			}
+			if len(previous.Spec.Containers) == 0 {
+				*replicas = 0
+				return
+			}
+			*template = *previous
+			return
+		}
+	}
+}
+
+// jobStatus returns readiness of the job and its status message
+func jobStatus(job *batchv1.Job) (bool, string) {
+	if job.Status.Succeeded > 0 {
+		return true, "job succeeded"
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return false, fmt.Sprintf("job failed: %s", condition.Message)
+		}
+	}
+	if job.Status.Active > 0 {
+		return false, "job is running"
+	}
+	return false, "job is pending"
+}
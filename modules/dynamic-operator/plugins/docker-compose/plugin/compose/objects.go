@@ -8,14 +8,14 @@ import (
 	"strconv"
 	"strings"
 
-	"k8s.io/apimachinery/pkg/api/resource"
-
 	"github.com/compose-spec/compose-go/types"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,11 +26,27 @@ import (
 )
 
 const (
-	IngressPathExtension       = "x-kuberlogic-access-http-path"
-	HealthEndpointExtension    = "x-kuberlogic-health-endpoint"
-	SetCredentialsCmdExtension = "x-kuberlogic-set-credentials-cmd"
-	ConfigsExtension           = "x-kuberlogic-file-configs"
-	SecretsExtension           = "x-kuberlogic-secrets"
+	IngressPathExtension        = "x-kuberlogic-access-http-path"
+	HealthEndpointExtension     = "x-kuberlogic-health-endpoint"
+	SetCredentialsCmdExtension  = "x-kuberlogic-set-credentials-cmd"
+	ConfigsExtension            = "x-kuberlogic-file-configs"
+	SecretsExtension            = "x-kuberlogic-secrets"
+	ParametersExtension         = "x-kuberlogic-parameters"
+	SplitExtension              = "x-kuberlogic-split"
+	StatefulExtension           = "x-kuberlogic-stateful"
+	StatelessExtension          = "x-kuberlogic-stateless"
+	VolumeAccessModeExtension   = "x-kuberlogic-access-mode"
+	PortHTTPExtension           = "x-kuberlogic-http"
+	PortServiceTypeExtension    = "x-kuberlogic-port-service-type"
+	VolumeSizeExtension         = "x-kuberlogic-size"
+	VolumeStorageClassExtension = "x-kuberlogic-storage-class"
+	OneShotExtension            = "x-kuberlogic-oneshot"
+	CronJobsExtension           = "x-kuberlogic-cronjobs"
+	VersionsExtension           = "x-kuberlogic-versions"
+
+	// HealthCheckHTTPGetExtension and HealthCheckTCPSocketExtension are set in the compose service healthcheck section
+	HealthCheckHTTPGetExtension   = "x-kuberlogic-http-get"
+	HealthCheckTCPSocketExtension = "x-kuberlogic-tcp-socket"
 )
 
 var (
@@ -47,6 +63,11 @@ var (
 		Group:   "apps",
 		Version: "v1",
 		Kind:    "Deployment"}
+	statefulsetGVK = schema.GroupVersionKind{
+		Group:   "apps",
+		Version: "v1",
+		Kind:    "StatefulSet",
+	}
 	ingressGVK = schema.GroupVersionKind{
 		Group:   "networking.k8s.io",
 		Version: "v1",
@@ -62,11 +83,26 @@ var (
 		Version: "v1",
 		Kind:    "ConfigMap",
 	}
+	pdbGVK = schema.GroupVersionKind{
+		Group:   "policy",
+		Version: "v1",
+		Kind:    "PodDisruptionBudget",
+	}
+	jobGVK = schema.GroupVersionKind{
+		Group:   "batch",
+		Version: "v1",
+		Kind:    "Job",
+	}
+	cronJobGVK = schema.GroupVersionKind{
+		Group:   "batch",
+		Version: "v1",
+		Kind:    "CronJob",
+	}
 )
 
 var (
 	ErrUnknownObject                = errors.New("unknown object kind")
-	ErrTooManyAccessPorts           = errors.New("only one exposed port is allowed")
+	ErrTooManyAccessPorts           = errors.New("only one HTTP port is allowed")
 	ErrParsingPublishedPort         = errors.New("can't parse published port")
 	ErrDuplicatePublishedPort       = errors.New("duplicate published port")
 	ErrIngressPathEmpty             = errors.New("HTTP access path is not found")
@@ -74,20 +110,64 @@ var (
 	ErrTooManyCredentialsCommands   = errors.New("too many " + SetCredentialsCmdExtension + " extensions")
 	ErrCredentialsCommandNotDefined = errors.New(SetCredentialsCmdExtension + " extension not found")
 	ErrConfigsDecodeFailed          = errors.New(ConfigsExtension + " must be of type map[string]string")
-	ErrSecretsDecodeFailed          = errors.New(SecretsExtension + " must be of type map[string]string")
+	ErrSecretsDecodeFailed          = errors.New(SecretsExtension + " must be a map of templates or secret definitions")
 	ErrStringConversionFailed       = errors.New("failed to read string")
+	ErrParametersDecodeFailed       = errors.New(ParametersExtension + " must be a map of parameter definitions")
+	ErrInvalidEnvVar                = errors.New("invalid environment variable")
+	ErrSplitDecodeFailed            = errors.New(SplitExtension + " must be a boolean")
+	ErrSplitSharedVolume            = errors.New("volumes can't be shared by services in " + SplitExtension + " mode")
+	ErrStatefulDecodeFailed         = errors.New(StatefulExtension + " must be a boolean")
+	ErrStatelessDecodeFailed        = errors.New(StatelessExtension + " must be a boolean")
+	ErrVolumeAccessModeDecodeFailed = errors.New(VolumeAccessModeExtension + " must be ReadWriteOnce or ReadWriteMany")
+	ErrScalingNotSupported          = errors.New("only 1 replica can be set")
+	ErrHealthCheckDecodeFailed      = errors.New("invalid healthcheck")
+	ErrDependencyNotSupported       = errors.New("unsupported service dependency")
+	ErrResourcesDecodeFailed        = errors.New("invalid deploy resources")
+	ErrResourcesExceedLimits        = errors.New("resources of services exceed limits")
+	ErrUnsupportedField             = errors.New("unsupported compose field")
+	ErrPortDecodeFailed             = errors.New("invalid port")
+	ErrVolumeDecodeFailed           = errors.New("invalid volume")
+	ErrVolumeDownsizeForbidden      = errors.New("volume downsize forbidden")
+	ErrOneShotDecodeFailed          = errors.New(OneShotExtension + " must be a boolean")
+	ErrOneShotNotSupported          = errors.New("unsupported one-shot service")
+	ErrCronJobsDecodeFailed         = errors.New(CronJobsExtension + " must be a map of cron job definitions")
+	ErrVersionsDecodeFailed         = errors.New(VersionsExtension + " must be a definition of supported versions")
+	ErrVersionNotSupported          = errors.New("unsupported version")
 )
 
 type ComposeModel struct {
 	composeProject *types.Project
 	logger         *zap.SugaredLogger
+	// split is set when every compose service runs in its own deployment
+	split bool
+	// stateful is set when any compose service is stateful, all services run in a single statefulset unless split is set
+	stateful bool
 
 	service               *corev1.Service
 	persistentvolumeclaim *corev1.PersistentVolumeClaim
 	deployment            *appsv1.Deployment
+	statefulset           *appsv1.StatefulSet
 	ingress               *networkingv1.Ingress
 	secret                *corev1.Secret
 	configmap             *corev1.ConfigMap
+	pdb                   *policyv1.PodDisruptionBudget
+
+	// splitDeployments, splitStatefulSets, splitServices and splitPDBs are keyed by the compose service name
+	splitDeployments  map[string]*appsv1.Deployment
+	splitStatefulSets map[string]*appsv1.StatefulSet
+	splitServices     map[string]*corev1.Service
+	splitPDBs         map[string]*policyv1.PodDisruptionBudget
+	// portServices expose ports that are not served by the ingress, they are keyed by the service name
+	portServices map[string]*corev1.Service
+	// volumeClaims are claims of compose volumes keyed by the claim name,
+	// persistentvolumeclaim is the claim of all volumes kept by previous installations
+	volumeClaims map[string]*corev1.PersistentVolumeClaim
+	// oneShotServices are run by jobs, they are not a part of composeProject services
+	oneShotServices types.Services
+	// jobs of one-shot services are keyed by the job name
+	jobs map[string]*batchv1.Job
+	// cronJobs of the x-kuberlogic-cronjobs extension are keyed by the cron job name
+	cronJobs map[string]*batchv1.CronJob
 }
 
 // Reconcile method updates current request object to their required parameters
@@ -95,7 +175,7 @@ func (c *ComposeModel) Reconcile(req *commons.PluginRequest) ([]map[schema.Group
 	c.logger.Debug("Reconcile")
 
 	existingObjects := req.GetObjects()
-	if err := c.fromCluster(existingObjects); err != nil {
+	if err := c.fromCluster(req.Name, existingObjects); err != nil {
 		return nil, errors.Wrap(err, "error marshaling cluster objects")
 	}
 
@@ -110,13 +190,66 @@ func (c *ComposeModel) Ready(req *commons.PluginRequest) (bool, error) {
 	c.logger.Debug("Ready")
 
 	existingObjects := req.GetObjects()
-	if err := c.fromCluster(existingObjects); err != nil {
+	if err := c.fromCluster(req.Name, existingObjects); err != nil {
 		return false, errors.Wrap(err, "error marshaling cluster objects")
 	}
 	return c.isReady(), nil
 }
 
-// Types returns list of empty objects with their GVK
+// Components returns readiness of compose application workloads and volumes
+func (c *ComposeModel) Components(req *commons.PluginRequest) ([]commons.ComponentStatus, error) {
+	if err := c.fromCluster(req.Name, req.GetObjects()); err != nil {
+		return nil, errors.Wrap(err, "error marshaling cluster objects")
+	}
+
+	var components []commons.ComponentStatus
+	for _, deployment := range c.deployments() {
+		status := deployment.Status
+		components = append(components, commons.ComponentStatus{
+			Kind:    deploymentGVK.Kind,
+			Name:    deployment.GetName(),
+			Ready:   status.ReadyReplicas == status.Replicas,
+			Message: fmt.Sprintf("%d/%d replicas are ready", status.ReadyReplicas, status.Replicas),
+		})
+	}
+	for _, sts := range c.statefulSets() {
+		status := sts.Status
+		components = append(components, commons.ComponentStatus{
+			Kind:    statefulsetGVK.Kind,
+			Name:    sts.GetName(),
+			Ready:   status.ReadyReplicas == status.Replicas,
+			Message: fmt.Sprintf("%d/%d replicas are ready", status.ReadyReplicas, status.Replicas),
+		})
+	}
+	for _, job := range c.sortedJobs() {
+		ready, message := jobStatus(job)
+		components = append(components, commons.ComponentStatus{
+			Kind:    jobGVK.Kind,
+			Name:    job.GetName(),
+			Ready:   ready,
+			Message: message,
+		})
+	}
+	for _, claim := range append([]*corev1.PersistentVolumeClaim{c.persistentvolumeclaim}, c.sortedVolumeClaims()...) {
+		if claim.GetName() == "" {
+			continue
+		}
+		phase := claim.Status.Phase
+		if phase == "" {
+			phase = corev1.ClaimPending
+		}
+		components = append(components, commons.ComponentStatus{
+			Kind:    pvcGVK.Kind,
+			Name:    claim.GetName(),
+			Ready:   phase == corev1.ClaimBound,
+			Message: fmt.Sprintf("volume claim is %s", strings.ToLower(string(phase))),
+		})
+	}
+	return components, nil
+}
+
+// Types returns list of empty objects with their GVK.
+// Objects of the split mode are of the same kinds.
 func (c *ComposeModel) Types() []map[schema.GroupVersionKind]client.Object {
 	c.logger.Debug("Type")
 	return []map[schema.GroupVersionKind]client.Object{
@@ -129,6 +262,9 @@ func (c *ComposeModel) Types() []map[schema.GroupVersionKind]client.Object {
 		{
 			deploymentGVK: &appsv1.Deployment{},
 		},
+		{
+			statefulsetGVK: &appsv1.StatefulSet{},
+		},
 		{
 			ingressGVK: &networkingv1.Ingress{},
 		},
@@ -138,30 +274,84 @@ func (c *ComposeModel) Types() []map[schema.GroupVersionKind]client.Object {
 		{
 			configmapGVK: &corev1.ConfigMap{},
 		},
+		{
+			pdbGVK: &policyv1.PodDisruptionBudget{},
+		},
+		{
+			jobGVK: &batchv1.Job{},
+		},
+		{
+			cronJobGVK: &batchv1.CronJob{},
+		},
 	}
 }
 
 func (c *ComposeModel) AccessServiceName() string {
+	if !c.HTTPAccess() {
+		// ports are exposed with port services only
+		names := make([]string, 0, len(c.portServices))
+		for name := range c.portServices {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) != 0 {
+			return names[0]
+		}
+	}
+	if c.split {
+		for _, svc := range c.sortedServices() {
+			if httpPortIndex(svc) != -1 {
+				return svc.Name
+			}
+		}
+	}
 	return c.service.GetName()
 }
 
 func NewComposeModel(p *types.Project, l *zap.SugaredLogger) *ComposeModel {
+	// one-shot services are not run by workloads
+	project := *p
+	project.Services = workloadServices(p)
+	var oneShot types.Services
+	for _, svc := range p.Services {
+		if oneShotService(svc) {
+			oneShot = append(oneShot, svc)
+		}
+	}
+	sort.Slice(oneShot, func(i, j int) bool {
+		return oneShot[i].Name < oneShot[j].Name
+	})
+
 	return &ComposeModel{
-		composeProject: p,
-		logger:         l,
+		composeProject:  &project,
+		oneShotServices: oneShot,
+		logger:          l,
 
 		service:               &corev1.Service{},
 		persistentvolumeclaim: &corev1.PersistentVolumeClaim{},
 		deployment:            &appsv1.Deployment{},
+		statefulset:           &appsv1.StatefulSet{},
 		ingress:               &networkingv1.Ingress{},
 		secret:                &corev1.Secret{},
 		configmap:             &corev1.ConfigMap{},
+		pdb:                   &policyv1.PodDisruptionBudget{},
+
+		split:             splitMode(p),
+		stateful:          statefulProject(p),
+		splitDeployments:  make(map[string]*appsv1.Deployment),
+		splitStatefulSets: make(map[string]*appsv1.StatefulSet),
+		splitServices:     make(map[string]*corev1.Service),
+		splitPDBs:         make(map[string]*policyv1.PodDisruptionBudget),
+		portServices:      make(map[string]*corev1.Service),
+		volumeClaims:      make(map[string]*corev1.PersistentVolumeClaim),
+		jobs:              make(map[string]*batchv1.Job),
+		cronJobs:          make(map[string]*batchv1.CronJob),
 	}
 }
 
 // objectsWithGVK packs all compose service dependant object into a single slice with all their GVKs
 func (c *ComposeModel) objectsWithGVK() []map[schema.GroupVersionKind]client.Object {
-	return []map[schema.GroupVersionKind]client.Object{
+	objects := []map[schema.GroupVersionKind]client.Object{
 		{
 			serviceGVK: c.service,
 		},
@@ -181,25 +371,92 @@ func (c *ComposeModel) objectsWithGVK() []map[schema.GroupVersionKind]client.Obj
 			configmapGVK: c.configmap,
 		},
 	}
+	if c.statefulset.GetName() != "" {
+		objects = append(objects, map[schema.GroupVersionKind]client.Object{statefulsetGVK: c.statefulset})
+	}
+	if c.pdb.GetName() != "" {
+		objects = append(objects, map[schema.GroupVersionKind]client.Object{pdbGVK: c.pdb})
+	}
+	for _, svc := range c.sortedServices() {
+		if deployment, found := c.splitDeployments[svc.Name]; found {
+			objects = append(objects, map[schema.GroupVersionKind]client.Object{deploymentGVK: deployment})
+		}
+		if sts, found := c.splitStatefulSets[svc.Name]; found {
+			objects = append(objects, map[schema.GroupVersionKind]client.Object{statefulsetGVK: sts})
+		}
+		if service, found := c.splitServices[svc.Name]; found {
+			objects = append(objects, map[schema.GroupVersionKind]client.Object{serviceGVK: service})
+		}
+		if pdb, found := c.splitPDBs[svc.Name]; found {
+			objects = append(objects, map[schema.GroupVersionKind]client.Object{pdbGVK: pdb})
+		}
+	}
+
+	for _, claim := range c.sortedVolumeClaims() {
+		objects = append(objects, map[schema.GroupVersionKind]client.Object{pvcGVK: claim})
+	}
+
+	names := make([]string, 0, len(c.portServices))
+	for name := range c.portServices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		objects = append(objects, map[schema.GroupVersionKind]client.Object{serviceGVK: c.portServices[name]})
+	}
+	for _, job := range c.sortedJobs() {
+		objects = append(objects, map[schema.GroupVersionKind]client.Object{jobGVK: job})
+	}
+	for _, cronJob := range c.sortedCronJobs() {
+		objects = append(objects, map[schema.GroupVersionKind]client.Object{cronJobGVK: cronJob})
+	}
+	return objects
 }
 
-// fromCluster unpacks PluginRequest unstructured.Unstructured objects into client-go native structs
-func (c *ComposeModel) fromCluster(objects []*unstructured.Unstructured) error {
+// fromCluster unpacks PluginRequest unstructured.Unstructured objects of the kuberlogic service name into client-go native structs
+func (c *ComposeModel) fromCluster(name string, objects []*unstructured.Unstructured) error {
 	for _, obj := range objects {
 		var object client.Object
 		switch obj.GetKind() {
 		case "Service":
 			object = c.service
+			if obj.GetLabels()[portServiceLabel] != "" {
+				object = c.portService(obj.GetName())
+			} else if c.isSplitComponent(obj.GetName()) {
+				object = c.splitService(obj.GetName())
+			}
 		case "PersistentVolumeClaim":
+			// the shared claim of the previous installation is named after the service,
+			// claims of statefulset replicas created from templates without the volume label are claims of compose volumes too
 			object = c.persistentvolumeclaim
+			if obj.GetLabels()[volumeLabel] != "" || (obj.GetName() != "" && obj.GetName() != name) {
+				object = c.volumeClaim(obj.GetName())
+			}
 		case "Deployment":
 			object = c.deployment
+			if c.isSplitComponent(obj.GetName()) {
+				object = c.splitDeployment(obj.GetName())
+			}
+		case "StatefulSet":
+			object = c.statefulset
+			if c.isSplitComponent(obj.GetName()) {
+				object = c.splitStatefulSet(obj.GetName())
+			}
 		case "Ingress":
 			object = c.ingress
 		case "Secret":
 			object = c.secret
 		case "ConfigMap":
 			object = c.configmap
+		case "PodDisruptionBudget":
+			object = c.pdb
+			if c.isSplitComponent(obj.GetName()) {
+				object = c.splitPDB(obj.GetName())
+			}
+		case "Job":
+			object = c.job(obj.GetName())
+		case "CronJob":
+			object = c.cronJob(obj.GetName())
 		default:
 			return ErrUnknownObject
 		}
@@ -211,24 +468,113 @@ func (c *ComposeModel) fromCluster(objects []*unstructured.Unstructured) error {
 }
 
 func (c *ComposeModel) isReady() bool {
-	if c.deployment == nil {
+	deployments, statefulsets := c.deployments(), c.statefulSets()
+	if len(deployments)+len(statefulsets) == 0 {
 		return false
 	}
-	return c.deployment.Status.ReadyReplicas == c.deployment.Status.Replicas
+	for _, deployment := range deployments {
+		if deployment.Status.ReadyReplicas != deployment.Status.Replicas {
+			return false
+		}
+	}
+	for _, sts := range statefulsets {
+		if sts.Status.ReadyReplicas != sts.Status.Replicas {
+			return false
+		}
+	}
+	// workloads depending on one-shot services are updated when jobs succeed
+	for _, job := range c.jobs {
+		if job.Status.Succeeded == 0 {
+			return false
+		}
+	}
+	// statefulsets are started when pods of the previous installation are stopped
+	for _, deployment := range c.legacyDeployments() {
+		if deployment.Status.Replicas != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isSplitComponent returns true when the object name belongs to a compose service in the split mode
+func (c *ComposeModel) isSplitComponent(name string) bool {
+	if !c.split {
+		return false
+	}
+	_, err := c.composeProject.GetService(name)
+	return err == nil
+}
+
+// deployments returns deployments of the compose application ordered by name
+func (c *ComposeModel) deployments() []*appsv1.Deployment {
+	var deployments []*appsv1.Deployment
+	if !c.split {
+		if c.deployment.GetName() != "" && !c.stateful {
+			deployments = append(deployments, c.deployment)
+		}
+		return deployments
+	}
+	for _, svc := range c.sortedServices() {
+		if deployment, found := c.splitDeployments[svc.Name]; found && !statefulService(svc) {
+			deployments = append(deployments, deployment)
+		}
+	}
+	return deployments
+}
+
+// statefulSets returns statefulsets of the compose application ordered by name
+func (c *ComposeModel) statefulSets() []*appsv1.StatefulSet {
+	var statefulsets []*appsv1.StatefulSet
+	if !c.split {
+		if c.statefulset.GetName() != "" && c.stateful {
+			statefulsets = append(statefulsets, c.statefulset)
+		}
+		return statefulsets
+	}
+	for _, svc := range c.sortedServices() {
+		if sts, found := c.splitStatefulSets[svc.Name]; found && statefulService(svc) {
+			statefulsets = append(statefulsets, sts)
+		}
+	}
+	return statefulsets
+}
+
+// legacyDeployments returns deployments of the previous installation that are scaled down
+func (c *ComposeModel) legacyDeployments() []*appsv1.Deployment {
+	var deployments []*appsv1.Deployment
+	if c.deployment.GetName() != "" && (c.split || c.stateful) {
+		deployments = append(deployments, c.deployment)
+	}
+	for _, svc := range c.sortedServices() {
+		if deployment, found := c.splitDeployments[svc.Name]; found && statefulService(svc) {
+			deployments = append(deployments, deployment)
+		}
+	}
+	return deployments
 }
 
 // setObjects updates dependant object parameters according to PluginRequest
 func (c *ComposeModel) setObjects(req *commons.PluginRequest) error {
+	// workloads are held until jobs of the version succeed
+	if err := c.setJobs(req); err != nil {
+		return errors.Wrap(err, "failed to set jobs")
+	}
 	if err := c.setApplicationObjects(req); err != nil {
 		return errors.Wrap(err, "failed to set application objects")
 	}
+	c.retireSharedClaim()
 	c.logger.Debug("set persistentvolumeclaim", "object", c.persistentvolumeclaim)
-	c.logger.Debug("set deployment", "object", c.deployment)
+	c.logger.Debug("set deployments", "objects", c.deployments())
+	c.logger.Debug("set statefulsets", "objects", c.statefulSets())
 	if err := c.setApplicationAccessObjects(req); err != nil {
 		return errors.Wrap(err, "failed to set application access objects")
 	}
 	c.logger.Debug("set service", "object", c.service)
 	c.logger.Debug("set ingress", "object", c.ingress)
+	if err := c.setCronJobs(req); err != nil {
+		return errors.Wrap(err, "failed to set cron jobs")
+	}
 	return nil
 }
 
@@ -241,171 +587,247 @@ func (c *ComposeModel) setApplicationObjects(req *commons.PluginRequest) error {
 		c.secret.Data = make(map[string][]byte, 0)
 	}
 	// now go and set secret data
-	if secrets, set := c.composeProject.Extensions[SecretsExtension]; set {
-		for k, v := range secrets.(map[string]interface{}) {
-			if _, set := c.secret.Data[k]; set {
-				c.logger.Debug("secret %s is already set. skipping.", k)
-				continue
-			}
+	// rotated secrets are removed from the secret and generated again
+	definitions, err := secretDefinitions(c.composeProject)
+	if err != nil {
+		return err
+	}
+	for _, def := range definitions {
+		if _, set := c.secret.Data[def.id]; set {
+			c.logger.Debug("secret %s is already set. skipping.", def.id)
+			continue
+		}
 
-			value, err := req.RenderTemplate(v.(string), c.secret.Data)
-			if err != nil {
-				return errors.Wrapf(err, "failed to generate secret %s", k)
-			}
-			c.secret.Data[k] = []byte(value.String())
+		value, err := req.RenderTemplate(def.template, c.secret.Data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate secret %s", def.id)
 		}
+		c.secret.Data[def.id] = []byte(value.String())
+	}
+
+	if c.split {
+		return c.setSplitApplicationObjects(req)
+	}
+	if c.stateful {
+		// deployment of the previous installation is retired when stateful services are introduced
+		retireDeployment(c.deployment)
+		if err := c.setStatefulSet(c.statefulset, req.Name, req.Name, labels(req.Name), c.composeProject.Services,
+			[]*appsv1.Deployment{c.deployment}, req); err != nil {
+			return err
+		}
+		c.statefulset.Spec.Template.Spec.HostAliases = hostAliases(c.statefulset.Spec.Template.Spec.Containers)
+		return nil
 	}
 
+	markVolumesMigrated(c.deployment, &c.deployment.Spec.Template, deploymentRolledOut(c.deployment))
 	c.deployment.SetName(req.Name)
 	c.deployment.SetNamespace(req.Namespace)
 	c.deployment.SetLabels(labels(req.Name))
 
-	c.deployment.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
-	c.deployment.Spec.Replicas = &req.Replicas
+	c.setDeploymentStrategy(c.deployment, c.composeProject.Services)
+	replicas := c.workloadReplicas(c.composeProject.Services, req)
+	c.deployment.Spec.Replicas = &replicas
 	c.deployment.Spec.Selector = &metav1.LabelSelector{
 		MatchLabels: labels(req.Name),
 	}
-	c.deployment.Spec.Template.SetLabels(labels(req.Name))
-	c.deployment.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
-	c.deployment.Spec.Template.Spec.Volumes = make([]corev1.Volume, 0)
-	c.deployment.Spec.Template.Spec.HostAliases = []corev1.HostAlias{
-		{
-			IP:        "127.0.0.1",
-			Hostnames: []string{},
-		},
-	}
 	c.deployment.Spec.Paused = false
-	terminationGracePeriod := int64(60)
-	c.deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = &terminationGracePeriod
+	c.setDisruptionBudget(c.pdb, c.deployment)
 
-	containers := make([]corev1.Container, 0)
 	// handle docker-compose services as deployment containers
-	for _, composeService := range c.composeProject.Services {
-		var container *corev1.Container
-		for _, deploymentContainer := range c.deployment.Spec.Template.Spec.Containers {
-			if deploymentContainer.Name == composeService.Name {
-				container = &deploymentContainer
-				c.logger.Debug("Deployment container found.", "object", container)
-				break
-			}
-		}
-		// append if not empty
-		if container == nil {
-			container = &corev1.Container{
-				Name: composeService.Name,
-			}
-			c.logger.Debug("Deployment container not found. Creating one.", "object", container)
-		}
-		c.deployment.Spec.Template.Spec.HostAliases[0].Hostnames = append(c.deployment.Spec.Template.Spec.HostAliases[0].Hostnames, container.Name)
+	previous := c.deployment.Spec.Template.DeepCopy()
+	if err := c.setPodTemplate(&c.deployment.Spec.Template, labels(req.Name), c.composeProject.Services, nil, req); err != nil {
+		return err
+	}
+	if !volumesMigrated(c.deployment) {
+		c.setMigration(&c.deployment.Spec.Template.Spec, c.sharedClaimSources(&c.deployment.Spec.Template.Spec))
+	}
+	c.deployment.Spec.Template.Spec.HostAliases = hostAliases(c.deployment.Spec.Template.Spec.Containers)
+	c.holdWorkload(&c.deployment.Spec.Template, previous, c.deployment.Spec.Replicas, c.composeProject.Services, req)
+	return nil
+}
 
-		// this will not be kept in secret even when a flag is set
-		imageValue, err := req.RenderTemplate(composeService.Image, c.secret.Data)
-		if err != nil || imageValue.String() == "" {
-			return errors.Wrapf(err, "invalid image value: %s", imageValue.String())
-		}
-		container.Image = imageValue.String()
-		container.Command = composeService.Command
+// setPodTemplate transforms compose services into containers of the pod template.
+// Volumes are claimed by claims when they are set, otherwise every compose volume gets its own claim.
+func (c *ComposeModel) setPodTemplate(template *corev1.PodTemplateSpec, podLabels map[string]string, services types.Services, claims *[]corev1.PersistentVolumeClaim, req *commons.PluginRequest) error {
+	template.SetLabels(podLabels)
+	annotations := serviceAnnotations(services)
+	for k, v := range c.podAnnotations(req) {
+		annotations[k] = v
+	}
+	template.SetAnnotations(annotations)
+	template.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	template.Spec.Volumes = make([]corev1.Volume, 0)
+	template.Spec.TerminationGracePeriodSeconds = terminationGracePeriod(services)
 
-		if container.Env, err = c.buildContainerEnvVars(&composeService, req); err != nil {
-			return errors.Wrapf(err, "failed to build environment variables for service %s", composeService.Name)
+	containers := make([]corev1.Container, 0)
+	for _, composeService := range services {
+		container, err := c.buildContainer(&composeService, &template.Spec, claims, req)
+		if err != nil {
+			return err
 		}
+		containers = append(containers, container)
+		c.logger.Debug("Pod containers list", "containers", containers)
+	}
+	// healthy dependencies are started first, containers are started in order
+	sort.SliceStable(containers, func(i, j int) bool {
+		orderI := startOrder(c.composeProject, containers[i].Name, make(map[string]bool))
+		orderJ := startOrder(c.composeProject, containers[j].Name, make(map[string]bool))
+		if orderI != orderJ {
+			return orderI < orderJ
+		}
+		return containers[i].Name < containers[j].Name
+	})
+	template.Spec.Containers = containers
+	template.Spec.InitContainers = c.dependencyWaitContainers(services)
+	return nil
+}
 
-		container.Ports = make([]corev1.ContainerPort, 0)
-		for _, p := range composeService.Ports {
-			target := intstr.FromInt(int(p.Target))
-			proto := corev1.ProtocolTCP
-
-			port := corev1.ContainerPort{
-				Name:          target.String() + "-port",
-				ContainerPort: target.IntVal,
-				Protocol:      proto,
-			}
-			container.Ports = append(container.Ports, port)
-
-			healthz := "/"
-			if customHealthz := composeService.Extensions[HealthEndpointExtension]; customHealthz != nil {
-				healthz = customHealthz.(string)
-			}
+// hostAliases resolves names of containers to the pod address
+func hostAliases(containers []corev1.Container) []corev1.HostAlias {
+	hostnames := make([]string, 0, len(containers))
+	for _, container := range containers {
+		hostnames = append(hostnames, container.Name)
+	}
+	sort.Strings(hostnames)
+	return []corev1.HostAlias{
+		{
+			IP:        "127.0.0.1",
+			Hostnames: hostnames,
+		},
+	}
+}
 
-			container.ReadinessProbe = &corev1.Probe{
-				Handler: corev1.Handler{
-					HTTPGet: &corev1.HTTPGetAction{
-						Path: healthz,
-						Port: intstr.FromString(port.Name),
-					},
-				},
-				FailureThreshold:    3,
-				InitialDelaySeconds: 5,
-				PeriodSeconds:       5,
-			}
-		}
-		sort.SliceStable(container.Ports, func(i, j int) bool {
-			return container.Ports[i].Name < container.Ports[j].Name
-		})
+// podAnnotations returns pod template annotations, pods are rolled when secrets or environment variables are changed
+func (c *ComposeModel) podAnnotations(req *commons.PluginRequest) map[string]string {
+	annotations := map[string]string{
+		secretsChecksumAnnotation: secretsChecksum(c.secret.Data),
+	}
+	if req.EnvChecksum != "" {
+		annotations[envChecksumAnnotation] = req.EnvChecksum
+	}
+	return annotations
+}
 
-		if container.VolumeMounts, err = c.buildContainerVolumeMounts(&composeService, req); err != nil {
-			return errors.Wrapf(err, "failed to build volume mounts for container %s", composeService.Name)
+// buildContainer transforms composeService into a container of the pod podSpec.
+// Fields of the existing podSpec container are kept, volumes used by the container are added to podSpec.
+func (c *ComposeModel) buildContainer(composeService *types.ServiceConfig, podSpec *corev1.PodSpec, claims *[]corev1.PersistentVolumeClaim, req *commons.PluginRequest) (corev1.Container, error) {
+	container := corev1.Container{
+		Name: composeService.Name,
+	}
+	found := false
+	for _, podContainer := range podSpec.Containers {
+		if podContainer.Name == composeService.Name {
+			container, found = podContainer, true
+			c.logger.Debug("Pod container found.", "object", container)
+			break
 		}
-
-		containers = append(containers, *container)
-		c.logger.Debug("Deployment containers list", "containers", containers)
 	}
-	c.deployment.Spec.Template.Spec.Containers = containers
+	if !found {
+		c.logger.Debug("Pod container not found. Creating one.", "object", container)
+	}
 
-	sort.SliceStable(c.deployment.Spec.Template.Spec.Containers, func(i, j int) bool {
-		return c.deployment.Spec.Template.Spec.Containers[i].Name < c.deployment.Spec.Template.Spec.Containers[j].Name
-	})
-	sort.SliceStable(c.deployment.Spec.Template.Spec.HostAliases[0].Hostnames, func(i, j int) bool {
-		return c.deployment.Spec.Template.Spec.HostAliases[0].Hostnames[i] < c.deployment.Spec.Template.Spec.HostAliases[0].Hostnames[j]
-	})
-	return nil
-}
+	// this will not be kept in secret even when a flag is set
+	imageValue, err := req.RenderTemplate(composeService.Image, c.secret.Data)
+	if err != nil || imageValue.String() == "" {
+		return container, errors.Wrapf(err, "invalid image value: %s", imageValue.String())
+	}
+	container.Image = imageValue.String()
+	if err := setContainerFields(&container, *composeService); err != nil {
+		return container, err
+	}
 
-func (c *ComposeModel) setApplicationAccessObjects(req *commons.PluginRequest) error {
-	c.service.SetName(req.Name)
-	c.service.SetNamespace(req.Namespace)
-	c.service.SetLabels(labels(req.Name))
+	if container.Resources, err = containerResources(*composeService); err != nil {
+		return container, err
+	}
 
-	c.service.Spec.Selector = labels(req.Name)
-	c.service.Spec.Type = corev1.ServiceTypeClusterIP
-	c.service.Spec.Ports = []corev1.ServicePort{}
+	if container.Env, err = c.buildContainerEnvVars(composeService, req); err != nil {
+		return container, errors.Wrapf(err, "failed to build environment variables for service %s", composeService.Name)
+	}
 
-	svcPorts := make(map[int32]string, 0)
+	container.Ports = make([]corev1.ContainerPort, 0)
+	container.ReadinessProbe, container.LivenessProbe = nil, nil
+	httpPort := httpPortIndex(*composeService)
+	for i, p := range composeService.Ports {
+		target := intstr.FromInt(int(p.Target))
+		proto := portProtocol(p)
+
+		port := corev1.ContainerPort{
+			Name:          target.String() + "-port",
+			ContainerPort: target.IntVal,
+			Protocol:      proto,
+		}
+		if proto == corev1.ProtocolUDP {
+			port.Name = target.String() + "-udp"
+		}
+		container.Ports = append(container.Ports, port)
 
-	for _, svc := range c.composeProject.Services {
-		if svc.Ports == nil || len(svc.Ports) == 0 {
+		// only the port served by the ingress is checked with HTTP requests
+		if i != httpPort {
 			continue
 		}
 
-		published := svc.Ports[0]
-		targetPort := intstr.FromInt(int(published.Target))
-		publishedPort, err := strconv.Atoi(published.Published)
-		if err != nil {
-			return errors.Wrap(ErrParsingPublishedPort, fmt.Sprintf("can't render port %s", published.Published))
+		healthz := "/"
+		if customHealthz := composeService.Extensions[HealthEndpointExtension]; customHealthz != nil {
+			healthz = customHealthz.(string)
 		}
 
-		svcPort := corev1.ServicePort{
-			Name:       "app-" + targetPort.String(),
-			Protocol:   corev1.ProtocolTCP,
-			Port:       int32(publishedPort),
-			TargetPort: targetPort,
+		container.ReadinessProbe = &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: healthz,
+					Port: intstr.FromString(port.Name),
+				},
+			},
+			FailureThreshold:    3,
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       5,
 		}
-		c.service.Spec.Ports = append(c.service.Spec.Ports, svcPort)
+	}
+	sort.SliceStable(container.Ports, func(i, j int) bool {
+		return container.Ports[i].Name < container.Ports[j].Name
+	})
 
-		path := "/"
-		if svc.Extensions != nil && svc.Extensions[IngressPathExtension] != nil {
-			path = svc.Extensions[IngressPathExtension].(string)
+	// compose healthcheck replaces the default readiness check of the exposed port
+	if composeService.HealthCheck != nil {
+		probe, err := healthCheckProbe(*composeService)
+		if err != nil {
+			return container, err
+		}
+		container.ReadinessProbe, container.LivenessProbe = probe, nil
+		if probe != nil {
+			liveness := *probe
+			container.LivenessProbe = &liveness
 		}
+	}
+	container.Lifecycle = c.dependencyHook(*composeService)
 
-		svcPorts[svcPort.Port] = path
+	if container.VolumeMounts, err = c.buildContainerVolumeMounts(composeService, podSpec, claims, req); err != nil {
+		return container, errors.Wrapf(err, "failed to build volume mounts for container %s", composeService.Name)
+	}
+	tmpfs, tmpfsMounts, err := tmpfsVolumes(*composeService)
+	if err != nil {
+		return container, err
+	}
+	podSpec.Volumes = append(podSpec.Volumes, tmpfs...)
+	container.VolumeMounts = append(container.VolumeMounts, tmpfsMounts...)
+	return container, nil
+}
+
+func (c *ComposeModel) setApplicationAccessObjects(req *commons.PluginRequest) error {
+	var paths []accessPath
+	var err error
+	if c.split {
+		paths, err = c.setSplitAccessObjects(req)
+	} else {
+		paths, err = c.setAccessService(req)
+	}
+	if err != nil {
+		return err
 	}
-	sort.Slice(c.service.Spec.Ports, func(i, j int) bool {
-		return c.service.Spec.Ports[i].Name < c.service.Spec.Ports[j].Name
-	})
 
 	// now handle ingress
-	// Host is not specified, no ingress object
-	if req.Host == "" {
+	// Host is not specified or no ports are served with HTTP, no ingress object
+	if req.Host == "" || len(paths) == 0 {
 		return nil
 	}
 
@@ -427,27 +849,22 @@ func (c *ComposeModel) setApplicationAccessObjects(req *commons.PluginRequest) e
 		}
 	}
 
-	paths := make([]networkingv1.HTTPIngressPath, 0)
+	ingressPaths := make([]networkingv1.HTTPIngressPath, 0)
 	pathType := networkingv1.PathTypePrefix
-	for _, port := range c.service.Spec.Ports {
-		path, found := svcPorts[port.Port]
-		if !found {
-			return errors.Wrap(ErrIngressPathEmpty, fmt.Sprintf("ingress path not found for port %s", port.Name))
-		}
-
+	for _, path := range paths {
 		ingressPath := networkingv1.HTTPIngressPath{
 			PathType: &pathType,
-			Path:     path,
+			Path:     path.path,
 			Backend: networkingv1.IngressBackend{
 				Service: &networkingv1.IngressServiceBackend{
-					Name: c.service.GetName(),
+					Name: path.service,
 					Port: networkingv1.ServiceBackendPort{
-						Name: port.Name,
+						Name: path.port,
 					},
 				},
 			},
 		}
-		paths = append(paths, ingressPath)
+		ingressPaths = append(ingressPaths, ingressPath)
 	}
 
 	c.ingress.Spec.Rules = []networkingv1.IngressRule{
@@ -455,7 +872,7 @@ func (c *ComposeModel) setApplicationAccessObjects(req *commons.PluginRequest) e
 			Host: req.Host,
 			IngressRuleValue: networkingv1.IngressRuleValue{
 				HTTP: &networkingv1.HTTPIngressRuleValue{
-					Paths: paths,
+					Paths: ingressPaths,
 				},
 			},
 		},
@@ -463,6 +880,69 @@ func (c *ComposeModel) setApplicationAccessObjects(req *commons.PluginRequest) e
 	return nil
 }
 
+// accessPath is an HTTP path of the ingress served by the service port
+type accessPath struct {
+	path    string
+	service string
+	port    string
+}
+
+// setAccessService exposes published ports of all compose services with a single service,
+// returned paths are ordered by the service port name
+func (c *ComposeModel) setAccessService(req *commons.PluginRequest) ([]accessPath, error) {
+	c.service.SetName(req.Name)
+	c.service.SetNamespace(req.Namespace)
+	c.service.SetLabels(labels(req.Name))
+
+	c.service.Spec.Selector = labels(req.Name)
+	c.service.Spec.Type = corev1.ServiceTypeClusterIP
+	c.service.Spec.Ports = []corev1.ServicePort{}
+
+	paths := make([]accessPath, 0)
+	for _, svc := range c.composeProject.Services {
+		httpPort := httpPortIndex(svc)
+		if httpPort == -1 {
+			continue
+		}
+
+		published := svc.Ports[httpPort]
+		targetPort := intstr.FromInt(int(published.Target))
+		publishedPort, err := strconv.Atoi(published.Published)
+		if err != nil {
+			return nil, errors.Wrap(ErrParsingPublishedPort, fmt.Sprintf("can't render port %s", published.Published))
+		}
+
+		svcPort := corev1.ServicePort{
+			Name:       "app-" + targetPort.String(),
+			Protocol:   corev1.ProtocolTCP,
+			Port:       int32(publishedPort),
+			TargetPort: targetPort,
+		}
+		c.service.Spec.Ports = append(c.service.Spec.Ports, svcPort)
+
+		paths = append(paths, accessPath{
+			path:    ingressPath(svc),
+			service: c.service.GetName(),
+			port:    svcPort.Name,
+		})
+	}
+	sort.Slice(c.service.Spec.Ports, func(i, j int) bool {
+		return c.service.Spec.Ports[i].Name < c.service.Spec.Ports[j].Name
+	})
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].port < paths[j].port
+	})
+	return paths, c.setPortServices(req.Name, labels(req.Name), c.composeProject.Services, req)
+}
+
+// ingressPath returns an HTTP path the compose service svc is exposed on
+func ingressPath(svc types.ServiceConfig) string {
+	if svc.Extensions != nil && svc.Extensions[IngressPathExtension] != nil {
+		return svc.Extensions[IngressPathExtension].(string)
+	}
+	return "/"
+}
+
 func (c *ComposeModel) GetCredentialsMethod(req *commons.PluginRequestCredentialsMethod) (*commons.PluginResponseCredentialsMethod, error) {
 	// search across services
 	var commandTemplate, container string
@@ -482,11 +962,15 @@ func (c *ComposeModel) GetCredentialsMethod(req *commons.PluginRequestCredential
 		return nil, err
 	}
 
+	podLabels := labels(req.Name)
+	if c.split {
+		podLabels = splitLabels(req.Name, container)
+	}
 	return &commons.PluginResponseCredentialsMethod{
 		Method: "exec",
 		Exec: commons.CredentialsMethodExec{
 			PodSelector: metav1.LabelSelector{
-				MatchLabels: labels(req.Name),
+				MatchLabels: podLabels,
 			},
 			Container: container,
 			Command:   strings.Split(v.String(), " "),
@@ -501,8 +985,9 @@ func labels(name string) map[string]string {
 }
 
 // buildContainerEnvVars transforms composeSvc environment variables to Kuberlogic compatible []corev1.EnvVar
+// variables set by users take precedence over parameter defaults and compose environment
 func (c *ComposeModel) buildContainerEnvVars(composeSvc *types.ServiceConfig, req *commons.PluginRequest) ([]corev1.EnvVar, error) {
-	envs := make([]corev1.EnvVar, 0)
+	envs := make(map[string]corev1.EnvVar, 0)
 
 	for key, rawValue := range composeSvc.Environment {
 		e := corev1.EnvVar{
@@ -530,70 +1015,70 @@ func (c *ComposeModel) buildContainerEnvVars(composeSvc *types.ServiceConfig, re
 			}
 		}
 
-		envs = append(envs, e)
+		envs[e.Name] = e
 	}
-	// all additional parameters mapped to env vars for each container
+	// additional parameters are mapped to env vars of containers they are scoped to
 	c.logger.Debugf("extra parameters: %+v", req.Parameters)
-	for key, value := range req.Parameters {
-		envs = append(envs, corev1.EnvVar{
-			Name:  key,
-			Value: fmt.Sprintf("%v", value),
-		})
+	advanced, err := advancedEnvVars(c.composeProject, req.Parameters)
+	if err != nil {
+		return nil, err
 	}
-
-	sort.Slice(envs, func(i, j int) bool {
-		return envs[i].Name < envs[j].Name
-	})
-	return envs, nil
-}
-
-func (c *ComposeModel) buildContainerVolumeMounts(s *types.ServiceConfig, req *commons.PluginRequest) ([]corev1.VolumeMount, error) {
-	volumeMounts := make([]corev1.VolumeMount, 0)
-
-	if len(s.Volumes) > 0 {
-		c.persistentvolumeclaim.SetName(req.Name)
-		c.persistentvolumeclaim.SetNamespace(req.Namespace)
-		c.persistentvolumeclaim.Labels = labels(req.Namespace)
-
-		c.persistentvolumeclaim.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{
-			corev1.ReadWriteOnce,
+	for _, e := range advanced {
+		if e.Container != "" && e.Container != composeSvc.Name {
+			continue
 		}
-		if req.StorageClass != "" {
-			c.persistentvolumeclaim.Spec.StorageClassName = &req.StorageClass
+		envs[e.Name] = corev1.EnvVar{
+			Name:  e.Name,
+			Value: e.Value,
 		}
+	}
 
-		limits, err := req.GetLimits()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get limits")
+	schema, err := parameterSchema(c.composeProject)
+	if err != nil {
+		return nil, err
+	}
+	for _, parameter := range schema {
+		if parameter.Default == "" || (parameter.Container != "" && parameter.Container != composeSvc.Name) {
+			continue
 		}
-
-		storage := limits.Storage()
-		if storage != nil && !storage.IsZero() {
-			c.persistentvolumeclaim.Spec.Resources = corev1.ResourceRequirements{
-				Requests: map[corev1.ResourceName]resource.Quantity{
-					corev1.ResourceStorage: *storage,
-				},
+		if _, set := envs[parameter.Name]; !set {
+			envs[parameter.Name] = corev1.EnvVar{
+				Name:  parameter.Name,
+				Value: parameter.Default,
 			}
 		}
+	}
+	for _, e := range userEnvVars(req, composeSvc.Name) {
+		envs[e.Name] = e
+	}
 
-		var found bool
-		for _, v := range c.deployment.Spec.Template.Spec.Volumes {
-			if v.Name == c.persistentvolumeclaim.GetName() {
-				found = true
-			}
+	result := make([]corev1.EnvVar, 0, len(envs))
+	for _, e := range envs {
+		result = append(result, e)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// buildContainerVolumeMounts returns volume mounts of the compose service s, volumes are added to podSpec.
+// Volumes of stateful pods are added to claims, volumes of other pods are claimed by claims of compose volumes.
+func (c *ComposeModel) buildContainerVolumeMounts(s *types.ServiceConfig, podSpec *corev1.PodSpec, claims *[]corev1.PersistentVolumeClaim, req *commons.PluginRequest) ([]corev1.VolumeMount, error) {
+	volumeMounts := make([]corev1.VolumeMount, 0)
+
+	if len(s.Volumes) > 0 && claims != nil {
+		mounts, err := c.claimVolumeMounts(s, claims, req)
+		if err != nil {
+			return nil, err
 		}
-		if !found {
-			c.deployment.Spec.Template.Spec.Volumes = append(c.deployment.Spec.Template.Spec.Volumes,
-				corev1.Volume{
-					Name: c.persistentvolumeclaim.GetName(),
-					VolumeSource: corev1.VolumeSource{
-						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-							ClaimName: c.persistentvolumeclaim.GetName(),
-							ReadOnly:  false,
-						},
-					},
-				})
+		volumeMounts = append(volumeMounts, mounts...)
+	} else if len(s.Volumes) > 0 {
+		mounts, err := c.volumeClaimMounts(s, podSpec, req)
+		if err != nil {
+			return nil, err
 		}
+		volumeMounts = append(volumeMounts, mounts...)
 	}
 
 	if configMap, set := s.Extensions[ConfigsExtension]; set {
@@ -608,14 +1093,14 @@ func (c *ComposeModel) buildContainerVolumeMounts(s *types.ServiceConfig, req *c
 		const configVolumeName = "file-configs"
 		var found bool
 
-		for _, v := range c.deployment.Spec.Template.Spec.Volumes {
+		for _, v := range podSpec.Volumes {
 			if v.Name == configVolumeName {
 				found = true
 			}
 		}
 
 		if !found {
-			c.deployment.Spec.Template.Spec.Volumes = append(c.deployment.Spec.Template.Spec.Volumes,
+			podSpec.Volumes = append(podSpec.Volumes,
 				corev1.Volume{
 					Name: configVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -648,14 +1133,6 @@ func (c *ComposeModel) buildContainerVolumeMounts(s *types.ServiceConfig, req *c
 		}
 	}
 
-	for _, v := range s.Volumes {
-		volumeMounts = append(volumeMounts, corev1.VolumeMount{
-			Name:      c.persistentvolumeclaim.GetName(),
-			ReadOnly:  false,
-			MountPath: v.Target,
-			SubPath:   v.Source + "-" + s.Name,
-		})
-	}
 	sort.SliceStable(volumeMounts, func(i, j int) bool {
 		return volumeMounts[i].Name < volumeMounts[j].Name
 	})
@@ -665,15 +1142,63 @@ func (c *ComposeModel) buildContainerVolumeMounts(s *types.ServiceConfig, req *c
 // ValidateComposeProject reads docker-compose project p, checks it against Kuberlogic requirements and returns error when validation fails
 func ValidateComposeProject(p *types.Project) error {
 	// validate secrets extension
-	if secrets, set := p.Extensions[SecretsExtension]; set {
-		if _, converted := secrets.(map[string]interface{}); !converted {
-			return errors.Wrapf(ErrSecretsDecodeFailed, "failed to decode parameter %s", SecretsExtension)
-		}
-		for k, v := range secrets.(map[string]interface{}) {
-			if _, ok := v.(string); !ok {
-				return errors.Wrapf(ErrSecretsDecodeFailed, "it is expected that key `%s` value is string", k)
-			}
-		}
+	if _, err := secretDefinitions(p); err != nil {
+		return err
+	}
+
+	// validate parameters extension
+	if _, err := parameterSchema(p); err != nil {
+		return err
+	}
+
+	// validate versions extension
+	if _, err := versionsDefinitionOf(p); err != nil {
+		return err
+	}
+
+	// validate split extension
+	if err := validateSplit(p); err != nil {
+		return err
+	}
+
+	// validate stateful extension
+	if err := validateStateful(p); err != nil {
+		return err
+	}
+
+	// validate stateless and volume access mode extensions
+	if err := validateStateless(p); err != nil {
+		return err
+	}
+
+	// validate volumes and their extensions
+	if err := validateVolumes(p); err != nil {
+		return err
+	}
+
+	// validate one-shot services
+	if err := validateOneShot(p); err != nil {
+		return err
+	}
+
+	// validate cron jobs extension
+	if err := validateCronJobs(p); err != nil {
+		return err
+	}
+
+	// validate healthchecks and dependencies
+	if err := validateDependencies(p); err != nil {
+		return err
+	}
+
+	// validate deploy resources
+	if err := validateResources(p); err != nil {
+		return err
+	}
+
+	// validate fields of compose services
+	if err := validateContainerFields(p); err != nil {
+		return err
 	}
 
 	// validate configs
@@ -695,23 +1220,23 @@ func ValidateComposeProject(p *types.Project) error {
 	// svcPorts contains exposed ports, we will check for duplicates
 	ingressPaths := make(map[string]string, 0)
 	svcPorts := make(map[string]string, 0)
+	if err := validatePorts(p); err != nil {
+		return err
+	}
 	for _, svc := range p.Services {
-		// no ports found
-		if svc.Ports == nil || len(svc.Ports) == 0 {
-			continue
-		}
-
-		// too many ports found
-		if len(svc.Ports) != 1 {
-			return errors.Wrapf(ErrTooManyAccessPorts, "error in service `%s`", svc.Name)
+		for _, port := range svc.Ports {
+			key := port.Published + "/" + string(portProtocol(port))
+			if name, found := svcPorts[key]; found {
+				return errors.Wrapf(ErrDuplicatePublishedPort,
+					"failed to expose service `%s` on port `%s`, it is already used by service `%s`", svc.Name, port.Published, name)
+			}
+			svcPorts[key] = svc.Name
 		}
 
-		port := svc.Ports[0]
-		if name, found := svcPorts[port.Published]; found {
-			return errors.Wrapf(ErrDuplicatePublishedPort,
-				"failed to expose service `%s` on port `%s`, it is already used by service `%s`", svc.Name, port.Published, name)
+		// no ports served with HTTP
+		if httpPortIndex(svc) == -1 {
+			continue
 		}
-		svcPorts[port.Published] = svc.Name
 
 		// check for ingress paths duplicates
 		path := "/"
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/compose-spec/compose-go/types"
 	"github.com/go-test/deep"
@@ -12,8 +13,14 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
@@ -78,7 +85,7 @@ var _ = Describe("docker-compose model", func() {
 			By("Checking Reconcile return parameters")
 			objs, err := c.Reconcile(requests)
 			Expect(err).Should(BeNil())
-			Expect(len(objs)).Should(Equal(6))
+			Expect(len(objs)).Should(Equal(7))
 
 			By("Validating returned Deployment")
 			firstDeployment := *c.deployment
@@ -110,7 +117,7 @@ var _ = Describe("docker-compose model", func() {
 			By("Checking Reconcile result for the 2nd time")
 			secondRunObjs, secondErr := c.Reconcile(requests)
 			Expect(secondErr).Should(BeNil())
-			Expect(len(secondRunObjs)).Should(Equal(6))
+			Expect(len(secondRunObjs)).Should(Equal(7))
 
 			By("Validating returned Deployment")
 			secondDeployment := *c.deployment
@@ -294,6 +301,10 @@ var _ = Describe("docker-compose model", func() {
 					},
 					"second": types.VolumeConfig{
 						Name: "second",
+						Extensions: map[string]interface{}{
+							"x-kuberlogic-size":          "1G",
+							"x-kuberlogic-storage-class": "fast",
+						},
 					},
 				},
 			}
@@ -318,24 +329,31 @@ var _ = Describe("docker-compose model", func() {
 				_, err := c.Reconcile(requests)
 				Expect(err).Should(BeNil())
 
-				By("Checking persistentvolumeclaim object")
-				Expect(c.persistentvolumeclaim).ShouldNot(BeNil())
-				Expect(*c.persistentvolumeclaim.Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("10G")))
-				Expect(*c.persistentvolumeclaim.Spec.StorageClassName).Should(Equal(requests.StorageClass))
+				By("Checking persistentvolumeclaim objects")
+				Expect(c.persistentvolumeclaim.GetName()).Should(Equal(""))
+				Expect(c.volumeClaims).Should(HaveLen(2))
+				demo := c.volumeClaims["demo-kls-demo"]
+				Expect(*demo.Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("10G")))
+				Expect(*demo.Spec.StorageClassName).Should(Equal(requests.StorageClass))
+				Expect(demo.GetLabels()[volumeLabel]).Should(Equal("demo"))
+				second := c.volumeClaims["demo-kls-second"]
+				Expect(*second.Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("1G")))
+				Expect(*second.Spec.StorageClassName).Should(Equal("fast"))
 
 				By("Checking pod volume")
-				Expect(len(c.deployment.Spec.Template.Spec.Volumes)).Should(Equal(1))
+				Expect(len(c.deployment.Spec.Template.Spec.Volumes)).Should(Equal(2))
+				Expect(c.deployment.Spec.Template.Spec.InitContainers).Should(BeEmpty())
 
 				By("Checking container volume mounts")
 				Expect(len(c.deployment.Spec.Template.Spec.Containers[0].VolumeMounts)).Should(Equal(2))
 				Expect(c.deployment.Spec.Template.Spec.Containers[0].VolumeMounts[0]).Should(Equal(corev1.VolumeMount{
-					Name:      c.persistentvolumeclaim.GetName(),
+					Name:      demo.GetName(),
 					ReadOnly:  false,
 					MountPath: "/tmp/demo",
 					SubPath:   "demo-demo-app",
 				}))
 				Expect(c.deployment.Spec.Template.Spec.Containers[0].VolumeMounts[1]).Should(Equal(corev1.VolumeMount{
-					Name:      c.persistentvolumeclaim.GetName(),
+					Name:      second.GetName(),
 					ReadOnly:  false,
 					MountPath: "/tmp/second",
 					SubPath:   "second-demo-app",
@@ -542,7 +560,7 @@ var _ = Describe("docker-compose model", func() {
 			By("Checking Reconcile return parameters")
 			objs, err := c.Reconcile(requests)
 			Expect(err).Should(BeNil())
-			Expect(len(objs)).Should(Equal(6))
+			Expect(len(objs)).Should(Equal(7))
 
 			By("Validating returned Deployment")
 
@@ -740,6 +758,1525 @@ var _ = Describe("docker-compose model", func() {
 		})
 	})
 
+	Context("When secrets are declared", func() {
+		token := `{{ Secret "token" }}`
+		project := &types.Project{
+			Name: "test",
+			Extensions: map[string]interface{}{
+				"x-kuberlogic-secrets": map[string]interface{}{
+					"password": "{{ GenerateKey 16 }}",
+					"token": map[string]interface{}{
+						"value":     "{{ GenerateKey 16 }}",
+						"visible":   false,
+						"rotatable": true,
+					},
+				},
+			},
+			Services: types.Services{
+				types.ServiceConfig{
+					Name:        "app",
+					Image:       "demo:test",
+					Environment: types.MappingWithEquals{"TOKEN": &token},
+				},
+			},
+		}
+
+		It("Should declare them and roll pods when a secret is rotated", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			req := &commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1}
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			secrets, err := c.Secrets()
+			Expect(err).Should(BeNil())
+			Expect(*secrets).Should(Equal(commons.PluginSecrets{
+				Name: "demo",
+				Keys: []commons.SecretKey{
+					{ID: "password", Visible: true},
+					{ID: "token", Rotatable: true},
+				},
+			}))
+
+			password, token := c.secret.Data["password"], c.secret.Data["token"]
+			checksum := c.deployment.Spec.Template.Annotations[secretsChecksumAnnotation]
+			Expect(checksum).ShouldNot(BeEmpty())
+
+			By("Removing the rotated secret")
+			delete(c.secret.Data, "token")
+			secret, err := commons.ToUnstructured(c.secret, secretGVK)
+			Expect(err).Should(BeNil())
+			deployment, err := commons.ToUnstructured(c.deployment, deploymentGVK)
+			Expect(err).Should(BeNil())
+			req.SetObjects([]*unstructured.Unstructured{secret, deployment})
+
+			c = NewComposeModel(project, zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.secret.Data["password"]).Should(Equal(password))
+			Expect(c.secret.Data["token"]).ShouldNot(Equal(token))
+			Expect(c.deployment.Spec.Template.Annotations[secretsChecksumAnnotation]).ShouldNot(Equal(checksum))
+		})
+	})
+
+	Context("When environment variables are set", func() {
+		project := &types.Project{
+			Name: "test",
+			Extensions: map[string]interface{}{
+				"x-kuberlogic-parameters": map[string]interface{}{
+					"MAX_UPLOAD_SIZE": map[string]interface{}{
+						"description": "maximum size of uploads",
+						"type":        "integer",
+						"default":     "10",
+						"container":   "app",
+					},
+					"SMTP_PASSWORD": map[string]interface{}{
+						"secret": true,
+					},
+					"LOG_LEVEL": nil,
+				},
+			},
+			Services: types.Services{
+				types.ServiceConfig{
+					Name:        "app",
+					Image:       "demo:test",
+					Environment: types.MappingWithEquals{"LOG_LEVEL": &envVal},
+				},
+				types.ServiceConfig{
+					Name:  "worker",
+					Image: "demo:test",
+				},
+			},
+		}
+
+		It("Should declare parameters", func() {
+			parameters, err := NewComposeModel(project, zap.NewRaw().Sugar()).Parameters()
+			Expect(err).Should(BeNil())
+			Expect(parameters).Should(Equal([]commons.ParameterSchema{
+				{Name: "LOG_LEVEL", Type: "string"},
+				{Name: "MAX_UPLOAD_SIZE", Container: "app", Description: "maximum size of uploads", Type: "integer", Default: "10"},
+				{Name: "SMTP_PASSWORD", Type: "string", Secret: true},
+			}))
+		})
+
+		It("Should set them to containers", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			req := &commons.PluginRequest{
+				Name:      "demo",
+				Namespace: "demo",
+				Replicas:  1,
+				Env: []commons.EnvVar{
+					{Name: "LOG_LEVEL", Value: "info"},
+					{Name: "LOG_LEVEL", Container: "worker", Value: "debug"},
+					{Name: "SMTP_PASSWORD", SecretName: "env", SecretKey: "SMTP_PASSWORD"},
+				},
+				EnvChecksum: "checksum",
+			}
+			Expect(ValidateEnv(project, req.Env)).Should(BeNil())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			smtp := corev1.EnvVar{
+				Name: "SMTP_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "env"},
+						Key:                  "SMTP_PASSWORD",
+					},
+				},
+			}
+			containers := c.deployment.Spec.Template.Spec.Containers
+			Expect(containers[0].Env).Should(Equal([]corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "info"},
+				{Name: "MAX_UPLOAD_SIZE", Value: "10"},
+				smtp,
+			}))
+			Expect(containers[1].Env).Should(Equal([]corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "debug"},
+				smtp,
+			}))
+			Expect(c.deployment.Spec.Template.Annotations[envChecksumAnnotation]).Should(Equal("checksum"))
+		})
+
+		It("Should validate them against parameters", func() {
+			for _, env := range [][]commons.EnvVar{
+				{{Name: "UNKNOWN", Value: "1"}},
+				{{Name: "MAX_UPLOAD_SIZE", Container: "app", Value: "ten"}},
+				{{Name: "MAX_UPLOAD_SIZE", Container: "worker", Value: "10"}},
+				{{Name: "SMTP_PASSWORD", Value: "plain"}},
+				{{Name: "LOG_LEVEL", Container: "unknown", Value: "info"}},
+				{{Name: "LOG_LEVEL", Value: "info"}, {Name: "LOG_LEVEL", Value: "debug"}},
+			} {
+				Expect(errors.Is(ValidateEnv(project, env), ErrInvalidEnvVar)).Should(BeTrue(), "%+v", env)
+			}
+		})
+
+		It("Should set advanced parameters to containers they are scoped to", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			req := &commons.PluginRequest{
+				Name:       "demo",
+				Namespace:  "demo",
+				Replicas:   1,
+				Parameters: map[string]interface{}{"MAX_UPLOAD_SIZE": float64(20), "LOG_LEVEL": "warn"},
+				Env: []commons.EnvVar{
+					{Name: "LOG_LEVEL", Container: "worker", Value: "debug"},
+				},
+			}
+			Expect(ValidateAdvanced(project, req.Parameters)).Should(BeNil())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			containers := c.deployment.Spec.Template.Spec.Containers
+			Expect(containers[0].Env).Should(Equal([]corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "warn"},
+				{Name: "MAX_UPLOAD_SIZE", Value: "20"},
+			}))
+			Expect(containers[1].Env).Should(Equal([]corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "debug"},
+			}))
+
+			By("Validating them against parameters")
+			for _, parameters := range []map[string]interface{}{
+				{"UNKNOWN": "1"},
+				{"MAX_UPLOAD_SIZE": "ten"},
+				{"SMTP_PASSWORD": "plain"},
+				{"LOG_LEVEL": map[string]interface{}{"level": "info"}},
+			} {
+				Expect(errors.Is(ValidateAdvanced(project, parameters), ErrInvalidEnvVar)).Should(BeTrue(), "%+v", parameters)
+			}
+		})
+	})
+
+	Context("When split mode is enabled", func() {
+		project := &types.Project{
+			Name:       "test",
+			Extensions: map[string]interface{}{"x-kuberlogic-split": true},
+			Services: types.Services{
+				types.ServiceConfig{
+					Name:  "web",
+					Image: "web:test",
+					Ports: []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+					Extensions: map[string]interface{}{
+						"x-kuberlogic-set-credentials-cmd": "cli set password {{ .password }}",
+					},
+				},
+				types.ServiceConfig{
+					Name:  "db",
+					Image: "db:test",
+					Volumes: []types.ServiceVolumeConfig{
+						{Source: "data", Target: "/data"},
+					},
+				},
+			},
+			Volumes: types.Volumes{"data": types.VolumeConfig{Name: "data"}},
+		}
+		req := &commons.PluginRequest{
+			Name:      "demo",
+			Namespace: "demo",
+			Host:      "demo.example.com",
+			Replicas:  1,
+		}
+
+		It("Should create a deployment and a service per compose service", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			objs, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(len(objs)).Should(Equal(11))
+			Expect(c.deployment.GetName()).Should(Equal(""))
+			Expect(c.service.GetName()).Should(Equal(""))
+			Expect(c.AccessServiceName()).Should(Equal("web"))
+
+			By("Checking deployments")
+			Expect(c.splitDeployments).Should(HaveLen(2))
+			web := c.splitDeployments["web"]
+			Expect(web.GetName()).Should(Equal("web"))
+			Expect(web.Spec.Selector.MatchLabels).Should(Equal(map[string]string{
+				"docker-compose.service/name":      "demo",
+				"docker-compose.service/component": "web",
+			}))
+			Expect(web.Spec.Template.Spec.HostAliases).Should(BeEmpty())
+			Expect(web.Spec.Template.Spec.Containers).Should(HaveLen(1))
+			Expect(web.Spec.Template.Spec.Containers[0].Name).Should(Equal("web"))
+			Expect(web.Spec.Template.Spec.Volumes).Should(BeEmpty())
+			db := c.splitDeployments["db"]
+			Expect(db.Spec.Template.Spec.Containers).Should(HaveLen(1))
+			Expect(db.Spec.Template.Spec.Containers[0].Image).Should(Equal("db:test"))
+			Expect(db.Spec.Template.Spec.Volumes).Should(HaveLen(1))
+			Expect(db.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).Should(Equal("demo-data"))
+
+			By("Checking discovery services")
+			Expect(c.splitServices["db"].Spec.ClusterIP).Should(Equal(corev1.ClusterIPNone))
+			Expect(c.splitServices["db"].Spec.Ports).Should(BeEmpty())
+			Expect(c.splitServices["db"].Spec.Selector).Should(Equal(db.Spec.Selector.MatchLabels))
+			Expect(c.splitServices["web"].Spec.Ports).Should(Equal([]corev1.ServicePort{
+				{Name: "app-80", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(80)},
+			}))
+
+			By("Checking ingress")
+			backend := c.ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+			Expect(backend.Name).Should(Equal("web"))
+			Expect(backend.Port.Name).Should(Equal("app-80"))
+
+			By("Reconciling with cluster objects")
+			existing := &commons.PluginRequest{}
+			*existing = *req
+			for _, elem := range objs {
+				for gvk, obj := range elem {
+					if obj.GetName() == "" {
+						continue
+					}
+					u, err := commons.ToUnstructured(obj, gvk)
+					Expect(err).Should(BeNil())
+					existing.AddObject(u)
+				}
+			}
+			second := NewComposeModel(project, zap.NewRaw().Sugar())
+			_, err = second.Reconcile(existing)
+			Expect(err).Should(BeNil())
+			Expect(second.splitDeployments).Should(HaveLen(2))
+			Expect(deep.Equal(second.splitDeployments["web"].Spec, web.Spec)).Should(BeNil())
+		})
+
+		It("Should be ready when all deployments are ready", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			c.splitDeployments["web"].Status.Replicas = 1
+			c.splitDeployments["web"].Status.ReadyReplicas = 1
+			c.splitDeployments["db"].Status.Replicas = 1
+			web, err := commons.ToUnstructured(c.splitDeployments["web"], deploymentGVK)
+			Expect(err).Should(BeNil())
+			db, err := commons.ToUnstructured(c.splitDeployments["db"], deploymentGVK)
+			Expect(err).Should(BeNil())
+
+			status := &commons.PluginRequest{}
+			status.SetObjects([]*unstructured.Unstructured{web, db})
+			ready, err := NewComposeModel(project, zap.NewRaw().Sugar()).Ready(status)
+			Expect(err).Should(BeNil())
+			Expect(ready).Should(BeFalse())
+			components, err := NewComposeModel(project, zap.NewRaw().Sugar()).Components(status)
+			Expect(err).Should(BeNil())
+			Expect(components).Should(Equal([]commons.ComponentStatus{
+				{Kind: "Deployment", Name: "db", Ready: false, Message: "0/1 replicas are ready"},
+				{Kind: "Deployment", Name: "web", Ready: true, Message: "1/1 replicas are ready"},
+			}))
+
+			c.splitDeployments["db"].Status.ReadyReplicas = 1
+			db, err = commons.ToUnstructured(c.splitDeployments["db"], deploymentGVK)
+			Expect(err).Should(BeNil())
+			status.SetObjects([]*unstructured.Unstructured{web, db})
+			ready, err = NewComposeModel(project, zap.NewRaw().Sugar()).Ready(status)
+			Expect(err).Should(BeNil())
+			Expect(ready).Should(BeTrue())
+		})
+
+		It("Should select the pod of the credentials command service", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			resp, err := c.GetCredentialsMethod(&commons.PluginRequestCredentialsMethod{
+				Name: "demo",
+				Data: map[string]string{"password": "secret"},
+			})
+			Expect(err).Should(BeNil())
+			Expect(resp.Exec.Container).Should(Equal("web"))
+			Expect(resp.Exec.PodSelector.MatchLabels).Should(Equal(map[string]string{
+				"docker-compose.service/name":      "demo",
+				"docker-compose.service/component": "web",
+			}))
+		})
+	})
+
+	Context("When stateful services are declared", func() {
+		newProject := func(split bool) *types.Project {
+			return &types.Project{
+				Name:       "test",
+				Extensions: map[string]interface{}{"x-kuberlogic-split": split},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:  "web",
+						Image: "web:test",
+						Ports: []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+					},
+					types.ServiceConfig{
+						Name:       "db",
+						Image:      "db:test",
+						Extensions: map[string]interface{}{"x-kuberlogic-stateful": true},
+						Volumes: []types.ServiceVolumeConfig{
+							{Source: "db_data", Target: "/var/lib/db"},
+						},
+					},
+				},
+				Volumes: types.Volumes{"db_data": types.VolumeConfig{Name: "db_data"}},
+			}
+		}
+		newRequest := func() *commons.PluginRequest {
+			req := &commons.PluginRequest{
+				Name:         "demo",
+				Namespace:    "demo",
+				Replicas:     1,
+				StorageClass: "fast",
+			}
+			Expect(req.SetLimits(&corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("5Gi"),
+			})).Should(BeNil())
+			return req
+		}
+
+		It("Should run all services in a statefulset without the split mode", func() {
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			Expect(c.deployment.GetName()).Should(Equal(""))
+			Expect(c.persistentvolumeclaim.GetName()).Should(Equal(""))
+			sts := c.statefulset
+			Expect(sts.GetName()).Should(Equal("demo"))
+			Expect(*sts.Spec.Replicas).Should(Equal(int32(1)))
+			Expect(sts.Spec.ServiceName).Should(Equal(c.service.GetName()))
+			Expect(sts.Spec.Template.Spec.Containers).Should(HaveLen(2))
+			Expect(sts.Spec.Template.Spec.HostAliases[0].Hostnames).Should(Equal([]string{"db", "web"}))
+			Expect(sts.Spec.Template.Spec.InitContainers).Should(BeEmpty())
+
+			By("Checking volume claim templates")
+			Expect(sts.Spec.VolumeClaimTemplates).Should(HaveLen(1))
+			claim := sts.Spec.VolumeClaimTemplates[0]
+			Expect(claim.GetName()).Should(Equal("db-data"))
+			Expect(*claim.Spec.StorageClassName).Should(Equal("fast"))
+			Expect(*claim.Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("5Gi")))
+			Expect(sts.Spec.Template.Spec.Containers[0].VolumeMounts).Should(Equal([]corev1.VolumeMount{
+				{Name: "db-data", MountPath: "/var/lib/db", SubPath: "db_data-db"},
+			}))
+		})
+
+		It("Should run stateful services in their own statefulsets with the split mode", func() {
+			c := NewComposeModel(newProject(true), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			Expect(c.splitDeployments).Should(HaveLen(1))
+			Expect(c.splitDeployments).Should(HaveKey("web"))
+			Expect(c.splitStatefulSets).Should(HaveLen(1))
+			sts := c.splitStatefulSets["db"]
+			Expect(sts.GetName()).Should(Equal("db"))
+			Expect(sts.Spec.ServiceName).Should(Equal("db"))
+			Expect(c.splitServices["db"].Spec.ClusterIP).Should(Equal(corev1.ClusterIPNone))
+			Expect(sts.Spec.Selector.MatchLabels).Should(Equal(splitLabels("demo", "db")))
+			Expect(sts.Spec.VolumeClaimTemplates).Should(HaveLen(1))
+		})
+
+		It("Should expand claims of statefulset replicas", func() {
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+			Expect(c.statefulset.Spec.VolumeClaimTemplates[0].GetLabels()).Should(HaveKeyWithValue("docker-compose.service/volume", "db-data"))
+			Expect(c.volumeClaims).Should(HaveKey("db-data-demo-0"))
+			Expect(*c.volumeClaims["db-data-demo-0"].Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("5Gi")))
+
+			By("Reconciling with a larger storage limit")
+			sts, err := commons.ToUnstructured(c.statefulset, statefulsetGVK)
+			Expect(err).Should(BeNil())
+			var claims []*unstructured.Unstructured
+			for _, ordinal := range []string{"0", "1"} {
+				// claims are created by the statefulset from templates without the volume label
+				claim := &corev1.PersistentVolumeClaim{}
+				claim.SetName("db-data-demo-" + ordinal)
+				claim.SetNamespace("demo")
+				claim.SetLabels(labels("demo"))
+				claim.Spec = c.statefulset.Spec.VolumeClaimTemplates[0].Spec
+				u, err := commons.ToUnstructured(claim, pvcGVK)
+				Expect(err).Should(BeNil())
+				claims = append(claims, u)
+			}
+			req := newRequest()
+			Expect(req.SetLimits(&corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("10Gi"),
+			})).Should(BeNil())
+			req.SetObjects(append(claims, sts))
+
+			c = NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.persistentvolumeclaim.GetName()).Should(Equal(""))
+			Expect(*c.statefulset.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("5Gi")))
+			for _, name := range []string{"db-data-demo-0", "db-data-demo-1"} {
+				claim := c.volumeClaims[name]
+				Expect(claim.GetLabels()).Should(HaveKeyWithValue("docker-compose.service/volume", "db-data"))
+				Expect(*claim.Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("10Gi")))
+			}
+
+			By("Checking the downsize of claims of replicas")
+			for _, claim := range claims {
+				claim.SetLabels(map[string]string{"docker-compose.service/volume": "db-data"})
+			}
+			err = ValidateVolumes(newProject(false), &corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")}, claims)
+			Expect(errors.Is(err, ErrVolumeDownsizeForbidden)).Should(BeTrue())
+		})
+
+		It("Should delete deployments of services that become stateful with the split mode", func() {
+			legacy := &appsv1.Deployment{}
+			legacy.SetName("db")
+			legacy.SetNamespace("demo")
+			legacy.Status.Replicas = 1
+			u, err := commons.ToUnstructured(legacy, deploymentGVK)
+			Expect(err).Should(BeNil())
+			req := newRequest()
+			req.SetObjects([]*unstructured.Unstructured{u})
+
+			c := NewComposeModel(newProject(true), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(*c.splitDeployments["db"].Spec.Replicas).Should(Equal(int32(0)))
+			Expect(*c.splitStatefulSets["db"].Spec.Replicas).Should(Equal(int32(0)))
+
+			By("Reconciling once pods of the deployment are stopped")
+			legacy.Status.Replicas = 0
+			u, err = commons.ToUnstructured(legacy, deploymentGVK)
+			Expect(err).Should(BeNil())
+			req.SetObjects([]*unstructured.Unstructured{u})
+			c = NewComposeModel(newProject(true), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.splitDeployments).ShouldNot(HaveKey("db"))
+			Expect(*c.splitStatefulSets["db"].Spec.Replicas).Should(Equal(int32(1)))
+		})
+
+		It("Should migrate data of the previous installation", func() {
+			req := newRequest()
+
+			By("Reconciling the deployment installation")
+			project := newProject(false)
+			project.Services[1].Extensions = nil
+			previous := NewComposeModel(project, zap.NewRaw().Sugar())
+			objs, err := previous.Reconcile(req)
+			Expect(err).Should(BeNil())
+			previous.deployment.Status.Replicas = 1
+			for _, elem := range objs {
+				for gvk, obj := range elem {
+					if obj.GetName() == "" {
+						continue
+					}
+					u, err := commons.ToUnstructured(obj, gvk)
+					Expect(err).Should(BeNil())
+					req.AddObject(u)
+				}
+			}
+
+			By("Reconciling the stateful installation")
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			objs, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(len(objs)).Should(Equal(8))
+			Expect(*c.deployment.Spec.Replicas).Should(Equal(int32(0)))
+			Expect(c.volumeClaims["demo-db-data"].GetName()).Should(Equal("demo-db-data"))
+			Expect(*c.statefulset.Spec.Replicas).Should(Equal(int32(0)))
+
+			podSpec := c.statefulset.Spec.Template.Spec
+			Expect(podSpec.Volumes).Should(ContainElement(corev1.Volume{
+				Name: "legacy-demo-db-data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "demo-db-data", ReadOnly: true},
+				},
+			}))
+			Expect(podSpec.InitContainers).Should(HaveLen(1))
+			migration := podSpec.InitContainers[0]
+			Expect(migration.VolumeMounts).Should(Equal([]corev1.VolumeMount{
+				{Name: "legacy-demo-db-data", MountPath: "/legacy-volumes/demo-db-data", ReadOnly: true},
+				{Name: "db-data", MountPath: "/volumes/db-data"},
+			}))
+			Expect(migration.Command[2]).Should(Equal("if [ -d /legacy-volumes/demo-db-data/db_data-db ] && [ ! -e /volumes/db-data/.kuberlogic-migrated-db_data-db ]; " +
+				"then mkdir -p /volumes/db-data/db_data-db && cp -a /legacy-volumes/demo-db-data/db_data-db/. /volumes/db-data/db_data-db/ && " +
+				"touch /volumes/db-data/.kuberlogic-migrated-db_data-db; fi"))
+
+			By("Checking readiness while the deployment is scaled down")
+			c.statefulset.Status.Replicas = 0
+			deployment, err := commons.ToUnstructured(previous.deployment, deploymentGVK)
+			Expect(err).Should(BeNil())
+			sts, err := commons.ToUnstructured(c.statefulset, statefulsetGVK)
+			Expect(err).Should(BeNil())
+			status := &commons.PluginRequest{}
+			status.SetObjects([]*unstructured.Unstructured{deployment, sts})
+			ready, err := NewComposeModel(newProject(false), zap.NewRaw().Sugar()).Ready(status)
+			Expect(err).Should(BeNil())
+			Expect(ready).Should(BeFalse())
+
+			By("Starting the statefulset when the deployment is scaled down")
+			previous.deployment.Status.Replicas = 0
+			deployment, err = commons.ToUnstructured(previous.deployment, deploymentGVK)
+			Expect(err).Should(BeNil())
+			req.SetObjects([]*unstructured.Unstructured{deployment, sts})
+			for _, elem := range objs {
+				for gvk, obj := range elem {
+					if obj.GetName() == "" || gvk == deploymentGVK || gvk == statefulsetGVK {
+						continue
+					}
+					u, err := commons.ToUnstructured(obj, gvk)
+					Expect(err).Should(BeNil())
+					req.AddObject(u)
+				}
+			}
+			c = NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(*c.statefulset.Spec.Replicas).Should(Equal(int32(1)))
+			Expect(c.deployment.GetName()).Should(Equal(""))
+			components, err := NewComposeModel(newProject(false), zap.NewRaw().Sugar()).Components(status)
+			Expect(err).Should(BeNil())
+			Expect(components).Should(Equal([]commons.ComponentStatus{
+				{Kind: "StatefulSet", Name: "demo", Ready: true, Message: "0/0 replicas are ready"},
+			}))
+
+			By("Dropping legacy claims once data is copied by all replicas")
+			c.statefulset.Status.Replicas = 1
+			c.statefulset.Status.UpdatedReplicas = 1
+			c.statefulset.Status.ReadyReplicas = 1
+			sts, err = commons.ToUnstructured(c.statefulset, statefulsetGVK)
+			Expect(err).Should(BeNil())
+			req.SetObjects([]*unstructured.Unstructured{deployment, sts})
+			c = NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.statefulset.GetAnnotations()).Should(HaveKeyWithValue("docker-compose.service/volumes-migrated", "true"))
+			Expect(c.statefulset.Spec.Template.Spec.InitContainers).Should(BeEmpty())
+			Expect(c.statefulset.Spec.Template.Spec.Volumes).Should(BeEmpty())
+		})
+	})
+
+	Context("When stateless services are scaled", func() {
+		newProject := func(split bool) *types.Project {
+			return &types.Project{
+				Name:       "test",
+				Extensions: map[string]interface{}{"x-kuberlogic-split": split},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:       "web",
+						Image:      "web:test",
+						Extensions: map[string]interface{}{"x-kuberlogic-stateless": true},
+						Ports:      []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+						Volumes: []types.ServiceVolumeConfig{
+							{Source: "uploads", Target: "/uploads"},
+						},
+					},
+					types.ServiceConfig{
+						Name:  "worker",
+						Image: "worker:test",
+					},
+				},
+				Volumes: types.Volumes{
+					"uploads": types.VolumeConfig{
+						Name:       "uploads",
+						Extensions: map[string]interface{}{"x-kuberlogic-access-mode": "ReadWriteMany"},
+					},
+				},
+			}
+		}
+		newRequest := func() *commons.PluginRequest {
+			return &commons.PluginRequest{
+				Name:      "demo",
+				Namespace: "demo",
+				Replicas:  3,
+			}
+		}
+
+		It("Should scale stateless services in the split mode", func() {
+			c := NewComposeModel(newProject(true), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			web := c.splitDeployments["web"]
+			Expect(*web.Spec.Replicas).Should(Equal(int32(3)))
+			Expect(web.Spec.Strategy.Type).Should(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+			Expect(c.volumeClaims["demo-uploads"].Spec.AccessModes).Should(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}))
+
+			By("Checking the disruption budget")
+			pdb := c.splitPDBs["web"]
+			Expect(pdb.GetName()).Should(Equal("web"))
+			Expect(pdb.Spec.MaxUnavailable.IntValue()).Should(Equal(1))
+			Expect(pdb.Spec.Selector.MatchLabels).Should(Equal(splitLabels("demo", "web")))
+
+			By("Checking services that are not stateless")
+			worker := c.splitDeployments["worker"]
+			Expect(*worker.Spec.Replicas).Should(Equal(int32(1)))
+			Expect(worker.Spec.Strategy.Type).Should(Equal(appsv1.RecreateDeploymentStrategyType))
+			Expect(c.splitPDBs).ShouldNot(HaveKey("worker"))
+		})
+
+		It("Should scale the deployment when all services are stateless", func() {
+			p := newProject(false)
+			p.Services[1].Extensions = map[string]interface{}{"x-kuberlogic-stateless": true}
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			Expect(*c.deployment.Spec.Replicas).Should(Equal(int32(3)))
+			Expect(c.deployment.Spec.Strategy.Type).Should(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+			Expect(c.pdb.GetName()).Should(Equal("demo"))
+			Expect(c.pdb.Spec.Selector.MatchLabels).Should(Equal(labels("demo")))
+		})
+
+		It("Should keep a single replica of the deployment with services that are not stateless", func() {
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			Expect(*c.deployment.Spec.Replicas).Should(Equal(int32(1)))
+			Expect(c.deployment.Spec.Strategy.Type).Should(Equal(appsv1.RecreateDeploymentStrategyType))
+			Expect(c.pdb.GetName()).Should(Equal(""))
+		})
+
+		It("Should explain which services block scaling", func() {
+			p := newProject(false)
+			err := ValidateReplicas(p, 3)
+			Expect(errors.Is(err, ErrScalingNotSupported)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("service `worker` is not marked with x-kuberlogic-stateless"))
+			Expect(ValidateReplicas(p, 1)).Should(BeNil())
+
+			p.Volumes["uploads"] = types.VolumeConfig{Name: "uploads"}
+			p.Extensions["x-kuberlogic-split"] = true
+			err = ValidateReplicas(p, 3)
+			Expect(errors.Is(err, ErrScalingNotSupported)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("service `web` mounts volume `uploads` that is not ReadWriteMany"))
+			Expect(err.Error()).ShouldNot(ContainSubstring("worker"))
+
+			p.Services[0].Extensions = nil
+			err = ValidateReplicas(p, 3)
+			Expect(errors.Is(err, ErrScalingNotSupported)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("no services are marked with x-kuberlogic-stateless"))
+		})
+	})
+
+	Context("When multiple ports are published", func() {
+		newProject := func(split bool) *types.Project {
+			return &types.Project{
+				Name:       "test",
+				Extensions: map[string]interface{}{"x-kuberlogic-split": split},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:  "broker",
+						Image: "broker:test",
+						Ports: []types.ServicePortConfig{
+							{Target: 1883, Published: "1883", Extensions: map[string]interface{}{"x-kuberlogic-http": false}},
+							{Target: 9001, Published: "9001", Extensions: map[string]interface{}{"x-kuberlogic-http": true}},
+							{Target: 5353, Published: "53", Protocol: "udp"},
+						},
+					},
+				},
+			}
+		}
+		newRequest := func() *commons.PluginRequest {
+			return &commons.PluginRequest{
+				Name:      "demo",
+				Namespace: "demo",
+				Host:      "demo.example.com",
+				Replicas:  1,
+			}
+		}
+
+		It("Should expose ports that are not served by the ingress with port services", func() {
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			objs, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+			Expect(c.HTTPAccess()).Should(BeTrue())
+			Expect(c.AccessServiceName()).Should(Equal("demo"))
+
+			By("Checking container ports")
+			container := c.deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Ports).Should(Equal([]corev1.ContainerPort{
+				{Name: "1883-port", ContainerPort: 1883, Protocol: corev1.ProtocolTCP},
+				{Name: "5353-udp", ContainerPort: 5353, Protocol: corev1.ProtocolUDP},
+				{Name: "9001-port", ContainerPort: 9001, Protocol: corev1.ProtocolTCP},
+			}))
+			Expect(container.ReadinessProbe.HTTPGet.Port.String()).Should(Equal("9001-port"))
+
+			By("Checking the access service")
+			Expect(c.service.Spec.Ports).Should(HaveLen(1))
+			Expect(c.service.Spec.Ports[0].Port).Should(Equal(int32(9001)))
+			Expect(c.ingress.Spec.Rules[0].HTTP.Paths).Should(HaveLen(1))
+
+			By("Checking port services")
+			Expect(c.portServices).Should(HaveLen(2))
+			tcp := c.portServices["demo-tcp"]
+			Expect(tcp.Spec.Type).Should(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(tcp.Spec.Selector).Should(Equal(labels("demo")))
+			Expect(tcp.GetLabels()[portServiceLabel]).Should(Equal("tcp"))
+			Expect(tcp.Spec.Ports).Should(Equal([]corev1.ServicePort{
+				{Name: "port-1883", Protocol: corev1.ProtocolTCP, Port: 1883, TargetPort: intstr.FromInt(1883)},
+			}))
+			udp := c.portServices["demo-udp"]
+			Expect(udp.Spec.Ports).Should(Equal([]corev1.ServicePort{
+				{Name: "port-53", Protocol: corev1.ProtocolUDP, Port: 53, TargetPort: intstr.FromInt(5353)},
+			}))
+
+			By("Reconciling with allocated node ports")
+			existing := newRequest()
+			for _, elem := range objs {
+				for gvk, obj := range elem {
+					if obj.GetName() == "" {
+						continue
+					}
+					if svc, ok := obj.(*corev1.Service); ok && svc.GetName() == "demo-tcp" {
+						svc.Spec.Ports[0].NodePort = 31883
+					}
+					u, err := commons.ToUnstructured(obj, gvk)
+					Expect(err).Should(BeNil())
+					existing.AddObject(u)
+				}
+			}
+			p := newProject(false)
+			p.Extensions["x-kuberlogic-port-service-type"] = "NodePort"
+			second := NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err = second.Reconcile(existing)
+			Expect(err).Should(BeNil())
+			Expect(second.portServices).Should(HaveLen(2))
+			Expect(second.portServices["demo-tcp"].Spec.Type).Should(Equal(corev1.ServiceTypeNodePort))
+			Expect(second.portServices["demo-tcp"].Spec.Ports[0].NodePort).Should(Equal(int32(31883)))
+			Expect(second.service.Spec.Ports).Should(HaveLen(1))
+		})
+
+		It("Should expose ports of compose services in the split mode", func() {
+			c := NewComposeModel(newProject(true), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			Expect(c.splitServices["broker"].Spec.Ports).Should(Equal([]corev1.ServicePort{
+				{Name: "app-1883", Protocol: corev1.ProtocolTCP, Port: 1883, TargetPort: intstr.FromInt(1883)},
+				{Name: "app-5353-udp", Protocol: corev1.ProtocolUDP, Port: 5353, TargetPort: intstr.FromInt(5353)},
+				{Name: "app-9001", Protocol: corev1.ProtocolTCP, Port: 9001, TargetPort: intstr.FromInt(9001)},
+			}))
+			Expect(c.ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Name).Should(Equal("app-9001"))
+			Expect(c.portServices).Should(HaveKey("broker-tcp"))
+			Expect(c.portServices).Should(HaveKey("broker-udp"))
+			Expect(c.portServices["broker-udp"].Spec.Selector).Should(Equal(splitLabels("demo", "broker")))
+		})
+
+		It("Should not create the ingress without HTTP ports", func() {
+			p := newProject(false)
+			p.Services[0].Ports = p.Services[0].Ports[2:]
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest())
+			Expect(err).Should(BeNil())
+
+			Expect(c.HTTPAccess()).Should(BeFalse())
+			Expect(c.AccessServiceName()).Should(Equal("demo-udp"))
+			Expect(c.ingress.GetName()).Should(Equal(""))
+		})
+
+		It("Should validate published ports", func() {
+			for _, port := range []types.ServicePortConfig{
+				{Target: 1, Published: "1", Protocol: "sctp"},
+				{Target: 1, Published: "1", Protocol: "udp", Extensions: map[string]interface{}{"x-kuberlogic-http": true}},
+				{Target: 1, Published: "1", Extensions: map[string]interface{}{"x-kuberlogic-http": "yes"}},
+			} {
+				p := &types.Project{
+					Name:     "test",
+					Services: types.Services{{Name: "demo", Image: "demo", Ports: []types.ServicePortConfig{port}}},
+				}
+				Expect(errors.Is(ValidateComposeProject(p), ErrPortDecodeFailed)).Should(BeTrue(), "%+v", port)
+			}
+
+			p := newProject(false)
+			p.Extensions["x-kuberlogic-port-service-type"] = "ClusterIP"
+			Expect(errors.Is(ValidateComposeProject(p), ErrPortDecodeFailed)).Should(BeTrue())
+
+			p = newProject(false)
+			p.Services[0].Ports[2].Published = "1883"
+			Expect(ValidateComposeProject(p)).Should(BeNil())
+			p.Services[0].Ports[2].Protocol = "tcp"
+			Expect(errors.Is(ValidateComposeProject(p), ErrDuplicatePublishedPort)).Should(BeTrue())
+		})
+	})
+
+	Context("When healthchecks, dependencies and resources are declared", func() {
+		newProject := func(split bool) *types.Project {
+			interval := types.Duration(10 * time.Second)
+			retries := uint64(5)
+			return &types.Project{
+				Name:       "test",
+				Extensions: map[string]interface{}{"x-kuberlogic-split": split},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:  "app",
+						Image: "app:test",
+						Ports: []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+						HealthCheck: &types.HealthCheckConfig{
+							Extensions: map[string]interface{}{"x-kuberlogic-http-get": "/healthz"},
+						},
+						DependsOn: types.DependsOnConfig{
+							"db": types.ServiceDependency{Condition: types.ServiceConditionHealthy},
+						},
+						Deploy: &types.DeployConfig{
+							Resources: types.Resources{
+								Limits:       &types.Resource{NanoCPUs: "0.5", MemoryBytes: 256 * 1024 * 1024},
+								Reservations: &types.Resource{MemoryBytes: 128 * 1024 * 1024},
+							},
+						},
+					},
+					types.ServiceConfig{
+						Name:  "db",
+						Image: "db:test",
+						HealthCheck: &types.HealthCheckConfig{
+							Test:     types.HealthCheckTest{"CMD-SHELL", "pg_isready"},
+							Interval: &interval,
+							Retries:  &retries,
+						},
+					},
+				},
+			}
+		}
+		req := &commons.PluginRequest{
+			Name:      "demo",
+			Namespace: "demo",
+			Replicas:  1,
+		}
+
+		It("Should set probes, resources and start healthy dependencies first", func() {
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			containers := c.deployment.Spec.Template.Spec.Containers
+			Expect(containers).Should(HaveLen(2))
+			Expect(c.deployment.Spec.Template.Spec.InitContainers).Should(BeEmpty())
+
+			By("Checking the dependency container")
+			db := containers[0]
+			Expect(db.Name).Should(Equal("db"))
+			Expect(db.ReadinessProbe.Exec.Command).Should(Equal([]string{"sh", "-c", "pg_isready"}))
+			Expect(db.ReadinessProbe.PeriodSeconds).Should(Equal(int32(10)))
+			Expect(db.ReadinessProbe.TimeoutSeconds).Should(Equal(int32(30)))
+			Expect(db.ReadinessProbe.FailureThreshold).Should(Equal(int32(5)))
+			Expect(db.LivenessProbe).Should(Equal(db.ReadinessProbe))
+			Expect(db.Lifecycle.PostStart.Exec.Command).Should(Equal([]string{
+				"sh", "-c", `until "$@"; do sleep 10; done`, "wait-healthy", "sh", "-c", "pg_isready",
+			}))
+			Expect(db.Resources).Should(Equal(corev1.ResourceRequirements{}))
+
+			By("Checking the dependent container")
+			app := containers[1]
+			Expect(app.Name).Should(Equal("app"))
+			Expect(app.ReadinessProbe.HTTPGet.Path).Should(Equal("/healthz"))
+			Expect(app.ReadinessProbe.HTTPGet.Port).Should(Equal(intstr.FromInt(80)))
+			Expect(app.LivenessProbe.HTTPGet.Path).Should(Equal("/healthz"))
+			Expect(app.Lifecycle).Should(BeNil())
+			Expect(app.Resources.Limits.Cpu().String()).Should(Equal("500m"))
+			Expect(app.Resources.Limits.Memory().String()).Should(Equal("256Mi"))
+			Expect(app.Resources.Requests.Memory().String()).Should(Equal("128Mi"))
+		})
+
+		It("Should wait for healthy dependencies with init containers in the split mode", func() {
+			c := NewComposeModel(newProject(true), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			app := c.splitDeployments["app"].Spec.Template.Spec
+			Expect(app.InitContainers).Should(HaveLen(1))
+			Expect(app.InitContainers[0].Name).Should(Equal("wait-db"))
+			Expect(app.InitContainers[0].Command).Should(Equal([]string{"sh", "-c", "until nslookup db; do sleep 2; done"}))
+			Expect(c.splitDeployments["db"].Spec.Template.Spec.InitContainers).Should(BeEmpty())
+			Expect(c.splitDeployments["db"].Spec.Template.Spec.Containers[0].Lifecycle).Should(BeNil())
+		})
+
+		It("Should keep the default readiness check when the healthcheck is not declared", func() {
+			p := newProject(false)
+			p.Services[0].HealthCheck = nil
+			p.Services[0].DependsOn = nil
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			app := c.deployment.Spec.Template.Spec.Containers[0]
+			Expect(app.Name).Should(Equal("app"))
+			Expect(app.ReadinessProbe.HTTPGet.Path).Should(Equal("/"))
+			Expect(app.LivenessProbe).Should(BeNil())
+		})
+
+		It("Should check resources against limits", func() {
+			p := newProject(false)
+			limits := &corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+			Expect(ValidateResources(p, 1, limits)).Should(BeNil())
+
+			p.Services[1].Deploy = &types.DeployConfig{
+				Resources: types.Resources{Limits: &types.Resource{NanoCPUs: "1"}},
+			}
+			err := ValidateResources(p, 1, limits)
+			Expect(errors.Is(err, ErrResourcesExceedLimits)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("cpu of services `app`, `db` (1500m) exceeds the limit 1"))
+		})
+	})
+
+	Context("When compose volumes are claimed", func() {
+		newProject := func() *types.Project {
+			return &types.Project{
+				Name: "test",
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:  "app",
+						Image: "app:test",
+						Volumes: []types.ServiceVolumeConfig{
+							{Source: "uploads", Target: "/uploads"},
+							{Source: "cache", Target: "/cache"},
+						},
+					},
+				},
+				Volumes: types.Volumes{
+					"uploads": types.VolumeConfig{
+						Name: "uploads",
+						Extensions: map[string]interface{}{
+							"x-kuberlogic-size":          "20Gi",
+							"x-kuberlogic-storage-class": "slow",
+						},
+					},
+					"cache": types.VolumeConfig{Name: "cache"},
+				},
+			}
+		}
+		newRequest := func(storage string) *commons.PluginRequest {
+			req := &commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1, StorageClass: "fast"}
+			Expect(req.SetLimits(&corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(storage),
+			})).Should(BeNil())
+			return req
+		}
+		toUnstructured := func(obj client.Object, gvk schema.GroupVersionKind) *unstructured.Unstructured {
+			u, err := commons.ToUnstructured(obj, gvk)
+			Expect(err).Should(BeNil())
+			return u
+		}
+
+		It("Should expand claims and keep their immutable fields", func() {
+			c := NewComposeModel(newProject(), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(newRequest("1Gi"))
+			Expect(err).Should(BeNil())
+			Expect(c.volumeClaims).Should(HaveLen(2))
+			cache := c.volumeClaims["demo-cache"]
+			Expect(*cache.Spec.StorageClassName).Should(Equal("fast"))
+			Expect(*cache.Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("1Gi")))
+			Expect(*c.volumeClaims["demo-uploads"].Spec.StorageClassName).Should(Equal("slow"))
+
+			By("Reconciling with a greater storage limit")
+			cache.Spec.StorageClassName = nil
+			cache.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+			uploads := c.volumeClaims["demo-uploads"].DeepCopy()
+			uploads.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("30Gi")
+			req := newRequest("2Gi")
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(cache, pvcGVK), toUnstructured(uploads, pvcGVK)})
+
+			second := NewComposeModel(newProject(), zap.NewRaw().Sugar())
+			_, err = second.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(second.volumeClaims).Should(HaveLen(2))
+			Expect(*second.volumeClaims["demo-cache"].Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("2Gi")))
+			Expect(second.volumeClaims["demo-cache"].Spec.AccessModes).Should(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}))
+			Expect(*second.volumeClaims["demo-uploads"].Spec.Resources.Requests.Storage()).Should(Equal(resource.MustParse("30Gi")))
+
+			By("Validating the downsize of volumes")
+			claims := []*unstructured.Unstructured{toUnstructured(cache, pvcGVK)}
+			Expect(ValidateVolumes(newProject(), &corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")}, claims)).Should(BeNil())
+			err = ValidateVolumes(newProject(), &corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("500Mi")}, claims)
+			Expect(errors.Is(err, ErrVolumeDownsizeForbidden)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("volume `cache` can't be resized from 1Gi to 500Mi"))
+			err = ValidateVolumes(newProject(), &corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+				[]*unstructured.Unstructured{toUnstructured(uploads, pvcGVK)})
+			Expect(errors.Is(err, ErrVolumeDownsizeForbidden)).Should(BeTrue())
+		})
+
+		It("Should migrate data of the shared claim of the previous installation", func() {
+			legacy := &corev1.PersistentVolumeClaim{}
+			legacy.SetName("demo")
+			legacy.SetNamespace("demo")
+			req := newRequest("1Gi")
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(legacy, pvcGVK)})
+
+			c := NewComposeModel(newProject(), zap.NewRaw().Sugar())
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.persistentvolumeclaim.GetName()).Should(Equal("demo"))
+
+			podSpec := c.deployment.Spec.Template.Spec
+			Expect(podSpec.Volumes).Should(ContainElement(corev1.Volume{
+				Name: "legacy-volume",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "demo", ReadOnly: true},
+				},
+			}))
+			Expect(podSpec.InitContainers).Should(HaveLen(1))
+			migration := podSpec.InitContainers[0]
+			Expect(migration.Image).Should(Equal("alpine:3.16.2"))
+			Expect(migration.VolumeMounts).Should(Equal([]corev1.VolumeMount{
+				{Name: "legacy-volume", MountPath: "/legacy", ReadOnly: true},
+				{Name: "demo-cache", MountPath: "/volumes/demo-cache"},
+				{Name: "demo-uploads", MountPath: "/volumes/demo-uploads"},
+			}))
+			Expect(migration.Command[2]).Should(ContainSubstring("cp -a /legacy/uploads-app/. /volumes/demo-uploads/uploads-app/"))
+			Expect(migration.Command[2]).Should(ContainSubstring("cp -a /legacy/cache-app/. /volumes/demo-cache/cache-app/"))
+
+			By("Reconciling while the deployment is rolled out")
+			c.deployment.Status.Replicas = 1
+			c.deployment.Status.UpdatedReplicas = 1
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(legacy, pvcGVK), toUnstructured(c.deployment, deploymentGVK)})
+			rolling := NewComposeModel(newProject(), zap.NewRaw().Sugar())
+			_, err = rolling.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(rolling.deployment.GetAnnotations()).ShouldNot(HaveKey("docker-compose.service/volumes-migrated"))
+			Expect(rolling.deployment.Spec.Template.Spec.InitContainers).Should(HaveLen(1))
+			Expect(rolling.persistentvolumeclaim.GetName()).Should(Equal("demo"))
+
+			By("Reconciling once data is copied by all replicas")
+			c.deployment.Status.ReadyReplicas = 1
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(legacy, pvcGVK), toUnstructured(c.deployment, deploymentGVK)})
+			migrated := NewComposeModel(newProject(), zap.NewRaw().Sugar())
+			_, err = migrated.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(migrated.deployment.GetAnnotations()).Should(HaveKeyWithValue("docker-compose.service/volumes-migrated", "true"))
+			Expect(migrated.deployment.Spec.Template.Spec.InitContainers).Should(BeEmpty())
+			Expect(migrated.persistentvolumeclaim.GetName()).Should(Equal(""))
+			for _, volume := range migrated.deployment.Spec.Template.Spec.Volumes {
+				Expect(volume.PersistentVolumeClaim.ClaimName).ShouldNot(Equal("demo"))
+			}
+		})
+
+		It("Should validate volumes", func() {
+			for _, extensions := range []map[string]interface{}{
+				{"x-kuberlogic-size": "big"},
+				{"x-kuberlogic-size": "-1Gi"},
+				{"x-kuberlogic-size": 10},
+				{"x-kuberlogic-storage-class": ""},
+			} {
+				p := newProject()
+				p.Volumes["cache"] = types.VolumeConfig{Name: "cache", Extensions: extensions}
+				Expect(errors.Is(ValidateComposeProject(p), ErrVolumeDecodeFailed)).Should(BeTrue(), "%+v", extensions)
+			}
+
+			p := newProject()
+			p.Services[0].Volumes[1] = types.ServiceVolumeConfig{Type: types.VolumeTypeBind, Source: "/var/cache", Target: "/cache"}
+			Expect(errors.Is(ValidateComposeProject(p), ErrUnsupportedField)).Should(BeTrue())
+
+			p.Services[0].Volumes[1] = types.ServiceVolumeConfig{Type: types.VolumeTypeVolume, Target: "/cache"}
+			Expect(errors.Is(ValidateComposeProject(p), ErrVolumeDecodeFailed)).Should(BeTrue())
+		})
+	})
+
+	Context("When one-shot services are declared", func() {
+		newProject := func(split bool) *types.Project {
+			return &types.Project{
+				Name: "test",
+				Extensions: map[string]interface{}{
+					"x-kuberlogic-split": split,
+				},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:    "migrate",
+						Image:   "app:{{ .Version }}",
+						Command: types.ShellCommand{"app", "migrate"},
+						Restart: "on-failure:3",
+						Extensions: map[string]interface{}{
+							"x-kuberlogic-oneshot": true,
+						},
+					},
+					types.ServiceConfig{
+						Name:  "web",
+						Image: "app:{{ .Version }}",
+						Ports: []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+						DependsOn: types.DependsOnConfig{
+							"migrate": types.ServiceDependency{Condition: types.ServiceConditionCompletedSuccessfully},
+						},
+					},
+				},
+			}
+		}
+		toUnstructured := func(obj client.Object, gvk schema.GroupVersionKind) *unstructured.Unstructured {
+			u, err := commons.ToUnstructured(obj, gvk)
+			Expect(err).Should(BeNil())
+			return u
+		}
+
+		It("Should run one-shot services by jobs of the version", func() {
+			Expect(ValidateComposeProject(newProject(false))).Should(BeNil())
+
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			req := &commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1, Version: "1.0"}
+			objects, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.jobs).Should(HaveLen(1))
+			Expect(objects[len(objects)-1]).Should(HaveKey(jobGVK))
+
+			job := c.jobs[jobName("demo", "migrate", "1.0")]
+			Expect(job.GetName()).Should(HavePrefix("demo-migrate-"))
+			Expect(job.GetLabels()).Should(HaveKeyWithValue("docker-compose.service/job", "migrate"))
+			Expect(*job.Spec.BackoffLimit).Should(Equal(int32(3)))
+			Expect(job.Spec.Template.Spec.RestartPolicy).Should(Equal(corev1.RestartPolicyOnFailure))
+			Expect(job.Spec.Template.GetLabels()).Should(Equal(map[string]string{"docker-compose.service/job": "migrate"}))
+			Expect(job.Spec.Template.Spec.Containers).Should(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].Image).Should(Equal("app:1.0"))
+
+			By("Holding the new deployment until the job succeeds")
+			Expect(c.deployment.Spec.Template.Spec.Containers).Should(HaveLen(1))
+			Expect(c.deployment.Spec.Template.Spec.Containers[0].Name).Should(Equal("web"))
+			Expect(*c.deployment.Spec.Replicas).Should(Equal(int32(0)))
+			Expect(c.isReady()).Should(BeFalse())
+
+			job.Status.Succeeded = 1
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(job, jobGVK), toUnstructured(c.deployment, deploymentGVK)})
+			c = NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(*c.deployment.Spec.Replicas).Should(Equal(int32(1)))
+
+			By("Keeping the previous pod template on the version change")
+			deployment := toUnstructured(c.deployment, deploymentGVK)
+			req = &commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1, Version: "2.0"}
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(job, jobGVK), deployment})
+			c = NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			objects, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.jobs).Should(HaveLen(1))
+			Expect(c.jobs).Should(HaveKey(jobName("demo", "migrate", "2.0")))
+			Expect(c.deployment.Spec.Template.Spec.Containers[0].Image).Should(Equal("app:1.0"))
+			for _, o := range objects {
+				if obj, found := o[jobGVK]; found {
+					Expect(obj.GetName()).ShouldNot(Equal(job.GetName()))
+				}
+			}
+
+			upgrade := c.jobs[jobName("demo", "migrate", "2.0")]
+			upgrade.Status.Succeeded = 1
+			req.SetObjects([]*unstructured.Unstructured{toUnstructured(upgrade, jobGVK), deployment})
+			c = NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.deployment.Spec.Template.Spec.Containers[0].Image).Should(Equal("app:2.0"))
+		})
+
+		It("Should hold only dependent services in the split mode", func() {
+			p := newProject(true)
+			p.Services = append(p.Services, types.ServiceConfig{Name: "worker", Image: "worker:test"})
+			Expect(ValidateComposeProject(p)).Should(BeNil())
+
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err := c.Reconcile(&commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1})
+			Expect(err).Should(BeNil())
+			Expect(c.splitDeployments).Should(HaveLen(2))
+			Expect(*c.splitDeployments["web"].Spec.Replicas).Should(Equal(int32(0)))
+			Expect(*c.splitDeployments["worker"].Spec.Replicas).Should(Equal(int32(1)))
+			Expect(c.splitServices).ShouldNot(HaveKey("migrate"))
+		})
+
+		It("Should report failed jobs", func() {
+			job := &batchv1.Job{}
+			job.SetName("demo-migrate-c4ca4238")
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit"},
+			}
+			status := &commons.PluginRequest{}
+			status.SetObjects([]*unstructured.Unstructured{toUnstructured(job, jobGVK)})
+
+			c := NewComposeModel(newProject(false), zap.NewRaw().Sugar())
+			components, err := c.Components(status)
+			Expect(err).Should(BeNil())
+			Expect(components).Should(Equal([]commons.ComponentStatus{
+				{Kind: "Job", Name: "demo-migrate-c4ca4238", Ready: false, Message: "job failed: Job has reached the specified backoff limit"},
+			}))
+			Expect(c.isReady()).Should(BeFalse())
+		})
+
+		It("Should validate one-shot services", func() {
+			for _, tc := range []struct {
+				update func(p *types.Project)
+				err    string
+			}{
+				{func(p *types.Project) { p.Services[0].Extensions["x-kuberlogic-oneshot"] = "yes" }, "must be a boolean"},
+				{func(p *types.Project) { p.Services[0].Restart = types.RestartPolicyAlways }, "restart policy `always`"},
+				{func(p *types.Project) { p.Services[0].Restart = "on-failure:many" }, "invalid restart policy"},
+				{func(p *types.Project) { p.Services[0].Ports = p.Services[1].Ports }, "can't publish ports"},
+				{func(p *types.Project) { p.Services[0].Extensions["x-kuberlogic-stateful"] = true }, "can't set x-kuberlogic-stateful"},
+				{func(p *types.Project) {
+					p.Services[0].DependsOn = types.DependsOnConfig{"web": types.ServiceDependency{Condition: types.ServiceConditionStarted}}
+				}, "only when x-kuberlogic-split is set"},
+				{func(p *types.Project) {
+					p.Services[1].DependsOn["migrate"] = types.ServiceDependency{Condition: types.ServiceConditionHealthy}
+				}, "can't wait one-shot service `migrate`"},
+			} {
+				p := newProject(false)
+				tc.update(p)
+				err := ValidateComposeProject(p)
+				Expect(err).ShouldNot(BeNil(), tc.err)
+				Expect(err.Error()).Should(ContainSubstring(tc.err))
+			}
+
+			By("Checking replicas of workloads only")
+			p := newProject(false)
+			p.Services[1].Extensions = map[string]interface{}{"x-kuberlogic-stateless": true}
+			Expect(ValidateReplicas(p, 2)).Should(BeNil())
+		})
+	})
+
+	Context("When cron jobs are declared", func() {
+		newProject := func(cronJobs map[string]interface{}) *types.Project {
+			return &types.Project{
+				Name: "test",
+				Extensions: map[string]interface{}{
+					"x-kuberlogic-secrets": map[string]interface{}{
+						"token": "{{ GenerateKey 8 }}",
+					},
+					"x-kuberlogic-cronjobs": cronJobs,
+				},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:       "web",
+						Image:      "app:{{ .Version }}",
+						Entrypoint: types.ShellCommand{"/entrypoint.sh"},
+						Command:    types.ShellCommand{"serve"},
+						Ports:      []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+						Environment: types.MappingWithEquals{
+							"ENV1": &envVal,
+						},
+						Volumes: []types.ServiceVolumeConfig{
+							{Source: "data", Target: "/data"},
+						},
+					},
+				},
+				Volumes: types.Volumes{"data": types.VolumeConfig{Name: "data"}},
+			}
+		}
+
+		It("Should run clones of compose services on the schedule", func() {
+			p := newProject(map[string]interface{}{
+				"cleanup": map[string]interface{}{
+					"schedule": "0 3 * * *",
+					"service":  "web",
+					"command":  []interface{}{"app", "cleanup"},
+				},
+				"digest": map[string]interface{}{
+					"schedule": "@weekly",
+					"service":  "web",
+					"command":  "app send-digest",
+				},
+			})
+			Expect(ValidateComposeProject(p)).Should(BeNil())
+
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			objects, err := c.Reconcile(&commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1, Version: "1.0"})
+			Expect(err).Should(BeNil())
+			Expect(c.cronJobs).Should(HaveLen(2))
+			Expect(objects[len(objects)-1]).Should(HaveKey(cronJobGVK))
+
+			cleanup := c.cronJobs["demo-cleanup"]
+			Expect(cleanup.GetLabels()).Should(HaveKeyWithValue("docker-compose.service/cronjob", "cleanup"))
+			Expect(cleanup.Spec.Schedule).Should(Equal("0 3 * * *"))
+			Expect(cleanup.Spec.ConcurrencyPolicy).Should(Equal(batchv1.ForbidConcurrent))
+
+			pod := cleanup.Spec.JobTemplate.Spec.Template
+			Expect(pod.Spec.RestartPolicy).Should(Equal(corev1.RestartPolicyOnFailure))
+			Expect(pod.Spec.Containers).Should(HaveLen(1))
+			container := pod.Spec.Containers[0]
+			Expect(container.Image).Should(Equal("app:1.0"))
+			Expect(container.Command).Should(Equal([]string{"/entrypoint.sh"}))
+			Expect(container.Args).Should(Equal([]string{"app", "cleanup"}))
+			Expect(container.Ports).Should(BeEmpty())
+			Expect(container.ReadinessProbe).Should(BeNil())
+			Expect(container.Env).Should(Equal(c.deployment.Spec.Template.Spec.Containers[0].Env))
+			Expect(container.VolumeMounts).Should(Equal(c.deployment.Spec.Template.Spec.Containers[0].VolumeMounts))
+			Expect(pod.Spec.Volumes).Should(Equal(c.deployment.Spec.Template.Spec.Volumes))
+			Expect(pod.GetAnnotations()).Should(HaveKeyWithValue(secretsChecksumAnnotation, secretsChecksum(c.secret.Data)))
+
+			Expect(c.cronJobs["demo-digest"].Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args).
+				Should(Equal([]string{"sh", "-c", "app send-digest"}))
+
+			By("Dropping cron jobs removed from the extension")
+			cronJob, err := commons.ToUnstructured(c.cronJobs["demo-digest"], cronJobGVK)
+			Expect(err).Should(BeNil())
+			req := &commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1}
+			req.SetObjects([]*unstructured.Unstructured{cronJob})
+			delete(p.Extensions["x-kuberlogic-cronjobs"].(map[string]interface{}), "digest")
+			c = NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err = c.Reconcile(req)
+			Expect(err).Should(BeNil())
+			Expect(c.cronJobs).Should(HaveLen(1))
+			Expect(c.cronJobs).Should(HaveKey("demo-cleanup"))
+		})
+
+		It("Should limit names of cron jobs", func() {
+			p := newProject(map[string]interface{}{
+				"cleanup-of-expired-sessions-and-uploads": map[string]interface{}{"schedule": "@daily", "service": "web"},
+				"cleanup-of-expired-sessions-and-reports": map[string]interface{}{"schedule": "@daily", "service": "web"},
+			})
+			Expect(ValidateComposeProject(p)).Should(BeNil())
+
+			c := NewComposeModel(p, zap.NewRaw().Sugar())
+			_, err := c.Reconcile(&commons.PluginRequest{Name: "demo-with-long-name", Namespace: "demo", Replicas: 1, Version: "1.0"})
+			Expect(err).Should(BeNil())
+			Expect(c.cronJobs).Should(HaveLen(2))
+			for name, cronJob := range c.cronJobs {
+				Expect(len(name)).Should(BeNumerically("<=", 52))
+				Expect(name).Should(HavePrefix("demo-with-long-name-cleanup-of-expired-sess"))
+				Expect(cronJob.GetName()).Should(Equal(name))
+			}
+			Expect(cronJobName("demo", "cleanup")).Should(Equal("demo-cleanup"))
+		})
+
+		It("Should validate cron jobs", func() {
+			for _, tc := range []struct {
+				cronJobs interface{}
+				err      string
+			}{
+				{"cleanup", "failed to decode parameter"},
+				{map[string]interface{}{"Cleanup": map[string]interface{}{"schedule": "@daily", "service": "web"}}, "invalid name of cron job `Cleanup`"},
+				{map[string]interface{}{"cleanup": "@daily"}, "value is object"},
+				{map[string]interface{}{"cleanup": map[string]interface{}{"schedule": "@daily", "service": "web", "user": "root"}}, "unknown field `user`"},
+				{map[string]interface{}{"cleanup": map[string]interface{}{"schedule": "daily", "service": "web"}}, "invalid schedule `daily`"},
+				{map[string]interface{}{"cleanup": map[string]interface{}{"schedule": "@daily", "service": "worker"}}, "unknown service `worker`"},
+				{map[string]interface{}{"cleanup": map[string]interface{}{"schedule": "@daily", "service": "web", "command": []interface{}{1}}}, "invalid type of field `command`"},
+			} {
+				p := newProject(nil)
+				p.Extensions["x-kuberlogic-cronjobs"] = tc.cronJobs
+				err := ValidateComposeProject(p)
+				Expect(errors.Is(err, ErrCronJobsDecodeFailed)).Should(BeTrue(), tc.err)
+				Expect(err.Error()).Should(ContainSubstring(tc.err))
+			}
+
+			By("Checking volumes of stateful services")
+			p := newProject(map[string]interface{}{"cleanup": map[string]interface{}{"schedule": "@daily", "service": "web"}})
+			p.Services[0].Extensions = map[string]interface{}{"x-kuberlogic-stateful": true}
+			err := ValidateComposeProject(p)
+			Expect(errors.Is(err, ErrCronJobsDecodeFailed)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("can't mount volume `data`"))
+		})
+	})
+
+	Context("When supported versions are declared", func() {
+		newProject := func(versions interface{}) *types.Project {
+			return &types.Project{
+				Name: "test",
+				Extensions: map[string]interface{}{
+					"x-kuberlogic-versions": versions,
+				},
+				Services: types.Services{
+					types.ServiceConfig{
+						Name:  "web",
+						Image: "app:{{ .Version }}",
+					},
+				},
+			}
+		}
+
+		It("Should return the default and supported versions", func() {
+			p := newProject(map[string]interface{}{
+				"supported": []interface{}{"1.9", "2.0", "2.1"},
+			})
+			Expect(ValidateComposeProject(p)).Should(BeNil())
+			defaultVersion, versions, err := Versions(p)
+			Expect(err).Should(BeNil())
+			Expect(defaultVersion).Should(Equal("2.1"))
+			Expect(versions).Should(Equal([]string{"1.9", "2.0", "2.1"}))
+
+			p.Extensions["x-kuberlogic-versions"].(map[string]interface{})["default"] = "2.0"
+			defaultVersion, _, err = Versions(p)
+			Expect(err).Should(BeNil())
+			Expect(defaultVersion).Should(Equal("2.0"))
+
+			By("Accepting any version without the extension")
+			delete(p.Extensions, "x-kuberlogic-versions")
+			defaultVersion, versions, err = Versions(p)
+			Expect(err).Should(BeNil())
+			Expect(defaultVersion).Should(BeEmpty())
+			Expect(versions).Should(BeEmpty())
+			Expect(ValidateVersion(p, "latest", "")).Should(BeNil())
+		})
+
+		It("Should validate version changes", func() {
+			p := newProject(map[string]interface{}{
+				"supported": []interface{}{"1.9", "2.0", "2.1"},
+			})
+			Expect(ValidateVersion(p, "2.0", "")).Should(BeNil())
+			Expect(ValidateVersion(p, "2.1", "1.9")).Should(BeNil())
+			Expect(ValidateVersion(p, "1.9", "1.9")).Should(BeNil())
+			// services can keep or leave versions that are not supported anymore
+			Expect(ValidateVersion(p, "1.8", "1.8")).Should(BeNil())
+			Expect(ValidateVersion(p, "1.9", "1.8")).Should(BeNil())
+
+			for _, tc := range []struct {
+				version, previous string
+				err               string
+			}{
+				{"3.0", "", "version `3.0` is not supported"},
+				{"3.0", "2.1", "version `3.0` is not supported"},
+				{"", "", "version `` is not supported"},
+				{"1.9", "2.0", "downgrade from version `2.0` to `1.9` is not allowed"},
+			} {
+				err := ValidateVersion(p, tc.version, tc.previous)
+				Expect(errors.Is(err, ErrVersionNotSupported)).Should(BeTrue(), tc.err)
+				Expect(err.Error()).Should(ContainSubstring(tc.err))
+			}
+
+			By("Allowing downgrades")
+			p.Extensions["x-kuberlogic-versions"].(map[string]interface{})["downgrade"] = true
+			Expect(ValidateVersion(p, "1.9", "2.1")).Should(BeNil())
+
+			By("Upgrading sequentially")
+			p.Extensions["x-kuberlogic-versions"].(map[string]interface{})["sequential"] = true
+			Expect(ValidateVersion(p, "2.0", "1.9")).Should(BeNil())
+			err := ValidateVersion(p, "2.1", "1.9")
+			Expect(errors.Is(err, ErrVersionNotSupported)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring("must go to version `2.0` first"))
+		})
+
+		It("Should validate the extension", func() {
+			for _, tc := range []struct {
+				versions interface{}
+				err      string
+			}{
+				{"2.1", "failed to decode parameter"},
+				{map[string]interface{}{}, "supported versions are not set"},
+				{map[string]interface{}{"supported": "2.1"}, "invalid type of field `supported`"},
+				{map[string]interface{}{"supported": []interface{}{"2.0", "2.0"}}, "invalid type of field `supported`"},
+				{map[string]interface{}{"supported": []interface{}{"2.0"}, "latest": "2.0"}, "unknown field `latest`"},
+				{map[string]interface{}{"supported": []interface{}{"2.0"}, "downgrade": "yes"}, "invalid type of field `downgrade`"},
+				{map[string]interface{}{"supported": []interface{}{"2.0"}, "default": "2.1"}, "default version `2.1` is not supported"},
+			} {
+				err := ValidateComposeProject(newProject(tc.versions))
+				Expect(errors.Is(err, ErrVersionsDecodeFailed)).Should(BeTrue(), tc.err)
+				Expect(err.Error()).Should(ContainSubstring(tc.err))
+			}
+		})
+	})
+
+	Context("When components status is requested", func() {
+		project := &types.Project{
+			Name: "test",
+			Services: types.Services{
+				types.ServiceConfig{
+					Name:  "app",
+					Image: "demo:test",
+					Ports: []types.ServicePortConfig{{Target: 80, Published: "8001"}},
+					Volumes: []types.ServiceVolumeConfig{
+						{Source: "data", Target: "/data"},
+					},
+				},
+			},
+			Volumes: types.Volumes{"data": types.VolumeConfig{Name: "data"}},
+		}
+
+		It("Should report the deployment and the volume claim", func() {
+			c := NewComposeModel(project, zap.NewRaw().Sugar())
+			req := &commons.PluginRequest{Name: "demo", Namespace: "demo", Replicas: 1}
+			_, err := c.Reconcile(req)
+			Expect(err).Should(BeNil())
+
+			c.deployment.Status.Replicas = 1
+			deployment, err := commons.ToUnstructured(c.deployment, deploymentGVK)
+			Expect(err).Should(BeNil())
+			pvc, err := commons.ToUnstructured(c.volumeClaims["demo-data"], pvcGVK)
+			Expect(err).Should(BeNil())
+
+			status := &commons.PluginRequest{}
+			status.SetObjects([]*unstructured.Unstructured{deployment, pvc})
+			components, err := NewComposeModel(project, zap.NewRaw().Sugar()).Components(status)
+			Expect(err).Should(BeNil())
+			Expect(components).Should(Equal([]commons.ComponentStatus{
+				{Kind: "Deployment", Name: "demo", Ready: false, Message: "0/1 replicas are ready"},
+				{Kind: "PersistentVolumeClaim", Name: "demo-data", Ready: false, Message: "volume claim is pending"},
+			}))
+		})
+	})
+
 	Context("validating docker-compose project", func() {
 		When("it is valid", func() {
 			It("Should succeed", func() {
@@ -775,6 +2312,54 @@ var _ = Describe("docker-compose model", func() {
 				Expect(errors.Is(ValidateComposeProject(q), ErrSecretsDecodeFailed)).Should(BeTrue())
 			})
 
+			It("should fail with unknown secret definition fields", func() {
+				q := &types.Project{
+					Name: "test",
+					Extensions: map[string]interface{}{
+						"x-kuberlogic-secrets": map[string]interface{}{
+							"token": map[string]interface{}{
+								"value":   "{{ GenerateKey 16 }}",
+								"rotated": true,
+							},
+						},
+					},
+					Services: []types.ServiceConfig{
+						{
+							Name:  "demo",
+							Image: "demo",
+						},
+					},
+				}
+
+				Expect(errors.Is(ValidateComposeProject(q), ErrSecretsDecodeFailed)).Should(BeTrue())
+			})
+
+			It("should fail with incorrect parameters", func() {
+				for _, parameters := range []interface{}{
+					"test",
+					map[string]interface{}{"A": "test"},
+					map[string]interface{}{"A": map[string]interface{}{"type": "float"}},
+					map[string]interface{}{"A": map[string]interface{}{"type": "integer", "default": "ten"}},
+					map[string]interface{}{"A": map[string]interface{}{"container": "unknown"}},
+					map[string]interface{}{"A": map[string]interface{}{"required": true}},
+				} {
+					q := &types.Project{
+						Name: "test",
+						Extensions: map[string]interface{}{
+							"x-kuberlogic-parameters": parameters,
+						},
+						Services: []types.ServiceConfig{
+							{
+								Name:  "demo",
+								Image: "demo",
+							},
+						},
+					}
+
+					Expect(errors.Is(ValidateComposeProject(q), ErrParametersDecodeFailed)).Should(BeTrue(), "%+v", parameters)
+				}
+			})
+
 			It("should fail with incorrect configs", func() {
 				q := &types.Project{
 					Name: "test",
@@ -792,7 +2377,111 @@ var _ = Describe("docker-compose model", func() {
 				Expect(errors.Is(ValidateComposeProject(q), ErrConfigsDecodeFailed))
 			})
 
-			It("should fail when two ports are published", func() {
+			It("should fail with incorrect split mode", func() {
+				p := &types.Project{
+					Name:       "test",
+					Extensions: map[string]interface{}{"x-kuberlogic-split": "yes"},
+					Services:   []types.ServiceConfig{{Name: "demo", Image: "demo"}},
+				}
+				Expect(errors.Is(ValidateComposeProject(p), ErrSplitDecodeFailed)).Should(BeTrue())
+
+				p.Extensions["x-kuberlogic-split"] = true
+				p.Services = []types.ServiceConfig{
+					{Name: "web", Image: "web", Volumes: []types.ServiceVolumeConfig{{Source: "data", Target: "/uploads"}}},
+					{Name: "db", Image: "db", Volumes: []types.ServiceVolumeConfig{{Source: "data", Target: "/data"}}},
+				}
+				err := ValidateComposeProject(p)
+				Expect(errors.Is(err, ErrSplitSharedVolume)).Should(BeTrue())
+				Expect(err.Error()).Should(ContainSubstring("services `db` and `web` mount volume `data`"))
+
+				p.Services[1].Volumes[0].Source = "db_data"
+				Expect(ValidateComposeProject(p)).Should(BeNil())
+			})
+
+			It("should fail with incorrect stateful services", func() {
+				p := &types.Project{
+					Name: "test",
+					Services: []types.ServiceConfig{
+						{Name: "demo", Image: "demo", Extensions: map[string]interface{}{"x-kuberlogic-stateful": "yes"}},
+					},
+				}
+				Expect(errors.Is(ValidateComposeProject(p), ErrStatefulDecodeFailed)).Should(BeTrue())
+			})
+
+			It("should fail with incorrect stateless services", func() {
+				p := &types.Project{
+					Name: "test",
+					Services: []types.ServiceConfig{
+						{Name: "demo", Image: "demo", Extensions: map[string]interface{}{"x-kuberlogic-stateless": "yes"}},
+					},
+				}
+				Expect(errors.Is(ValidateComposeProject(p), ErrStatelessDecodeFailed)).Should(BeTrue())
+
+				p.Services[0].Extensions = map[string]interface{}{"x-kuberlogic-stateless": true, "x-kuberlogic-stateful": true}
+				Expect(errors.Is(ValidateComposeProject(p), ErrStatelessDecodeFailed)).Should(BeTrue())
+
+				p.Services[0].Extensions = nil
+				p.Volumes = types.Volumes{"data": types.VolumeConfig{
+					Name:       "data",
+					Extensions: map[string]interface{}{"x-kuberlogic-access-mode": "ReadOnlyMany"},
+				}}
+				Expect(errors.Is(ValidateComposeProject(p), ErrVolumeAccessModeDecodeFailed)).Should(BeTrue())
+			})
+
+			It("should fail with incorrect healthchecks and dependencies", func() {
+				for _, svc := range []types.ServiceConfig{
+					{Name: "demo", Image: "demo", HealthCheck: &types.HealthCheckConfig{Test: types.HealthCheckTest{"RUN", "true"}}},
+					{Name: "demo", Image: "demo", HealthCheck: &types.HealthCheckConfig{
+						Extensions: map[string]interface{}{"x-kuberlogic-tcp-socket": "db"},
+					}},
+					{Name: "demo", Image: "demo", HealthCheck: &types.HealthCheckConfig{
+						Extensions: map[string]interface{}{"x-kuberlogic-http-get": "/healthz"},
+					}},
+					{Name: "demo", Image: "demo", HealthCheck: &types.HealthCheckConfig{
+						Test:       types.HealthCheckTest{"CMD", "true"},
+						Extensions: map[string]interface{}{"x-kuberlogic-tcp-socket": 80},
+					}},
+				} {
+					p := &types.Project{Name: "test", Services: []types.ServiceConfig{svc}}
+					Expect(errors.Is(ValidateComposeProject(p), ErrHealthCheckDecodeFailed)).Should(BeTrue(), "%+v", svc.HealthCheck)
+				}
+
+				p := &types.Project{
+					Name: "test",
+					Services: []types.ServiceConfig{
+						{Name: "web", Image: "web", DependsOn: types.DependsOnConfig{
+							"db": types.ServiceDependency{Condition: types.ServiceConditionHealthy},
+						}},
+						{Name: "db", Image: "db"},
+					},
+				}
+				err := ValidateComposeProject(p)
+				Expect(errors.Is(err, ErrDependencyNotSupported)).Should(BeTrue())
+				Expect(err.Error()).Should(ContainSubstring("service `web` depends on service `db` that does not declare a healthcheck"))
+
+				p.Services[1].HealthCheck = &types.HealthCheckConfig{
+					Extensions: map[string]interface{}{"x-kuberlogic-tcp-socket": 5432},
+				}
+				Expect(errors.Is(ValidateComposeProject(p), ErrDependencyNotSupported)).Should(BeTrue())
+
+				p.Extensions = map[string]interface{}{"x-kuberlogic-split": true}
+				Expect(ValidateComposeProject(p)).Should(BeNil())
+			})
+
+			It("should fail with incorrect deploy resources", func() {
+				for _, resources := range []types.Resources{
+					{Limits: &types.Resource{NanoCPUs: "half"}},
+					{Limits: &types.Resource{MemoryBytes: 64}, Reservations: &types.Resource{MemoryBytes: 128}},
+				} {
+					p := &types.Project{
+						Name:     "test",
+						Services: []types.ServiceConfig{{Name: "demo", Image: "demo", Deploy: &types.DeployConfig{Resources: resources}}},
+					}
+					Expect(errors.Is(ValidateComposeProject(p), ErrResourcesDecodeFailed)).Should(BeTrue(), "%+v", resources)
+				}
+			})
+
+			It("should fail when two HTTP ports are published", func() {
 				q := &types.Project{
 					Name: "test",
 					Services: []types.ServiceConfig{
@@ -801,12 +2490,14 @@ var _ = Describe("docker-compose model", func() {
 							Image: "demo",
 							Ports: []types.ServicePortConfig{
 								{
-									Published: "1",
-									Target:    1,
+									Published:  "1",
+									Target:     1,
+									Extensions: map[string]interface{}{PortHTTPExtension: true},
 								},
 								{
-									Published: "2",
-									Target:    2,
+									Published:  "2",
+									Target:     2,
+									Extensions: map[string]interface{}{PortHTTPExtension: true},
 								},
 							},
 						},
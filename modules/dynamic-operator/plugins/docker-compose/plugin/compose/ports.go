@@ -0,0 +1,177 @@
+package compose
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// portServiceLabel is set on services exposing ports that are not served by the ingress, the value is the protocol of ports
+const portServiceLabel = "docker-compose.service/ports"
+
+// portProtocol returns a protocol of the compose port p
+func portProtocol(p types.ServicePortConfig) corev1.Protocol {
+	if strings.EqualFold(p.Protocol, string(corev1.ProtocolUDP)) {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
+
+// httpPortIndex returns an index of the compose service svc port exposed with the ingress, -1 is returned when there is no such port.
+// The first TCP port is exposed with the ingress unless another port sets the x-kuberlogic-http extension,
+// other ports are exposed with a LoadBalancer or NodePort service:
+//
+//	services:
+//	  broker:
+//	    image: eclipse-mosquitto
+//	    ports:
+//	      - target: 1883
+//	        published: 1883
+//	        x-kuberlogic-http: false
+//	      - target: 9001
+//	        published: 9001
+//	        x-kuberlogic-http: true
+func httpPortIndex(svc types.ServiceConfig) int {
+	for i, p := range svc.Ports {
+		if http, _ := p.Extensions[PortHTTPExtension].(bool); http {
+			return i
+		}
+	}
+	for i, p := range svc.Ports {
+		if _, set := p.Extensions[PortHTTPExtension]; !set && portProtocol(p) == corev1.ProtocolTCP {
+			return i
+		}
+	}
+	return -1
+}
+
+// portServiceType returns a type of services exposing ports that are not served by the ingress:
+//
+//	x-kuberlogic-port-service-type: NodePort
+func portServiceType(p *types.Project) corev1.ServiceType {
+	if t, _ := p.Extensions[PortServiceTypeExtension].(string); t == string(corev1.ServiceTypeNodePort) {
+		return corev1.ServiceTypeNodePort
+	}
+	return corev1.ServiceTypeLoadBalancer
+}
+
+// validatePorts checks published ports of compose services
+func validatePorts(p *types.Project) error {
+	if raw, set := p.Extensions[PortServiceTypeExtension]; set {
+		if t, _ := raw.(string); t != string(corev1.ServiceTypeLoadBalancer) && t != string(corev1.ServiceTypeNodePort) {
+			return errors.Wrapf(ErrPortDecodeFailed, "`%s` must be %s or %s", PortServiceTypeExtension, corev1.ServiceTypeLoadBalancer, corev1.ServiceTypeNodePort)
+		}
+	}
+
+	for _, svc := range p.Services {
+		var httpPorts int
+		for _, port := range svc.Ports {
+			switch strings.ToLower(port.Protocol) {
+			case "", "tcp", "udp":
+			default:
+				return errors.Wrapf(ErrPortDecodeFailed, "unsupported protocol `%s` of port `%d` in service `%s`", port.Protocol, port.Target, svc.Name)
+			}
+			if _, err := strconv.Atoi(port.Published); err != nil {
+				return errors.Wrapf(ErrParsingPublishedPort, "port `%s` of service `%s`", port.Published, svc.Name)
+			}
+
+			raw, set := port.Extensions[PortHTTPExtension]
+			if !set {
+				continue
+			}
+			http, converted := raw.(bool)
+			if !converted {
+				return errors.Wrapf(ErrPortDecodeFailed, "`%s` of port `%d` in service `%s` must be a boolean", PortHTTPExtension, port.Target, svc.Name)
+			}
+			if http && portProtocol(port) != corev1.ProtocolTCP {
+				return errors.Wrapf(ErrPortDecodeFailed, "UDP port `%d` of service `%s` can't be exposed with HTTP", port.Target, svc.Name)
+			}
+			if http {
+				httpPorts++
+			}
+		}
+		if httpPorts > 1 {
+			return errors.Wrapf(ErrTooManyAccessPorts, "error in service `%s`", svc.Name)
+		}
+	}
+	return nil
+}
+
+// portService returns a service exposing ports by the name, the service is created when it is not found
+func (c *ComposeModel) portService(name string) *corev1.Service {
+	service, found := c.portServices[name]
+	if !found {
+		service = &corev1.Service{}
+		c.portServices[name] = service
+	}
+	return service
+}
+
+// setPortServices exposes ports of compose services that are not served by the ingress.
+// Services are named after base and the protocol of ports, so TCP and UDP ports are supported by any load balancer.
+func (c *ComposeModel) setPortServices(base string, selector map[string]string, services types.Services, req *commons.PluginRequest) error {
+	ports := make(map[corev1.Protocol][]corev1.ServicePort)
+	for _, svc := range services {
+		httpPort := httpPortIndex(svc)
+		for i, p := range svc.Ports {
+			if i == httpPort {
+				continue
+			}
+			published, err := strconv.Atoi(p.Published)
+			if err != nil {
+				return errors.Wrapf(ErrParsingPublishedPort, "can't render port %s", p.Published)
+			}
+			protocol := portProtocol(p)
+			ports[protocol] = append(ports[protocol], corev1.ServicePort{
+				Name:       "port-" + strconv.Itoa(published),
+				Protocol:   protocol,
+				Port:       int32(published),
+				TargetPort: intstr.FromInt(int(p.Target)),
+			})
+		}
+	}
+
+	for protocol, servicePorts := range ports {
+		service := c.portService(base + "-" + strings.ToLower(string(protocol)))
+		service.SetName(base + "-" + strings.ToLower(string(protocol)))
+		service.SetNamespace(req.Namespace)
+		serviceLabels := labels(req.Name)
+		serviceLabels[portServiceLabel] = strings.ToLower(string(protocol))
+		service.SetLabels(serviceLabels)
+
+		// node ports are kept once they are allocated
+		nodePorts := make(map[string]int32, len(service.Spec.Ports))
+		for _, p := range service.Spec.Ports {
+			nodePorts[p.Name] = p.NodePort
+		}
+		sort.Slice(servicePorts, func(i, j int) bool {
+			return servicePorts[i].Port < servicePorts[j].Port
+		})
+		for i := range servicePorts {
+			servicePorts[i].NodePort = nodePorts[servicePorts[i].Name]
+		}
+
+		service.Spec.Selector = selector
+		service.Spec.Type = portServiceType(c.composeProject)
+		service.Spec.Ports = servicePorts
+		c.logger.Debug("set port service", "object", service)
+	}
+	return nil
+}
+
+// HTTPAccess returns true when any port of compose services is exposed with the ingress
+func (c *ComposeModel) HTTPAccess() bool {
+	for _, svc := range c.composeProject.Services {
+		if httpPortIndex(svc) != -1 {
+			return true
+		}
+	}
+	return false
+}
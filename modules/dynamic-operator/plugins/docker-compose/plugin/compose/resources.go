@@ -0,0 +1,125 @@
+package compose
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// composeResources converts the compose resource r to a resource list
+func composeResources(r *types.Resource) (corev1.ResourceList, error) {
+	if r == nil {
+		return nil, nil
+	}
+	list := make(corev1.ResourceList)
+	if r.NanoCPUs != "" {
+		cpu, err := resource.ParseQuantity(r.NanoCPUs)
+		if err != nil || cpu.Sign() <= 0 {
+			return nil, errors.Errorf("invalid cpus value `%s`", r.NanoCPUs)
+		}
+		list[corev1.ResourceCPU] = cpu
+	}
+	if r.MemoryBytes > 0 {
+		list[corev1.ResourceMemory] = *resource.NewQuantity(int64(r.MemoryBytes), resource.BinarySI)
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list, nil
+}
+
+// containerResources returns resources of the compose service svc set with the deploy section:
+//
+//	services:
+//	  web:
+//	    image: nginx
+//	    deploy:
+//	      resources:
+//	        limits:
+//	          cpus: "0.5"
+//	          memory: 256M
+//	        reservations:
+//	          memory: 128M
+func containerResources(svc types.ServiceConfig) (corev1.ResourceRequirements, error) {
+	if svc.Deploy == nil {
+		return corev1.ResourceRequirements{}, nil
+	}
+
+	limits, err := composeResources(svc.Deploy.Resources.Limits)
+	if err != nil {
+		return corev1.ResourceRequirements{}, errors.Wrapf(ErrResourcesDecodeFailed, "limits of service `%s`: %s", svc.Name, err)
+	}
+	requests, err := composeResources(svc.Deploy.Resources.Reservations)
+	if err != nil {
+		return corev1.ResourceRequirements{}, errors.Wrapf(ErrResourcesDecodeFailed, "reservations of service `%s`: %s", svc.Name, err)
+	}
+	for name, request := range requests {
+		if limit, set := limits[name]; set && request.Cmp(limit) > 0 {
+			return corev1.ResourceRequirements{}, errors.Wrapf(ErrResourcesDecodeFailed, "%s reservation of service `%s` is greater than its limit", name, svc.Name)
+		}
+	}
+	return corev1.ResourceRequirements{
+		Limits:   limits,
+		Requests: requests,
+	}, nil
+}
+
+// validateResources checks the deploy resources section of compose services
+func validateResources(p *types.Project) error {
+	for _, svc := range p.Services {
+		if _, err := containerResources(svc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateResources checks that resources of compose services running replicas fit in limits of the service.
+// Only resources set in limits are checked, resources of services that are not limited are not counted.
+func ValidateResources(p *types.Project, replicas int32, limits *corev1.ResourceList) error {
+	if limits == nil {
+		return nil
+	}
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		limit, set := (*limits)[name]
+		if !set || limit.IsZero() {
+			continue
+		}
+
+		total := resource.Quantity{}
+		var services []string
+		for _, svc := range p.Services {
+			resources, err := containerResources(svc)
+			if err != nil {
+				return err
+			}
+			value, found := resources.Limits[name]
+			if !found {
+				value, found = resources.Requests[name]
+			}
+			if !found {
+				continue
+			}
+
+			pod := p.Services
+			if splitMode(p) {
+				pod = types.Services{svc}
+			}
+			for i := int32(0); i < serviceReplicas(p, pod, replicas); i++ {
+				total.Add(value)
+			}
+			services = append(services, svc.Name)
+		}
+		if total.Cmp(limit) > 0 {
+			sort.Strings(services)
+			return errors.Wrapf(ErrResourcesExceedLimits, "%s of services `%s` (%s) exceeds the limit %s",
+				name, strings.Join(services, "`, `"), total.String(), limit.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package compose
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// statelessService returns true when the compose service svc sets the x-kuberlogic-stateless extension.
+// Stateless services are scaled to the number of service replicas:
+//
+//	services:
+//	  web:
+//	    image: nginx
+//	    x-kuberlogic-stateless: true
+func statelessService(svc types.ServiceConfig) bool {
+	stateless, _ := svc.Extensions[StatelessExtension].(bool)
+	return stateless
+}
+
+// volumeAccessMode returns an access mode of the compose volume source set with the x-kuberlogic-access-mode extension.
+// Volumes are ReadWriteOnce by default, ReadWriteMany volumes can be mounted by replicas on different nodes:
+//
+//	volumes:
+//	  uploads:
+//	    x-kuberlogic-access-mode: ReadWriteMany
+func volumeAccessMode(p *types.Project, source string) corev1.PersistentVolumeAccessMode {
+	if mode, _ := p.Volumes[source].Extensions[VolumeAccessModeExtension].(string); mode == string(corev1.ReadWriteMany) {
+		return corev1.ReadWriteMany
+	}
+	return corev1.ReadWriteOnce
+}
+
+// scalingBlockers returns reasons why pods running services can't be replicated
+func scalingBlockers(p *types.Project, services types.Services) []string {
+	var blockers []string
+	for _, svc := range services {
+		if !statelessService(svc) {
+			blockers = append(blockers, fmt.Sprintf("service `%s` is not marked with %s", svc.Name, StatelessExtension))
+			continue
+		}
+		for _, v := range svc.Volumes {
+			if volumeAccessMode(p, v.Source) != corev1.ReadWriteMany {
+				blockers = append(blockers, fmt.Sprintf("service `%s` mounts volume `%s` that is not %s", svc.Name, v.Source, corev1.ReadWriteMany))
+			}
+		}
+	}
+	return blockers
+}
+
+// validateStateless checks the x-kuberlogic-stateless and x-kuberlogic-access-mode extensions of the project p
+func validateStateless(p *types.Project) error {
+	for _, svc := range p.Services {
+		raw, set := svc.Extensions[StatelessExtension]
+		if !set {
+			continue
+		}
+		if _, converted := raw.(bool); !converted {
+			return errors.Wrapf(ErrStatelessDecodeFailed, "failed to decode parameter `%s` in service `%s`", StatelessExtension, svc.Name)
+		}
+		if statelessService(svc) && statefulService(svc) {
+			return errors.Wrapf(ErrStatelessDecodeFailed, "service `%s` can't be both stateless and stateful", svc.Name)
+		}
+	}
+	for name, v := range p.Volumes {
+		raw, set := v.Extensions[VolumeAccessModeExtension]
+		if !set {
+			continue
+		}
+		if mode, _ := raw.(string); mode != string(corev1.ReadWriteOnce) && mode != string(corev1.ReadWriteMany) {
+			return errors.Wrapf(ErrVolumeAccessModeDecodeFailed, "unsupported access mode `%v` of volume `%s`", raw, name)
+		}
+	}
+	return nil
+}
+
+// ValidateReplicas checks that compose services of the project p can be scaled to replicas.
+// All services must be stateless unless the split mode is enabled, then only stateless services are scaled.
+// One-shot services are run once by jobs.
+func ValidateReplicas(p *types.Project, replicas int32) error {
+	if replicas <= 1 {
+		return nil
+	}
+
+	services := workloadServices(p)
+	if splitMode(p) {
+		services = nil
+		for _, svc := range workloadServices(p) {
+			if statelessService(svc) {
+				services = append(services, svc)
+			}
+		}
+		if len(services) == 0 {
+			return errors.Wrapf(ErrScalingNotSupported, "no services are marked with %s", StatelessExtension)
+		}
+	}
+	if blockers := scalingBlockers(p, services); len(blockers) != 0 {
+		return errors.Wrap(ErrScalingNotSupported, strings.Join(blockers, ", "))
+	}
+	return nil
+}
+
+// serviceReplicas returns a number of replicas of pods running services of the project p, pods that can't be replicated run once
+func serviceReplicas(p *types.Project, services types.Services, replicas int32) int32 {
+	if len(scalingBlockers(p, services)) == 0 || replicas < 1 {
+		return replicas
+	}
+	return 1
+}
+
+// workloadReplicas returns a number of replicas of pods running services
+func (c *ComposeModel) workloadReplicas(services types.Services, req *commons.PluginRequest) int32 {
+	return serviceReplicas(c.composeProject, services, req.Replicas)
+}
+
+// setDeploymentStrategy rolls pods of services that don't block scaling, other deployments are recreated
+func (c *ComposeModel) setDeploymentStrategy(deployment *appsv1.Deployment, services types.Services) {
+	if len(scalingBlockers(c.composeProject, services)) == 0 {
+		deployment.Spec.Strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
+		return
+	}
+	deployment.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+	deployment.Spec.Strategy.RollingUpdate = nil
+}
+
+// setDisruptionBudget limits voluntary disruptions of the replicated deployment pods to a single pod at a time.
+// The budget is kept once it is created.
+func (c *ComposeModel) setDisruptionBudget(pdb *policyv1.PodDisruptionBudget, deployment *appsv1.Deployment) {
+	if pdb.GetName() == "" && *deployment.Spec.Replicas <= 1 {
+		return
+	}
+	pdb.SetName(deployment.GetName())
+	pdb.SetNamespace(deployment.GetNamespace())
+	pdb.SetLabels(deployment.GetLabels())
+
+	maxUnavailable := intstr.FromInt(1)
+	pdb.Spec.MaxUnavailable = &maxUnavailable
+	pdb.Spec.MinAvailable = nil
+	pdb.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: deployment.Spec.Selector.MatchLabels,
+	}
+	c.logger.Debug("set poddisruptionbudget", "object", pdb)
+}
@@ -0,0 +1,121 @@
+package compose
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// secretsChecksumAnnotation is set on the deployment pod template, pods are rolled when secrets are changed
+const secretsChecksumAnnotation = "kuberlogic.com/secrets-checksum"
+
+// secretDefinition is a key of the x-kuberlogic-secrets extension.
+// The key value is either a template or an object with the template and the key properties:
+//
+//	x-kuberlogic-secrets:
+//	  token: "{{ GenerateKey 16 }}"
+//	  session-key:
+//	    value: "{{ GenerateKey 30 }}"
+//	    visible: false
+//	    rotatable: true
+type secretDefinition struct {
+	id       string
+	template string
+	// visible secrets can be listed and revealed by users, true by default
+	visible bool
+	// rotatable secrets can be regenerated or set by users, false by default
+	rotatable bool
+}
+
+// secretDefinitions decodes the x-kuberlogic-secrets extension of the project p, definitions are sorted by id
+func secretDefinitions(p *types.Project) ([]secretDefinition, error) {
+	raw, set := p.Extensions[SecretsExtension]
+	if !set {
+		return nil, nil
+	}
+	secrets, converted := raw.(map[string]interface{})
+	if !converted {
+		return nil, errors.Wrapf(ErrSecretsDecodeFailed, "failed to decode parameter %s", SecretsExtension)
+	}
+
+	definitions := make([]secretDefinition, 0, len(secrets))
+	for k, v := range secrets {
+		def := secretDefinition{
+			id:      k,
+			visible: true,
+		}
+		switch value := v.(type) {
+		case string:
+			def.template = value
+		case map[string]interface{}:
+			for field, fieldValue := range value {
+				var ok bool
+				switch field {
+				case "value":
+					def.template, ok = fieldValue.(string)
+				case "visible":
+					def.visible, ok = fieldValue.(bool)
+				case "rotatable":
+					def.rotatable, ok = fieldValue.(bool)
+				default:
+					return nil, errors.Wrapf(ErrSecretsDecodeFailed, "unknown field `%s` of key `%s`", field, k)
+				}
+				if !ok {
+					return nil, errors.Wrapf(ErrSecretsDecodeFailed, "invalid type of field `%s` of key `%s`", field, k)
+				}
+			}
+			if def.template == "" {
+				return nil, errors.Wrapf(ErrSecretsDecodeFailed, "field `value` of key `%s` must be set", k)
+			}
+		default:
+			return nil, errors.Wrapf(ErrSecretsDecodeFailed, "it is expected that key `%s` value is string or object", k)
+		}
+		definitions = append(definitions, def)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].id < definitions[j].id
+	})
+	return definitions, nil
+}
+
+// Secrets declares secrets of the compose project for users
+func (c *ComposeModel) Secrets() (*commons.PluginSecrets, error) {
+	definitions, err := secretDefinitions(c.composeProject)
+	if err != nil {
+		return nil, err
+	}
+	secrets := &commons.PluginSecrets{
+		Name: c.secret.GetName(),
+	}
+	for _, def := range definitions {
+		secrets.Keys = append(secrets.Keys, commons.SecretKey{
+			ID:        def.id,
+			Visible:   def.visible,
+			Rotatable: def.rotatable,
+		})
+	}
+	return secrets, nil
+}
+
+// secretsChecksum returns a checksum of the secret data
+func secretsChecksum(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(data[k])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
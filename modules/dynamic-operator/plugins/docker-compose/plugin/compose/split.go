@@ -0,0 +1,230 @@
+package compose
+
+import (
+	"sort"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+// splitComponentLabel is set on pods of a compose service in the split mode
+const splitComponentLabel = "docker-compose.service/component"
+
+// splitMode returns true when the project p sets the x-kuberlogic-split extension.
+// Every compose service is run in its own deployment and is discovered by other services with DNS:
+//
+//	x-kuberlogic-split: true
+func splitMode(p *types.Project) bool {
+	split, _ := p.Extensions[SplitExtension].(bool)
+	return split
+}
+
+// validateSplit checks the x-kuberlogic-split extension of the project p.
+// Claims of volumes mounted by services that are not stateful can't be shared by pods of different deployments unless they are ReadWriteMany.
+func validateSplit(p *types.Project) error {
+	raw, set := p.Extensions[SplitExtension]
+	if !set {
+		return nil
+	}
+	split, converted := raw.(bool)
+	if !converted {
+		return errors.Wrapf(ErrSplitDecodeFailed, "failed to decode parameter %s", SplitExtension)
+	}
+	if !split {
+		return nil
+	}
+
+	mountedBy := make(map[string][]string)
+	for _, svc := range p.Services {
+		if statefulService(svc) {
+			continue
+		}
+		for _, v := range svc.Volumes {
+			services := mountedBy[v.Source]
+			if volumeAccessMode(p, v.Source) != corev1.ReadWriteMany && (len(services) == 0 || services[len(services)-1] != svc.Name) {
+				mountedBy[v.Source] = append(services, svc.Name)
+			}
+		}
+	}
+	volumes := make([]string, 0, len(mountedBy))
+	for source := range mountedBy {
+		volumes = append(volumes, source)
+	}
+	sort.Strings(volumes)
+	for _, source := range volumes {
+		if services := mountedBy[source]; len(services) > 1 {
+			sort.Strings(services)
+			return errors.Wrapf(ErrSplitSharedVolume, "services `%s` and `%s` mount volume `%s`", services[0], services[1], source)
+		}
+	}
+	return nil
+}
+
+// splitLabels returns labels of the compose service component pods of the kuberlogic service name
+func splitLabels(name, component string) map[string]string {
+	l := labels(name)
+	l[splitComponentLabel] = component
+	return l
+}
+
+// sortedServices returns compose services ordered by name
+func (c *ComposeModel) sortedServices() types.Services {
+	services := make(types.Services, len(c.composeProject.Services))
+	copy(services, c.composeProject.Services)
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	return services
+}
+
+// splitDeployment returns a deployment of the compose service name, the deployment is created when it is not found
+func (c *ComposeModel) splitDeployment(name string) *appsv1.Deployment {
+	deployment, found := c.splitDeployments[name]
+	if !found {
+		deployment = &appsv1.Deployment{}
+		c.splitDeployments[name] = deployment
+	}
+	return deployment
+}
+
+// splitStatefulSet returns a statefulset of the compose service name, the statefulset is created when it is not found
+func (c *ComposeModel) splitStatefulSet(name string) *appsv1.StatefulSet {
+	sts, found := c.splitStatefulSets[name]
+	if !found {
+		sts = &appsv1.StatefulSet{}
+		c.splitStatefulSets[name] = sts
+	}
+	return sts
+}
+
+// splitPDB returns a disruption budget of the compose service name, the budget is created when it is not found
+func (c *ComposeModel) splitPDB(name string) *policyv1.PodDisruptionBudget {
+	pdb, found := c.splitPDBs[name]
+	if !found {
+		pdb = &policyv1.PodDisruptionBudget{}
+		c.splitPDBs[name] = pdb
+	}
+	return pdb
+}
+
+// splitService returns a service of the compose service name, the service is created when it is not found
+func (c *ComposeModel) splitService(name string) *corev1.Service {
+	service, found := c.splitServices[name]
+	if !found {
+		service = &corev1.Service{}
+		c.splitServices[name] = service
+	}
+	return service
+}
+
+// setSplitApplicationObjects runs every compose service in its own deployment or statefulset
+func (c *ComposeModel) setSplitApplicationObjects(req *commons.PluginRequest) error {
+	// deployment of the previous installation is retired when the split mode is enabled
+	retireDeployment(c.deployment)
+
+	for _, composeService := range c.composeProject.Services {
+		podLabels := splitLabels(req.Name, composeService.Name)
+
+		if statefulService(composeService) {
+			legacy := []*appsv1.Deployment{c.deployment}
+			if deployment, found := c.splitDeployments[composeService.Name]; found {
+				retireDeployment(deployment)
+				if deployment.GetName() == "" {
+					delete(c.splitDeployments, composeService.Name)
+				} else {
+					legacy = append(legacy, deployment)
+				}
+			}
+			sts := c.splitStatefulSet(composeService.Name)
+			if err := c.setStatefulSet(sts, composeService.Name, composeService.Name, podLabels, types.Services{composeService},
+				legacy, req); err != nil {
+				return err
+			}
+			continue
+		}
+
+		deployment := c.splitDeployment(composeService.Name)
+		markVolumesMigrated(deployment, &deployment.Spec.Template, deploymentRolledOut(deployment))
+		deployment.SetName(composeService.Name)
+		deployment.SetNamespace(req.Namespace)
+		deployment.SetLabels(podLabels)
+
+		services := types.Services{composeService}
+		c.setDeploymentStrategy(deployment, services)
+		replicas := c.workloadReplicas(services, req)
+		deployment.Spec.Replicas = &replicas
+		deployment.Spec.Selector = &metav1.LabelSelector{
+			MatchLabels: podLabels,
+		}
+		deployment.Spec.Paused = false
+		if _, found := c.splitPDBs[composeService.Name]; found || replicas > 1 {
+			c.setDisruptionBudget(c.splitPDB(composeService.Name), deployment)
+		}
+		previous := deployment.Spec.Template.DeepCopy()
+		if err := c.setPodTemplate(&deployment.Spec.Template, podLabels, services, nil, req); err != nil {
+			return err
+		}
+		if !volumesMigrated(deployment) {
+			c.setMigration(&deployment.Spec.Template.Spec, c.sharedClaimSources(&deployment.Spec.Template.Spec))
+		}
+		c.holdWorkload(&deployment.Spec.Template, previous, deployment.Spec.Replicas, services, req)
+		c.logger.Debug("set deployment", "object", deployment)
+	}
+	return nil
+}
+
+// setSplitAccessObjects creates a headless service per compose service, so compose services resolve each other by name.
+// Published ports are listed by the services, HTTP ports are exposed with the ingress and other ports with port services.
+// Returned paths are ordered by the compose service name.
+func (c *ComposeModel) setSplitAccessObjects(req *commons.PluginRequest) ([]accessPath, error) {
+	paths := make([]accessPath, 0)
+	for _, composeService := range c.sortedServices() {
+		service := c.splitService(composeService.Name)
+		service.SetName(composeService.Name)
+		service.SetNamespace(req.Namespace)
+		service.SetLabels(labels(req.Name))
+
+		service.Spec.Selector = splitLabels(req.Name, composeService.Name)
+		service.Spec.Type = corev1.ServiceTypeClusterIP
+		service.Spec.ClusterIP = corev1.ClusterIPNone
+		service.Spec.Ports = []corev1.ServicePort{}
+		httpPort := httpPortIndex(composeService)
+		for i, p := range composeService.Ports {
+			target := intstr.FromInt(int(p.Target))
+			port := corev1.ServicePort{
+				Name:       "app-" + target.String(),
+				Protocol:   portProtocol(p),
+				Port:       target.IntVal,
+				TargetPort: target,
+			}
+			if port.Protocol == corev1.ProtocolUDP {
+				port.Name += "-udp"
+			}
+			service.Spec.Ports = append(service.Spec.Ports, port)
+
+			if i == httpPort {
+				paths = append(paths, accessPath{
+					path:    ingressPath(composeService),
+					service: service.GetName(),
+					port:    port.Name,
+				})
+			}
+		}
+		sort.Slice(service.Spec.Ports, func(i, j int) bool {
+			return service.Spec.Ports[i].Name < service.Spec.Ports[j].Name
+		})
+		c.logger.Debug("set service", "object", service)
+
+		if err := c.setPortServices(composeService.Name, splitLabels(req.Name, composeService.Name), types.Services{composeService}, req); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,252 @@
+package compose
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+const (
+	// legacyVolumeName is a pod volume of the shared volume claim mounted by the migration init container
+	legacyVolumeName = "legacy-volume"
+	// migrationContainerName is the init container copying data of legacy claims
+	migrationContainerName = "migrate-volumes"
+)
+
+// MigrationImage runs the init container copying data of legacy volume claims to claims of a pod.
+// The image is pinned, so pods are not rolled when the image is updated, it is set by the plugin configuration.
+var MigrationImage = "alpine:3.16.2"
+
+var invalidClaimNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// statefulService returns true when the compose service svc sets the x-kuberlogic-stateful extension.
+// Stateful services are run by statefulsets, each volume of the service gets its own volume claim:
+//
+//	services:
+//	  db:
+//	    image: postgres
+//	    x-kuberlogic-stateful: true
+func statefulService(svc types.ServiceConfig) bool {
+	stateful, _ := svc.Extensions[StatefulExtension].(bool)
+	return stateful
+}
+
+// statefulProject returns true when any service of the project p is stateful
+func statefulProject(p *types.Project) bool {
+	for _, svc := range p.Services {
+		if statefulService(svc) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateStateful checks the x-kuberlogic-stateful extension of compose services
+func validateStateful(p *types.Project) error {
+	for _, svc := range p.Services {
+		if raw, set := svc.Extensions[StatefulExtension]; set {
+			if _, converted := raw.(bool); !converted {
+				return errors.Wrapf(ErrStatefulDecodeFailed, "failed to decode parameter `%s` in service `%s`", StatefulExtension, svc.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// volumeSubPath returns a path of the compose service s volume v on a volume claim
+func volumeSubPath(v types.ServiceVolumeConfig, s *types.ServiceConfig) string {
+	return v.Source + "-" + s.Name
+}
+
+// volumeClaimName returns a name of the volume claim template for the compose volume source
+func volumeClaimName(source string) string {
+	return strings.Trim(invalidClaimNameChars.ReplaceAllString(strings.ToLower(source), "-"), "-")
+}
+
+// claimVolumeMounts returns volume mounts of the stateful compose service s, volume claim templates are added to claims
+func (c *ComposeModel) claimVolumeMounts(s *types.ServiceConfig, claims *[]corev1.PersistentVolumeClaim, req *commons.PluginRequest) ([]corev1.VolumeMount, error) {
+	volumeMounts := make([]corev1.VolumeMount, 0, len(s.Volumes))
+	for _, v := range s.Volumes {
+		name := volumeClaimName(v.Source)
+
+		var found bool
+		for _, claim := range *claims {
+			if claim.GetName() == name {
+				found = true
+			}
+		}
+		if !found {
+			spec, err := volumeClaimSpec(c.composeProject, v.Source, req)
+			if err != nil {
+				return nil, err
+			}
+			claimLabels := labels(req.Name)
+			claimLabels[volumeLabel] = name
+			*claims = append(*claims, corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: claimLabels,
+				},
+				Spec: spec,
+			})
+		}
+
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: v.Target,
+			SubPath:   volumeSubPath(v, s),
+		})
+	}
+	return volumeMounts, nil
+}
+
+// setStatefulSet runs compose services in the statefulset sts governed by the service serviceName.
+// Pods are not started until legacy deployments are scaled down,
+// then data of the shared volume claim of the previous installation is copied to the statefulset claims.
+func (c *ComposeModel) setStatefulSet(sts *appsv1.StatefulSet, name, serviceName string, podLabels map[string]string, services types.Services,
+	legacy []*appsv1.Deployment, req *commons.PluginRequest) error {
+	markVolumesMigrated(sts, &sts.Spec.Template, statefulSetRolledOut(sts))
+	sts.SetName(name)
+	sts.SetNamespace(req.Namespace)
+	sts.SetLabels(podLabels)
+
+	replicas := c.workloadReplicas(services, req)
+	for _, deployment := range legacy {
+		if deployment.GetName() != "" && deployment.Status.Replicas != 0 {
+			replicas = 0
+		}
+	}
+	sts.Spec.Replicas = &replicas
+	sts.Spec.ServiceName = serviceName
+	sts.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: podLabels,
+	}
+
+	previous := sts.Spec.Template.DeepCopy()
+	claims := make([]corev1.PersistentVolumeClaim, 0)
+	if err := c.setPodTemplate(&sts.Spec.Template, podLabels, services, &claims, req); err != nil {
+		return err
+	}
+	// volume claim templates can't be changed once the statefulset is created, claims of replicas are expanded
+	if len(sts.Spec.VolumeClaimTemplates) == 0 {
+		sts.Spec.VolumeClaimTemplates = claims
+	}
+
+	// data of claims of compose volumes kept by deployments or of the shared claim is migrated until it is copied by all replicas
+	if !volumesMigrated(sts) {
+		sources := make(map[string]string, len(claims))
+		for _, claim := range claims {
+			if volumeClaim, found := c.volumeClaims[volumeClaimObjectName(req.Name, claim.GetName())]; found && volumeClaim.GetName() != "" {
+				sources[claim.GetName()] = volumeClaim.GetName()
+			} else if c.persistentvolumeclaim.GetName() != "" {
+				sources[claim.GetName()] = c.persistentvolumeclaim.GetName()
+			}
+		}
+		c.setMigration(&sts.Spec.Template.Spec, sources)
+	}
+	c.holdWorkload(&sts.Spec.Template, previous, sts.Spec.Replicas, services, req)
+	c.setReplicaClaims(sts, claims, req)
+	c.logger.Debug("set statefulset", "object", sts)
+	return nil
+}
+
+// setReplicaClaims sets claims of replicas of the statefulset sts created from the volume claim templates claims.
+// Templates can't be changed once the statefulset is created, so claims of replicas are expanded instead.
+// Claims of replicas found in the cluster are kept when the statefulset is scaled down.
+func (c *ComposeModel) setReplicaClaims(sts *appsv1.StatefulSet, claims []corev1.PersistentVolumeClaim, req *commons.PluginRequest) {
+	for _, template := range claims {
+		names := make(map[string]bool)
+		for ordinal := int32(0); ordinal < *sts.Spec.Replicas; ordinal++ {
+			names[replicaClaimName(template.GetName(), sts.GetName(), ordinal)] = true
+		}
+		replicaClaim := regexp.MustCompile("^" + regexp.QuoteMeta(template.GetName()+"-"+sts.GetName()) + "-[0-9]+$")
+		for name, claim := range c.volumeClaims {
+			if claim.GetName() != "" && replicaClaim.MatchString(name) {
+				names[name] = true
+			}
+		}
+
+		for name := range names {
+			claim := c.volumeClaim(name)
+			claim.SetName(name)
+			claim.SetNamespace(req.Namespace)
+			claimLabels := claim.GetLabels()
+			if claimLabels == nil {
+				claimLabels = make(map[string]string)
+			}
+			for k, v := range template.GetLabels() {
+				claimLabels[k] = v
+			}
+			claim.SetLabels(claimLabels)
+			expandClaim(claim, template.Spec)
+			c.logger.Debug("set replica volume claim", "object", claim)
+		}
+	}
+}
+
+// replicaClaimName returns a name of the claim created from the volume claim template for the statefulset replica ordinal
+func replicaClaimName(template, sts string, ordinal int32) string {
+	return fmt.Sprintf("%s-%s-%d", template, sts, ordinal)
+}
+
+// migrationContainer copies directories of containers volumes from legacy claims mounted with legacyMounts to volume claims.
+// sources maps volume claims to paths of legacy claims keeping their data.
+// Every directory is copied once, a marker file is kept in the root of the claim.
+func migrationContainer(containers []corev1.Container, sources map[string]string, legacyMounts []corev1.VolumeMount) corev1.Container {
+	container := corev1.Container{
+		Name:         migrationContainerName,
+		Image:        MigrationImage,
+		VolumeMounts: legacyMounts,
+	}
+	claimNames := make([]string, 0, len(sources))
+	for name := range sources {
+		claimNames = append(claimNames, name)
+	}
+	sort.Strings(claimNames)
+	for _, name := range claimNames {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: "/volumes/" + name,
+		})
+	}
+
+	var script []string
+	for _, appContainer := range containers {
+		for _, m := range appContainer.VolumeMounts {
+			legacyPath, found := sources[m.Name]
+			if !found {
+				continue
+			}
+			source, target := legacyPath+"/"+m.SubPath, "/volumes/"+m.Name+"/"+m.SubPath
+			marker := "/volumes/" + m.Name + "/.kuberlogic-migrated-" + m.SubPath
+			script = append(script, fmt.Sprintf("if [ -d %s ] && [ ! -e %s ]; then mkdir -p %s && cp -a %s/. %s/ && touch %s; fi",
+				source, marker, target, source, target, marker))
+		}
+	}
+	sort.Strings(script)
+	container.Command = []string{"sh", "-ec", strings.Join(script, "\n")}
+	return container
+}
+
+// retireDeployment stops pods of the legacy deployment found in the cluster.
+// The deployment is not returned once its pods are stopped, so it is deleted.
+func retireDeployment(deployment *appsv1.Deployment) {
+	if deployment.GetName() == "" {
+		return
+	}
+	if deployment.Status.Replicas == 0 {
+		*deployment = appsv1.Deployment{}
+		return
+	}
+	replicas := int32(0)
+	deployment.Spec.Replicas = &replicas
+}
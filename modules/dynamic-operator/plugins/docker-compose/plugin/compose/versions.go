@@ -0,0 +1,133 @@
+package compose
+
+import (
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+)
+
+// versionsDefinition is the value of the x-kuberlogic-versions extension.
+// Supported versions are ordered from the oldest to the newest, the newest version is the default unless it is set.
+// Services can't be downgraded unless downgrade is set, sequential upgrades don't skip supported versions:
+//
+//	x-kuberlogic-versions:
+//	  supported: ["1.9", "2.0", "2.1"]
+//	  default: "2.0"
+//	  downgrade: false
+//	  sequential: true
+type versionsDefinition struct {
+	supported      []string
+	defaultVersion string
+	downgrade      bool
+	sequential     bool
+}
+
+// versionsDefinitionOf decodes the x-kuberlogic-versions extension of the project p, nil is returned when it is not set
+func versionsDefinitionOf(p *types.Project) (*versionsDefinition, error) {
+	raw, set := p.Extensions[VersionsExtension]
+	if !set {
+		return nil, nil
+	}
+	fields, converted := raw.(map[string]interface{})
+	if !converted {
+		return nil, errors.Wrapf(ErrVersionsDecodeFailed, "failed to decode parameter %s", VersionsExtension)
+	}
+
+	def := &versionsDefinition{}
+	for field, fieldValue := range fields {
+		var ok bool
+		switch field {
+		case "supported":
+			def.supported, ok = supportedVersions(fieldValue)
+		case "default":
+			def.defaultVersion, ok = fieldValue.(string)
+		case "downgrade":
+			def.downgrade, ok = fieldValue.(bool)
+		case "sequential":
+			def.sequential, ok = fieldValue.(bool)
+		default:
+			return nil, errors.Wrapf(ErrVersionsDecodeFailed, "unknown field `%s`", field)
+		}
+		if !ok {
+			return nil, errors.Wrapf(ErrVersionsDecodeFailed, "invalid type of field `%s`", field)
+		}
+	}
+
+	if len(def.supported) == 0 {
+		return nil, errors.Wrap(ErrVersionsDecodeFailed, "supported versions are not set")
+	}
+	if def.defaultVersion == "" {
+		def.defaultVersion = def.supported[len(def.supported)-1]
+	}
+	if def.index(def.defaultVersion) == -1 {
+		return nil, errors.Wrapf(ErrVersionsDecodeFailed, "default version `%s` is not supported", def.defaultVersion)
+	}
+	return def, nil
+}
+
+// supportedVersions converts the list of supported versions, versions must be unique non-empty strings
+func supportedVersions(raw interface{}) ([]string, bool) {
+	list, converted := raw.([]interface{})
+	if !converted {
+		return nil, false
+	}
+	versions := make([]string, 0, len(list))
+	seen := make(map[string]bool, len(list))
+	for _, item := range list {
+		version, converted := item.(string)
+		if !converted || version == "" || seen[version] {
+			return nil, false
+		}
+		seen[version] = true
+		versions = append(versions, version)
+	}
+	return versions, true
+}
+
+// index returns the position of the version in the list of supported versions or -1 when it is not supported
+func (v *versionsDefinition) index(version string) int {
+	for i, supported := range v.supported {
+		if supported == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// Versions returns the default version and the list of supported versions of the project p.
+// Both are empty when the x-kuberlogic-versions extension is not set, any version is accepted then.
+func Versions(p *types.Project) (string, []string, error) {
+	def, err := versionsDefinitionOf(p)
+	if err != nil || def == nil {
+		return "", nil, err
+	}
+	return def.defaultVersion, def.supported, nil
+}
+
+// ValidateVersion checks that the version is supported by the project p and the change from the previous version is allowed.
+// The previous version is empty when the service is created, services that run a version that is not supported anymore
+// can keep it or can be upgraded to any supported version.
+func ValidateVersion(p *types.Project, version, previous string) error {
+	def, err := versionsDefinitionOf(p)
+	if err != nil || def == nil {
+		return err
+	}
+	if version == previous && previous != "" {
+		return nil
+	}
+
+	target := def.index(version)
+	if target == -1 {
+		return errors.Wrapf(ErrVersionNotSupported, "version `%s` is not supported, use one of %v", version, def.supported)
+	}
+	current := def.index(previous)
+	if current == -1 {
+		return nil
+	}
+	if target < current && !def.downgrade {
+		return errors.Wrapf(ErrVersionNotSupported, "downgrade from version `%s` to `%s` is not allowed", previous, version)
+	}
+	if target > current+1 && def.sequential {
+		return errors.Wrapf(ErrVersionNotSupported, "upgrade from version `%s` must go to version `%s` first", previous, def.supported[current+1])
+	}
+	return nil
+}
@@ -0,0 +1,378 @@
+package compose
+
+import (
+	"sort"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kuberlogic/kuberlogic/modules/dynamic-operator/plugin/commons"
+)
+
+const (
+	// volumeLabel is set on claims of compose volumes, the value is the claim name of the volume
+	volumeLabel = "docker-compose.service/volume"
+	// migratedAnnotation is set on workloads whose pods copied data of legacy claims, legacy claims are not mounted by them anymore
+	migratedAnnotation = "docker-compose.service/volumes-migrated"
+)
+
+// volumeSize returns the storage of the compose volume source set with the x-kuberlogic-size extension,
+// the storage limit of the service is used when the extension is not set:
+//
+//	volumes:
+//	  db-data:
+//	    x-kuberlogic-size: 10Gi
+//	    x-kuberlogic-storage-class: fast
+func volumeSize(p *types.Project, source string, limits *corev1.ResourceList) (resource.Quantity, error) {
+	raw, set := p.Volumes[source].Extensions[VolumeSizeExtension]
+	if !set {
+		if limits == nil {
+			return resource.Quantity{}, nil
+		}
+		return *limits.Storage(), nil
+	}
+	value, _ := raw.(string)
+	size, err := resource.ParseQuantity(value)
+	if err != nil || size.Sign() <= 0 {
+		return resource.Quantity{}, errors.Wrapf(ErrVolumeDecodeFailed, "`%s` of volume `%s` must be a storage quantity", VolumeSizeExtension, source)
+	}
+	return size, nil
+}
+
+// volumeStorageClass returns the storage class of the compose volume source, the storage class of the service is used by default
+func volumeStorageClass(p *types.Project, source string, req *commons.PluginRequest) *string {
+	if class, _ := p.Volumes[source].Extensions[VolumeStorageClassExtension].(string); class != "" {
+		return &class
+	}
+	if req.StorageClass != "" {
+		return &req.StorageClass
+	}
+	return nil
+}
+
+// volumeClaimSpec returns the claim spec of the compose volume source
+func volumeClaimSpec(p *types.Project, source string, req *commons.PluginRequest) (corev1.PersistentVolumeClaimSpec, error) {
+	limits, err := req.GetLimits()
+	if err != nil {
+		return corev1.PersistentVolumeClaimSpec{}, errors.Wrap(err, "failed to get limits")
+	}
+	size, err := volumeSize(p, source, limits)
+	if err != nil {
+		return corev1.PersistentVolumeClaimSpec{}, err
+	}
+
+	spec := corev1.PersistentVolumeClaimSpec{
+		AccessModes: []corev1.PersistentVolumeAccessMode{
+			volumeAccessMode(p, source),
+		},
+		StorageClassName: volumeStorageClass(p, source, req),
+	}
+	if !size.IsZero() {
+		spec.Resources = corev1.ResourceRequirements{
+			Requests: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceStorage: size,
+			},
+		}
+	}
+	return spec, nil
+}
+
+// volumeClaimObjectName returns a name of the claim of the compose volume source of the kuberlogic service name
+func volumeClaimObjectName(name, source string) string {
+	return name + "-" + volumeClaimName(source)
+}
+
+// validateVolumes checks volumes of compose services and extensions of compose volumes
+func validateVolumes(p *types.Project) error {
+	for _, svc := range p.Services {
+		for _, v := range svc.Volumes {
+			switch v.Type {
+			case "", types.VolumeTypeVolume:
+			case types.VolumeTypeTmpfs:
+				return errors.Wrapf(ErrUnsupportedField, "tmpfs volume `%s` of service `%s`, use tmpfs", v.Target, svc.Name)
+			default:
+				return errors.Wrapf(ErrUnsupportedField, "%s mount `%s` of service `%s`, use a named volume", v.Type, v.Source, svc.Name)
+			}
+			if volumeClaimName(v.Source) == "" {
+				return errors.Wrapf(ErrVolumeDecodeFailed, "volume `%s` of service `%s` must be named", v.Target, svc.Name)
+			}
+		}
+	}
+
+	for name, v := range p.Volumes {
+		if _, err := volumeSize(p, name, nil); err != nil {
+			return err
+		}
+		if raw, set := v.Extensions[VolumeStorageClassExtension]; set {
+			if class, _ := raw.(string); class == "" {
+				return errors.Wrapf(ErrVolumeDecodeFailed, "`%s` of volume `%s` must be a storage class name", VolumeStorageClassExtension, name)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateVolumes checks that claims of compose volumes found in objects are not downsized with limits
+func ValidateVolumes(p *types.Project, limits *corev1.ResourceList, objects []*unstructured.Unstructured) error {
+	sources := make(map[string]string, len(p.Volumes))
+	for _, svc := range p.Services {
+		for _, v := range svc.Volumes {
+			sources[volumeClaimName(v.Source)] = v.Source
+		}
+	}
+
+	for _, obj := range objects {
+		source, found := sources[obj.GetLabels()[volumeLabel]]
+		if obj.GetKind() != pvcGVK.Kind || !found {
+			continue
+		}
+		claim := &corev1.PersistentVolumeClaim{}
+		if err := commons.FromUnstructured(obj.UnstructuredContent(), claim); err != nil {
+			return errors.Wrapf(err, "error marshaling %s", obj.GetKind())
+		}
+
+		size, err := volumeSize(p, source, limits)
+		if err != nil {
+			return err
+		}
+		current := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+		if !size.IsZero() && size.Cmp(current) < 0 {
+			return errors.Wrapf(ErrVolumeDownsizeForbidden, "volume `%s` can't be resized from %s to %s", source, current.String(), size.String())
+		}
+	}
+	return nil
+}
+
+// volumeClaim returns a claim of a compose volume by the name, the claim is created when it is not found
+func (c *ComposeModel) volumeClaim(name string) *corev1.PersistentVolumeClaim {
+	claim, found := c.volumeClaims[name]
+	if !found {
+		claim = &corev1.PersistentVolumeClaim{}
+		c.volumeClaims[name] = claim
+	}
+	return claim
+}
+
+// sortedVolumeClaims returns claims of compose volumes ordered by name
+func (c *ComposeModel) sortedVolumeClaims() []*corev1.PersistentVolumeClaim {
+	claims := make([]*corev1.PersistentVolumeClaim, 0, len(c.volumeClaims))
+	for _, claim := range c.volumeClaims {
+		claims = append(claims, claim)
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].GetName() < claims[j].GetName()
+	})
+	return claims
+}
+
+// setVolumeClaim sets the claim of the compose volume source.
+// Access modes and the storage class can't be changed once the claim is created, claims are only expanded.
+func (c *ComposeModel) setVolumeClaim(source string, req *commons.PluginRequest) (*corev1.PersistentVolumeClaim, error) {
+	spec, err := volumeClaimSpec(c.composeProject, source, req)
+	if err != nil {
+		return nil, err
+	}
+
+	name := volumeClaimObjectName(req.Name, source)
+	claim := c.volumeClaim(name)
+	claim.SetName(name)
+	claim.SetNamespace(req.Namespace)
+	claimLabels := labels(req.Name)
+	claimLabels[volumeLabel] = volumeClaimName(source)
+	claim.SetLabels(claimLabels)
+	expandClaim(claim, spec)
+	c.logger.Debug("set volume claim", "object", claim)
+	return claim, nil
+}
+
+// expandClaim sets the claim spec of a new claim, claims found in the cluster are only expanded to the size of spec
+func expandClaim(claim *corev1.PersistentVolumeClaim, spec corev1.PersistentVolumeClaimSpec) {
+	if len(claim.Spec.AccessModes) == 0 {
+		claim.Spec.AccessModes = spec.AccessModes
+	}
+	if claim.Spec.StorageClassName == nil {
+		claim.Spec.StorageClassName = spec.StorageClassName
+	}
+	size, current := spec.Resources.Requests[corev1.ResourceStorage], claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	if size.Cmp(current) > 0 {
+		claim.Spec.Resources.Requests = spec.Resources.Requests
+	}
+}
+
+// volumeClaimMounts returns volume mounts of the compose service s, claims of volumes are added to podSpec
+func (c *ComposeModel) volumeClaimMounts(s *types.ServiceConfig, podSpec *corev1.PodSpec, req *commons.PluginRequest) ([]corev1.VolumeMount, error) {
+	volumeMounts := make([]corev1.VolumeMount, 0, len(s.Volumes))
+	for _, v := range s.Volumes {
+		claim, err := c.setVolumeClaim(v.Source, req)
+		if err != nil {
+			return nil, err
+		}
+
+		var found bool
+		for _, volume := range podSpec.Volumes {
+			if volume.Name == claim.GetName() {
+				found = true
+			}
+		}
+		if !found {
+			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+				Name: claim.GetName(),
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claim.GetName(),
+					},
+				},
+			})
+		}
+
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      claim.GetName(),
+			MountPath: v.Target,
+			SubPath:   volumeSubPath(v, s),
+		})
+	}
+	return volumeMounts, nil
+}
+
+// sharedClaimSources returns the shared claim of the previous installation as the legacy claim of compose volumes claims mounted by the pod podSpec
+func (c *ComposeModel) sharedClaimSources(podSpec *corev1.PodSpec) map[string]string {
+	if c.persistentvolumeclaim.GetName() == "" {
+		return nil
+	}
+	sources := make(map[string]string)
+	for _, volume := range podSpec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		if _, found := c.volumeClaims[volume.PersistentVolumeClaim.ClaimName]; found {
+			sources[volume.Name] = c.persistentvolumeclaim.GetName()
+		}
+	}
+	return sources
+}
+
+// retireSharedClaim drops the shared claim of the previous installation once it is not mounted by pods of workloads,
+// data of the claim is copied to claims of compose volumes then. The claim is not returned, so it is deleted.
+func (c *ComposeModel) retireSharedClaim() {
+	name := c.persistentvolumeclaim.GetName()
+	if name == "" {
+		return
+	}
+	var templates []corev1.PodTemplateSpec
+	for _, deployment := range append(c.deployments(), c.legacyDeployments()...) {
+		templates = append(templates, deployment.Spec.Template)
+	}
+	for _, sts := range c.statefulSets() {
+		templates = append(templates, sts.Spec.Template)
+	}
+	for _, template := range templates {
+		for _, volume := range template.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == name {
+				return
+			}
+		}
+	}
+	*c.persistentvolumeclaim = corev1.PersistentVolumeClaim{}
+}
+
+// setMigration mounts legacy claims to the pod podSpec, data of containers volumes is copied to claims before the pod is started.
+// sources maps pod volumes of claims to legacy claims keeping their data, the shared claim of the previous installation is mounted to /legacy.
+func (c *ComposeModel) setMigration(podSpec *corev1.PodSpec, sources map[string]string) {
+	if len(sources) == 0 {
+		return
+	}
+
+	legacyClaims := make([]string, 0, len(sources))
+	for _, legacy := range sources {
+		legacyClaims = append(legacyClaims, legacy)
+	}
+	sort.Strings(legacyClaims)
+
+	paths := make(map[string]string, len(sources))
+	var mounts []corev1.VolumeMount
+	for i, legacy := range legacyClaims {
+		if i > 0 && legacyClaims[i-1] == legacy {
+			continue
+		}
+		volumeName, path := "legacy-"+legacy, "/legacy-volumes/"+legacy
+		if legacy == c.persistentvolumeclaim.GetName() {
+			volumeName, path = legacyVolumeName, "/legacy"
+		}
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: legacy,
+					ReadOnly:  true,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: path,
+			ReadOnly:  true,
+		})
+		for claim, source := range sources {
+			if source == legacy {
+				paths[claim] = path
+			}
+		}
+	}
+	// data is migrated before init containers waiting for dependencies are run
+	podSpec.InitContainers = append([]corev1.Container{
+		migrationContainer(podSpec.Containers, paths, mounts),
+	}, podSpec.InitContainers...)
+}
+
+// markVolumesMigrated annotates the workload obj found in the cluster once all its replicas are rolled out
+// with the migration init container in the pod template, data of legacy claims is copied by them then.
+func markVolumesMigrated(obj metav1.Object, template *corev1.PodTemplateSpec, rolledOut bool) {
+	if obj.GetName() == "" || !rolledOut {
+		return
+	}
+	var migrating bool
+	for _, container := range template.Spec.InitContainers {
+		if container.Name == migrationContainerName {
+			migrating = true
+		}
+	}
+	if !migrating {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[migratedAnnotation] = "true"
+	obj.SetAnnotations(annotations)
+}
+
+// volumesMigrated returns true when pods of the workload obj copied data of legacy claims
+func volumesMigrated(obj metav1.Object) bool {
+	return obj.GetAnnotations()[migratedAnnotation] == "true"
+}
+
+// deploymentRolledOut returns true when all desired replicas of the deployment are updated and ready
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas == 0 {
+		return false
+	}
+	replicas, status := *deployment.Spec.Replicas, deployment.Status
+	return status.ObservedGeneration >= deployment.GetGeneration() &&
+		status.Replicas == replicas && status.UpdatedReplicas == replicas && status.ReadyReplicas == replicas
+}
+
+// statefulSetRolledOut returns true when all desired replicas of the statefulset are updated and ready
+func statefulSetRolledOut(sts *appsv1.StatefulSet) bool {
+	if sts.Spec.Replicas == nil || *sts.Spec.Replicas == 0 {
+		return false
+	}
+	replicas, status := *sts.Spec.Replicas, sts.Status
+	return status.ObservedGeneration >= sts.GetGeneration() &&
+		status.Replicas == replicas && status.UpdatedReplicas == replicas && status.ReadyReplicas == replicas
+}